@@ -1,7 +1,12 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -22,6 +27,95 @@ type VariantAutoscalingSpec struct {
 	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
 	// +kubebuilder:default="10.0"
 	VariantCost string `json:"variantCost,omitempty"`
+
+	// Cost specifies the cost per replica for this variant as a Kubernetes quantity with an
+	// optional unit. When set it takes precedence over VariantCost.
+	// +kubebuilder:validation:Optional
+	Cost *VariantCostSpec `json:"cost,omitempty"`
+
+	// MaxScaleUpRate caps how many replicas this variant may add per minute,
+	// independent of how often the optimization loop runs.
+	// When unset, scale-up is not rate limited.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxScaleUpRate *int32 `json:"maxScaleUpRate,omitempty"`
+
+	// MaxScaleDownRate caps how many replicas this variant may remove per minute,
+	// so capacity is drained gradually even when removing more at once looks safe.
+	// When unset, scale-down is not rate limited.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxScaleDownRate *int32 `json:"maxScaleDownRate,omitempty"`
+
+	// ScaleDownFloor is the fewest replicas saturation-based scale-down may leave this variant
+	// with, however safe removing a replica looks. It does not scale a variant up that is
+	// already below it. When unset, scale-down stops at 1 replica.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ScaleDownFloor *int32 `json:"scaleDownFloor,omitempty"`
+
+	// TargetKvUtilization is the KV cache utilization (0.0-1.0) to keep replicas around,
+	// e.g. "0.7" for ~70%. When set, the scale-up trigger is derived as
+	// kvCacheThreshold - targetKvUtilization instead of using kvSpareTrigger from the
+	// saturation scaling config. Must not exceed kvCacheThreshold.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(0(\.\d+)?|1(\.0+)?)$`
+	TargetKvUtilization string `json:"targetKvUtilization,omitempty"`
+
+	// ScaleToZero overrides the scale-to-zero ConfigMap for this variant's model.
+	// +kubebuilder:validation:Optional
+	ScaleToZero *ScaleToZeroSpec `json:"scaleToZero,omitempty"`
+
+	// LinkedScaleTargets are helper Deployments of the variant, e.g. a router, that scale in
+	// proportion to it. Replica metrics are emitted for each of them with the variant's desired
+	// replicas times the target's ratio.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=map
+	// +listMapKey=name
+	LinkedScaleTargets []LinkedScaleTarget `json:"linkedScaleTargets,omitempty"`
+}
+
+// LinkedScaleTarget is a Deployment scaled in proportion to a variant.
+type LinkedScaleTarget struct {
+	// Name is the name of the linked Deployment, in the VariantAutoscaling's namespace.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Ratio is the number of linked replicas per replica of the variant, e.g. "0.5" for one
+	// router per two model server replicas. The linked desired replicas are rounded up.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	Ratio string `json:"ratio"`
+}
+
+// ScaleToZeroSpec configures scale-to-zero for a variant's model. Fields that are unset
+// fall back to the model's entry in the scale-to-zero ConfigMap, then to its defaults.
+// Scale-to-zero applies to all variants of a model, so when they disagree a variant that
+// disables it wins over one that enables it, and the longest retention period wins.
+type ScaleToZeroSpec struct {
+	// Enabled turns scaling the model to zero replicas when it receives no requests on or off.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RetentionPeriod is how long the model must receive no requests before it is scaled
+	// to zero, as a duration, e.g. "15m".
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|ms|s|m|h))+$`
+	RetentionPeriod string `json:"retentionPeriod,omitempty"`
+}
+
+// VariantCostSpec expresses the cost per replica of a variant.
+type VariantCostSpec struct {
+	// PerReplica is the cost of one replica as a Kubernetes quantity, e.g. "12.5" or "12500m".
+	// +kubebuilder:validation:Required
+	PerReplica resource.Quantity `json:"perReplica"`
+
+	// Unit names what the cost is measured in, e.g. "USD/hour". It is informational only;
+	// variants of a model should express their costs in the same unit.
+	// +kubebuilder:validation:Optional
+	Unit string `json:"unit,omitempty"`
 }
 
 // VariantAutoscalingStatus represents the current status of autoscaling for a variant,
@@ -55,6 +149,17 @@ type OptimizedAlloc struct {
 	// NumReplicas is the number of replicas for the optimized allocation.
 	// +kubebuilder:validation:Minimum=0
 	NumReplicas int `json:"numReplicas"`
+
+	// ReasonCode is a machine-readable code explaining why NumReplicas was chosen
+	// (e.g. KvSpareLow, QueueSpareLow, ScaleDownSafe, PendingGuard, Preserved, Steady).
+	// +optional
+	ReasonCode string `json:"reasonCode,omitempty"`
+
+	// Confidence is how far NumReplicas can be trusted given the coverage and freshness of the
+	// variant's metrics, from "0.00" (no usable metrics) to "1.00" (every replica reporting fresh
+	// metrics). Consumers may choose to ignore low-confidence recommendations.
+	// +optional
+	Confidence string `json:"confidence,omitempty"`
 }
 
 // ActuationStatus provides details about the actuation process and its current status.
@@ -99,6 +204,21 @@ func init() {
 	SchemeBuilder.Register(&VariantAutoscaling{}, &VariantAutoscalingList{})
 }
 
+// SaturationAnalysisAnnotation holds the JSON-serialized saturation analysis of the VA's model.
+// It is set on one VA per model, and only when the controller exports the analysis.
+const SaturationAnalysisAnnotation = "wva.llmd.ai/saturation-analysis"
+
+// PreviewAnnotation puts a VA into preview mode when set to "true": its next decision is computed
+// and published in PreviewDecisionAnnotation, but its desired replicas are left unchanged.
+const PreviewAnnotation = "wva.llmd.ai/preview"
+
+// PreviewDecisionAnnotation holds the JSON-serialized decision that would be applied to a VA in preview mode.
+const PreviewDecisionAnnotation = "wva.llmd.ai/preview-decision"
+
+// LastScaleReasonAnnotation holds the reason of the latest decision applied to a VA. It is set on
+// the VA's scale target Deployment for `kubectl describe`, and only when the controller is configured to.
+const LastScaleReasonAnnotation = "wva.llmd.ai/last-scale-reason"
+
 // Condition Types for VariantAutoscaling
 const (
 	// TypeTargetResolved indicates whether the target model variant has been resolved successfully
@@ -107,6 +227,41 @@ const (
 	TypeMetricsAvailable = "MetricsAvailable"
 	// TypeOptimizationReady indicates whether the optimization engine can run successfully
 	TypeOptimizationReady = "OptimizationReady"
+	// TypePartialMetrics indicates whether too few replicas are reporting metrics to act on
+	TypePartialMetrics = "PartialMetrics"
+	// TypeActuated indicates whether the scale target's replicas match the desired replicas,
+	// i.e. whether the recommendation has been acted on (e.g. by HPA)
+	TypeActuated = "Actuated"
+	// TypeElevatedErrorRate indicates whether the model's HTTP error rate is high enough that
+	// scale-down is blocked
+	TypeElevatedErrorRate = "ElevatedErrorRate"
+	// TypeRecoveredFromZero indicates that the scale target was found with zero replicas although
+	// scale-to-zero is disabled for the model, and was scaled back to its minimum
+	TypeRecoveredFromZero = "RecoveredFromZero"
+	// TypeStuckPending indicates whether the variant's pending replicas have been pending longer
+	// than the configured maximum pending age, so they no longer hold back the model's scaling
+	TypeStuckPending = "StuckPending"
+	// TypeScaleTargetDeleted indicates whether the scale target was deleted after it had been
+	// resolved, so the VA's replica metrics were removed
+	TypeScaleTargetDeleted = "ScaleTargetDeleted"
+	// TypeNoInventory indicates whether the GPU limiter found no accelerator inventory in the
+	// cluster, so the variant's scaling decisions were not limited by available GPUs
+	TypeNoInventory = "NoInventory"
+	// TypeObserving indicates whether the model is still being observed after the controller
+	// started, so its variants are held at their current replicas
+	TypeObserving = "Observing"
+	// TypeMetricLabelDrift indicates whether the model's metric series are missing the labels
+	// identifying their pods, so they cannot be attributed to replicas
+	TypeMetricLabelDrift = "MetricLabelDrift"
+	// TypeMaintenanceHold indicates whether a maintenance window is active, so the variant's
+	// desired replicas are held and no scale changes are made
+	TypeMaintenanceHold = "MaintenanceHold"
+	// TypeFlapping indicates whether the variant's desired replicas reversed direction more
+	// often within the flap window than the configured threshold, a sign of misconfigured triggers
+	TypeFlapping = "Flapping"
+	// TypeDeploymentPaused indicates whether the variant's deployment rollout is paused, so its
+	// desired replicas are held and no scale changes are made
+	TypeDeploymentPaused = "DeploymentPaused"
 )
 
 // Condition Reasons for MetricsAvailable
@@ -119,6 +274,8 @@ const (
 	ReasonMetricsStale = "MetricsStale"
 	// ReasonPrometheusError indicates error querying Prometheus
 	ReasonPrometheusError = "PrometheusError"
+	// ReasonScaleTargetMissing indicates metrics are unavailable because the scale target no longer exists
+	ReasonScaleTargetMissing = "ScaleTargetMissing"
 )
 
 // Condition Reasons for OptimizationReady
@@ -140,6 +297,103 @@ const (
 	ReasonTargetNotFound = "TargetNotFound"
 )
 
+// Condition Reasons for PartialMetrics
+const (
+	// ReasonMetricsCoverageLow indicates the fraction of replicas reporting metrics is below the configured minimum
+	ReasonMetricsCoverageLow = "MetricsCoverageLow"
+	// ReasonMetricsCoverageSufficient indicates enough replicas are reporting metrics to make scaling decisions
+	ReasonMetricsCoverageSufficient = "MetricsCoverageSufficient"
+)
+
+// Condition Reasons for ElevatedErrorRate
+const (
+	// ReasonErrorRateHigh indicates the error rate reached the configured threshold and scale-down is blocked
+	ReasonErrorRateHigh = "ErrorRateHigh"
+	// ReasonErrorRateNormal indicates the error rate is below the configured threshold
+	ReasonErrorRateNormal = "ErrorRateNormal"
+)
+
+// Condition Reasons for StuckPending
+const (
+	// ReasonPendingTooLong indicates replicas have been pending longer than the configured maximum pending age
+	ReasonPendingTooLong = "PendingTooLong"
+	// ReasonPendingWithinLimit indicates no replicas have been pending longer than the configured maximum pending age
+	ReasonPendingWithinLimit = "PendingWithinLimit"
+)
+
+// Condition Reasons for ScaleTargetDeleted
+const (
+	// ReasonDeploymentDeleted indicates the scale target Deployment was deleted and the VA's replica metrics were cleared
+	ReasonDeploymentDeleted = "DeploymentDeleted"
+	// ReasonDeploymentRecreated indicates the scale target Deployment exists again after it was deleted
+	ReasonDeploymentRecreated = "DeploymentRecreated"
+)
+
+// Condition Reasons for NoInventory
+const (
+	// ReasonNoAcceleratorInventory indicates no accelerators were discovered, so the GPU limiter was skipped
+	ReasonNoAcceleratorInventory = "NoAcceleratorInventory"
+	// ReasonAcceleratorInventoryFound indicates the GPU limiter found accelerators and limited decisions by them
+	ReasonAcceleratorInventoryFound = "AcceleratorInventoryFound"
+)
+
+// Condition Reasons for Observing
+const (
+	// ReasonCollectingSamples indicates fewer than the configured minimum cycles of the model have been observed
+	ReasonCollectingSamples = "CollectingSamples"
+	// ReasonObservationComplete indicates the configured minimum cycles of the model have been observed
+	ReasonObservationComplete = "ObservationComplete"
+)
+
+// Condition Reasons for MetricLabelDrift
+const (
+	// ReasonPodLabelMissing indicates series were returned without a pod or pod_name label
+	ReasonPodLabelMissing = "PodLabelMissing"
+	// ReasonExpectedLabelsPresent indicates every returned series carries a pod or pod_name label
+	ReasonExpectedLabelsPresent = "ExpectedLabelsPresent"
+)
+
+// Condition Reasons for MaintenanceHold
+const (
+	// ReasonMaintenanceWindowActive indicates the decision was made within a maintenance window
+	ReasonMaintenanceWindowActive = "MaintenanceWindowActive"
+	// ReasonNoMaintenanceWindow indicates no maintenance window is active
+	ReasonNoMaintenanceWindow = "NoMaintenanceWindow"
+)
+
+// Condition Reasons for Flapping
+const (
+	// ReasonFrequentDirectionChanges indicates the desired replicas reversed direction at least
+	// the configured number of times within the flap window
+	ReasonFrequentDirectionChanges = "FrequentDirectionChanges"
+	// ReasonStableScalingDirection indicates the desired replicas reversed direction fewer times
+	// than the configured threshold within the flap window
+	ReasonStableScalingDirection = "StableScalingDirection"
+)
+
+// Condition Reasons for RecoveredFromZero
+const (
+	// ReasonZeroReplicasWithoutScaleToZero indicates the scale target was scaled back from zero
+	// replicas, which are only expected when scale-to-zero is enabled
+	ReasonZeroReplicasWithoutScaleToZero = "ZeroReplicasWithoutScaleToZero"
+)
+
+// Condition Reasons for Actuated
+const (
+	// ReasonDesiredReplicasReached indicates the scale target's replicas are within tolerance of the desired replicas
+	ReasonDesiredReplicasReached = "DesiredReplicasReached"
+	// ReasonReplicaDrift indicates the scale target's replicas differ from the desired replicas
+	ReasonReplicaDrift = "ReplicaDrift"
+)
+
+// Condition Reasons for DeploymentPaused
+const (
+	// ReasonRolloutPaused indicates the deployment has spec.paused set
+	ReasonRolloutPaused = "RolloutPaused"
+	// ReasonRolloutResumed indicates the deployment's rollout is no longer paused
+	ReasonRolloutResumed = "RolloutResumed"
+)
+
 // GetScaleTargetAPI returns the API of the scale target resource.
 func (va *VariantAutoscaling) GetScaleTargetAPI() string {
 	return va.Spec.ScaleTargetRef.APIVersion
@@ -154,3 +408,41 @@ func (va *VariantAutoscaling) GetScaleTargetName() string {
 func (va *VariantAutoscaling) GetScaleTargetKind() string {
 	return va.Spec.ScaleTargetRef.Kind
 }
+
+// IsPreview reports whether the VA is in preview mode.
+func (va *VariantAutoscaling) IsPreview() bool {
+	return va.Annotations[PreviewAnnotation] == "true"
+}
+
+// FormatConfidence formats a decision confidence for OptimizedAlloc.Confidence.
+func FormatConfidence(confidence float64) string {
+	return strconv.FormatFloat(confidence, 'f', 2, 64)
+}
+
+// LinkedReplicas returns the desired replicas of the linked target for the given desired replicas
+// of the variant: replicas times the ratio, rounded up so that a variant with replicas keeps at
+// least one replica of the linked target.
+func (t LinkedScaleTarget) LinkedReplicas(replicas int) (int, error) {
+	ratio, err := strconv.ParseFloat(t.Ratio, 64)
+	if err != nil || ratio <= 0 {
+		return 0, fmt.Errorf("invalid ratio %q of linked scale target %s: must be a positive number", t.Ratio, t.Name)
+	}
+	// Tolerate floating point error, e.g. 10 * 0.3 = 3.0000000000000004, before rounding up
+	return int(math.Ceil(float64(replicas)*ratio - 1e-9)), nil
+}
+
+// GetVariantCost returns the cost per replica of the variant, taken from Spec.Cost when set and
+// from Spec.VariantCost otherwise. The second return value is false when neither is set.
+func (va *VariantAutoscaling) GetVariantCost() (float64, bool, error) {
+	if va.Spec.Cost != nil {
+		return va.Spec.Cost.PerReplica.AsApproximateFloat64(), true, nil
+	}
+	if va.Spec.VariantCost == "" {
+		return 0, false, nil
+	}
+	cost, err := strconv.ParseFloat(va.Spec.VariantCost, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid variantCost %q: %w", va.Spec.VariantCost, err)
+	}
+	return cost, true, nil
+}
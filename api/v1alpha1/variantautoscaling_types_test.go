@@ -218,3 +218,68 @@ func jsonContainsKey(b []byte, key string) bool {
 	_, ok := m[key]
 	return ok
 }
+
+func TestGetVariantCost(t *testing.T) {
+	fromString := makeValidVA()
+	fromString.Spec.VariantCost = "12.5"
+
+	// The quantity is read from JSON, as the API server would store it
+	fromQuantity := makeValidVA()
+	if err := json.Unmarshal([]byte(`{"perReplica":"12500m","unit":"USD/hour"}`), &fromQuantity.Spec.Cost); err != nil {
+		t.Fatalf("unmarshal cost failed: %v", err)
+	}
+
+	for name, va := range map[string]*VariantAutoscaling{"variantCost": fromString, "cost": fromQuantity} {
+		cost, ok, err := va.GetVariantCost()
+		if err != nil || !ok {
+			t.Fatalf("%s: unexpected result ok=%v err=%v", name, ok, err)
+		}
+		if cost != 12.5 {
+			t.Errorf("%s: expected cost 12.5, got %v", name, cost)
+		}
+	}
+
+	// The quantity takes precedence over the string
+	fromQuantity.Spec.VariantCost = "10.0"
+	if cost, _, _ := fromQuantity.GetVariantCost(); cost != 12.5 {
+		t.Errorf("expected cost to take precedence over variantCost, got %v", cost)
+	}
+
+	if _, ok, err := makeValidVA().GetVariantCost(); ok || err != nil {
+		t.Errorf("expected no cost when unset, got ok=%v err=%v", ok, err)
+	}
+	invalid := makeValidVA()
+	invalid.Spec.VariantCost = "cheap"
+	if _, _, err := invalid.GetVariantCost(); err == nil {
+		t.Error("expected an error for a non-numeric variantCost")
+	}
+}
+
+func TestLinkedScaleTargetLinkedReplicas(t *testing.T) {
+	tests := []struct {
+		ratio    string
+		replicas int
+		want     int
+	}{
+		{ratio: "0.5", replicas: 4, want: 2},
+		{ratio: "0.5", replicas: 5, want: 3},
+		{ratio: "0.3", replicas: 10, want: 3},
+		{ratio: "2", replicas: 3, want: 6},
+		{ratio: "0.25", replicas: 0, want: 0},
+	}
+	for _, tt := range tests {
+		got, err := LinkedScaleTarget{Name: "router", Ratio: tt.ratio}.LinkedReplicas(tt.replicas)
+		if err != nil {
+			t.Fatalf("ratio %s: unexpected error: %v", tt.ratio, err)
+		}
+		if got != tt.want {
+			t.Errorf("ratio %s of %d replicas: expected %d, got %d", tt.ratio, tt.replicas, tt.want, got)
+		}
+	}
+
+	for _, ratio := range []string{"", "half", "0", "-1"} {
+		if _, err := (LinkedScaleTarget{Name: "router", Ratio: ratio}).LinkedReplicas(1); err == nil {
+			t.Errorf("expected an error for ratio %q", ratio)
+		}
+	}
+}
@@ -40,6 +40,21 @@ func (in *ActuationStatus) DeepCopy() *ActuationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkedScaleTarget) DeepCopyInto(out *LinkedScaleTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkedScaleTarget.
+func (in *LinkedScaleTarget) DeepCopy() *LinkedScaleTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkedScaleTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptimizedAlloc) DeepCopyInto(out *OptimizedAlloc) {
 	*out = *in
@@ -56,12 +71,32 @@ func (in *OptimizedAlloc) DeepCopy() *OptimizedAlloc {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleToZeroSpec) DeepCopyInto(out *ScaleToZeroSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleToZeroSpec.
+func (in *ScaleToZeroSpec) DeepCopy() *ScaleToZeroSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleToZeroSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VariantAutoscaling) DeepCopyInto(out *VariantAutoscaling) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -119,6 +154,36 @@ func (in *VariantAutoscalingList) DeepCopyObject() runtime.Object {
 func (in *VariantAutoscalingSpec) DeepCopyInto(out *VariantAutoscalingSpec) {
 	*out = *in
 	out.ScaleTargetRef = in.ScaleTargetRef
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		*out = new(VariantCostSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxScaleUpRate != nil {
+		in, out := &in.MaxScaleUpRate, &out.MaxScaleUpRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxScaleDownRate != nil {
+		in, out := &in.MaxScaleDownRate, &out.MaxScaleDownRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownFloor != nil {
+		in, out := &in.ScaleDownFloor, &out.ScaleDownFloor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(ScaleToZeroSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinkedScaleTargets != nil {
+		in, out := &in.LinkedScaleTargets, &out.LinkedScaleTargets
+		*out = make([]LinkedScaleTarget, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariantAutoscalingSpec.
@@ -154,3 +219,19 @@ func (in *VariantAutoscalingStatus) DeepCopy() *VariantAutoscalingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariantCostSpec) DeepCopyInto(out *VariantCostSpec) {
+	*out = *in
+	out.PerReplica = in.PerReplica.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariantCostSpec.
+func (in *VariantCostSpec) DeepCopy() *VariantCostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VariantCostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/tls"
 	goflag "flag"
+	"net/http"
 	"os"
 	"path/filepath"
 	"time"
@@ -35,6 +36,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	ctrlzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -44,16 +46,21 @@ import (
 
 	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source/k8smetrics"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source/prometheus"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/controller"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/datastore"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/defaulting"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/saturation"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/scalefromzero"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/selftest"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/sinks"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 	poolutil "github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils/pool"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/validation"
 	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus/client_golang/api"
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
@@ -90,15 +97,32 @@ func main() {
 	// Leader election configuration
 	var (
 		enableLeaderElection bool
-		leaseDuration        time.Duration
-		renewDeadline        time.Duration
-		retryPeriod          time.Duration
+		leaderElection       utils.LeaderElectionTimings
 		restTimeout          time.Duration
+		statusBatchWindow    time.Duration
+		configDebounce       time.Duration
+		deployEventDebounce  time.Duration
+		pendingDecisionWait  time.Duration
+		pendingDecisionAge   time.Duration
+		deployGetRetries     int
+		deployGetBase        time.Duration
+		deployGetCap         time.Duration
+		collectorConfig      config.CollectorConfig
 	)
 	// Feature flags
 	var (
-		secureMetrics bool
-		enableHTTP2   bool
+		secureMetrics       bool
+		enableHTTP2         bool
+		metricsTargetLabel  bool
+		metricsTenantLabel  bool
+		metricsMaxSeries    int
+		metricsMinDelta     int
+		decisionHistorySize int
+		validateOnly        bool
+		printRecordingRule  bool
+		printAdapterRules   bool
+		annotateScaleReason bool
+		enableVADefaulting  bool
 	)
 	// Other
 	var tlsOpts []func(*tls.Config)
@@ -123,18 +147,77 @@ func main() {
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.StringVar(&watchNamespace, "watch-namespace", "",
 		"Namespace to watch for updates. If unspecified, all namespaces are watched.")
+	flag.BoolVar(&metricsTargetLabel, "metrics-target-name-label", false,
+		"If set, replica metrics carry an extra target_name label with the name of the scaled deployment, "+
+			"in addition to variant_name (the VariantAutoscaling name).")
+	flag.BoolVar(&metricsTenantLabel, "metrics-tenant-namespace-label", false,
+		"If set, replica metrics carry an extra tenant_namespace label with the VariantAutoscaling namespace, "+
+			"which keeps its name when scraped, so metrics can be filtered per tenant namespace.")
+	flag.BoolVar(&annotateScaleReason, "annotate-scale-reason", false,
+		"If set, the reason of the latest scaling decision is written to the wva.llmd.ai/last-scale-reason "+
+			"annotation of each VariantAutoscaling's scale target Deployment.")
+	flag.IntVar(&metricsMaxSeries, "metrics-max-series-per-metric", 0,
+		"Maximum number of series (distinct label sets) of each custom metric. New series beyond the "+
+			"limit are dropped and logged. 0 means unlimited.")
+	flag.IntVar(&metricsMinDelta, "metrics-min-desired-delta", 0,
+		"Minimum change of a variant's desired replicas that updates the emitted desired replicas metric. "+
+			"Smaller changes keep the last emitted value; scaling to or from zero is always emitted. "+
+			"0 or 1 emits every change.")
+	flag.IntVar(&decisionHistorySize, "decision-history-size", sinks.DefaultDecisionHistorySize,
+		"Number of recent scaling decisions kept in memory per VariantAutoscaling and served as JSON at "+
+			sinks.DecisionHistoryPath+" on the metrics server. 0 disables the decision history.")
+	flag.DurationVar(&statusBatchWindow, "status-update-batch-window", 0,
+		"Coalesce VariantAutoscaling status updates triggered by scaling decisions within this window "+
+			"into a single update per VA (e.g. 2s). 0 disables batching.")
+	flag.DurationVar(&configDebounce, "config-update-debounce-window", time.Second,
+		"Coalesce updates of a watched ConfigMap within this window into a single application of its "+
+			"latest data. 0 applies every update immediately.")
+	flag.DurationVar(&deployEventDebounce, "deployment-event-debounce-window", time.Second,
+		"Coalesce Deployment create events for the same VariantAutoscaling within this window into a "+
+			"single reconcile. 0 reconciles on every create immediately.")
+	flag.DurationVar(&pendingDecisionWait, "pending-decision-requeue", 5*time.Second,
+		"Requeue a VariantAutoscaling whose scale target exists but that has no scaling decision yet "+
+			"after this duration, so a new VA gets its first decision promptly. 0 disables it.")
+	flag.DurationVar(&pendingDecisionAge, "pending-decision-max-age", 2*time.Minute,
+		"Stop requeueing a VariantAutoscaling after --pending-decision-requeue once it is older than this "+
+			"and still has no scaling decision; it is then reconciled every --reconcile-period.")
+	flag.IntVar(&deployGetRetries, "deployment-get-retries", utils.DefaultDeploymentGetBackoff.Retries,
+		"Number of times a failed Deployment read is retried before giving up.")
+	flag.DurationVar(&deployGetBase, "deployment-get-backoff-base", utils.DefaultDeploymentGetBackoff.Base,
+		"Wait before the first retry of a failed Deployment read. Doubles after each retry.")
+	flag.DurationVar(&deployGetCap, "deployment-get-backoff-cap", utils.DefaultDeploymentGetBackoff.Cap,
+		"Longest wait between retries of a failed Deployment read. 0 means uncapped.")
+	flag.StringVar((*string)(&collectorConfig.Type), "metrics-collector", string(config.CollectorTypePrometheus),
+		"Backend replica metrics are collected from: \"prometheus\" or \"k8s-metrics\" (the Kubernetes custom metrics API, "+
+			"for clusters without Prometheus).")
+	flag.StringVar((*string)(&collectorConfig.ShadowType), "shadow-metrics-collector", "",
+		"Second backend collected alongside --metrics-collector and compared to it, reporting discrepancies "+
+			"in wva_collector_discrepancy_total: \"prometheus\" or \"k8s-metrics\". Decisions always use "+
+			"--metrics-collector. Empty disables shadow collection.")
+	flag.BoolVar(&validateOnly, "validate-only", false,
+		"If set, validate the saturation, accelerator cost and service class ConfigMaps and all "+
+			"VariantAutoscalings, print a report and exit (nonzero on any error) without starting the manager.")
+	flag.BoolVar(&enableVADefaulting, "enable-va-defaulting-webhook", false,
+		"If set, serve a mutating webhook that defaults the fields a VariantAutoscaling omits on creation "+
+			"from the WVA_DEFAULT_* keys of the autoscaler ConfigMap. Requires the webhook configuration and certificates.")
+	flag.BoolVar(&printRecordingRule, "print-recording-rules", false,
+		"If set, print a PrometheusRule manifest with recommended recording rules for the WVA metrics "+
+			"and exit without starting the manager.")
+	flag.BoolVar(&printAdapterRules, "print-external-metrics-rules", false,
+		"If set, print prometheus-adapter Helm values with the external metrics rules serving the WVA metrics "+
+			"per namespace and exit without starting the manager.")
 	flag.IntVar(&loggerVerbosity, "v", logging.DEFAULT, "number for the log level verbosity")
 
 	// Leader election timeout configuration flags
 	// These can be overridden in manager.yaml to tune for different environments
 	// (e.g., higher values for environments with network latency or API server slowness)
-	flag.DurationVar(&leaseDuration, "leader-election-lease-duration", 60*time.Second,
+	flag.DurationVar(&leaderElection.LeaseDuration, "leader-election-lease-duration", utils.DefaultLeaderElectionTimings.LeaseDuration,
 		"The duration that non-leader candidates will wait to force acquire leadership. "+
 			"Increased from default 15s to 60s to prevent lease renewal failures in environments with network latency.")
-	flag.DurationVar(&renewDeadline, "leader-election-renew-deadline", 50*time.Second,
+	flag.DurationVar(&leaderElection.RenewDeadline, "leader-election-renew-deadline", utils.DefaultLeaderElectionTimings.RenewDeadline,
 		"The duration that the acting master will retry refreshing leadership before giving up. "+
 			"Increased from default 10s to 50s to provide more tolerance for network latency and API server delays.")
-	flag.DurationVar(&retryPeriod, "leader-election-retry-period", 10*time.Second,
+	flag.DurationVar(&leaderElection.RetryPeriod, "leader-election-retry-period", utils.DefaultLeaderElectionTimings.RetryPeriod,
 		"The duration the clients should wait between tries of actions. "+
 			"Increased from default 2s to 10s to reduce API server load and provide more time between renewal attempts.")
 	flag.DurationVar(&restTimeout, "rest-client-timeout", 60*time.Second,
@@ -156,6 +239,69 @@ func main() {
 	setupLog := ctrl.Log.WithName("setup")
 	setupLog.Info("Logger initialized")
 
+	if printRecordingRule {
+		manifest, err := metrics.RecordingRulesYAML(metrics.RecordingRuleOptions{
+			Name:                 "workload-variant-autoscaler-recording-rules",
+			ControllerInstance:   os.Getenv(metrics.ControllerInstanceEnvVar),
+			TenantNamespaceLabel: metricsTenantLabel,
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to generate recording rules")
+			os.Exit(1)
+		}
+		_, _ = os.Stdout.Write(manifest)
+		os.Exit(0)
+	}
+
+	if printAdapterRules {
+		values, err := metrics.ExternalMetricsRulesYAML(metrics.ExternalMetricsRuleOptions{
+			ControllerInstance:   os.Getenv(metrics.ControllerInstanceEnvVar),
+			TenantNamespaceLabel: metricsTenantLabel,
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to generate external metrics rules")
+			os.Exit(1)
+		}
+		_, _ = os.Stdout.Write(values)
+		os.Exit(0)
+	}
+
+	utils.SetAcceleratorLabelKey(os.Getenv(utils.AcceleratorLabelKeyEnvVar))
+
+	if err := utils.SetDeploymentGetBackoff(utils.BackoffPolicy{
+		Retries: deployGetRetries,
+		Base:    deployGetBase,
+		Cap:     deployGetCap,
+	}); err != nil {
+		setupLog.Error(err, "invalid deployment get backoff flags")
+		os.Exit(1)
+	}
+
+	if err := leaderElection.Validate(); err != nil {
+		setupLog.Error(err, "invalid leader election flags")
+		os.Exit(1)
+	}
+
+	if err := collectorConfig.Validate(); err != nil {
+		setupLog.Error(err, "invalid metrics collector flag")
+		os.Exit(1)
+	}
+
+	if validateOnly {
+		validationClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+		if err != nil {
+			setupLog.Error(err, "unable to create client for validation")
+			os.Exit(1)
+		}
+		validationOpts := validation.DefaultOptions()
+		validationOpts.WatchNamespace = watchNamespace
+		os.Exit(validation.Run(context.Background(), validationClient, validationOpts, os.Stdout))
+	}
+
+	if flag.Arg(0) == "selftest" {
+		os.Exit(selftest.Run(context.Background(), os.Stdout))
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -212,6 +358,13 @@ func main() {
 		TLSOpts:       tlsOpts,
 	}
 
+	// Optional decision history, served next to the metrics and protected by the same filter
+	var historySink *sinks.HistorySink
+	if decisionHistorySize > 0 {
+		historySink = sinks.NewHistorySink(decisionHistorySize)
+		metricsServerOptions.ExtraHandlers = map[string]http.Handler{sinks.DecisionHistoryPath: historySink}
+	}
+
 	if secureMetrics {
 		// FilterProvider is used to protect the metrics endpoint with authn/authz.
 		// These configurations ensure that only authorized users and service accounts
@@ -273,10 +426,6 @@ func main() {
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "72dd1cf1.llm-d.ai",
-		// Leader election timeout configuration (configurable via flags)
-		LeaseDuration: &leaseDuration,
-		RenewDeadline: &renewDeadline,
-		RetryPeriod:   &retryPeriod,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -288,6 +437,8 @@ func main() {
 		// deployments and upgrades, reducing downtime from ~60s to ~1-2s.
 		LeaderElectionReleaseOnCancel: true,
 	}
+	// Leader election timeout configuration (configurable via flags)
+	leaderElection.Apply(&mgrOptions)
 
 	if watchNamespace != "" {
 		setupLog.Info("Watching single namespace", "namespace", watchNamespace)
@@ -310,80 +461,158 @@ func main() {
 	_ = metrics.NewMetricsEmitter()
 	setupLog.Info("Metrics emitter created successfully")
 
-	// Configure Prometheus client using flexible configuration with TLS support
-	promConfig, err := config.GetPrometheusConfig(context.Background(), mgr.GetClient())
-	if err != nil {
-		setupLog.Error(err, "failed to get Prometheus configuration")
-		os.Exit(1)
-	}
+	// Configure Prometheus client using flexible configuration with TLS support, unless replica
+	// metrics are read from the custom metrics API only
+	var promAPI promv1.API
+	if collectorConfig.Uses(config.CollectorTypePrometheus) {
+		promConfig, err := config.GetPrometheusConfig(context.Background(), mgr.GetClient())
+		if err != nil {
+			setupLog.Error(err, "failed to get Prometheus configuration")
+			os.Exit(1)
+		}
 
-	// ensure we have a valid configuration
-	if promConfig == nil {
-		setupLog.Error(nil, "no Prometheus configuration found - this should not happen")
-		os.Exit(1)
-	}
+		// ensure we have a valid configuration
+		if promConfig == nil {
+			setupLog.Error(nil, "no Prometheus configuration found - this should not happen")
+			os.Exit(1)
+		}
 
-	// Always validate TLS configuration since HTTPS is required
-	if err := utils.ValidateTLSConfig(promConfig); err != nil {
-		setupLog.Error(err, "TLS configuration validation failed - HTTPS is required")
-		os.Exit(1)
-	}
+		// Always validate TLS configuration since HTTPS is required
+		if err := utils.ValidateTLSConfig(promConfig); err != nil {
+			setupLog.Error(err, "TLS configuration validation failed - HTTPS is required")
+			os.Exit(1)
+		}
 
-	setupLog.Info("Initializing Prometheus client",
-		"address", promConfig.BaseURL,
-		"tlsEnabled", true,
-	)
+		setupLog.Info("Initializing Prometheus client",
+			"address", promConfig.BaseURL,
+			"tlsEnabled", true,
+		)
 
-	// Create Prometheus client with TLS support
-	promClientConfig, err := utils.CreatePrometheusClientConfig(promConfig)
-	if err != nil {
-		setupLog.Error(err, "failed to create prometheus client config")
-		os.Exit(1)
-	}
+		// Create Prometheus client with TLS support
+		promClientConfig, err := utils.CreatePrometheusClientConfig(promConfig)
+		if err != nil {
+			setupLog.Error(err, "failed to create prometheus client config")
+			os.Exit(1)
+		}
 
-	promClient, err := api.NewClient(*promClientConfig)
-	if err != nil {
-		setupLog.Error(err, "failed to create prometheus client")
-		os.Exit(1)
-	}
+		promClient, err := api.NewClient(*promClientConfig)
+		if err != nil {
+			setupLog.Error(err, "failed to create prometheus client")
+			os.Exit(1)
+		}
 
-	promAPI := promv1.NewAPI(promClient)
+		promAPI = promv1.NewAPI(promClient)
 
-	// Validate that the API is working by testing a simple query with retry logic
-	if err := utils.ValidatePrometheusAPI(context.Background(), promAPI); err != nil {
-		setupLog.Error(err, "CRITICAL: Failed to connect to Prometheus - WVA requires Prometheus connectivity for autoscaling decisions")
-		os.Exit(1)
+		// Validate that the API is working by testing a simple query with retry logic
+		if err := utils.ValidatePrometheusAPI(context.Background(), promAPI); err != nil {
+			setupLog.Error(err, "CRITICAL: Failed to connect to Prometheus - WVA requires Prometheus connectivity for autoscaling decisions")
+			os.Exit(1)
+		}
+		setupLog.Info("Prometheus client and API wrapper initialized and validated successfully")
+	}
+
+	// Optional NATS decision sink, created up front so a misconfiguration fails at startup
+	var natsSink *sinks.TopicSink
+	natsSubject := os.Getenv("DECISION_NATS_SUBJECT")
+	if natsSubject == "" {
+		natsSubject = "wva.decisions"
+	}
+	if natsURL := os.Getenv("DECISION_NATS_URL"); natsURL != "" {
+		natsSink, err = sinks.NewNATSSink(natsURL, natsSubject)
+		if err != nil {
+			setupLog.Error(err, "invalid decision NATS sink configuration")
+			os.Exit(1)
+		}
+		// Close the NATS connection when the manager stops
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return natsSink.Close()
+		})); err != nil {
+			setupLog.Error(err, "unable to add decision NATS sink to manager")
+			os.Exit(1)
+		}
 	}
-	setupLog.Info("Prometheus client and API wrapper initialized and validated successfully")
 
 	// Register optimization engine loops with the manager. Only start when leader.
 	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
 		sourceRegistry := source.NewSourceRegistry()
 		setupLog.Info("Initializing metrics source registry")
 
-		// Read Prometheus cache configuration from ConfigMap
-		// TODO(LV): Uncomment and implement cache configuration reading
-		// cacheConfig, err := config.ReadPrometheusCacheConfig(context.Background(), mgr.GetClient())
-		// if err != nil {
-		// 	setupLog.Error(err, "Failed to read Prometheus cache config from ConfigMap, using defaults")
-		// 	cacheConfig = nil // Use defaults
-		// }
+		// Read Prometheus cache configuration from ConfigMap: when background refresh is opted
+		// into, results are refreshed every fetch interval and served until they reach the stale threshold
+		var metricsRefresh source.BackgroundRefreshConfig
+		cacheConfig, err := config.ReadPrometheusCacheConfig(ctx, mgr.GetClient())
+		if err != nil {
+			setupLog.Error(err, "Failed to read Prometheus cache config from ConfigMap, querying Prometheus on every cycle")
+		} else if cacheConfig.BackgroundRefresh && cacheConfig.Enabled && cacheConfig.FetchInterval > 0 {
+			metricsRefresh = source.BackgroundRefreshConfig{
+				RefreshInterval: cacheConfig.FetchInterval,
+				MaxStaleness:    cacheConfig.FreshnessThresholds.StaleThreshold,
+			}
+			if err := metricsRefresh.Validate(); err != nil {
+				setupLog.Error(err, "Invalid Prometheus cache config, querying Prometheus on every cycle")
+				metricsRefresh = source.BackgroundRefreshConfig{}
+			}
+		}
 
-		// Register PrometheusSource with default config
-		promSource := prometheus.NewPrometheusSource(ctx, promAPI, prometheus.DefaultPrometheusSourceConfig())
+		// newSource creates the PrometheusSource, or the custom metrics API source, with default config
+		newSource := func(collectorType config.CollectorType) source.MetricsSource {
+			if collectorType == config.CollectorTypeK8sMetrics {
+				metricsClient, err := k8smetrics.NewRESTClient(restConfig)
+				if err != nil {
+					setupLog.Error(err, "failed to create custom metrics API client")
+					os.Exit(1)
+				}
+				return k8smetrics.NewK8sMetricsSource(ctx, metricsClient, k8smetrics.DefaultK8sMetricsSourceConfig())
+			}
+			return prometheus.NewPrometheusSource(ctx, promAPI, prometheus.DefaultPrometheusSourceConfig())
+		}
+		promSource := newSource(collectorConfig.Type)
+		if collectorConfig.Type == config.CollectorTypeK8sMetrics {
+			setupLog.Info("Collecting replica metrics from the Kubernetes custom metrics API")
+		}
+		if collectorConfig.Type == config.CollectorTypePrometheus && metricsRefresh.RefreshInterval > 0 {
+			promSource = source.NewBackgroundRefreshSource(ctx, promSource, metricsRefresh)
+			setupLog.Info("Serving metrics from a background-refresh cache",
+				"refreshInterval", metricsRefresh.RefreshInterval, "maxStaleness", metricsRefresh.MaxStaleness)
+		}
 
 		// Register in global source registry
-		if err := sourceRegistry.Register("prometheus", promSource); err != nil {
-			setupLog.Error(err, "failed to register prometheus source in source registry")
+		if err := sourceRegistry.Register(source.PrimarySourceName, promSource); err != nil {
+			setupLog.Error(err, "failed to register metrics source in source registry")
 			os.Exit(1)
 		}
 
+		// Optional shadow source, compared to the primary one without affecting decisions
+		if collectorConfig.ShadowType != "" {
+			if err := sourceRegistry.Register(source.ShadowSourceName, newSource(collectorConfig.ShadowType)); err != nil {
+				setupLog.Error(err, "failed to register shadow metrics source in source registry")
+				os.Exit(1)
+			}
+			setupLog.Info("Shadow collecting replica metrics", "collector", collectorConfig.ShadowType)
+		}
+
 		engine := saturation.NewEngine(
 			mgr.GetClient(),
 			mgr.GetScheme(),
 			mgr.GetEventRecorderFor("workload-variant-autoscaler-saturation-engine"),
 			sourceRegistry,
 		)
+		if webhookURL := os.Getenv("DECISION_WEBHOOK_URL"); webhookURL != "" {
+			engine.RegisterExternalDecisionSink(ctx, sinks.NewWebhookSink(webhookURL))
+			setupLog.Info("Registered decision webhook sink", "url", webhookURL)
+		}
+		if configMapName := os.Getenv("DECISION_CONFIGMAP_NAME"); configMapName != "" {
+			engine.RegisterExternalDecisionSink(ctx, sinks.NewConfigMapSink(mgr.GetClient(), configMapName))
+			setupLog.Info("Registered decision ConfigMap sink", "configMap", configMapName)
+		}
+		if natsSink != nil {
+			engine.RegisterExternalDecisionSink(ctx, natsSink)
+			setupLog.Info("Registered decision NATS sink", "subject", natsSubject)
+		}
+		if historySink != nil {
+			engine.RegisterDecisionSink(historySink)
+		}
 		go engine.StartOptimizeLoop(ctx)
 		return nil
 	}))
@@ -408,11 +637,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	reconcilePeriod, err := controller.ReconcilePeriodFromEnv()
+	if err != nil {
+		setupLog.Error(err, "unable to read the reconcile period")
+		os.Exit(1)
+	}
+
 	// Create the reconciler
 	reconciler := &controller.VariantAutoscalingReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("workload-variant-autoscaler-controller-manager"),
+
+		StatusBatchWindow:             statusBatchWindow,
+		ConfigUpdateDebounceWindow:    configDebounce,
+		DeploymentEventDebounceWindow: deployEventDebounce,
+		AnnotateScaleReason:           annotateScaleReason,
+		ReconcilePeriod:               reconcilePeriod,
+		PendingDecisionRequeue:        pendingDecisionWait,
+		PendingDecisionMaxAge:         pendingDecisionAge,
 	}
 
 	// Setup the controller with the manager
@@ -420,6 +663,18 @@ func main() {
 		setupLog.Error(err, "unable to create controller")
 		os.Exit(1)
 	}
+	if enableVADefaulting {
+		defaulter := &defaulting.VariantAutoscalingDefaulter{
+			Reader:        mgr.GetAPIReader(),
+			ConfigMapName: config.GetConfigMapName(),
+			Namespace:     config.GetNamespace(),
+		}
+		if err = defaulter.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create VariantAutoscaling defaulting webhook")
+			os.Exit(1)
+		}
+		setupLog.Info("VariantAutoscaling defaulting webhook enabled", "configMap", defaulter.ConfigMapName)
+	}
 	// +kubebuilder:scaffold:builder
 
 	// Create InferencePool reconciler
@@ -476,6 +731,10 @@ func main() {
 	// Register custom metrics with the controller-runtime Prometheus registry
 	// This makes the metrics available for scraping by Prometheus and direct endpoint access
 	setupLog.Info("Registering custom metrics with Prometheus registry")
+	metrics.SetTargetNameLabelEnabled(metricsTargetLabel)
+	metrics.SetTenantNamespaceLabelEnabled(metricsTenantLabel)
+	metrics.SetMaxSeriesPerMetric(metricsMaxSeries)
+	metrics.SetMinDesiredDelta(metricsMinDelta)
 	if err := metrics.InitMetrics(crmetrics.Registry); err != nil {
 		setupLog.Error(err, "failed to initialize metrics")
 		os.Exit(1)
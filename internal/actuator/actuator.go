@@ -48,40 +48,73 @@ func (a *Actuator) GetCurrentDeploymentReplicas(ctx context.Context, va *llmdOpt
 	return 1, nil
 }
 
+// EmitMetrics emits the replica metrics of VariantAutoscaling for external autoscalers.
+// A desired replica count of 0 is emitted like any other value: during scale-to-zero the
+// series must read exactly 0, since HPA treats an absent external metric differently.
 func (a *Actuator) EmitMetrics(ctx context.Context, VariantAutoscaling *llmdOptv1alpha1.VariantAutoscaling) error {
 	// Emit replica metrics with real-time data for external autoscalers
 	logger := log.FromContext(ctx)
-	if VariantAutoscaling.Status.DesiredOptimizedAlloc.NumReplicas >= 0 {
 
-		// Get real current replicas from Deployment (not stale VariantAutoscaling status)
-		currentReplicas, err := a.GetCurrentDeploymentReplicas(ctx, VariantAutoscaling)
+	// Get real current replicas from Deployment (not stale VariantAutoscaling status)
+	currentReplicas, err := a.GetCurrentDeploymentReplicas(ctx, VariantAutoscaling)
+	if err != nil {
+		logger.Error(err, "Could not get current deployment replicas, using VariantAutoscaling status",
+			"variantName", VariantAutoscaling.Name)
+		currentReplicas = 0 // Fallback to 0 since CurrentAlloc is removed
+	}
+
+	if err := a.MetricsEmitter.EmitReplicaMetrics(
+		ctx,
+		VariantAutoscaling,
+		currentReplicas, // Real current from Deployment
+		int32(VariantAutoscaling.Status.DesiredOptimizedAlloc.NumReplicas), // Inferno's optimization target
+		VariantAutoscaling.Status.DesiredOptimizedAlloc.Accelerator,
+	); err != nil {
+		logger.Error(err, "Failed to emit optimization signals for variantAutoscaling",
+			"variantName", VariantAutoscaling.Name)
+		// Don't fail the reconciliation for metric emission errors
+		// Metrics are critical for HPA, but emission failures shouldn't break core functionality
+		return nil
+	}
+	logger.Info("EmitReplicaMetrics completed",
+		"variantName", VariantAutoscaling.Name,
+		"currentReplicas", currentReplicas,
+		"desiredReplicas", VariantAutoscaling.Status.DesiredOptimizedAlloc.NumReplicas,
+		"accelerator", VariantAutoscaling.Status.DesiredOptimizedAlloc.Accelerator)
+
+	a.EmitLinkedMetrics(ctx, VariantAutoscaling,
+		int32(VariantAutoscaling.Status.DesiredOptimizedAlloc.NumReplicas),
+		VariantAutoscaling.Status.DesiredOptimizedAlloc.Accelerator)
+	return nil
+}
+
+// EmitLinkedMetrics emits the replica metrics of the linked scale targets of VariantAutoscaling,
+// each desiring the variant's desired replicas times its ratio. Like for the variant, failures
+// are logged and do not fail the caller.
+func (a *Actuator) EmitLinkedMetrics(ctx context.Context, VariantAutoscaling *llmdOptv1alpha1.VariantAutoscaling, desired int32, accelerator string) {
+	logger := log.FromContext(ctx)
+
+	for _, linked := range VariantAutoscaling.Spec.LinkedScaleTargets {
+		linkedDesired, err := linked.LinkedReplicas(int(desired))
 		if err != nil {
-			logger.Error(err, "Could not get current deployment replicas, using VariantAutoscaling status",
-				"variantName", VariantAutoscaling.Name)
-			currentReplicas = 0 // Fallback to 0 since CurrentAlloc is removed
+			logger.Error(err, "Skipping linked scale target", "variantName", VariantAutoscaling.Name)
+			continue
 		}
 
-		if err := a.MetricsEmitter.EmitReplicaMetrics(
-			ctx,
-			VariantAutoscaling,
-			currentReplicas, // Real current from Deployment
-			int32(VariantAutoscaling.Status.DesiredOptimizedAlloc.NumReplicas), // Inferno's optimization target
-			VariantAutoscaling.Status.DesiredOptimizedAlloc.Accelerator,
-		); err != nil {
-			logger.Error(err, "Failed to emit optimization signals for variantAutoscaling",
-				"variantName", VariantAutoscaling.Name)
-			// Don't fail the reconciliation for metric emission errors
-			// Metrics are critical for HPA, but emission failures shouldn't break core functionality
-			return nil
+		// A linked Deployment that cannot be read still gets its desired replicas
+		var currentReplicas int32
+		var deploy appsv1.Deployment
+		if err := a.Client.Get(ctx, client.ObjectKey{Name: linked.Name, Namespace: VariantAutoscaling.Namespace}, &deploy); err != nil {
+			logger.Error(err, "Could not get linked Deployment replicas",
+				"variantName", VariantAutoscaling.Name, "linkedTarget", linked.Name)
+		} else {
+			currentReplicas = deploy.Status.Replicas
+		}
+
+		if err := a.MetricsEmitter.EmitLinkedReplicaMetrics(
+			ctx, VariantAutoscaling, linked.Name, currentReplicas, int32(linkedDesired), accelerator); err != nil {
+			logger.Error(err, "Failed to emit linked scale target metrics",
+				"variantName", VariantAutoscaling.Name, "linkedTarget", linked.Name)
 		}
-		logger.Info("EmitReplicaMetrics completed",
-			"variantName", VariantAutoscaling.Name,
-			"currentReplicas", currentReplicas,
-			"desiredReplicas", VariantAutoscaling.Status.DesiredOptimizedAlloc.NumReplicas,
-			"accelerator", VariantAutoscaling.Status.DesiredOptimizedAlloc.Accelerator)
-		return nil
 	}
-	logger.Info("Skipping EmitReplicaMetrics - NumReplicas is 0",
-		"variantName", VariantAutoscaling.Name)
-	return nil
 }
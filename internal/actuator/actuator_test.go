@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
 	ctrlutils "github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 	. "github.com/onsi/ginkgo/v2"
@@ -244,13 +245,31 @@ var _ = Describe("Actuator", func() {
 			// but we can verify the method completed without error
 		})
 
-		It("should skip metrics emission when desired replicas is 0", func() {
+		It("should emit zero desired replicas during scale-to-zero", func() {
+			Expect(actuator.EmitMetrics(ctx, va)).To(Succeed())
 			va.Status.DesiredOptimizedAlloc.NumReplicas = 0
 			fmt.Printf("Emitting metrics for variantAutoscaling - name: %s\n numReplicas: %d\n", va.Name, va.Status.DesiredOptimizedAlloc.NumReplicas)
 			err := actuator.EmitMetrics(ctx, va)
 			Expect(err).NotTo(HaveOccurred())
 
-			// Method should succeed but skip metrics emission
+			// The series is set to 0 rather than left at its last value or removed,
+			// so HPA reads an explicit zero
+			families, err := registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			var desired []float64
+			for _, family := range families {
+				if family.GetName() != constants.WVADesiredReplicas {
+					continue
+				}
+				for _, metric := range family.GetMetric() {
+					for _, label := range metric.GetLabel() {
+						if label.GetName() == constants.LabelVariantName && label.GetValue() == va.Name {
+							desired = append(desired, metric.GetGauge().GetValue())
+						}
+					}
+				}
+			}
+			Expect(desired).To(Equal([]float64{0}))
 		})
 
 		It("should use fallback replicas when deployment retrieval fails", func() {
@@ -396,7 +415,7 @@ var _ = Describe("Actuator", func() {
 				Status: llmdVariantAutoscalingV1alpha1.VariantAutoscalingStatus{
 					// DesiredOptimizedAlloc.NumReplicas will be 0 by default
 					DesiredOptimizedAlloc: llmdVariantAutoscalingV1alpha1.OptimizedAlloc{
-						NumReplicas: 0, // This should cause EmitMetrics to skip
+						NumReplicas: 0, // Emitted as an explicit zero
 						Accelerator: "A100",
 					},
 				},
@@ -408,7 +427,7 @@ var _ = Describe("Actuator", func() {
 			}()
 			fmt.Printf("Emitting metrics for variantAutoscaling - name: %s\n numReplicas: %d\n", va.Name, va.Status.DesiredOptimizedAlloc.NumReplicas)
 			err := actuator.EmitMetrics(ctx, va)
-			Expect(err).NotTo(HaveOccurred()) // Should skip metrics emission due to 0 replicas
+			Expect(err).NotTo(HaveOccurred()) // Missing deployment falls back to 0 current replicas
 		})
 	})
 
@@ -19,6 +19,7 @@ package actuator
 import (
 	"context"
 
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	poolutil "github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils/pool"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -52,8 +53,48 @@ func NewDirectActuator(config *rest.Config) (*DirectActuator, error) {
 }
 
 func (da *DirectActuator) ScaleTargetObject(ctx context.Context, scaledObject *unstructured.Unstructured, replicas int32) error {
+	return da.ScaleTarget(scaledObject).SetReplicas(ctx, replicas)
+}
+
+// ScaleTarget returns a ScaleTargetProvider for scaledObject, which may be of any kind
+// implementing the scale subresource.
+func (da *DirectActuator) ScaleTarget(scaledObject *unstructured.Unstructured) interfaces.ScaleTargetProvider {
+	return &scaleSubresourceTarget{actuator: da, object: scaledObject}
+}
+
+// scaleSubresourceTarget is a ScaleTargetProvider that reads and updates the scale subresource
+// of an arbitrary scale target through the scale client.
+type scaleSubresourceTarget struct {
+	actuator *DirectActuator
+	object   *unstructured.Unstructured
+}
+
+// GetReplicas returns status.replicas of the scale subresource, or spec.replicas while the
+// status has not caught up yet.
+func (t *scaleSubresourceTarget) GetReplicas(ctx context.Context) (int, error) {
+	scale, _, err := t.actuator.getScaleTargetScale(ctx, t.object)
+	if err != nil {
+		return 0, err
+	}
+	if scale.Status.Replicas == 0 {
+		return int(scale.Spec.Replicas), nil
+	}
+	return int(scale.Status.Replicas), nil
+}
+
+// GetReadyReplicas returns status.readyReplicas of the object, which the scale subresource does not expose.
+func (t *scaleSubresourceTarget) GetReadyReplicas(_ context.Context) (int, error) {
+	ready, _, err := unstructured.NestedInt64(t.object.Object, "status", "readyReplicas")
+	if err != nil {
+		return 0, err
+	}
+	return int(ready), nil
+}
+
+// SetReplicas updates spec.replicas of the scale subresource, skipping the update when it is unchanged.
+func (t *scaleSubresourceTarget) SetReplicas(ctx context.Context, replicas int32) error {
 	logger := log.FromContext(ctx)
-	scale, gr, err := da.getScaleTargetScale(ctx, scaledObject)
+	scale, gr, err := t.actuator.getScaleTargetScale(ctx, t.object)
 	if err != nil {
 		return err
 	}
@@ -63,13 +104,13 @@ func (da *DirectActuator) ScaleTargetObject(ctx context.Context, scaledObject *u
 		return nil
 	}
 
-	currentReplicas, err := da.updateScaleOnScaleTarget(ctx, scaledObject, scale, replicas, gr)
+	currentReplicas, err := t.actuator.updateScaleOnScaleTarget(ctx, t.object, scale, replicas, gr)
 	if err == nil {
 		logger.Info("Successfully updated ScaleTarget",
 			"Original Replicas Count", currentReplicas,
 			"New Replicas Count", replicas)
 	} else {
-		logger.Error(err, "Failed to scale Target", "kind", scaledObject.GetKind(), "namespace", scaledObject.GetNamespace(), "name", scaledObject.GetName())
+		logger.Error(err, "Failed to scale Target", "kind", t.object.GetKind(), "namespace", t.object.GetNamespace(), "name", t.object.GetName())
 		return err
 	}
 	return nil
@@ -192,3 +192,38 @@ func TestDirectActuator(t *testing.T) {
 		})
 	}
 }
+
+func TestDirectActuator_ScaleTarget(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	scaledObject := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "vllm", "namespace": "default"},
+		"status":     map[string]interface{}{"replicas": int64(3), "readyReplicas": int64(2)},
+	}}
+
+	fakeScaleClient := &scalefake.FakeScaleClient{}
+	fakeScaleClient.AddReactor("get", "deployments", func(action clienttesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &autoscalingapi.Scale{
+			ObjectMeta: metav1.ObjectMeta{Name: "vllm", Namespace: "default"},
+			Spec:       autoscalingapi.ScaleSpec{Replicas: 4},
+			Status:     autoscalingapi.ScaleStatus{Replicas: 3},
+		}, nil
+	})
+	actuator := &DirectActuator{
+		scaleClient: fakeScaleClient,
+		Mapper:      testrestmapper.TestOnlyStaticRESTMapper(scheme, schema.GroupVersion{Group: "apps", Version: "v1"}),
+	}
+	target := actuator.ScaleTarget(scaledObject)
+
+	replicas, err := target.GetReplicas(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, replicas, "current replicas come from the scale subresource status")
+
+	ready, err := target.GetReadyReplicas(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, ready)
+}
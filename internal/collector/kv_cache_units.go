@@ -0,0 +1,72 @@
+package collector
+
+import (
+	"math"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+)
+
+// KvCacheBytesFallbackEnvVar enables deriving KV cache usage from the used and total bytes
+// series for pods without a usage ratio, when set to "true".
+const KvCacheBytesFallbackEnvVar = "WVA_KV_CACHE_BYTES_FALLBACK"
+
+// kvCachePercentMax is the largest KV cache usage read as a percentage. Exporters that report
+// usage on a 0-100 scale would otherwise read as permanently saturated.
+const kvCachePercentMax = 100.0
+
+// kvCacheFractionMax is the largest KV cache usage read as a fraction. Fractions slightly above
+// 1 come from rounding in the exporter, and reading them as a percentage would turn a full
+// cache into an almost empty one.
+const kvCacheFractionMax = 1.5
+
+// normalizeKvCacheUsage returns KV cache usage as a fraction (0.0-1.0). A value above 1 and at
+// most kvCacheFractionMax is clamped to 1. A value above kvCacheFractionMax and at most
+// kvCachePercentMax is taken to be a percentage and scaled down, reporting true so the caller
+// can warn about the exporter's unit. Other values are returned unchanged.
+func normalizeKvCacheUsage(usage float64) (float64, bool) {
+	switch {
+	case usage > 1 && usage <= kvCacheFractionMax:
+		return 1, false
+	case usage > kvCacheFractionMax && usage <= kvCachePercentMax:
+		return usage / 100, true
+	}
+	return usage, false
+}
+
+// kvCacheBytesSample is a KV cache usage derived from a pod's used and total bytes.
+type kvCacheBytesSample struct {
+	usage     float64
+	timestamp time.Time
+}
+
+// kvCacheUsageFromBytes derives each pod's KV cache usage as used / total bytes. Pods missing
+// either series, or with a total that is not positive, are left out rather than read as idle
+// or saturated. The sample is as old as the older of the two series.
+func kvCacheUsageFromBytes(used, total *source.MetricResult) map[string]kvCacheBytesSample {
+	if used == nil || total == nil || used.HasError() || total.HasError() {
+		return nil
+	}
+	totals := make(map[string]source.MetricValue, len(total.Values))
+	for _, value := range total.Values {
+		if pod := podLabel(value.Labels); pod != "" {
+			totals[pod] = value
+		}
+	}
+
+	samples := make(map[string]kvCacheBytesSample, len(used.Values))
+	for _, value := range used.Values {
+		pod := podLabel(value.Labels)
+		totalValue, ok := totals[pod]
+		if pod == "" || !ok || !(totalValue.Value > 0) || math.IsInf(totalValue.Value, 0) ||
+			math.IsNaN(value.Value) || math.IsInf(value.Value, 0) {
+			continue
+		}
+		timestamp := value.Timestamp
+		if totalValue.Timestamp.Before(timestamp) {
+			timestamp = totalValue.Timestamp
+		}
+		samples[pod] = kvCacheBytesSample{usage: value.Value / totalValue.Value, timestamp: timestamp}
+	}
+	return samples
+}
@@ -0,0 +1,81 @@
+package collector
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+)
+
+func TestNormalizeKvCacheUsage(t *testing.T) {
+	tests := []struct {
+		name           string
+		usage          float64
+		expected       float64
+		wantNormalized bool
+	}{
+		{name: "fraction untouched", usage: 0.85, expected: 0.85},
+		{name: "full fraction untouched", usage: 1, expected: 1},
+		{name: "zero untouched", usage: 0, expected: 0},
+		{name: "fraction just above full clamped", usage: 1.0001, expected: 1},
+		{name: "fraction at margin clamped", usage: 1.5, expected: 1},
+		{name: "percentage scaled", usage: 85, expected: 0.85, wantNormalized: true},
+		{name: "full percentage scaled", usage: 100, expected: 1, wantNormalized: true},
+		{name: "out of range untouched", usage: 250, expected: 250},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, normalized := normalizeKvCacheUsage(tt.usage)
+			if got != tt.expected || normalized != tt.wantNormalized {
+				t.Errorf("normalizeKvCacheUsage(%v) = (%v, %v), want (%v, %v)",
+					tt.usage, got, normalized, tt.expected, tt.wantNormalized)
+			}
+		})
+	}
+}
+
+func TestKvCacheUsageFromBytes(t *testing.T) {
+	now := time.Now()
+	sample := func(pod string, value float64, timestamp time.Time) source.MetricValue {
+		return source.MetricValue{Value: value, Timestamp: timestamp, Labels: map[string]string{"pod": pod}}
+	}
+	used := &source.MetricResult{Values: []source.MetricValue{
+		sample("half", 4e9, now),
+		sample("full", 8e9, now),
+		sample("idle", 0, now),
+		sample("zero-total", 1e9, now),
+		sample("no-total", 1e9, now),
+		sample("nan", math.NaN(), now),
+	}}
+	total := &source.MetricResult{Values: []source.MetricValue{
+		sample("half", 8e9, now.Add(-time.Minute)),
+		sample("full", 8e9, now),
+		sample("idle", 8e9, now),
+		sample("zero-total", 0, now),
+		sample("nan", 8e9, now),
+	}}
+
+	samples := kvCacheUsageFromBytes(used, total)
+	want := map[string]float64{"half": 0.5, "full": 1, "idle": 0}
+	if len(samples) != len(want) {
+		t.Fatalf("expected usage for %v, got %v", want, samples)
+	}
+	for pod, usage := range want {
+		if got, ok := samples[pod]; !ok || got.usage != usage {
+			t.Errorf("%s: expected usage %v, got %+v", pod, usage, got)
+		}
+	}
+	if got := samples["half"].timestamp; !got.Equal(now.Add(-time.Minute)) {
+		t.Errorf("expected the older total timestamp, got %v", got)
+	}
+
+	total.Error = context.DeadlineExceeded
+	if samples := kvCacheUsageFromBytes(used, total); len(samples) != 0 {
+		t.Errorf("expected no usage derived from a failed query, got %v", samples)
+	}
+	if samples := kvCacheUsageFromBytes(used, nil); len(samples) != 0 {
+		t.Errorf("expected no usage derived without totals, got %v", samples)
+	}
+}
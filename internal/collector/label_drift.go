@@ -0,0 +1,71 @@
+package collector
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+)
+
+// Labels identifying the pod a series was scraped from, in order of preference.
+const (
+	labelPod     = "pod"
+	labelPodName = "pod_name"
+)
+
+// podLabel returns the pod a series was scraped from, or "" when it carries no pod label.
+func podLabel(labels map[string]string) string {
+	if pod := labels[labelPod]; pod != "" {
+		return pod
+	}
+	return labels[labelPodName]
+}
+
+// MissingPodLabelQueries returns, sorted, the names of the queries in results that returned
+// series with neither a pod nor a pod_name label. Such series cannot be attributed to a replica
+// and are dropped, which happens when the model server renames the label identifying its pods.
+func MissingPodLabelQueries(results map[string]*source.MetricResult) []string {
+	var queries []string
+	for name, result := range results {
+		if result == nil || result.HasError() {
+			continue
+		}
+		for _, value := range result.Values {
+			if podLabel(value.Labels) == "" {
+				queries = append(queries, name)
+				break
+			}
+		}
+	}
+	sort.Strings(queries)
+	return queries
+}
+
+// LabelDriftTracker keeps, per model, the queries of the latest collection whose series were
+// missing the pod label, so that the drift can be reported on the model's VAs. It is safe for
+// concurrent use.
+type LabelDriftTracker struct {
+	mu      sync.Mutex
+	missing map[string][]string
+}
+
+// NewLabelDriftTracker creates an empty tracker.
+func NewLabelDriftTracker() *LabelDriftTracker {
+	return &LabelDriftTracker{missing: make(map[string][]string)}
+}
+
+// Record replaces the queries recorded for the model with those of the latest collection.
+func (t *LabelDriftTracker) Record(namespace, modelID string, queries []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.missing[namespace+"/"+modelID] = queries
+}
+
+// Drift returns the queries whose series were missing the pod label in the model's latest
+// collection, and whether the model has been collected at all.
+func (t *LabelDriftTracker) Drift(namespace, modelID string) ([]string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	queries, ok := t.missing[namespace+"/"+modelID]
+	return queries, ok
+}
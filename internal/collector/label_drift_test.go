@@ -0,0 +1,90 @@
+package collector
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/registration"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// staticSource returns fixed query results on every refresh.
+type staticSource struct {
+	results map[string]*source.MetricResult
+	// queries holds the queries of the last refresh
+	queries []string
+}
+
+func (s *staticSource) QueryList() *source.QueryList { return nil }
+
+func (s *staticSource) Refresh(_ context.Context, spec source.RefreshSpec) (map[string]*source.MetricResult, error) {
+	s.queries = spec.Queries
+	return s.results, nil
+}
+
+func (s *staticSource) Get(_ string, _ map[string]string) *source.CachedValue { return nil }
+
+func TestReplicaMetricsCollector_DetectsLabelDrift(t *testing.T) {
+	now := time.Now()
+	// The model server renamed its pod label: every series carries only the new one
+	src := &staticSource{results: map[string]*source.MetricResult{
+		registration.QueryKvCacheUsage: {Values: []source.MetricValue{
+			{Value: 0.5, Timestamp: now, Labels: map[string]string{"instance_pod": "llama-decode-0"}},
+			{Value: 0.6, Timestamp: now, Labels: map[string]string{"instance_pod": "llama-decode-1"}},
+		}},
+		registration.QueueLengthQueries()[0]: {Values: []source.MetricValue{
+			{Value: 2, Timestamp: now, Labels: map[string]string{"instance_pod": "llama-decode-0"}},
+		}},
+	}}
+	tracker := NewLabelDriftTracker()
+	c := NewReplicaMetricsCollector(src, nil).WithLabelDriftTracker(tracker)
+
+	if _, checked := tracker.Drift("llm", "meta/llama"); checked {
+		t.Fatal("expected no drift reported before the model is collected")
+	}
+
+	replicaMetrics, err := c.CollectReplicaMetrics(context.Background(), "meta/llama", "llm", nil, nil, nil, interfaces.OptionalReplicaMetrics{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(replicaMetrics) != 0 {
+		t.Errorf("expected unattributable series to be dropped, got %d replica metrics", len(replicaMetrics))
+	}
+	missing, checked := tracker.Drift("llm", "meta/llama")
+	want := []string{registration.QueryKvCacheUsage, registration.QueueLengthQueries()[0]}
+	sort.Strings(want)
+	if !checked || !reflect.DeepEqual(missing, want) {
+		t.Errorf("expected drift on %v, got %v (checked=%v)", want, missing, checked)
+	}
+
+	// Once the label is back, the drift is cleared
+	for _, result := range src.results {
+		for i := range result.Values {
+			result.Values[i].Labels = map[string]string{"pod": result.Values[i].Labels["instance_pod"]}
+		}
+	}
+	if _, err := c.CollectReplicaMetrics(context.Background(), "meta/llama", "llm", nil, nil, nil, interfaces.OptionalReplicaMetrics{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if missing, checked := tracker.Drift("llm", "meta/llama"); !checked || len(missing) != 0 {
+		t.Errorf("expected drift cleared, got %v (checked=%v)", missing, checked)
+	}
+}
+
+func TestMissingPodLabelQueries(t *testing.T) {
+	results := map[string]*source.MetricResult{
+		"kv":       {Values: []source.MetricValue{{Labels: map[string]string{"pod": "a"}}, {Labels: map[string]string{}}}},
+		"queue":    {Values: []source.MetricValue{{Labels: map[string]string{"pod_name": "a"}}}},
+		"rate":     {Values: []source.MetricValue{{Labels: map[string]string{"model_name": "llama"}}}},
+		"empty":    {},
+		"failed":   {Error: context.DeadlineExceeded},
+		"no-value": nil,
+	}
+	if got, want := MissingPodLabelQueries(results), []string{"kv", "rate"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,184 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// NodeCostLabelEnvVar names the node label holding the node's price, e.g. a spot price
+// maintained by a cloud pricing agent. Unset disables node label pricing.
+const NodeCostLabelEnvVar = "WVA_NODE_COST_LABEL"
+
+const (
+	// NodePriceCacheTTL is how long a node's price is reused before its label is read again.
+	NodePriceCacheTTL = time.Minute
+	// NodeCostErrorLogInterval is how often a failing price lookup is logged per deployment.
+	NodeCostErrorLogInterval = 10 * time.Minute
+)
+
+// cachedNodePrice is a node price read at readAt.
+type cachedNodePrice struct {
+	price  float64
+	ok     bool
+	readAt time.Time
+}
+
+// NodeLabelCostCollector derives the cost per replica of a variant from a price label on the
+// nodes its pods run on. Node prices are cached for NodePriceCacheTTL, so label updates are
+// picked up within a minute.
+type NodeLabelCostCollector struct {
+	client   client.Client
+	labelKey string
+	clock    clock.PassiveClock
+
+	mu     sync.Mutex
+	prices map[string]cachedNodePrice
+	// errorLogged holds when a lookup failure was last logged, by deployment
+	errorLogged map[client.ObjectKey]time.Time
+}
+
+// NewNodeLabelCostCollector creates a collector reading prices from the labelKey node label.
+func NewNodeLabelCostCollector(k8sClient client.Client, labelKey string, clk clock.PassiveClock) *NodeLabelCostCollector {
+	return &NodeLabelCostCollector{
+		client:      k8sClient,
+		labelKey:    labelKey,
+		clock:       clk,
+		prices:      make(map[string]cachedNodePrice),
+		errorLogged: make(map[client.ObjectKey]time.Time),
+	}
+}
+
+// VariantCost returns the average price of the nodes running the deployment's scheduled pods.
+// The second return value is false when none of those nodes carries a valid, non-negative price,
+// e.g. before the first pod is scheduled. Lookup failures are logged at most once per
+// NodeCostErrorLogInterval per deployment.
+func (c *NodeLabelCostCollector) VariantCost(ctx context.Context, deployment *appsv1.Deployment) (float64, bool, error) {
+	price, ok, err := c.variantCost(ctx, deployment)
+	if err != nil && c.errorLogDue(client.ObjectKeyFromObject(deployment)) {
+		logging.FromContext(ctx, logging.Collector).Info("Could not read node label pricing, using the configured variant cost",
+			"deployment", deployment.Name,
+			"namespace", deployment.Namespace,
+			"error", err)
+	}
+	return price, ok, err
+}
+
+func (c *NodeLabelCostCollector) variantCost(ctx context.Context, deployment *appsv1.Deployment) (float64, bool, error) {
+	if deployment.Spec.Selector == nil {
+		return 0, false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid selector of deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+
+	var pods corev1.PodList
+	if err := c.client.List(ctx, &pods, client.InNamespace(deployment.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return 0, false, fmt.Errorf("failed to list pods of deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+
+	// Each pod counts once, so a node running several replicas weighs accordingly
+	type nodePrice struct {
+		price float64
+		ok    bool
+	}
+	prices := make(map[string]nodePrice)
+	var total float64
+	var priced int
+	for _, pod := range pods.Items {
+		nodeName := pod.Spec.NodeName
+		if nodeName == "" || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		price, seen := prices[nodeName]
+		if !seen {
+			if price.price, price.ok, err = c.nodePrice(ctx, nodeName); err != nil {
+				return 0, false, err
+			}
+			prices[nodeName] = price
+		}
+		if !price.ok {
+			continue
+		}
+		total += price.price
+		priced++
+	}
+	if priced == 0 {
+		return 0, false, nil
+	}
+	return total / float64(priced), true, nil
+}
+
+// errorLogDue reports whether a lookup failure of the deployment should be logged, and if so
+// starts a new NodeCostErrorLogInterval for it.
+func (c *NodeLabelCostCollector) errorLogDue(key client.ObjectKey) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	if last, ok := c.errorLogged[key]; ok && now.Sub(last) < NodeCostErrorLogInterval {
+		return false
+	}
+	c.errorLogged[key] = now
+	return true
+}
+
+// nodePrice returns the price of a node, from the cache while younger than NodePriceCacheTTL.
+// Failed reads are not cached.
+func (c *NodeLabelCostCollector) nodePrice(ctx context.Context, nodeName string) (float64, bool, error) {
+	c.mu.Lock()
+	now := c.clock.Now()
+	cached, found := c.prices[nodeName]
+	if found && now.Sub(cached.readAt) < NodePriceCacheTTL {
+		c.mu.Unlock()
+		return cached.price, cached.ok, nil
+	}
+	// Drop expired entries so that removed nodes do not accumulate
+	for name, entry := range c.prices {
+		if now.Sub(entry.readAt) >= NodePriceCacheTTL {
+			delete(c.prices, name)
+		}
+	}
+	c.mu.Unlock()
+
+	price, ok, err := c.readNodePrice(ctx, nodeName)
+	if err != nil {
+		return 0, false, err
+	}
+	c.mu.Lock()
+	c.prices[nodeName] = cachedNodePrice{price: price, ok: ok, readAt: now}
+	c.mu.Unlock()
+	return price, ok, nil
+}
+
+// readNodePrice returns the price label of a node. The second return value is false when the
+// node is gone or the label is missing, malformed or negative.
+func (c *NodeLabelCostCollector) readNodePrice(ctx context.Context, nodeName string) (float64, bool, error) {
+	var node corev1.Node
+	if err := c.client.Get(ctx, client.ObjectKey{Name: nodeName}, &node); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+	value, ok := node.Labels[c.labelKey]
+	if !ok {
+		return 0, false, nil
+	}
+	price, err := strconv.ParseFloat(value, 64)
+	if err != nil || price < 0 {
+		return 0, false, nil
+	}
+	return price, true, nil
+}
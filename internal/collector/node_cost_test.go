@@ -0,0 +1,121 @@
+package collector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testPriceLabel = "pricing.example.com/spot-price"
+
+func priceNode(name, price string) *corev1.Node {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+	if price != "" {
+		node.Labels[testPriceLabel] = price
+	}
+	return node
+}
+
+func variantPod(name, app, nodeName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": app}},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func TestNodeLabelCostCollector_VariantCost(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		priceNode("spot-a", "1.2"),
+		priceNode("spot-b", "0.6"),
+		priceNode("unpriced", ""),
+		priceNode("garbled", "cheap"),
+		variantPod("llama-1", "llama", "spot-a"),
+		variantPod("llama-2", "llama", "spot-a"),
+		variantPod("llama-3", "llama", "spot-b"),
+		variantPod("llama-4", "llama", "unpriced"),
+		variantPod("llama-5", "llama", ""), // pending
+		variantPod("mistral-1", "mistral", "garbled"),
+		variantPod("other-1", "other", "spot-b"),
+	).Build()
+	clk := testclock.NewFakePassiveClock(time.Now())
+	costs := NewNodeLabelCostCollector(k8sClient, testPriceLabel, clk)
+
+	deployment := func(app string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: app, Namespace: "default"},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			},
+		}
+	}
+
+	cost, ok, err := costs.VariantCost(ctx, deployment("llama"))
+	if err != nil || !ok {
+		t.Fatalf("VariantCost(llama) = (%v, %v, %v), want a price", cost, ok, err)
+	}
+	// Only priced pods count: (1.2 + 1.2 + 0.6) / 3
+	if want := 1.0; cost < want-1e-9 || cost > want+1e-9 {
+		t.Errorf("VariantCost(llama) = %v, want %v", cost, want)
+	}
+
+	if cost, ok, err := costs.VariantCost(ctx, deployment("mistral")); err != nil || ok {
+		t.Errorf("VariantCost(mistral) = (%v, %v, %v), want no price from a malformed label", cost, ok, err)
+	}
+	if cost, ok, err := costs.VariantCost(ctx, deployment("absent")); err != nil || ok {
+		t.Errorf("VariantCost(absent) = (%v, %v, %v), want no price without pods", cost, ok, err)
+	}
+
+	// A spot price change is picked up once the cached price expires
+	node := &corev1.Node{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: "spot-b"}, node); err != nil {
+		t.Fatalf("failed to get node: %v", err)
+	}
+	node.Labels[testPriceLabel] = "3.6"
+	if err := k8sClient.Update(ctx, node); err != nil {
+		t.Fatalf("failed to update node: %v", err)
+	}
+	if cost, ok, err := costs.VariantCost(ctx, deployment("llama")); err != nil || !ok || cost != 1.0 {
+		t.Errorf("VariantCost(llama) before expiry = (%v, %v, %v), want the cached price 1", cost, ok, err)
+	}
+	clk.SetTime(clk.Now().Add(NodePriceCacheTTL))
+	cost, ok, err = costs.VariantCost(ctx, deployment("llama"))
+	if err != nil || !ok {
+		t.Fatalf("VariantCost(llama) after relabel = (%v, %v, %v), want a price", cost, ok, err)
+	}
+	if want := 2.0; cost < want-1e-9 || cost > want+1e-9 {
+		t.Errorf("VariantCost(llama) after relabel = %v, want %v", cost, want)
+	}
+}
+
+func TestNodeLabelCostCollector_ErrorLogDue(t *testing.T) {
+	clk := testclock.NewFakePassiveClock(time.Now())
+	costs := NewNodeLabelCostCollector(nil, testPriceLabel, clk)
+	llama := client.ObjectKey{Namespace: "default", Name: "llama"}
+
+	if !costs.errorLogDue(llama) {
+		t.Error("expected the first failure to be logged")
+	}
+	if costs.errorLogDue(llama) {
+		t.Error("expected a repeated failure within the interval not to be logged")
+	}
+	if !costs.errorLogDue(client.ObjectKey{Namespace: "default", Name: "mistral"}) {
+		t.Error("expected the failure of another deployment to be logged")
+	}
+	clk.SetTime(clk.Now().Add(NodeCostErrorLogInterval))
+	if !costs.errorLogDue(llama) {
+		t.Error("expected a failure after the interval to be logged")
+	}
+}
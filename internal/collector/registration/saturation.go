@@ -1,7 +1,13 @@
 package registration
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
 )
 
 // Query name constants for type-safe query references.
@@ -9,11 +15,95 @@ const (
 	// Saturation queries (per-pod peak metrics over time windows)
 	QueryKvCacheUsage = "kv_cache_usage"
 	QueryQueueLength  = "queue_length"
+
+	// KV cache bytes queries (per-pod used and total bytes), to derive the usage from
+	QueryKvCacheUsedBytes  = "kv_cache_used_bytes"
+	QueryKvCacheTotalBytes = "kv_cache_total_bytes"
+
+	// Goodput query (per-pod output token rate)
+	QueryOutputTokenRate = "output_token_rate"
+
+	// Speculative decoding query (per-pod draft acceptance rate)
+	QuerySpecDecodeAcceptanceRate = "spec_decode_acceptance_rate"
+
+	// Error rate query (per-pod fraction of HTTP requests failing with a 5xx status)
+	QueryErrorRate = "error_rate"
+
+	// Rejected request query (per-pod rate of HTTP requests rejected with a 429 status)
+	QueryRejectedRequestRate = "rejected_request_rate"
+
+	// Tokens-in-flight query (per-pod running and waiting requests times average request size)
+	QueryTokensInFlight = "tokens_in_flight"
+
+	// Arrival rate and request size queries (per-pod requests per second and average prompt and
+	// generated tokens per request)
+	QueryArrivalRate     = "arrival_rate"
+	QueryAvgInputTokens  = "avg_input_tokens"
+	QueryAvgOutputTokens = "avg_output_tokens"
 )
 
-// RegisterSaturationQueries registers queries used by the saturation analyzer.
+// QueueLengthMetricNames lists the metric names that may expose per-pod queue depth,
+// in priority order. Different vLLM versions and scrape pipelines use different names;
+// the collector queries all of them and uses the first one that returns data.
+var QueueLengthMetricNames = []string{
+	constants.VLLMNumRequestsWaiting,
+	constants.VLLMNumRequestsWaitingUnderscore,
+}
+
+// QueueLengthQueries returns the registered query names for QueueLengthMetricNames,
+// in the same priority order. The primary metric is registered as QueryQueueLength.
+func QueueLengthQueries() []string {
+	queries := make([]string, len(QueueLengthMetricNames))
+	for i := range QueueLengthMetricNames {
+		queries[i] = queueLengthQueryName(i)
+	}
+	return queries
+}
+
+// queueLengthQueryName returns the query name for the i-th queue length candidate.
+func queueLengthQueryName(i int) string {
+	if i == 0 {
+		return QueryQueueLength
+	}
+	return fmt.Sprintf("%s_fallback_%d", QueryQueueLength, i)
+}
+
+// OptionalQueries returns the registered query names of the optional metrics selected in
+// optional. The collector only refreshes these for models that enable a feature using them.
+func OptionalQueries(optional interfaces.OptionalReplicaMetrics) []string {
+	var queries []string
+	if optional.OutputTokenRate {
+		queries = append(queries, QueryOutputTokenRate)
+	}
+	if optional.SpecDecodeAcceptanceRate {
+		queries = append(queries, QuerySpecDecodeAcceptanceRate)
+	}
+	if optional.ErrorRate {
+		queries = append(queries, QueryErrorRate)
+	}
+	if optional.RejectedRequestRate {
+		queries = append(queries, QueryRejectedRequestRate)
+	}
+	if optional.TokensInFlight {
+		queries = append(queries, QueryTokensInFlight)
+	}
+	if optional.ArrivalRate {
+		queries = append(queries, QueryArrivalRate, QueryAvgInputTokens, QueryAvgOutputTokens)
+	}
+	return queries
+}
+
+// RegisterSaturationQueries registers queries used by the saturation analyzer on the primary
+// source, and on the shadow source when one is registered, since it serves the same collector.
+// The optional queries are registered too but only refreshed as selected by OptionalQueries.
 func RegisterSaturationQueries(sourceRegistry *source.SourceRegistry) {
-	registry := sourceRegistry.Get("prometheus").QueryList()
+	registerSaturationQueries(sourceRegistry.Get(source.PrimarySourceName).QueryList())
+	if shadow := sourceRegistry.Get(source.ShadowSourceName); shadow != nil {
+		registerSaturationQueries(shadow.QueryList())
+	}
+}
+
+func registerSaturationQueries(registry *source.QueryList) {
 
 	// KV cache usage per pod (peak over last minute)
 	// Uses max_over_time to catch saturation events between scrapes
@@ -25,14 +115,155 @@ func RegisterSaturationQueries(sourceRegistry *source.SourceRegistry) {
 		Description: "Peak KV cache utilization per pod (0.0-1.0) over last minute",
 	})
 
-	// Queue length per pod (peak over last minute)
+	// KV cache bytes in use per pod (peak over last minute) and allocated per pod, for exporters
+	// without a usage ratio. Only refreshed when the bytes fallback is enabled
+	registry.MustRegister(source.QueryTemplate{
+		Name:        QueryKvCacheUsedBytes,
+		Type:        source.QueryTypePromQL,
+		Template:    `max by (pod) (max_over_time(` + constants.KvCacheUsageBytes + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))`,
+		Params:      []string{source.ParamNamespace, source.ParamModelID},
+		Description: "Peak KV cache bytes in use per pod over last minute",
+	})
+	registry.MustRegister(source.QueryTemplate{
+		Name:        QueryKvCacheTotalBytes,
+		Type:        source.QueryTypePromQL,
+		Template:    `max by (pod) (` + constants.KvCacheTotalBytes + `{namespace="{{.namespace}}",model_name="{{.modelID}}"})`,
+		Params:      []string{source.ParamNamespace, source.ParamModelID},
+		Description: "KV cache bytes allocated per pod",
+	})
+
+	// Queue length per pod (peak over last minute), one query per candidate metric name
 	// Uses max_over_time to catch burst traffic
+	for i, metricName := range QueueLengthMetricNames {
+		registry.MustRegister(source.QueryTemplate{
+			Name:        queueLengthQueryName(i),
+			Type:        source.QueryTypePromQL,
+			Template:    `max by (pod) (max_over_time(` + metricName + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))`,
+			Params:      []string{source.ParamNamespace, source.ParamModelID},
+			Description: fmt.Sprintf("Peak queue length per pod over last minute (%s)", metricName),
+		})
+	}
+
+	// Output token rate per pod (tokens/sec over last minute)
+	// Used to detect goodput plateaus while the queue keeps growing
 	registry.MustRegister(source.QueryTemplate{
-		Name:        QueryQueueLength,
+		Name:        QueryOutputTokenRate,
 		Type:        source.QueryTypePromQL,
-		Template:    `max by (pod) (max_over_time(vllm:num_requests_waiting{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))`,
+		Template:    `sum by (pod) (rate(` + constants.VLLMGenerationTokensTotal + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))`,
 		Params:      []string{source.ParamNamespace, source.ParamModelID},
-		Description: "Peak queue length per pod over last minute",
+		Description: "Output tokens per second per pod over last minute (goodput)",
 	})
 
+	// Speculative decoding draft acceptance rate per pod (0.0-1.0 over last minute)
+	// Only returns data for engines running with speculative decoding
+	registry.MustRegister(source.QueryTemplate{
+		Name: QuerySpecDecodeAcceptanceRate,
+		Type: source.QueryTypePromQL,
+		Template: `sum by (pod) (rate(` + constants.VLLMSpecDecodeNumAcceptedTokensTotal + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))` +
+			` / sum by (pod) (rate(` + constants.VLLMSpecDecodeNumDraftTokensTotal + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))`,
+		Params:      []string{source.ParamNamespace, source.ParamModelID},
+		Description: "Fraction of speculative draft tokens accepted per pod over last minute",
+	})
+
+	// HTTP 5xx error rate per pod (0.0-1.0 over last minute)
+	// The API server metrics carry no model label; pods of other models are dropped by the collector
+	registry.MustRegister(source.QueryTemplate{
+		Name: QueryErrorRate,
+		Type: source.QueryTypePromQL,
+		Template: `sum by (pod) (rate(` + constants.HTTPRequestsTotal + `{namespace="{{.namespace}}",status=~"5.."}[1m]))` +
+			` / sum by (pod) (rate(` + constants.HTTPRequestsTotal + `{namespace="{{.namespace}}"}[1m]))`,
+		Params:      []string{source.ParamNamespace},
+		Description: "Fraction of HTTP requests failing with a 5xx status per pod over last minute",
+	})
+
+	// Rejected (HTTP 429) requests per second per pod over last minute. Requires the server to
+	// report exact status codes; a grouped "4xx" status cannot be told apart from client errors
+	registry.MustRegister(source.QueryTemplate{
+		Name:        QueryRejectedRequestRate,
+		Type:        source.QueryTypePromQL,
+		Template:    `sum by (pod) (rate(` + constants.HTTPRequestsTotal + `{namespace="{{.namespace}}",status="429"}[1m]))`,
+		Params:      []string{source.ParamNamespace},
+		Description: "HTTP requests rejected with a 429 status per second per pod over last minute",
+	})
+
+	// Tokens in flight per pod: peak running plus waiting requests over the last minute, times
+	// the average prompt plus generation tokens per request over the last five minutes
+	registry.MustRegister(source.QueryTemplate{
+		Name: QueryTokensInFlight,
+		Type: source.QueryTypePromQL,
+		Template: `(sum by (pod) (max_over_time(` + constants.VLLMNumRequestRunning + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))` +
+			` + sum by (pod) (max_over_time(` + constants.VLLMNumRequestsWaiting + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m])))` +
+			` * (sum by (pod) (rate(` + constants.VLLMRequestPromptTokensSum + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[5m]))` +
+			` + sum by (pod) (rate(` + constants.VLLMRequestGenerationTokensSum + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[5m])))` +
+			` / sum by (pod) (rate(` + constants.VLLMRequestPromptTokensCount + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[5m]))`,
+		Params:      []string{source.ParamNamespace, source.ParamModelID},
+		Description: "Estimated prompt and generation tokens held by running and waiting requests per pod",
+	})
+
+	// Requests per second per pod over last minute, used to redistribute the actual request rate
+	// in the scale-down simulation. Completed requests stand in for arrivals at steady state
+	registry.MustRegister(source.QueryTemplate{
+		Name:        QueryArrivalRate,
+		Type:        source.QueryTypePromQL,
+		Template:    `sum by (pod) (rate(` + constants.VLLMRequestSuccessTotal + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[1m]))`,
+		Params:      []string{source.ParamNamespace, source.ParamModelID},
+		Description: "Requests per second per pod over last minute",
+	})
+
+	// Average prompt and generated tokens per request per pod over the last five minutes
+	registry.MustRegister(source.QueryTemplate{
+		Name: QueryAvgInputTokens,
+		Type: source.QueryTypePromQL,
+		Template: `sum by (pod) (rate(` + constants.VLLMRequestPromptTokensSum + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[5m]))` +
+			` / sum by (pod) (rate(` + constants.VLLMRequestPromptTokensCount + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[5m]))`,
+		Params:      []string{source.ParamNamespace, source.ParamModelID},
+		Description: "Average prompt tokens per request per pod over last five minutes",
+	})
+	registry.MustRegister(source.QueryTemplate{
+		Name: QueryAvgOutputTokens,
+		Type: source.QueryTypePromQL,
+		Template: `sum by (pod) (rate(` + constants.VLLMRequestGenerationTokensSum + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[5m]))` +
+			` / sum by (pod) (rate(` + constants.VLLMRequestGenerationTokensCount + `{namespace="{{.namespace}}",model_name="{{.modelID}}"}[5m]))`,
+		Params:      []string{source.ParamNamespace, source.ParamModelID},
+		Description: "Average generated tokens per request per pod over last five minutes",
+	})
+}
+
+// SelectQueueLengthResult picks the first queue length result, in QueueLengthQueries order,
+// that returned data. Candidates that failed or returned no values are skipped.
+//
+// Returns the selected result and the metric name it was read from. If no candidate
+// returned data, the result is nil; the error is set only if a candidate failed, so
+// callers can distinguish "no queue metrics" from "queue metrics could not be queried".
+func SelectQueueLengthResult(ctx context.Context, results map[string]*source.MetricResult) (*source.MetricResult, string, error) {
+	logger := logging.FromContext(ctx, logging.Collector)
+
+	var firstErr error
+	for i, queryName := range QueueLengthQueries() {
+		result := results[queryName]
+		if result == nil {
+			continue
+		}
+		if result.HasError() {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("queue length query %s failed: %w", queryName, result.Error)
+			}
+			continue
+		}
+		if len(result.Values) == 0 {
+			continue
+		}
+
+		metricName := QueueLengthMetricNames[i]
+		if i == 0 {
+			logger.V(logging.DEBUG).Info("Queue length metric matched", "metric", metricName)
+		} else {
+			logger.V(logging.VERBOSE).Info("Queue length read from fallback metric",
+				"metric", metricName,
+				"primary", QueueLengthMetricNames[0])
+		}
+		return result, metricName, nil
+	}
+
+	return nil, "", firstErr
 }
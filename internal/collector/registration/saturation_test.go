@@ -0,0 +1,203 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source/prometheus"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+)
+
+// podVector builds a single-sample vector for the given pod.
+func podVector(pod string, value float64) model.Vector {
+	return model.Vector{
+		&model.Sample{
+			Metric:    model.Metric{"pod": model.LabelValue(pod)},
+			Value:     model.SampleValue(value),
+			Timestamp: model.TimeFromUnix(time.Now().Unix()),
+		},
+	}
+}
+
+var _ = Describe("Queue length metric fallback", func() {
+	var (
+		ctx           context.Context
+		registry      *source.SourceRegistry
+		mockAPI       *mockPrometheusAPI
+		metricsSource source.MetricsSource
+		params        map[string]string
+	)
+
+	// refreshQueueQueries registers the saturation queries against mockAPI and refreshes
+	// all queue length candidates, as the replica metrics collector does.
+	refreshQueueQueries := func() map[string]*source.MetricResult {
+		metricsSource = prometheus.NewPrometheusSource(ctx, mockAPI, prometheus.DefaultPrometheusSourceConfig())
+		Expect(registry.Register("prometheus", metricsSource)).To(Succeed())
+		RegisterSaturationQueries(registry)
+
+		results, err := metricsSource.Refresh(ctx, source.RefreshSpec{
+			Queries: QueueLengthQueries(),
+			Params:  params,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return results
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		registry = source.NewSourceRegistry()
+		params = map[string]string{
+			source.ParamModelID:   "my-model",
+			source.ParamNamespace: "default",
+		}
+	})
+
+	It("should register the saturation queries on the shadow source too", func() {
+		mockAPI = &mockPrometheusAPI{}
+		metricsSource = prometheus.NewPrometheusSource(ctx, mockAPI, prometheus.DefaultPrometheusSourceConfig())
+		shadowSource := prometheus.NewPrometheusSource(ctx, mockAPI, prometheus.DefaultPrometheusSourceConfig())
+		Expect(registry.Register(source.PrimarySourceName, metricsSource)).To(Succeed())
+		Expect(registry.Register(source.ShadowSourceName, shadowSource)).To(Succeed())
+		RegisterSaturationQueries(registry)
+
+		for _, name := range append(QueueLengthQueries(), QueryKvCacheUsage, QuerySpecDecodeAcceptanceRate) {
+			Expect(shadowSource.QueryList().Get(name)).NotTo(BeNil(), name)
+		}
+	})
+
+	It("should register one query per candidate metric name with the primary first", func() {
+		mockAPI = &mockPrometheusAPI{}
+		metricsSource = prometheus.NewPrometheusSource(ctx, mockAPI, prometheus.DefaultPrometheusSourceConfig())
+		Expect(registry.Register("prometheus", metricsSource)).To(Succeed())
+		RegisterSaturationQueries(registry)
+
+		queries := QueueLengthQueries()
+		Expect(queries).To(HaveLen(len(QueueLengthMetricNames)))
+		Expect(queries[0]).To(Equal(QueryQueueLength))
+		for i, name := range queries {
+			query := metricsSource.QueryList().Get(name)
+			Expect(query).NotTo(BeNil())
+			Expect(query.Template).To(ContainSubstring(QueueLengthMetricNames[i] + "{"))
+		}
+
+		tokenRate := metricsSource.QueryList().Get(QueryOutputTokenRate)
+		Expect(tokenRate).NotTo(BeNil())
+		Expect(tokenRate.Template).To(ContainSubstring("rate(" + constants.VLLMGenerationTokensTotal + "{"))
+
+		acceptance := metricsSource.QueryList().Get(QuerySpecDecodeAcceptanceRate)
+		Expect(acceptance).NotTo(BeNil())
+		Expect(acceptance.Template).To(ContainSubstring("rate(" + constants.VLLMSpecDecodeNumAcceptedTokensTotal + "{"))
+		Expect(acceptance.Template).To(ContainSubstring("rate(" + constants.VLLMSpecDecodeNumDraftTokensTotal + "{"))
+
+		errorRate := metricsSource.QueryList().Get(QueryErrorRate)
+		Expect(errorRate).NotTo(BeNil())
+		Expect(errorRate.Template).To(ContainSubstring("rate(" + constants.HTTPRequestsTotal + `{namespace="{{.namespace}}",status=~"5.."}`))
+
+		rejectedRequestRate := metricsSource.QueryList().Get(QueryRejectedRequestRate)
+		Expect(rejectedRequestRate).NotTo(BeNil())
+		Expect(rejectedRequestRate.Template).To(ContainSubstring("rate(" + constants.HTTPRequestsTotal + `{namespace="{{.namespace}}",status="429"}`))
+
+		tokensInFlight := metricsSource.QueryList().Get(QueryTokensInFlight)
+		Expect(tokensInFlight).NotTo(BeNil())
+		Expect(tokensInFlight.Template).To(ContainSubstring("max_over_time(" + constants.VLLMNumRequestRunning + "{"))
+		Expect(tokensInFlight.Template).To(ContainSubstring("rate(" + constants.VLLMRequestPromptTokensSum + "{"))
+		Expect(tokensInFlight.Template).To(ContainSubstring("rate(" + constants.VLLMRequestGenerationTokensSum + "{"))
+
+		arrivalRate := metricsSource.QueryList().Get(QueryArrivalRate)
+		Expect(arrivalRate).NotTo(BeNil())
+		Expect(arrivalRate.Template).To(ContainSubstring("rate(" + constants.VLLMRequestSuccessTotal + "{"))
+
+		avgInputTokens := metricsSource.QueryList().Get(QueryAvgInputTokens)
+		Expect(avgInputTokens).NotTo(BeNil())
+		Expect(avgInputTokens.Template).To(ContainSubstring("rate(" + constants.VLLMRequestPromptTokensCount + "{"))
+
+		avgOutputTokens := metricsSource.QueryList().Get(QueryAvgOutputTokens)
+		Expect(avgOutputTokens).NotTo(BeNil())
+		Expect(avgOutputTokens.Template).To(ContainSubstring("rate(" + constants.VLLMRequestGenerationTokensCount + "{"))
+	})
+
+	It("should use the primary metric when it returns data", func() {
+		mockAPI = &mockPrometheusAPI{
+			queryFunc: func(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+				if strings.Contains(query, constants.VLLMNumRequestsWaiting) {
+					return podVector("pod-a", 4), nil, nil
+				}
+				return podVector("pod-a", 99), nil, nil
+			},
+		}
+
+		result, metricName, err := SelectQueueLengthResult(ctx, refreshQueueQueries())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metricName).To(Equal(constants.VLLMNumRequestsWaiting))
+		Expect(result.Values).To(HaveLen(1))
+		Expect(result.Values[0].Value).To(Equal(4.0))
+	})
+
+	It("should fall back to a secondary metric name when the primary is absent", func() {
+		mockAPI = &mockPrometheusAPI{
+			queryFunc: func(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+				if strings.Contains(query, constants.VLLMNumRequestsWaitingUnderscore) {
+					return podVector("pod-a", 7), nil, nil
+				}
+				return model.Vector{}, nil, nil
+			},
+		}
+
+		result, metricName, err := SelectQueueLengthResult(ctx, refreshQueueQueries())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metricName).To(Equal(constants.VLLMNumRequestsWaitingUnderscore))
+		Expect(result.Values).To(HaveLen(1))
+		Expect(result.Values[0].Labels["pod"]).To(Equal("pod-a"))
+		Expect(result.Values[0].Value).To(Equal(7.0))
+	})
+
+	It("should fall back when the primary query fails", func() {
+		mockAPI = &mockPrometheusAPI{
+			queryFunc: func(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+				if strings.Contains(query, constants.VLLMNumRequestsWaitingUnderscore) {
+					return podVector("pod-a", 2), nil, nil
+				}
+				return nil, nil, errors.New("bad query")
+			},
+		}
+
+		result, metricName, err := SelectQueueLengthResult(ctx, refreshQueueQueries())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metricName).To(Equal(constants.VLLMNumRequestsWaitingUnderscore))
+		Expect(result.Values[0].Value).To(Equal(2.0))
+	})
+
+	It("should return no result and no error when no candidate has data", func() {
+		mockAPI = &mockPrometheusAPI{
+			queryFunc: func(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+				return model.Vector{}, nil, nil
+			},
+		}
+
+		result, metricName, err := SelectQueueLengthResult(ctx, refreshQueueQueries())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeNil())
+		Expect(metricName).To(BeEmpty())
+	})
+
+	It("should return an error when candidates fail and none has data", func() {
+		mockAPI = &mockPrometheusAPI{
+			queryFunc: func(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error) {
+				return nil, nil, errors.New("prometheus down")
+			},
+		}
+
+		result, _, err := SelectQueueLengthResult(ctx, refreshQueueQueries())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(QueryQueueLength))
+		Expect(result).To(BeNil())
+	})
+})
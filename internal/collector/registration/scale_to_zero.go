@@ -9,8 +9,6 @@ import (
 	"fmt"
 	"time"
 
-	ctrl "sigs.k8s.io/controller-runtime"
-
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
@@ -28,9 +26,9 @@ const (
 // RegisterScaleToZeroQueries registers queries used for scale-to-zero decisions.
 // This should be called during initialization to register query templates with the prometheus source.
 func RegisterScaleToZeroQueries(sourceRegistry *source.SourceRegistry) {
-	metricsSource := sourceRegistry.Get("prometheus")
+	metricsSource := sourceRegistry.Get(source.PrimarySourceName)
 	if metricsSource == nil {
-		ctrl.Log.V(logging.DEBUG).Info("Prometheus source not registered, skipping scale-to-zero query registration")
+		logging.Log(logging.Collector).V(logging.DEBUG).Info("Prometheus source not registered, skipping scale-to-zero query registration")
 		return
 	}
 
@@ -73,7 +71,7 @@ func CollectModelRequestCount(
 	namespace string,
 	retentionPeriod time.Duration,
 ) (float64, error) {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Collector)
 
 	// Convert Go duration to Prometheus duration format
 	retentionPeriodStr := utils.FormatPrometheusDuration(retentionPeriod)
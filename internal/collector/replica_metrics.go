@@ -23,10 +23,10 @@ package collector
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
@@ -35,6 +35,7 @@ import (
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/saturation"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 )
 
 // ReplicaMetricsCollector collects replica-level metrics for saturation analysis
@@ -43,6 +44,9 @@ type ReplicaMetricsCollector struct {
 	source      source.MetricsSource
 	k8sClient   client.Client
 	podVAMapper *source.PodVAMapper
+	labelDrift  *LabelDriftTracker
+	// kvCacheFromBytes derives KV cache usage from used and total bytes for pods without a ratio
+	kvCacheFromBytes bool
 }
 
 // NewReplicaMetricsCollector creates a new replica metrics collector.
@@ -54,6 +58,20 @@ func NewReplicaMetricsCollector(metricsSource source.MetricsSource, k8sClient cl
 	}
 }
 
+// WithKvCacheFromBytes makes the collector derive the KV cache usage of pods that report no
+// usage ratio from their used and total KV cache bytes. It returns c.
+func (c *ReplicaMetricsCollector) WithKvCacheFromBytes(enabled bool) *ReplicaMetricsCollector {
+	c.kvCacheFromBytes = enabled
+	return c
+}
+
+// WithLabelDriftTracker makes the collector record in tracker, per model, the queries whose
+// series are missing the pod label. It returns c.
+func (c *ReplicaMetricsCollector) WithLabelDriftTracker(tracker *LabelDriftTracker) *ReplicaMetricsCollector {
+	c.labelDrift = tracker
+	return c
+}
+
 // CollectReplicaMetrics collects KV cache and queue metrics for all replicas of a model
 // using the source infrastructure.
 //
@@ -68,6 +86,7 @@ func NewReplicaMetricsCollector(metricsSource source.MetricsSource, k8sClient cl
 //   - deployments: Map of deployment name to deployment object
 //   - variantAutoscalings: Map of deployment name to VA object
 //   - variantCosts: Map of deployment name to cost value
+//   - optional: The optional metrics to query besides KV cache usage and queue length
 //
 // Returns:
 //   - []interfaces.ReplicaMetrics: Per-pod metrics for saturation analysis
@@ -79,18 +98,21 @@ func (c *ReplicaMetricsCollector) CollectReplicaMetrics(
 	deployments map[string]*appsv1.Deployment,
 	variantAutoscalings map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
 	variantCosts map[string]float64,
+	optional interfaces.OptionalReplicaMetrics,
 ) ([]interfaces.ReplicaMetrics, error) {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Collector)
 
 	params := map[string]string{
 		source.ParamModelID:   modelID,
 		source.ParamNamespace: namespace,
 	}
 
-	// Refresh saturation queries (KV cache and queue length)
-	queries := []string{
-		registration.QueryKvCacheUsage,
-		registration.QueryQueueLength,
+	// Refresh saturation queries (KV cache and all queue length candidates), and the optional
+	// queries of the features enabled for the model
+	queries := append([]string{registration.QueryKvCacheUsage}, registration.QueueLengthQueries()...)
+	queries = append(queries, registration.OptionalQueries(optional)...)
+	if c.kvCacheFromBytes {
+		queries = append(queries, registration.QueryKvCacheUsedBytes, registration.QueryKvCacheTotalBytes)
 	}
 
 	results, err := c.source.Refresh(ctx, source.RefreshSpec{
@@ -101,14 +123,37 @@ func (c *ReplicaMetricsCollector) CollectReplicaMetrics(
 		return nil, fmt.Errorf("failed to refresh saturation metrics: %w", err)
 	}
 
+	// Series that cannot be attributed to a pod are dropped below; without this check a renamed
+	// pod label would only show as missing metrics
+	missingLabels := MissingPodLabelQueries(results)
+	if len(missingLabels) > 0 {
+		logger.Info("Metric series are missing the pod label and cannot be attributed to replicas; check the labels exported by the model server",
+			"model", modelID,
+			"namespace", namespace,
+			"queries", missingLabels,
+			"expectedLabels", []string{labelPod, labelPodName})
+	}
+	if c.labelDrift != nil {
+		c.labelDrift.Record(namespace, modelID, missingLabels)
+	}
+
 	// podMetricData holds per-pod metric values and timestamps
 	type podMetricData struct {
 		kvUsage        float64
 		kvTimestamp    time.Time
 		hasKv          bool
-		queueLen       int
+		queueLen       float64
 		queueTimestamp time.Time
 		hasQueue       bool
+		tokenRate      float64
+		acceptanceRate float64
+		hasAcceptance  bool
+		errorRate      float64
+		rejectedRate   float64
+		tokensInFlight float64
+		arrivalRate    float64
+		inputTokens    float64
+		outputTokens   float64
 	}
 
 	// Extract per-pod metrics from results
@@ -131,23 +176,63 @@ func (c *ReplicaMetricsCollector) CollectReplicaMetrics(
 			if podData[podName] == nil {
 				podData[podName] = &podMetricData{}
 			}
-			podData[podName].kvUsage = value.Value
+			// Some exporters report usage as a percentage, which would break every
+			// threshold comparison downstream
+			kvUsage, normalized := normalizeKvCacheUsage(value.Value)
+			if normalized {
+				logger.Info("KV cache usage above 1 looks like a percentage, scaling it to a fraction; check the exporter's unit",
+					"pod", podName,
+					"model", modelID,
+					"namespace", namespace,
+					"reported", value.Value,
+					"usage", kvUsage)
+			}
+			podData[podName].kvUsage = kvUsage
 			podData[podName].kvTimestamp = value.Timestamp
 			podData[podName].hasKv = true
 
 			logger.V(logging.DEBUG).Info("KV cache metric",
 				"pod", podName,
-				"usage", value.Value,
-				"usagePercent", value.Value*100)
+				"usage", kvUsage,
+				"usagePercent", kvUsage*100)
 		}
 	}
 
-	// Process queue length results
-	if result := results[registration.QueryQueueLength]; result != nil {
-		if result.HasError() {
-			return nil, fmt.Errorf("queue length query failed: %w", result.Error)
+	// Derive the KV cache usage of pods without a usage ratio from their used and total bytes
+	if c.kvCacheFromBytes {
+		used, total := results[registration.QueryKvCacheUsedBytes], results[registration.QueryKvCacheTotalBytes]
+		for _, result := range []*source.MetricResult{used, total} {
+			if result != nil && result.HasError() {
+				logger.V(logging.DEBUG).Info("KV cache bytes query failed, usage not derived",
+					"model", modelID,
+					"namespace", namespace,
+					"error", result.Error)
+			}
 		}
-		for _, value := range result.Values {
+		for podName, sample := range kvCacheUsageFromBytes(used, total) {
+			if data := podData[podName]; data != nil && data.hasKv {
+				continue
+			}
+			if podData[podName] == nil {
+				podData[podName] = &podMetricData{}
+			}
+			podData[podName].kvUsage = sample.usage
+			podData[podName].kvTimestamp = sample.timestamp
+			podData[podName].hasKv = true
+
+			logger.V(logging.DEBUG).Info("KV cache metric derived from bytes",
+				"pod", podName,
+				"usage", sample.usage)
+		}
+	}
+
+	// Process queue length results from the first candidate metric name that returned data
+	queueResult, _, err := registration.SelectQueueLengthResult(ctx, results)
+	if err != nil {
+		return nil, err
+	}
+	if queueResult != nil {
+		for _, value := range queueResult.Values {
 			podName := value.Labels["pod"]
 			if podName == "" {
 				podName = value.Labels["pod_name"]
@@ -159,13 +244,175 @@ func (c *ReplicaMetricsCollector) CollectReplicaMetrics(
 			if podData[podName] == nil {
 				podData[podName] = &podMetricData{}
 			}
-			podData[podName].queueLen = int(value.Value)
+			podData[podName].queueLen = value.Value
 			podData[podName].queueTimestamp = value.Timestamp
 			podData[podName].hasQueue = true
 
 			logger.V(logging.DEBUG).Info("Queue metric",
 				"pod", podName,
-				"queueLength", int(value.Value))
+				"queueLength", value.Value)
+		}
+	}
+
+	// Process output token rate results. Goodput is optional: a failed or missing query
+	// leaves the rate at 0, which disables goodput-based scaling for the model.
+	if result := results[registration.QueryOutputTokenRate]; result != nil {
+		if result.HasError() {
+			logger.V(logging.DEBUG).Info("Output token rate query failed, goodput unavailable",
+				"model", modelID,
+				"namespace", namespace,
+				"error", result.Error)
+		} else {
+			for _, value := range result.Values {
+				podName := value.Labels["pod"]
+				if podName == "" {
+					podName = value.Labels["pod_name"]
+				}
+				// Only annotate pods that report saturation metrics
+				if data := podData[podName]; data != nil {
+					data.tokenRate = value.Value
+				}
+			}
+		}
+	}
+
+	// Process speculative decoding acceptance rate results. Only engines running speculative
+	// decoding report it; pods without drafts in the window (NaN) are left without a rate, which
+	// leaves the acceptance trigger out of the picture for them.
+	if result := results[registration.QuerySpecDecodeAcceptanceRate]; result != nil {
+		if result.HasError() {
+			logger.V(logging.DEBUG).Info("Speculative decoding acceptance rate query failed",
+				"model", modelID,
+				"namespace", namespace,
+				"error", result.Error)
+		} else {
+			for _, value := range result.Values {
+				podName := value.Labels["pod"]
+				if podName == "" {
+					podName = value.Labels["pod_name"]
+				}
+				if math.IsNaN(value.Value) || math.IsInf(value.Value, 0) {
+					continue
+				}
+				// Only annotate pods that report saturation metrics
+				if data := podData[podName]; data != nil {
+					data.acceptanceRate = value.Value
+					data.hasAcceptance = true
+				}
+			}
+		}
+	}
+
+	// Process error rate results. The error rate is optional: a failed or missing query, or
+	// pods without requests in the window (NaN), leave the rate at 0, which never blocks scale-down.
+	if result := results[registration.QueryErrorRate]; result != nil {
+		if result.HasError() {
+			logger.V(logging.DEBUG).Info("Error rate query failed",
+				"model", modelID,
+				"namespace", namespace,
+				"error", result.Error)
+		} else {
+			for _, value := range result.Values {
+				podName := value.Labels["pod"]
+				if podName == "" {
+					podName = value.Labels["pod_name"]
+				}
+				if math.IsNaN(value.Value) || math.IsInf(value.Value, 0) {
+					continue
+				}
+				// Only annotate pods that report saturation metrics
+				if data := podData[podName]; data != nil {
+					data.errorRate = value.Value
+				}
+			}
+		}
+	}
+
+	// Process rejected request rate results. A failed or missing query, or pods that reject
+	// nothing (no series or NaN), leave the rate at 0, which never triggers scale-up.
+	if result := results[registration.QueryRejectedRequestRate]; result != nil {
+		if result.HasError() {
+			logger.V(logging.DEBUG).Info("Rejected request rate query failed",
+				"model", modelID,
+				"namespace", namespace,
+				"error", result.Error)
+		} else {
+			for _, value := range result.Values {
+				podName := value.Labels["pod"]
+				if podName == "" {
+					podName = value.Labels["pod_name"]
+				}
+				if math.IsNaN(value.Value) || math.IsInf(value.Value, 0) {
+					continue
+				}
+				// Only annotate pods that report saturation metrics
+				if data := podData[podName]; data != nil {
+					data.rejectedRate = value.Value
+				}
+			}
+		}
+	}
+
+	// Process tokens in flight results. A failed or missing query, or pods without completed
+	// requests in the window (NaN), leave the count at 0, which reads as an idle replica.
+	if result := results[registration.QueryTokensInFlight]; result != nil {
+		if result.HasError() {
+			logger.V(logging.DEBUG).Info("Tokens in flight query failed",
+				"model", modelID,
+				"namespace", namespace,
+				"error", result.Error)
+		} else {
+			for _, value := range result.Values {
+				podName := value.Labels["pod"]
+				if podName == "" {
+					podName = value.Labels["pod_name"]
+				}
+				if math.IsNaN(value.Value) || math.IsInf(value.Value, 0) {
+					continue
+				}
+				// Only annotate pods that report saturation metrics
+				if data := podData[podName]; data != nil {
+					data.tokensInFlight = value.Value
+				}
+			}
+		}
+	}
+
+	// Process arrival rate and request size results. A failed or missing query, or pods without
+	// requests in the window (NaN), leave the values at 0, and the scale-down simulation falls
+	// back to the KV cache and queue snapshot.
+	for _, query := range []struct {
+		name  string
+		field func(*podMetricData) *float64
+	}{
+		{registration.QueryArrivalRate, func(d *podMetricData) *float64 { return &d.arrivalRate }},
+		{registration.QueryAvgInputTokens, func(d *podMetricData) *float64 { return &d.inputTokens }},
+		{registration.QueryAvgOutputTokens, func(d *podMetricData) *float64 { return &d.outputTokens }},
+	} {
+		result := results[query.name]
+		if result == nil {
+			continue
+		}
+		if result.HasError() {
+			logger.V(logging.DEBUG).Info("Arrival rate query failed",
+				"query", query.name,
+				"model", modelID,
+				"namespace", namespace,
+				"error", result.Error)
+			continue
+		}
+		for _, value := range result.Values {
+			podName := value.Labels["pod"]
+			if podName == "" {
+				podName = value.Labels["pod_name"]
+			}
+			if math.IsNaN(value.Value) || math.IsInf(value.Value, 0) {
+				continue
+			}
+			// Only annotate pods that report saturation metrics
+			if data := podData[podName]; data != nil {
+				*query.field(data) = value.Value
+			}
 		}
 	}
 
@@ -210,10 +457,14 @@ func (c *ReplicaMetricsCollector) CollectReplicaMetrics(
 		// Get accelerator name from VariantAutoscaling label
 		acceleratorName := ""
 		if va, ok := variantAutoscalings[variantName]; ok && va != nil {
-			if va.Labels != nil {
-				if accName, exists := va.Labels["inference.optimization/acceleratorName"]; exists {
-					acceleratorName = accName
-				}
+			acceleratorName = utils.GetAcceleratorType(va)
+		}
+
+		// The metrics are as old as the older of the KV cache and queue samples
+		var age time.Duration
+		for _, sampledAt := range []time.Time{data.kvTimestamp, data.queueTimestamp} {
+			if !sampledAt.IsZero() && collectedAt.Sub(sampledAt) > age {
+				age = collectedAt.Sub(sampledAt)
 			}
 		}
 
@@ -234,9 +485,19 @@ func (c *ReplicaMetricsCollector) CollectReplicaMetrics(
 			KvCacheUsage:    kvUsage,
 			QueueLength:     queueLen,
 			Cost:            cost,
+			OutputTokenRate: data.tokenRate,
+
+			SpecDecodeAcceptanceRate:    data.acceptanceRate,
+			HasSpecDecodeAcceptanceRate: data.hasAcceptance,
+			ErrorRate:                   data.errorRate,
+			RejectedRequestRate:         data.rejectedRate,
+			TokensInFlight:              data.tokensInFlight,
+			ArrivalRate:                 data.arrivalRate,
+			AvgInputTokens:              data.inputTokens,
+			AvgOutputTokens:             data.outputTokens,
 			Metadata: &interfaces.ReplicaMetricsMetadata{
 				CollectedAt:     collectedAt,
-				Age:             0, // Fresh
+				Age:             age,
 				FreshnessStatus: "fresh",
 			},
 		}
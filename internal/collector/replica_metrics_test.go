@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/registration"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestReplicaMetricsCollector_RefreshesOnlySelectedOptionalQueries(t *testing.T) {
+	optionalQueries := []string{
+		registration.QueryOutputTokenRate, registration.QuerySpecDecodeAcceptanceRate,
+		registration.QueryErrorRate, registration.QueryRejectedRequestRate, registration.QueryTokensInFlight,
+		registration.QueryArrivalRate, registration.QueryAvgInputTokens, registration.QueryAvgOutputTokens,
+	}
+
+	tests := []struct {
+		name     string
+		config   interfaces.SaturationScalingConfig
+		expected []string
+	}{
+		{name: "no optional feature", expected: nil},
+		{
+			name:     "goodput and error rate",
+			config:   interfaces.SaturationScalingConfig{GoodputPlateauThreshold: 0.05, ErrorRateThreshold: 0.1},
+			expected: []string{registration.QueryOutputTokenRate, registration.QueryErrorRate},
+		},
+		{
+			name:     "arrival rate simulation",
+			config:   interfaces.SaturationScalingConfig{ArrivalRateSimulation: true},
+			expected: []string{registration.QueryArrivalRate, registration.QueryAvgInputTokens, registration.QueryAvgOutputTokens},
+		},
+		{
+			name: "every optional feature",
+			config: interfaces.SaturationScalingConfig{
+				GoodputPlateauThreshold: 0.05, SpecDecodeAcceptanceThreshold: 0.5, ErrorRateThreshold: 0.1,
+				RejectedRequestRateTrigger: 1, TokensInFlightSpareTrigger: 0.2, ArrivalRateSimulation: true,
+			},
+			expected: optionalQueries,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &staticSource{results: map[string]*source.MetricResult{}}
+			c := NewReplicaMetricsCollector(src, nil)
+			if _, err := c.CollectReplicaMetrics(context.Background(), "meta/llama", "llm", nil, nil, nil,
+				tt.config.OptionalReplicaMetrics()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slices.Contains(src.queries, registration.QueryKvCacheUsage) {
+				t.Errorf("expected the KV cache query to always be refreshed, got %v", src.queries)
+			}
+			var refreshed []string
+			for _, query := range optionalQueries {
+				if slices.Contains(src.queries, query) {
+					refreshed = append(refreshed, query)
+				}
+			}
+			if !slices.Equal(refreshed, tt.expected) {
+				t.Errorf("expected optional queries %v, got %v", tt.expected, refreshed)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package collector
+
+import (
+	"context"
+	"math"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+)
+
+// Metric names reported in discrepancies and in the metric label of
+// wva_collector_discrepancy_total.
+const (
+	DiscrepancyMetricKvCacheUsage = "kv_cache_usage"
+	DiscrepancyMetricQueueLength  = "queue_length"
+	// DiscrepancyMetricReplica marks a replica reported by only one of the collectors
+	DiscrepancyMetricReplica = "replica"
+)
+
+const (
+	// shadowKvCacheTolerance is the absolute KV cache usage difference (0.0-1.0) tolerated
+	// between collectors, covering scrape timing differences
+	shadowKvCacheTolerance = 0.05
+	// shadowQueueLengthTolerance is the absolute queue length difference tolerated between collectors
+	shadowQueueLengthTolerance = 1.0
+)
+
+// MetricsDiscrepancy describes a replica metric on which two collectors disagree.
+type MetricsDiscrepancy struct {
+	PodName string
+	Metric  string
+	Primary float64
+	Shadow  float64
+}
+
+// CompareReplicaMetrics compares the replica metrics of two collectors pod by pod.
+// KV cache usage and queue length differing by more than a small tolerance are reported,
+// as are pods returned by only one collector (with 1 for present and 0 for missing).
+func CompareReplicaMetrics(primary, shadow []interfaces.ReplicaMetrics) []MetricsDiscrepancy {
+	shadowByPod := make(map[string]interfaces.ReplicaMetrics, len(shadow))
+	for _, m := range shadow {
+		shadowByPod[m.PodName] = m
+	}
+
+	var discrepancies []MetricsDiscrepancy
+	seen := make(map[string]bool, len(primary))
+	for _, p := range primary {
+		seen[p.PodName] = true
+		s, ok := shadowByPod[p.PodName]
+		if !ok {
+			discrepancies = append(discrepancies, MetricsDiscrepancy{PodName: p.PodName, Metric: DiscrepancyMetricReplica, Primary: 1})
+			continue
+		}
+		if math.Abs(p.KvCacheUsage-s.KvCacheUsage) > shadowKvCacheTolerance {
+			discrepancies = append(discrepancies, MetricsDiscrepancy{
+				PodName: p.PodName, Metric: DiscrepancyMetricKvCacheUsage, Primary: p.KvCacheUsage, Shadow: s.KvCacheUsage,
+			})
+		}
+		if math.Abs(p.QueueLength-s.QueueLength) > shadowQueueLengthTolerance {
+			discrepancies = append(discrepancies, MetricsDiscrepancy{
+				PodName: p.PodName, Metric: DiscrepancyMetricQueueLength, Primary: p.QueueLength, Shadow: s.QueueLength,
+			})
+		}
+	}
+	for _, s := range shadow {
+		if !seen[s.PodName] {
+			discrepancies = append(discrepancies, MetricsDiscrepancy{PodName: s.PodName, Metric: DiscrepancyMetricReplica, Shadow: 1})
+		}
+	}
+	return discrepancies
+}
+
+// ShadowCollector runs a secondary collector alongside the primary one, e.g. while migrating
+// from Prometheus to EPP collection. Both are queried each cycle and disagreements are logged
+// and counted in wva_collector_discrepancy_total, but only the primary's metrics are returned,
+// so scaling decisions are unaffected by the shadow. Shadow failures are logged and ignored.
+type ShadowCollector struct {
+	primary interfaces.MetricsCollector
+	shadow  interfaces.MetricsCollector
+	emitter *metrics.MetricsEmitter
+}
+
+// NewShadowCollector creates a collector returning primary's metrics and comparing them to shadow's.
+func NewShadowCollector(primary, shadow interfaces.MetricsCollector, emitter *metrics.MetricsEmitter) *ShadowCollector {
+	return &ShadowCollector{
+		primary: primary,
+		shadow:  shadow,
+		emitter: emitter,
+	}
+}
+
+// CollectReplicaMetrics returns the primary collector's metrics after comparing them to the shadow's.
+func (c *ShadowCollector) CollectReplicaMetrics(
+	ctx context.Context,
+	modelID string,
+	namespace string,
+	deployments map[string]*appsv1.Deployment,
+	variantAutoscalings map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+	variantCosts map[string]float64,
+	optional interfaces.OptionalReplicaMetrics,
+) ([]interfaces.ReplicaMetrics, error) {
+	primaryMetrics, err := c.primary.CollectReplicaMetrics(ctx, modelID, namespace, deployments, variantAutoscalings, variantCosts, optional)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logging.FromContext(ctx, logging.Collector)
+
+	shadowMetrics, err := c.shadow.CollectReplicaMetrics(ctx, modelID, namespace, deployments, variantAutoscalings, variantCosts, optional)
+	if err != nil {
+		logger.Error(err, "Shadow collector failed, comparison skipped",
+			"modelID", modelID,
+			"namespace", namespace)
+		return primaryMetrics, nil
+	}
+
+	counts := make(map[string]int)
+	for _, d := range CompareReplicaMetrics(primaryMetrics, shadowMetrics) {
+		counts[d.Metric]++
+		logger.Info("Shadow collector discrepancy",
+			"modelID", modelID,
+			"namespace", namespace,
+			"pod", d.PodName,
+			"metric", d.Metric,
+			"primary", d.Primary,
+			"shadow", d.Shadow)
+	}
+	for metric, count := range counts {
+		if err := c.emitter.EmitCollectorDiscrepancy(ctx, modelID, namespace, metric, count); err != nil {
+			logger.V(logging.DEBUG).Info("Failed to emit collector discrepancy metric", "error", err)
+		}
+	}
+
+	return primaryMetrics, nil
+}
@@ -0,0 +1,154 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+)
+
+// staticCollector returns fixed replica metrics, counting how often it is queried.
+type staticCollector struct {
+	metrics []interfaces.ReplicaMetrics
+	err     error
+	calls   int
+}
+
+func (c *staticCollector) CollectReplicaMetrics(
+	_ context.Context, _, _ string,
+	_ map[string]*appsv1.Deployment,
+	_ map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+	_ map[string]float64,
+	_ interfaces.OptionalReplicaMetrics,
+) ([]interfaces.ReplicaMetrics, error) {
+	c.calls++
+	return c.metrics, c.err
+}
+
+// gatherDiscrepancies returns wva_collector_discrepancy_total by metric label.
+func gatherDiscrepancies(t *testing.T, registry *prometheus.Registry) map[string]float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	counts := map[string]float64{}
+	for _, family := range families {
+		if family.GetName() != constants.WVACollectorDiscrepancyTotal {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, pair := range metric.GetLabel() {
+				if pair.GetName() == constants.LabelMetric {
+					counts[pair.GetValue()] = metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return counts
+}
+
+func TestCompareReplicaMetrics(t *testing.T) {
+	primary := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", KvCacheUsage: 0.50, QueueLength: 2},
+		{PodName: "pod-2", KvCacheUsage: 0.60, QueueLength: 3},
+		{PodName: "pod-3", KvCacheUsage: 0.70, QueueLength: 4},
+	}
+	shadow := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", KvCacheUsage: 0.52, QueueLength: 2.5}, // within tolerance
+		{PodName: "pod-2", KvCacheUsage: 0.80, QueueLength: 8},
+		{PodName: "pod-4", KvCacheUsage: 0.10},
+	}
+
+	got := CompareReplicaMetrics(primary, shadow)
+	want := []MetricsDiscrepancy{
+		{PodName: "pod-2", Metric: DiscrepancyMetricKvCacheUsage, Primary: 0.60, Shadow: 0.80},
+		{PodName: "pod-2", Metric: DiscrepancyMetricQueueLength, Primary: 3, Shadow: 8},
+		{PodName: "pod-3", Metric: DiscrepancyMetricReplica, Primary: 1},
+		{PodName: "pod-4", Metric: DiscrepancyMetricReplica, Shadow: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d discrepancies, got %d: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("discrepancy %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	if d := CompareReplicaMetrics(primary, primary); len(d) != 0 {
+		t.Errorf("expected no discrepancies for identical metrics, got %+v", d)
+	}
+}
+
+func TestShadowCollector_CountsDiscrepanciesAndReturnsPrimary(t *testing.T) {
+	t.Setenv(metrics.ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	primary := &staticCollector{metrics: []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", KvCacheUsage: 0.50, QueueLength: 2},
+		{PodName: "pod-2", KvCacheUsage: 0.60, QueueLength: 3},
+	}}
+	shadow := &staticCollector{metrics: []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", KvCacheUsage: 0.95, QueueLength: 20},
+	}}
+	c := NewShadowCollector(primary, shadow, metrics.NewMetricsEmitter())
+
+	for cycle := 1; cycle <= 2; cycle++ {
+		got, err := c.CollectReplicaMetrics(context.Background(), "meta/llama-70b", "llm", nil, nil, nil, interfaces.OptionalReplicaMetrics{})
+		if err != nil {
+			t.Fatalf("cycle %d: unexpected error: %v", cycle, err)
+		}
+		// Decisions are driven by the primary collector's metrics only
+		if len(got) != 2 || got[0].KvCacheUsage != 0.50 || got[1].PodName != "pod-2" {
+			t.Fatalf("cycle %d: expected primary metrics, got %+v", cycle, got)
+		}
+
+		counts := gatherDiscrepancies(t, registry)
+		for _, metric := range []string{DiscrepancyMetricKvCacheUsage, DiscrepancyMetricQueueLength, DiscrepancyMetricReplica} {
+			if counts[metric] != float64(cycle) {
+				t.Errorf("cycle %d: expected %d %s discrepancies, got %v", cycle, cycle, metric, counts[metric])
+			}
+		}
+	}
+	if shadow.calls != 2 {
+		t.Errorf("expected the shadow to be queried every cycle, got %d calls", shadow.calls)
+	}
+}
+
+func TestShadowCollector_ShadowFailureDoesNotAffectPrimary(t *testing.T) {
+	primary := &staticCollector{metrics: []interfaces.ReplicaMetrics{{PodName: "pod-1", KvCacheUsage: 0.5}}}
+	shadow := &staticCollector{err: errors.New("epp unavailable")}
+	c := NewShadowCollector(primary, shadow, metrics.NewMetricsEmitter())
+
+	got, err := c.CollectReplicaMetrics(context.Background(), "meta/llama-70b", "llm", nil, nil, nil, interfaces.OptionalReplicaMetrics{})
+	if err != nil {
+		t.Fatalf("expected shadow failure to be ignored, got %v", err)
+	}
+	if len(got) != 1 || got[0].PodName != "pod-1" {
+		t.Errorf("expected primary metrics, got %+v", got)
+	}
+}
+
+func TestShadowCollector_PrimaryFailureIsReturned(t *testing.T) {
+	primary := &staticCollector{err: errors.New("prometheus unavailable")}
+	shadow := &staticCollector{}
+	c := NewShadowCollector(primary, shadow, metrics.NewMetricsEmitter())
+
+	if _, err := c.CollectReplicaMetrics(context.Background(), "meta/llama-70b", "llm", nil, nil, nil, interfaces.OptionalReplicaMetrics{}); err == nil {
+		t.Fatal("expected the primary collector's error")
+	}
+	if shadow.calls != 0 {
+		t.Errorf("expected the shadow not to be queried after a primary failure, got %d calls", shadow.calls)
+	}
+}
@@ -0,0 +1,172 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// BackgroundRefreshConfig configures a BackgroundRefreshSource.
+type BackgroundRefreshConfig struct {
+	// RefreshInterval is the age at which a served result triggers an asynchronous refresh.
+	RefreshInterval time.Duration
+	// MaxStaleness is the maximum age of a served result. Older results are refreshed
+	// synchronously before they are returned.
+	MaxStaleness time.Duration
+}
+
+// Validate checks that both durations are positive and that results are refreshed in the
+// background before they reach the maximum staleness.
+func (c BackgroundRefreshConfig) Validate() error {
+	if c.RefreshInterval <= 0 || c.MaxStaleness <= 0 {
+		return fmt.Errorf("refresh interval (%s) and max staleness (%s) must be positive", c.RefreshInterval, c.MaxStaleness)
+	}
+	if c.RefreshInterval >= c.MaxStaleness {
+		return fmt.Errorf("refresh interval (%s) must be less than max staleness (%s)", c.RefreshInterval, c.MaxStaleness)
+	}
+	return nil
+}
+
+// BackgroundRefreshSource wraps a MetricsSource so that Refresh serves recent results
+// immediately and refreshes them asynchronously. Only a cold spec, or one whose results are
+// older than MaxStaleness, waits for a live query, so a caller refreshing the same spec more
+// often than every MaxStaleness never blocks once warm.
+type BackgroundRefreshSource struct {
+	source MetricsSource
+	config BackgroundRefreshConfig
+	// ctx bounds the asynchronous refreshes, which outlive the Refresh call that started them
+	ctx   context.Context
+	clock clock.PassiveClock
+
+	mu      sync.Mutex // protects entries
+	entries map[CacheKey]*refreshEntry
+}
+
+// refreshEntry holds the latest results of a refresh spec.
+type refreshEntry struct {
+	results map[string]*MetricResult
+	// refreshedAt is when the query producing results started
+	refreshedAt time.Time
+	refreshing  bool
+}
+
+// NewBackgroundRefreshSource wraps source with a background-refresh cache. ctx bounds the
+// asynchronous refreshes.
+func NewBackgroundRefreshSource(ctx context.Context, source MetricsSource, config BackgroundRefreshConfig) *BackgroundRefreshSource {
+	return &BackgroundRefreshSource{
+		source:  source,
+		config:  config,
+		ctx:     ctx,
+		clock:   clock.RealClock{},
+		entries: make(map[CacheKey]*refreshEntry),
+	}
+}
+
+// QueryList returns the query registry of the wrapped source.
+func (s *BackgroundRefreshSource) QueryList() *QueryList {
+	return s.source.QueryList()
+}
+
+// Get retrieves a cached value from the wrapped source.
+func (s *BackgroundRefreshSource) Get(queryName string, params map[string]string) *CachedValue {
+	return s.source.Get(queryName, params)
+}
+
+// Refresh returns the latest results of spec if they are at most MaxStaleness old, starting an
+// asynchronous refresh once they are RefreshInterval old. Otherwise it queries the wrapped
+// source and waits for the results, which are only cached if every query succeeded.
+func (s *BackgroundRefreshSource) Refresh(ctx context.Context, spec RefreshSpec) (map[string]*MetricResult, error) {
+	key := BuildCacheKey(strings.Join(spec.Queries, ","), spec.Params)
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	if entry, ok := s.entries[key]; ok && now.Sub(entry.refreshedAt) <= s.config.MaxStaleness {
+		if now.Sub(entry.refreshedAt) >= s.config.RefreshInterval && !entry.refreshing {
+			entry.refreshing = true
+			go s.refreshAsync(key, RefreshSpec{Queries: slices.Clone(spec.Queries), Params: maps.Clone(spec.Params)})
+		}
+		results := entry.results
+		s.mu.Unlock()
+		return results, nil
+	}
+	s.mu.Unlock()
+
+	results, err := s.source.Refresh(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	if queryError(results) != nil {
+		// Return the failed results as the wrapped source would, but query again next time
+		// rather than serving them until they reach the maximum staleness
+		return results, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store(key, results, now)
+	// Drop the entries of specs no longer refreshed, which could only be served again after a
+	// synchronous refresh
+	for k, entry := range s.entries {
+		if !entry.refreshing && now.Sub(entry.refreshedAt) > s.config.MaxStaleness {
+			delete(s.entries, k)
+		}
+	}
+	return results, nil
+}
+
+// refreshAsync refreshes spec in the background. Results with a failed query are discarded so
+// that the previous results keep being served until they reach the maximum staleness.
+func (s *BackgroundRefreshSource) refreshAsync(key CacheKey, spec RefreshSpec) {
+	logger := logging.FromContext(s.ctx, logging.Collector)
+	startedAt := s.clock.Now()
+
+	results, err := s.source.Refresh(s.ctx, spec)
+	if err == nil {
+		err = queryError(results)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.entries[key]; ok {
+		entry.refreshing = false
+	}
+	if err != nil {
+		logger.V(logging.DEBUG).Info("Background metrics refresh failed, serving previous results",
+			"key", key, "error", err)
+		return
+	}
+	s.store(key, results, startedAt)
+}
+
+// queryError returns the error of the first failed query in results, in query name order, or
+// nil if all succeeded.
+func queryError(results map[string]*MetricResult) error {
+	for _, name := range slices.Sorted(maps.Keys(results)) {
+		if result := results[name]; result.HasError() {
+			return fmt.Errorf("query %s failed: %w", name, result.Error)
+		}
+	}
+	return nil
+}
+
+// store records results of the spec with key unless newer results are already recorded.
+// Callers must hold s.mu.
+func (s *BackgroundRefreshSource) store(key CacheKey, results map[string]*MetricResult, refreshedAt time.Time) {
+	entry, ok := s.entries[key]
+	if !ok {
+		s.entries[key] = &refreshEntry{results: results, refreshedAt: refreshedAt}
+		return
+	}
+	if refreshedAt.After(entry.refreshedAt) {
+		entry.results = results
+		entry.refreshedAt = refreshedAt
+	}
+}
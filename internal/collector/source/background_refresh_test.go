@@ -0,0 +1,171 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// countingSource is a MetricsSource returning the number of the Refresh call as the value of
+// every query. Refresh waits on block when it is set, and fails every query with queryErr when
+// that is set.
+type countingSource struct {
+	NoOpSource
+	mu       sync.Mutex
+	calls    int
+	block    chan struct{}
+	err      error
+	queryErr error
+}
+
+func (c *countingSource) Refresh(ctx context.Context, spec RefreshSpec) (map[string]*MetricResult, error) {
+	c.mu.Lock()
+	c.calls++
+	call, block, err, queryErr := c.calls, c.block, c.err, c.queryErr
+	c.mu.Unlock()
+
+	if block != nil {
+		<-block
+	}
+	if err != nil {
+		return nil, err
+	}
+	results := make(map[string]*MetricResult, len(spec.Queries))
+	for _, name := range spec.Queries {
+		results[name] = &MetricResult{QueryName: name, Values: []MetricValue{{Value: float64(call)}}, Error: queryErr}
+	}
+	return results, nil
+}
+
+func (c *countingSource) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+var _ = Describe("BackgroundRefreshSource", func() {
+	var (
+		ctx      context.Context
+		inner    *countingSource
+		fakeTime *clocktesting.FakePassiveClock
+		cached   *BackgroundRefreshSource
+		spec     RefreshSpec
+	)
+
+	// refreshValue refreshes spec and returns the value served for its query
+	refreshValue := func() float64 {
+		results, err := cached.Refresh(ctx, spec)
+		Expect(err).NotTo(HaveOccurred())
+		return results["kv_cache_usage"].FirstValue().Value
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		inner = &countingSource{}
+		fakeTime = clocktesting.NewFakePassiveClock(time.Unix(1700000000, 0))
+		cached = NewBackgroundRefreshSource(ctx, inner, BackgroundRefreshConfig{
+			RefreshInterval: 10 * time.Second,
+			MaxStaleness:    30 * time.Second,
+		})
+		cached.clock = fakeTime
+		spec = RefreshSpec{Queries: []string{"kv_cache_usage"}, Params: map[string]string{ParamModelID: "llama"}}
+	})
+
+	It("should query the wrapped source on a cold cache", func() {
+		Expect(refreshValue()).To(Equal(1.0))
+		Expect(inner.callCount()).To(Equal(1))
+	})
+
+	It("should serve recent results without querying", func() {
+		Expect(refreshValue()).To(Equal(1.0))
+		fakeTime.SetTime(fakeTime.Now().Add(5 * time.Second))
+		Expect(refreshValue()).To(Equal(1.0))
+		Expect(inner.callCount()).To(Equal(1))
+	})
+
+	It("should not block on a slow query once warm", func() {
+		Expect(refreshValue()).To(Equal(1.0))
+		inner.mu.Lock()
+		inner.block = make(chan struct{})
+		inner.mu.Unlock()
+
+		By("Serving the cached results while the background refresh is blocked")
+		fakeTime.SetTime(fakeTime.Now().Add(15 * time.Second))
+		served := make(chan float64)
+		go func() {
+			defer GinkgoRecover()
+			served <- refreshValue()
+		}()
+		Eventually(served).Should(Receive(Equal(1.0)))
+		Eventually(inner.callCount).Should(Equal(2))
+
+		By("Not starting a second refresh while one is in flight")
+		Expect(refreshValue()).To(Equal(1.0))
+		Expect(inner.callCount()).To(Equal(2))
+
+		By("Serving the refreshed results once the background refresh completes")
+		close(inner.block)
+		Eventually(refreshValue).Should(Equal(2.0))
+	})
+
+	It("should never serve results older than the max staleness", func() {
+		Expect(refreshValue()).To(Equal(1.0))
+		fakeTime.SetTime(fakeTime.Now().Add(31 * time.Second))
+		Expect(refreshValue()).To(Equal(2.0))
+		Expect(inner.callCount()).To(Equal(2))
+	})
+
+	It("should keep serving previous results when a background refresh fails", func() {
+		Expect(refreshValue()).To(Equal(1.0))
+		inner.mu.Lock()
+		inner.err = errors.New("prometheus unavailable")
+		inner.mu.Unlock()
+
+		fakeTime.SetTime(fakeTime.Now().Add(15 * time.Second))
+		Expect(refreshValue()).To(Equal(1.0))
+		Eventually(func() bool {
+			cached.mu.Lock()
+			defer cached.mu.Unlock()
+			for _, entry := range cached.entries {
+				if entry.refreshing {
+					return true
+				}
+			}
+			return false
+		}).Should(BeFalse())
+		Expect(refreshValue()).To(Equal(1.0))
+
+		By("Surfacing the error once the results are too stale to serve")
+		fakeTime.SetTime(fakeTime.Now().Add(20 * time.Second))
+		_, err := cached.Refresh(ctx, spec)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not cache results with a failed query", func() {
+		inner.mu.Lock()
+		inner.queryErr = errors.New("query timed out")
+		inner.mu.Unlock()
+
+		results, err := cached.Refresh(ctx, spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results["kv_cache_usage"].HasError()).To(BeTrue())
+
+		By("Querying again instead of serving the failed results")
+		inner.mu.Lock()
+		inner.queryErr = nil
+		inner.mu.Unlock()
+		Expect(refreshValue()).To(Equal(2.0))
+		Expect(inner.callCount()).To(Equal(2))
+	})
+
+	It("should validate the configuration", func() {
+		Expect(BackgroundRefreshConfig{RefreshInterval: 10 * time.Second, MaxStaleness: 30 * time.Second}.Validate()).To(Succeed())
+		Expect(BackgroundRefreshConfig{RefreshInterval: 30 * time.Second, MaxStaleness: 30 * time.Second}.Validate()).NotTo(Succeed())
+		Expect(BackgroundRefreshConfig{RefreshInterval: 0, MaxStaleness: 30 * time.Second}.Validate()).NotTo(Succeed())
+	})
+})
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
-	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 // Cache is an in-memory thread-safe metrics cache implementation
@@ -100,6 +99,6 @@ func (c *Cache) cleanupExpired() {
 	c.mu.Unlock()
 
 	if expiredCount > 0 {
-		ctrl.Log.V(logging.DEBUG).Info("Cache cleanup: removed expired entries", "count", expiredCount)
+		logging.Log(logging.Collector).V(logging.DEBUG).Info("Cache cleanup: removed expired entries", "count", expiredCount)
 	}
 }
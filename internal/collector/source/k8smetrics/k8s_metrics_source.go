@@ -0,0 +1,204 @@
+// Package k8smetrics provides a metrics source reading per-pod metrics from the Kubernetes
+// custom metrics API (custom.metrics.k8s.io), for clusters that expose model server metrics
+// through a metrics adapter rather than a Prometheus WVA can query.
+package k8smetrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/registration"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// customMetricsPath is the path of the custom metrics API version queried.
+const customMetricsPath = "/apis/custom.metrics.k8s.io/v1beta1"
+
+// K8sMetricsSourceConfig contains configuration for the custom metrics API source.
+type K8sMetricsSourceConfig struct {
+	// MetricNames maps query names (e.g. "kv_cache_usage") to the custom metrics API metric
+	// names serving them. Queries without a metric name return no result.
+	MetricNames map[string]string
+	// ModelLabel is the metric label holding the model ID, used to select the model's pods.
+	// Empty selects all pods of the namespace.
+	ModelLabel string
+	// DefaultTTL is the default cache TTL for query results.
+	DefaultTTL time.Duration
+	// QueryTimeout is the timeout for individual metrics API requests.
+	QueryTimeout time.Duration
+}
+
+// DefaultK8sMetricsSourceConfig returns the metric names a metrics adapter exposing the vLLM
+// metrics unrenamed would serve for the saturation queries.
+func DefaultK8sMetricsSourceConfig() K8sMetricsSourceConfig {
+	return K8sMetricsSourceConfig{
+		MetricNames: map[string]string{
+			registration.QueryKvCacheUsage: constants.VLLMKvCacheUsagePerc,
+			registration.QueryQueueLength:  constants.VLLMNumRequestsWaiting,
+		},
+		ModelLabel:   "model_name",
+		DefaultTTL:   30 * time.Second,
+		QueryTimeout: 10 * time.Second,
+	}
+}
+
+// K8sMetricsSource implements MetricsSource for the Kubernetes custom metrics API. Each query
+// reads one metric of all pods in the namespace; the registered query templates are not used.
+type K8sMetricsSource struct {
+	client   rest.Interface
+	registry *source.QueryList
+	config   K8sMetricsSourceConfig
+
+	mu    sync.RWMutex // protects the cache and refresh operations
+	cache *source.Cache
+}
+
+// NewK8sMetricsSource creates a custom metrics API source using client, a REST client for
+// the API server such as the one returned by NewRESTClient.
+func NewK8sMetricsSource(ctx context.Context, client rest.Interface, config K8sMetricsSourceConfig) *K8sMetricsSource {
+	return &K8sMetricsSource{
+		client:   client,
+		registry: source.NewQueryList(),
+		config:   config,
+		cache:    source.NewCache(ctx, config.DefaultTTL, 1*time.Second),
+	}
+}
+
+// NewRESTClient returns a REST client for the API server described by cfg, suitable for
+// NewK8sMetricsSource.
+func NewRESTClient(cfg *rest.Config) (rest.Interface, error) {
+	cfg = rest.CopyConfig(cfg)
+	if cfg.NegotiatedSerializer == nil {
+		cfg.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+	}
+	return rest.UnversionedRESTClientFor(cfg)
+}
+
+// QueryList returns the query registry for this source.
+func (k *K8sMetricsSource) QueryList() *source.QueryList {
+	return k.registry
+}
+
+// Refresh reads the metrics of spec.Queries for the pods of the namespace in spec.Params and
+// updates the cache. If spec.Queries is empty, all queries with a metric name are refreshed.
+// Queries without a metric name are left out of the results.
+func (k *K8sMetricsSource) Refresh(ctx context.Context, spec source.RefreshSpec) (map[string]*source.MetricResult, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	namespace := spec.Params[source.ParamNamespace]
+	if namespace == "" {
+		return nil, fmt.Errorf("parameter %q is required", source.ParamNamespace)
+	}
+
+	queryNames := spec.Queries
+	if len(queryNames) == 0 {
+		queryNames = slices.Sorted(maps.Keys(k.config.MetricNames))
+	}
+
+	results := make(map[string]*source.MetricResult, len(queryNames))
+	for _, queryName := range queryNames {
+		metricName, ok := k.config.MetricNames[queryName]
+		if !ok {
+			continue
+		}
+		result := k.readMetric(ctx, queryName, metricName, namespace, spec.Params[source.ParamModelID])
+		results[queryName] = result
+		k.cache.Set(source.BuildCacheKey(queryName, spec.Params), *result, k.config.DefaultTTL)
+	}
+
+	logging.FromContext(ctx, logging.Collector).V(logging.DEBUG).Info("Refreshed custom metrics API metrics",
+		"namespace", namespace,
+		"queriesExecuted", len(results))
+
+	return results, nil
+}
+
+// Get retrieves a cached value for a query with the given parameters.
+func (k *K8sMetricsSource) Get(queryName string, params map[string]string) *source.CachedValue {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	cached, ok := k.cache.Get(source.BuildCacheKey(queryName, params))
+	if !ok || cached.IsExpired() {
+		return nil
+	}
+	return cached
+}
+
+// metricValueList is the custom.metrics.k8s.io/v1beta1 MetricValueList, reduced to the
+// fields used here.
+type metricValueList struct {
+	Items []metricValue `json:"items"`
+}
+
+type metricValue struct {
+	DescribedObject struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+	} `json:"describedObject"`
+	Timestamp time.Time         `json:"timestamp"`
+	Value     resource.Quantity `json:"value"`
+}
+
+// readMetric reads metricName for all pods of namespace, restricted to modelID when a model
+// label is configured. A metric the API does not know yields a result without values, like a
+// Prometheus query matching no series.
+func (k *K8sMetricsSource) readMetric(ctx context.Context, queryName, metricName, namespace, modelID string) *source.MetricResult {
+	if k.config.QueryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, k.config.QueryTimeout)
+		defer cancel()
+	}
+
+	req := k.client.Get().AbsPath(customMetricsPath, "namespaces", namespace, "pods", "*", metricName)
+	if k.config.ModelLabel != "" && modelID != "" {
+		req = req.Param("metricLabelSelector", labels.Set{k.config.ModelLabel: modelID}.String())
+	}
+	raw, err := req.DoRaw(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return &source.MetricResult{QueryName: queryName, CollectedAt: time.Now()}
+		}
+		return &source.MetricResult{
+			QueryName:   queryName,
+			CollectedAt: time.Now(),
+			Error:       fmt.Errorf("custom metrics API request for %s failed: %w", metricName, err),
+		}
+	}
+
+	var list metricValueList
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return &source.MetricResult{
+			QueryName:   queryName,
+			CollectedAt: time.Now(),
+			Error:       fmt.Errorf("failed to decode custom metrics API response for %s: %w", metricName, err),
+		}
+	}
+
+	values := make([]source.MetricValue, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.DescribedObject.Kind != "Pod" || item.DescribedObject.Name == "" {
+			continue
+		}
+		values = append(values, source.MetricValue{
+			Value:     item.Value.AsApproximateFloat64(),
+			Timestamp: item.Timestamp,
+			Labels:    map[string]string{"pod": item.DescribedObject.Name, "namespace": namespace},
+		})
+	}
+	return &source.MetricResult{QueryName: queryName, Values: values, CollectedAt: time.Now()}
+}
@@ -0,0 +1,209 @@
+package k8smetrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/registration"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// fakeCustomMetricsAPI serves custom.metrics.k8s.io/v1beta1 pod metrics of one namespace from
+// values keyed by metric name and pod name, and records the requests it receives. It fails
+// every request while unavailable is set.
+type fakeCustomMetricsAPI struct {
+	namespace string
+	values    map[string]map[string]string
+
+	mu          sync.Mutex
+	requests    []*http.Request
+	unavailable bool
+}
+
+func (f *fakeCustomMetricsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests = append(f.requests, r)
+	unavailable := f.unavailable
+	f.mu.Unlock()
+
+	if unavailable {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	prefix := customMetricsPath + "/namespaces/" + f.namespace + "/pods/*/"
+	metricName, ok := strings.CutPrefix(r.URL.Path, prefix)
+	pods, known := f.values[metricName]
+	if !ok || !known {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404,"message":"the server could not find the metric %s for pods"}`, metricName)
+		return
+	}
+
+	items := make([]string, 0, len(pods))
+	for pod, value := range pods {
+		items = append(items, fmt.Sprintf(`{"describedObject":{"kind":"Pod","namespace":%q,"name":%q,"apiVersion":"/v1"},"metricName":%q,"timestamp":"2025-01-01T00:00:00Z","value":%q}`,
+			f.namespace, pod, metricName, value))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprintf(w, `{"kind":"MetricValueList","apiVersion":"custom.metrics.k8s.io/v1beta1","metadata":{},"items":[%s]}`,
+		strings.Join(items, ","))
+}
+
+func (f *fakeCustomMetricsAPI) lastRequest() *http.Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[len(f.requests)-1]
+}
+
+var _ = Describe("K8sMetricsSource", func() {
+	var (
+		ctx        context.Context
+		api        *fakeCustomMetricsAPI
+		server     *httptest.Server
+		metricsSrc *K8sMetricsSource
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		api = &fakeCustomMetricsAPI{
+			namespace: "llm",
+			values: map[string]map[string]string{
+				constants.VLLMKvCacheUsagePerc:   {"llama-a100-0": "750m", "llama-a100-1": "0.25"},
+				constants.VLLMNumRequestsWaiting: {"llama-a100-0": "3", "llama-a100-1": "0"},
+			},
+		}
+		server = httptest.NewServer(api)
+		DeferCleanup(server.Close)
+
+		restClient, err := NewRESTClient(&rest.Config{Host: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+		metricsSrc = NewK8sMetricsSource(ctx, restClient, DefaultK8sMetricsSourceConfig())
+	})
+
+	It("should read per-pod values of the mapped metrics", func() {
+		params := map[string]string{source.ParamNamespace: "llm", source.ParamModelID: "meta/llama"}
+		results, err := metricsSrc.Refresh(ctx, source.RefreshSpec{
+			Queries: []string{registration.QueryKvCacheUsage},
+			Params:  params,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		result := results[registration.QueryKvCacheUsage]
+		Expect(result.HasError()).To(BeFalse())
+		values := map[string]float64{}
+		for _, v := range result.Values {
+			values[v.Labels["pod"]] = v.Value
+			Expect(v.Timestamp.IsZero()).To(BeFalse())
+		}
+		Expect(values).To(Equal(map[string]float64{"llama-a100-0": 0.75, "llama-a100-1": 0.25}))
+
+		By("Selecting the model's series by the model label")
+		Expect(api.lastRequest().URL.Query().Get("metricLabelSelector")).To(Equal("model_name=meta/llama"))
+
+		By("Caching the result")
+		Expect(metricsSrc.Get(registration.QueryKvCacheUsage, params)).NotTo(BeNil())
+	})
+
+	It("should leave out queries without a metric and return no values for unknown metrics", func() {
+		cfg := DefaultK8sMetricsSourceConfig()
+		cfg.MetricNames[registration.QueryOutputTokenRate] = "vllm:generation_tokens_rate"
+		restClient, err := NewRESTClient(&rest.Config{Host: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+		metricsSrc = NewK8sMetricsSource(ctx, restClient, cfg)
+
+		results, err := metricsSrc.Refresh(ctx, source.RefreshSpec{
+			Queries: []string{registration.QueryOutputTokenRate, registration.QueryErrorRate},
+			Params:  map[string]string{source.ParamNamespace: "llm"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveKey(registration.QueryOutputTokenRate))
+		Expect(results).NotTo(HaveKey(registration.QueryErrorRate))
+		Expect(results[registration.QueryOutputTokenRate].HasError()).To(BeFalse())
+		Expect(results[registration.QueryOutputTokenRate].Values).To(BeEmpty())
+	})
+
+	It("should report API errors on the result", func() {
+		api.mu.Lock()
+		api.unavailable = true
+		api.mu.Unlock()
+		results, err := metricsSrc.Refresh(ctx, source.RefreshSpec{
+			Queries: []string{registration.QueryKvCacheUsage},
+			Params:  map[string]string{source.ParamNamespace: "llm"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results[registration.QueryKvCacheUsage].HasError()).To(BeTrue())
+	})
+
+	It("should require a namespace", func() {
+		_, err := metricsSrc.Refresh(ctx, source.RefreshSpec{Queries: []string{registration.QueryKvCacheUsage}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should populate replica metrics through the replica metrics collector", func() {
+		deploy := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "llama-a100", Namespace: "llm"},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llama-a100"}},
+			},
+		}
+		pods := make([]corev1.Pod, 0, 2)
+		for _, name := range []string{"llama-a100-0", "llama-a100-1"} {
+			pods = append(pods, corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: "llm", Labels: map[string]string{"app": "llama-a100"},
+			}})
+		}
+		k8sClient := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+			WithLists(&corev1.PodList{Items: pods}).Build()
+		va := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "llama-a100", Namespace: "llm",
+				Labels: map[string]string{"inference.optimization/acceleratorName": "A100"},
+			},
+			Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+				ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-a100"},
+				ModelID:        "meta/llama",
+			},
+		}
+
+		replicaCollector := collector.NewReplicaMetricsCollector(metricsSrc, k8sClient)
+		replicaMetrics, err := replicaCollector.CollectReplicaMetrics(ctx, "meta/llama", "llm",
+			map[string]*appsv1.Deployment{"llama-a100": deploy},
+			map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling{"llama-a100": va},
+			map[string]float64{"llama-a100": 40}, interfaces.OptionalReplicaMetrics{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replicaMetrics).To(HaveLen(2))
+
+		byPod := map[string]float64{}
+		for _, m := range replicaMetrics {
+			Expect(m.VariantName).To(Equal("llama-a100"))
+			Expect(m.ModelID).To(Equal("meta/llama"))
+			Expect(m.AcceleratorName).To(Equal("A100"))
+			Expect(m.Cost).To(Equal(40.0))
+			byPod[m.PodName] = m.KvCacheUsage
+			if m.PodName == "llama-a100-0" {
+				Expect(m.QueueLength).To(Equal(3.0))
+			}
+		}
+		Expect(byPod).To(Equal(map[string]float64{"llama-a100-0": 0.75, "llama-a100-1": 0.25}))
+	})
+})
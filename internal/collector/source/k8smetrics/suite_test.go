@@ -0,0 +1,13 @@
+package k8smetrics
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestK8sMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "K8sMetrics Suite")
+}
@@ -15,7 +15,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/prometheus/common/expfmt"
@@ -106,7 +105,7 @@ func (p *PodScrapingSource) Refresh(ctx context.Context, spec source.RefreshSpec
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Collector)
 
 	// Discover pods
 	pods, err := p.discoverPods(ctx)
@@ -212,7 +211,7 @@ func isPodReady(pod *corev1.Pod) bool {
 
 // scrapeAllPods scrapes metrics from all pods concurrently.
 func (p *PodScrapingSource) scrapeAllPods(ctx context.Context, pods []*corev1.Pod) map[string]*source.MetricResult {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Collector)
 	results := make(map[string]*source.MetricResult)
 	var resultsMu sync.Mutex
 
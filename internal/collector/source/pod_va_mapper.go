@@ -6,10 +6,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	llmdv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
 )
 
 // PodVAMapper maps pod names to their corresponding VariantAutoscaling objects.
@@ -50,7 +50,7 @@ func (m *PodVAMapper) findDeploymentForPod(
 	namespace string,
 	deployments map[string]*appsv1.Deployment,
 ) string {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Collector)
 
 	// TODO: optimize
 	for deploymentName, deployment := range deployments {
@@ -13,7 +13,6 @@ import (
 
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
-	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
@@ -68,7 +67,7 @@ func (p *PrometheusSource) Refresh(ctx context.Context, spec source.RefreshSpec)
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Collector)
 
 	// Determine which queries to execute
 	queryNames := spec.Queries
@@ -115,7 +114,7 @@ func (p *PrometheusSource) Refresh(ctx context.Context, spec source.RefreshSpec)
 
 // executeQuery builds and executes a single query.
 func (p *PrometheusSource) executeQuery(ctx context.Context, queryName string, params map[string]string) *source.MetricResult {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Collector)
 
 	// Escape parameter values to prevent PromQL injection
 	escapedParams := make(map[string]string, len(params))
@@ -5,6 +5,14 @@ import (
 	"sync"
 )
 
+// PrimarySourceName is the name of the source the engines collect replica metrics and
+// scale-to-zero request counts from, whichever backend serves them.
+const PrimarySourceName = "prometheus"
+
+// ShadowSourceName is the name under which a secondary metrics source is registered to enable
+// shadow collection: the saturation engine collects from it too and compares the results.
+const ShadowSourceName = "shadow"
+
 // SourceRegistry manages multiple metrics sources.
 // Use DefaultSourceRegistry() to access the singleton instance,
 // or NewSourceRegistry() to create isolated instances for testing.
@@ -0,0 +1,51 @@
+package config
+
+import "fmt"
+
+// CollectorType selects the backend replica metrics are collected from.
+type CollectorType string
+
+const (
+	// CollectorTypePrometheus queries Prometheus (default).
+	CollectorTypePrometheus CollectorType = "prometheus"
+	// CollectorTypeK8sMetrics reads the Kubernetes custom metrics API, for clusters without
+	// a Prometheus WVA can query.
+	CollectorTypeK8sMetrics CollectorType = "k8s-metrics"
+)
+
+// CollectorConfig configures replica metrics collection.
+type CollectorConfig struct {
+	// Type is the metrics backend
+	Type CollectorType
+	// ShadowType is an optional second backend collected alongside Type and compared to it,
+	// e.g. while migrating between backends. Decisions always use Type. Empty disables it.
+	ShadowType CollectorType
+}
+
+// Validate checks that Type names a supported backend, and ShadowType another one if set.
+func (c CollectorConfig) Validate() error {
+	if !c.Type.valid() {
+		return fmt.Errorf("metrics collector type must be %q or %q, got %q",
+			CollectorTypePrometheus, CollectorTypeK8sMetrics, c.Type)
+	}
+	if c.ShadowType == "" {
+		return nil
+	}
+	if !c.ShadowType.valid() {
+		return fmt.Errorf("shadow metrics collector type must be %q or %q, got %q",
+			CollectorTypePrometheus, CollectorTypeK8sMetrics, c.ShadowType)
+	}
+	if c.ShadowType == c.Type {
+		return fmt.Errorf("shadow metrics collector must differ from the metrics collector %q", c.Type)
+	}
+	return nil
+}
+
+// Uses reports whether t is the metrics backend or the shadow backend.
+func (c CollectorConfig) Uses(t CollectorType) bool {
+	return c.Type == t || c.ShadowType == t
+}
+
+func (t CollectorType) valid() bool {
+	return t == CollectorTypePrometheus || t == CollectorTypeK8sMetrics
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectorConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  CollectorConfig
+		wantErr bool
+	}{
+		{name: "prometheus", config: CollectorConfig{Type: CollectorTypePrometheus}},
+		{name: "k8s-metrics with prometheus shadow", config: CollectorConfig{Type: CollectorTypeK8sMetrics, ShadowType: CollectorTypePrometheus}},
+		{name: "unknown type", config: CollectorConfig{Type: "epp"}, wantErr: true},
+		{name: "unknown shadow type", config: CollectorConfig{Type: CollectorTypePrometheus, ShadowType: "epp"}, wantErr: true},
+		{name: "shadow same as primary", config: CollectorConfig{Type: CollectorTypePrometheus, ShadowType: CollectorTypePrometheus}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCollectorConfig_Uses(t *testing.T) {
+	c := CollectorConfig{Type: CollectorTypeK8sMetrics, ShadowType: CollectorTypePrometheus}
+	assert.True(t, c.Uses(CollectorTypePrometheus))
+	assert.True(t, c.Uses(CollectorTypeK8sMetrics))
+	assert.False(t, CollectorConfig{Type: CollectorTypeK8sMetrics}.Uses(CollectorTypePrometheus))
+}
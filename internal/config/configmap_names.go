@@ -0,0 +1,56 @@
+package config
+
+import "os"
+
+const (
+	// DefaultSaturationConfigMapName is the default name of the saturation scaling ConfigMap.
+	DefaultSaturationConfigMapName = "saturation-scaling-config"
+	// DefaultAcceleratorAliasesConfigMapName is the default name of the accelerator aliases ConfigMap.
+	DefaultAcceleratorAliasesConfigMapName = "accelerator-aliases"
+	// DefaultServiceClassesConfigMapName is the default name of the service classes ConfigMap.
+	DefaultServiceClassesConfigMapName = "service-classes-config"
+	// DefaultAcceleratorCostsConfigMapName is the default name of the accelerator unit costs ConfigMap.
+	DefaultAcceleratorCostsConfigMapName = "accelerator-unit-costs"
+
+	// AcceleratorCostsConfigMapNameEnvVar names the accelerator unit costs ConfigMap. The controller
+	// does not read that ConfigMap itself; the Helm chart sets it to the release-prefixed name it
+	// deploys so that --validate-only checks the right one.
+	AcceleratorCostsConfigMapNameEnvVar = "ACCELERATOR_COSTS_CONFIG_MAP_NAME"
+)
+
+// GetSaturationConfigMapName returns the saturation scaling ConfigMap name from
+// SATURATION_CONFIG_MAP_NAME, or the default.
+func GetSaturationConfigMapName() string {
+	return envOrDefault("SATURATION_CONFIG_MAP_NAME", DefaultSaturationConfigMapName)
+}
+
+// GetAcceleratorAliasesConfigMapName returns the accelerator aliases ConfigMap name from
+// ACCELERATOR_ALIASES_CONFIG_MAP_NAME, or the default.
+func GetAcceleratorAliasesConfigMapName() string {
+	return envOrDefault("ACCELERATOR_ALIASES_CONFIG_MAP_NAME", DefaultAcceleratorAliasesConfigMapName)
+}
+
+// GetServiceClassesConfigMapName returns the service classes ConfigMap name from
+// SERVICE_CLASSES_CONFIG_MAP_NAME, or the default.
+func GetServiceClassesConfigMapName() string {
+	return envOrDefault("SERVICE_CLASSES_CONFIG_MAP_NAME", DefaultServiceClassesConfigMapName)
+}
+
+// GetMaintenanceWindowsConfigMapName returns the maintenance windows ConfigMap name from
+// MAINTENANCE_WINDOWS_CONFIG_MAP_NAME, or the default.
+func GetMaintenanceWindowsConfigMapName() string {
+	return envOrDefault("MAINTENANCE_WINDOWS_CONFIG_MAP_NAME", DefaultMaintenanceWindowsConfigMapName)
+}
+
+// GetAcceleratorCostsConfigMapName returns the accelerator unit costs ConfigMap name from
+// ACCELERATOR_COSTS_CONFIG_MAP_NAME, or the default.
+func GetAcceleratorCostsConfigMapName() string {
+	return envOrDefault(AcceleratorCostsConfigMapNameEnvVar, DefaultAcceleratorCostsConfigMapName)
+}
+
+func envOrDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
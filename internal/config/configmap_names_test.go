@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigMapNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		envVar string
+		get    func() string
+		def    string
+	}{
+		{name: "saturation", envVar: "SATURATION_CONFIG_MAP_NAME", get: GetSaturationConfigMapName, def: DefaultSaturationConfigMapName},
+		{name: "accelerator aliases", envVar: "ACCELERATOR_ALIASES_CONFIG_MAP_NAME", get: GetAcceleratorAliasesConfigMapName, def: DefaultAcceleratorAliasesConfigMapName},
+		{name: "service classes", envVar: "SERVICE_CLASSES_CONFIG_MAP_NAME", get: GetServiceClassesConfigMapName, def: DefaultServiceClassesConfigMapName},
+		{name: "maintenance windows", envVar: "MAINTENANCE_WINDOWS_CONFIG_MAP_NAME", get: GetMaintenanceWindowsConfigMapName, def: DefaultMaintenanceWindowsConfigMapName},
+		{name: "accelerator costs", envVar: AcceleratorCostsConfigMapNameEnvVar, get: GetAcceleratorCostsConfigMapName, def: DefaultAcceleratorCostsConfigMapName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.envVar, "")
+			assert.Equal(t, tt.def, tt.get())
+
+			t.Setenv(tt.envVar, "wva-"+tt.def)
+			assert.Equal(t, "wva-"+tt.def, tt.get())
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"maps"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// DefaultMaintenanceWindowsConfigMapName is the default name of the ConfigMap that stores the
+// maintenance windows during which all scaling is frozen.
+const DefaultMaintenanceWindowsConfigMapName = "maintenance-windows-config"
+
+// ParseMaintenanceWindowConfigMap parses the maintenance windows of a maintenance windows
+// ConfigMap's data, one window per key, named after its key. Windows are returned in key order.
+// Entries that fail to parse or validate are logged and skipped.
+func ParseMaintenanceWindowConfigMap(data map[string]string) []interfaces.MaintenanceWindow {
+	windows := make([]interfaces.MaintenanceWindow, 0, len(data))
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		var window interfaces.MaintenanceWindow
+		if err := yaml.Unmarshal([]byte(data[key]), &window); err != nil {
+			ctrl.Log.Error(err, "Skipping maintenance window entry, failed to parse", "key", key)
+			continue
+		}
+		if err := window.Validate(); err != nil {
+			ctrl.Log.Error(err, "Skipping maintenance window entry, invalid", "key", key)
+			continue
+		}
+		window.Name = key
+		windows = append(windows, window)
+	}
+	return windows
+}
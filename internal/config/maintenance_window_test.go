@@ -0,0 +1,32 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMaintenanceWindowConfigMap(t *testing.T) {
+	data := map[string]string{
+		"network-upgrade": `
+start: "2026-03-01T22:00:00Z"
+end: "2026-03-02T02:00:00Z"
+reason: Core switch replacement
+`,
+		"etcd-defrag": `
+start: "2026-02-15T01:00:00+01:00"
+end: "2026-02-15T01:30:00+01:00"
+`,
+		"broken":   `start: [`,
+		"reversed": "start: \"2026-03-01T02:00:00Z\"\nend: \"2026-03-01T01:00:00Z\"\n",
+		"no-zone":  "start: \"2026-03-01 22:00\"\nend: \"2026-03-02 02:00\"\n",
+	}
+
+	windows := ParseMaintenanceWindowConfigMap(data)
+	require.Len(t, windows, 2, "invalid entries should be skipped")
+	assert.Equal(t, "etcd-defrag", windows[0].Name)
+	assert.Equal(t, "network-upgrade", windows[1].Name)
+	assert.Equal(t, "2026-03-01T22:00:00Z", windows[1].Start)
+	assert.Equal(t, "Core switch replacement", windows[1].Reason)
+}
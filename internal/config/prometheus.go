@@ -29,6 +29,9 @@ type CacheConfig struct {
 	FetchInterval time.Duration
 	// FreshnessThresholds define when metrics are considered fresh/stale/unavailable
 	FreshnessThresholds FreshnessThresholds
+	// BackgroundRefresh serves the optimization loop from query results refreshed in the
+	// background every FetchInterval. Opt-in: it is off unless explicitly enabled.
+	BackgroundRefresh bool
 }
 
 // FreshnessThresholds defines when metrics are considered fresh, stale, or unavailable.
@@ -72,7 +75,9 @@ func GetConfigMapName() string {
 	return DefaultConfigMapName
 }
 
-func getNamespace() string {
+// GetNamespace returns the namespace holding the autoscaler ConfigMap: the controller's own
+// namespace from POD_NAMESPACE, or the default installation namespace.
+func GetNamespace() string {
 	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
 		return ns
 	}
@@ -118,7 +123,7 @@ func GetPrometheusConfigFromEnv() (*interfaces.PrometheusConfig, error) {
 // GetPrometheusConfigFromConfigMap retrieves Prometheus configuration from ConfigMap
 func GetPrometheusConfigFromConfigMap(ctx context.Context, k8sClient client.Client) (*interfaces.PrometheusConfig, error) {
 	cm := corev1.ConfigMap{}
-	err := utils.GetConfigMapWithBackoff(ctx, k8sClient, GetConfigMapName(), getNamespace(), &cm)
+	err := utils.GetConfigMapWithBackoff(ctx, k8sClient, GetConfigMapName(), GetNamespace(), &cm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ConfigMap for Prometheus config: %w", err)
 	}
@@ -152,7 +157,7 @@ func GetPrometheusConfigFromConfigMap(ctx context.Context, k8sClient client.Clie
 // ReadPrometheusCacheConfig reads Prometheus collector cache configuration from the ConfigMap
 func ReadPrometheusCacheConfig(ctx context.Context, k8sClient client.Client) (*CacheConfig, error) {
 	cm := corev1.ConfigMap{}
-	err := utils.GetConfigMapWithBackoff(ctx, k8sClient, GetConfigMapName(), getNamespace(), &cm)
+	err := utils.GetConfigMapWithBackoff(ctx, k8sClient, GetConfigMapName(), GetNamespace(), &cm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get configmap for Prometheus cache config: %w", err)
 	}
@@ -189,6 +194,9 @@ func ReadPrometheusCacheConfig(ctx context.Context, k8sClient client.Client) (*C
 	// PROMETHEUS_METRICS_CACHE_UNAVAILABLE_THRESHOLD (default: 5m)
 	config.FreshnessThresholds.UnavailableThreshold = ParseDurationFromConfig(cm.Data, "PROMETHEUS_METRICS_CACHE_UNAVAILABLE_THRESHOLD", 5*time.Minute)
 
+	// PROMETHEUS_METRICS_BACKGROUND_REFRESH (default: false)
+	config.BackgroundRefresh = ParseBoolFromConfig(cm.Data, "PROMETHEUS_METRICS_BACKGROUND_REFRESH", false)
+
 	return config, nil
 }
 
@@ -1,10 +1,14 @@
 package config
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestParsePrometheusConfigFromEnv(t *testing.T) {
@@ -83,3 +87,27 @@ func TestParsePrometheusConfigFromEnv(t *testing.T) {
 		t.Fatalf("Failed to unset environment variable: %v", err)
 	}
 }
+
+func TestReadPrometheusCacheConfig_BackgroundRefreshIsOptIn(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     map[string]string
+		expected bool
+	}{
+		{name: "off by default", data: map[string]string{"PROMETHEUS_METRICS_CACHE_ENABLED": "true"}},
+		{name: "enabled explicitly", data: map[string]string{"PROMETHEUS_METRICS_BACKGROUND_REFRESH": "true"}, expected: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: GetConfigMapName(), Namespace: GetNamespace()},
+				Data:       tc.data,
+			}
+			k8sClient := fake.NewClientBuilder().WithObjects(cm).Build()
+
+			cacheConfig, err := ReadPrometheusCacheConfig(context.Background(), k8sClient)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, cacheConfig.BackgroundRefresh)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// ParseSaturationScalingConfigMap parses saturation scaling configuration from a ConfigMap's data.
+// Entries are layered so that unset fields are inherited:
+//   - "default": global defaults for all models
+//   - namespace entries (namespace field, no model_id): inherit from "default"
+//   - model entries (model_id and namespace fields): inherit from their namespace entry
+//     if one exists, otherwise from "default"
+//
+// Entries that fail to parse or validate are logged and skipped.
+func ParseSaturationScalingConfigMap(data map[string]string) map[string]interfaces.SaturationScalingConfig {
+	out, errs := parseSaturationScalingConfigMap(data)
+	for _, key := range slices.Sorted(maps.Keys(errs)) {
+		ctrl.Log.Error(errs[key], "Skipping saturation scaling config entry", "key", key)
+	}
+	return out
+}
+
+// ValidateSaturationScalingConfigMap parses a saturation scaling ConfigMap's data the same
+// way ParseSaturationScalingConfigMap does and returns the error of each entry that would be
+// skipped, keyed by entry. Override entries are validated after inheriting from their parent.
+func ValidateSaturationScalingConfigMap(data map[string]string) map[string]error {
+	_, errs := parseSaturationScalingConfigMap(data)
+	return errs
+}
+
+func parseSaturationScalingConfigMap(data map[string]string) (map[string]interfaces.SaturationScalingConfig, map[string]error) {
+	out := make(map[string]interfaces.SaturationScalingConfig)
+	errs := make(map[string]error)
+
+	// First pass: find each entry's scope so parents can be parsed before their children
+	var namespaceKeys, modelKeys []string
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		var scope interfaces.SaturationScalingConfig
+		if err := yaml.Unmarshal([]byte(data[key]), &scope); err != nil {
+			errs[key] = fmt.Errorf("failed to parse: %w", err)
+			continue
+		}
+		switch {
+		case key == GlobalDefaultsKey:
+			if err := scope.Validate(); err != nil {
+				errs[key] = fmt.Errorf("invalid: %w", err)
+				continue
+			}
+			out[key] = scope
+		case scope.ModelID == "":
+			namespaceKeys = append(namespaceKeys, key)
+		default:
+			modelKeys = append(modelKeys, key)
+		}
+	}
+
+	defaults := out[GlobalDefaultsKey]
+	namespaceDefaults := make(map[string]interfaces.SaturationScalingConfig)
+	for _, key := range namespaceKeys {
+		config, err := parseSaturationScalingOverride(data[key], defaults)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		out[key] = config
+		if config.Namespace == "" {
+			continue
+		}
+		if _, exists := namespaceDefaults[config.Namespace]; exists {
+			ctrl.Log.Info("Duplicate namespace entry found in saturation scaling ConfigMap - first key wins",
+				"namespace", config.Namespace, "duplicateKey", key)
+			continue
+		}
+		namespaceDefaults[config.Namespace] = config
+	}
+
+	for _, key := range modelKeys {
+		var scope interfaces.SaturationScalingConfig
+		_ = yaml.Unmarshal([]byte(data[key]), &scope) // already parsed in the first pass
+		parent, ok := namespaceDefaults[scope.Namespace]
+		if !ok {
+			parent = defaults
+		}
+		config, err := parseSaturationScalingOverride(data[key], parent)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		out[key] = config
+	}
+
+	ctrl.Log.V(logging.DEBUG).Info("Parsed saturation scaling config",
+		"entries", len(out),
+		"namespaceEntries", len(namespaceDefaults))
+
+	return out, errs
+}
+
+// parseSaturationScalingOverride parses an override entry on top of its parent config,
+// so fields absent from the entry keep the parent's values.
+func parseSaturationScalingOverride(yamlStr string, parent interfaces.SaturationScalingConfig) (interfaces.SaturationScalingConfig, error) {
+	config := parent
+	config.ModelID = ""
+	config.Namespace = ""
+	// Copy the maps so the override's entries don't leak into the parent
+	config.AcceleratorEnergyFactors = maps.Clone(parent.AcceleratorEnergyFactors)
+	config.AcceleratorCapacityFactors = maps.Clone(parent.AcceleratorCapacityFactors)
+	config.VariantWeights = maps.Clone(parent.VariantWeights)
+	config.AllowedAccelerators = slices.Clone(parent.AllowedAccelerators)
+	config.TrafficSchedule = slices.Clone(parent.TrafficSchedule)
+
+	if err := yaml.Unmarshal([]byte(yamlStr), &config); err != nil {
+		return interfaces.SaturationScalingConfig{}, fmt.Errorf("failed to parse: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return interfaces.SaturationScalingConfig{}, fmt.Errorf("invalid: %w", err)
+	}
+	return config, nil
+}
+
+// ResolveSaturationScalingConfig returns the saturation scaling config that applies to a model.
+// Priority (highest to lowest): the entry matching model_id and namespace, the entry matching
+// only the namespace, then "default". Returns the key of the chosen entry and false if none applies.
+func ResolveSaturationScalingConfig(
+	configs map[string]interfaces.SaturationScalingConfig,
+	modelID, namespace string,
+) (interfaces.SaturationScalingConfig, string, bool) {
+	// Sort keys so duplicate entries resolve deterministically (lexicographically first key wins)
+	keys := slices.Sorted(maps.Keys(configs))
+
+	for _, key := range keys {
+		if c := configs[key]; c.ModelID != "" && c.ModelID == modelID && c.Namespace == namespace {
+			return c, key, true
+		}
+	}
+	for _, key := range keys {
+		if c := configs[key]; c.ModelID == "" && c.Namespace != "" && c.Namespace == namespace {
+			return c, key, true
+		}
+	}
+	if c, ok := configs[GlobalDefaultsKey]; ok {
+		return c, GlobalDefaultsKey, true
+	}
+	return interfaces.SaturationScalingConfig{}, "", false
+}
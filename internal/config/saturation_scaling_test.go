@@ -0,0 +1,127 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSaturationScalingConfigMap_Inheritance(t *testing.T) {
+	data := map[string]string{
+		"default": `
+kvCacheThreshold: 0.80
+queueLengthThreshold: 5
+kvSpareTrigger: 0.1
+queueSpareTrigger: 3
+acceleratorEnergyFactors:
+  A100: 2.0
+`,
+		"team-a": `
+namespace: team-a
+kvCacheThreshold: 0.90
+scaleDownDelay: 5m
+acceleratorEnergyFactors:
+  H100: 3.0
+allowedAccelerators: [H100, A100]
+`,
+		"granite-team-a": `
+model_id: ibm/granite-13b
+namespace: team-a
+queueLengthThreshold: 20
+allowedAccelerators: [H100]
+`,
+		"llama-lab": `
+model_id: meta/llama-70b
+namespace: lab
+kvSpareTrigger: 0.2
+`,
+	}
+
+	configs := ParseSaturationScalingConfigMap(data)
+	require.Len(t, configs, 4)
+
+	// Namespace entry inherits unset fields from default
+	team := configs["team-a"]
+	assert.Equal(t, "team-a", team.Namespace)
+	assert.Equal(t, 0.90, team.KvCacheThreshold)
+	assert.Equal(t, 5.0, team.QueueLengthThreshold)
+	assert.Equal(t, 5*time.Minute, team.ScaleDownDelay)
+	assert.Equal(t, map[string]float64{"A100": 2.0, "H100": 3.0}, team.AcceleratorEnergyFactors)
+
+	// Model entry inherits from its namespace entry
+	granite := configs["granite-team-a"]
+	assert.Equal(t, "ibm/granite-13b", granite.ModelID)
+	assert.Equal(t, 0.90, granite.KvCacheThreshold)
+	assert.Equal(t, 20.0, granite.QueueLengthThreshold)
+	assert.Equal(t, 5*time.Minute, granite.ScaleDownDelay)
+	assert.Equal(t, []string{"H100"}, granite.AllowedAccelerators, "a list replaces the inherited one")
+
+	// Model entry without a namespace entry inherits from default
+	llama := configs["llama-lab"]
+	assert.Equal(t, 0.80, llama.KvCacheThreshold)
+	assert.Equal(t, 0.2, llama.KvSpareTrigger)
+	assert.Zero(t, llama.ScaleDownDelay)
+
+	// Overrides don't leak into their parents
+	assert.Equal(t, map[string]float64{"A100": 2.0}, configs["default"].AcceleratorEnergyFactors)
+	assert.Equal(t, []string{"H100", "A100"}, configs["team-a"].AllowedAccelerators)
+	assert.Empty(t, llama.AllowedAccelerators)
+}
+
+func TestParseSaturationScalingConfigMap_SkipsInvalidEntries(t *testing.T) {
+	data := map[string]string{
+		"default":   "kvCacheThreshold: 0.80\nkvSpareTrigger: 0.1\n",
+		"malformed": "kvCacheThreshold: [",
+		"invalid":   "namespace: team-a\nkvCacheThreshold: 1.5\n",
+	}
+
+	configs := ParseSaturationScalingConfigMap(data)
+	assert.Len(t, configs, 1)
+	assert.Contains(t, configs, "default")
+}
+
+func TestResolveSaturationScalingConfig(t *testing.T) {
+	configs := ParseSaturationScalingConfigMap(map[string]string{
+		"default":        "kvCacheThreshold: 0.80\nkvSpareTrigger: 0.1\n",
+		"team-a":         "namespace: team-a\nkvCacheThreshold: 0.90\n",
+		"granite-team-a": "model_id: ibm/granite-13b\nnamespace: team-a\nkvCacheThreshold: 0.70\n",
+	})
+
+	tests := []struct {
+		name            string
+		modelID         string
+		namespace       string
+		expectKey       string
+		expectThreshold float64
+	}{
+		{name: "model entry wins", modelID: "ibm/granite-13b", namespace: "team-a", expectKey: "granite-team-a", expectThreshold: 0.70},
+		{name: "namespace default applies to other models", modelID: "meta/llama-70b", namespace: "team-a", expectKey: "team-a", expectThreshold: 0.90},
+		{name: "namespace default applies to another model", modelID: "mistral/mistral-7b", namespace: "team-a", expectKey: "team-a", expectThreshold: 0.90},
+		{name: "model entry is scoped to its namespace", modelID: "ibm/granite-13b", namespace: "team-b", expectKey: "default", expectThreshold: 0.80},
+		{name: "global default", modelID: "meta/llama-70b", namespace: "team-b", expectKey: "default", expectThreshold: 0.80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, key, ok := ResolveSaturationScalingConfig(configs, tt.modelID, tt.namespace)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectKey, key)
+			assert.Equal(t, tt.expectThreshold, config.KvCacheThreshold)
+		})
+	}
+}
+
+func TestResolveSaturationScalingConfig_NoDefault(t *testing.T) {
+	configs := ParseSaturationScalingConfigMap(map[string]string{
+		"team-a": "namespace: team-a\nkvCacheThreshold: 0.90\n",
+	})
+
+	_, key, ok := ResolveSaturationScalingConfig(configs, "meta/llama-70b", "team-a")
+	assert.True(t, ok)
+	assert.Equal(t, "team-a", key)
+
+	_, _, ok = ResolveSaturationScalingConfig(configs, "meta/llama-70b", "team-b")
+	assert.False(t, ok)
+}
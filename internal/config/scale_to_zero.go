@@ -147,6 +147,30 @@ func GetScaleToZeroRetentionPeriod(configData ScaleToZeroConfigData, modelID str
 	return DefaultScaleToZeroRetentionPeriod
 }
 
+// WithScaleToZeroOverride returns a copy of configData in which the fields set in override
+// (EnableScaleToZero, RetentionPeriod) replace those of modelID's entry. This gives settings
+// from a VariantAutoscaling spec precedence over the ConfigMap, while unset fields keep
+// resolving through the model entry, the global defaults and the system default.
+func WithScaleToZeroOverride(configData ScaleToZeroConfigData, modelID string, override ModelScaleToZeroConfig) ScaleToZeroConfigData {
+	if override.EnableScaleToZero == nil && override.RetentionPeriod == "" {
+		return configData
+	}
+	out := make(ScaleToZeroConfigData, len(configData)+1)
+	for key, config := range configData {
+		out[key] = config
+	}
+	entry := out[modelID]
+	if override.EnableScaleToZero != nil {
+		enabled := *override.EnableScaleToZero
+		entry.EnableScaleToZero = &enabled
+	}
+	if override.RetentionPeriod != "" {
+		entry.RetentionPeriod = override.RetentionPeriod
+	}
+	out[modelID] = entry
+	return out
+}
+
 // GetMinNumReplicas returns the minimum number of replicas for a specific model based on
 // scale-to-zero configuration. Returns 0 if scale-to-zero is enabled, otherwise returns 1.
 func GetMinNumReplicas(configData ScaleToZeroConfigData, modelID string) int {
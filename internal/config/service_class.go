@@ -0,0 +1,31 @@
+package config
+
+import (
+	"maps"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// ParseServiceClassConfigMap parses the service classes of a service class ConfigMap's data,
+// one class per key. Classes are returned in key order. Entries that fail to parse or validate
+// are logged and skipped.
+func ParseServiceClassConfigMap(data map[string]string) []interfaces.ServiceClass {
+	classes := make([]interfaces.ServiceClass, 0, len(data))
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		var sc interfaces.ServiceClass
+		if err := yaml.Unmarshal([]byte(data[key]), &sc); err != nil {
+			ctrl.Log.Error(err, "Skipping service class entry, failed to parse", "key", key)
+			continue
+		}
+		if err := sc.Validate(); err != nil {
+			ctrl.Log.Error(err, "Skipping service class entry, invalid", "key", key)
+			continue
+		}
+		classes = append(classes, sc)
+	}
+	return classes
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServiceClassConfigMap(t *testing.T) {
+	data := map[string]string{
+		"premium.yaml": `
+name: Premium
+priority: 1
+queueLengthThreshold: 2
+data:
+  - model: ibm/granite-13b
+    slo-tpot: 24
+    slo-ttft: 500
+`,
+		"freemium.yaml": `
+name: Freemium
+priority: 10
+data:
+  - model: ibm/granite-13b
+    slo-tpot: 200
+    slo-ttft: 2000
+`,
+		"broken.yaml": `name: [`,
+		"negative-queue.yaml": `
+name: Negative
+priority: 3
+queueLengthThreshold: -1
+data:
+  - model: meta/llama-70b
+    slo-tpot: 80
+    slo-ttft: 500
+`,
+		"unnamed.yaml": `
+priority: 5
+data:
+  - model: meta/llama-70b
+    slo-tpot: 80
+    slo-ttft: 500
+`,
+	}
+
+	classes := ParseServiceClassConfigMap(data)
+	require.Len(t, classes, 2, "invalid entries should be skipped")
+	assert.Equal(t, "Freemium", classes[0].Name)
+	assert.Equal(t, "Premium", classes[1].Name)
+	assert.Equal(t, 1, classes[1].Priority)
+	assert.Equal(t, 24, classes[1].Data[0].SLOTPOT)
+	assert.Equal(t, 500, classes[1].Data[0].SLOTTFT)
+	assert.Equal(t, 2.0, classes[1].QueueLengthThreshold)
+	assert.Zero(t, classes[0].QueueLengthThreshold, "classes without a queue threshold keep the saturation config's")
+}
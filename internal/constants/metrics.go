@@ -45,13 +45,42 @@ const (
 	// Used with VLLMTimePerOutputTokenSecondsSum to calculate ITL (Inter-Token Latency).
 	VLLMTimePerOutputTokenSecondsCount = "vllm:time_per_output_token_seconds_count"
 
+	// VLLMGenerationTokensTotal tracks the total number of generated (output) tokens.
+	// Used as a rate to measure per-replica goodput (output tokens/sec).
+	VLLMGenerationTokensTotal = "vllm:generation_tokens_total"
+
+	// VLLMSpecDecodeNumAcceptedTokensTotal tracks the draft tokens accepted by the target model
+	// when speculative decoding is enabled.
+	// Used with VLLMSpecDecodeNumDraftTokensTotal to calculate the draft acceptance rate.
+	VLLMSpecDecodeNumAcceptedTokensTotal = "vllm:spec_decode_num_accepted_tokens_total"
+
+	// VLLMSpecDecodeNumDraftTokensTotal tracks the draft tokens proposed when speculative decoding is enabled.
+	// Used with VLLMSpecDecodeNumAcceptedTokensTotal to calculate the draft acceptance rate.
+	VLLMSpecDecodeNumDraftTokensTotal = "vllm:spec_decode_num_draft_tokens_total"
+
 	// VLLMKvCacheUsagePerc tracks the KV cache utilization as a percentage (0.0-1.0).
 	// Used by saturation analyzer to detect KV cache saturation and prevent OOM errors.
 	VLLMKvCacheUsagePerc = "vllm:kv_cache_usage_perc"
 
+	// KvCacheUsageBytes and KvCacheTotalBytes track the KV cache bytes in use and allocated, as
+	// exposed by exporters that do not report a utilization ratio. The collector derives the
+	// utilization from them when VLLMKvCacheUsagePerc is unavailable and the fallback is enabled.
+	KvCacheUsageBytes = "kv_cache_usage_bytes"
+	KvCacheTotalBytes = "kv_cache_total_bytes"
+
 	// VLLMNumRequestsWaiting tracks the number of requests waiting in the queue.
 	// Used by saturation analyzer to detect request queue saturation.
 	VLLMNumRequestsWaiting = "vllm:num_requests_waiting"
+
+	// VLLMNumRequestsWaitingUnderscore is the queue depth gauge as exposed by vLLM builds and
+	// scrape pipelines that use underscores instead of the "vllm:" namespace separator.
+	// Used as a fallback when VLLMNumRequestsWaiting returns no data.
+	VLLMNumRequestsWaitingUnderscore = "vllm_num_requests_waiting"
+
+	// HTTPRequestsTotal tracks the HTTP requests served by the vLLM API server, with the
+	// response status (e.g. "2xx", "5xx") in the status label.
+	// Used as a rate to calculate the per-replica error rate.
+	HTTPRequestsTotal = "http_requests_total"
 )
 
 // WVA Output Metrics
@@ -66,6 +95,12 @@ const (
 	// Labels: variant_name, namespace, accelerator_type
 	WVADesiredReplicas = "wva_desired_replicas"
 
+	// WVARecommendedReplicas is a gauge that tracks the replicas the saturation analysis recommended,
+	// before policies and limits (min/max replicas, scale-to-zero, inventory cap, GPU limiter,
+	// maxScaleUpRate, maxScaleDownRate) were applied. It differs from wva_desired_replicas while one of them binds.
+	// Labels: variant_name, namespace, accelerator_type
+	WVARecommendedReplicas = "wva_recommended_replicas"
+
 	// WVACurrentReplicas is a gauge that tracks the current number of replicas.
 	// Labels: variant_name, namespace, accelerator_type
 	WVACurrentReplicas = "wva_current_replicas"
@@ -73,6 +108,46 @@ const (
 	// WVADesiredRatio is a gauge that tracks the ratio of desired to current replicas.
 	// Labels: variant_name, namespace, accelerator_type
 	WVADesiredRatio = "wva_desired_ratio"
+
+	// WVAOptimizerCacheHitsTotal is a counter of optimizer allocations served from the cache.
+	WVAOptimizerCacheHitsTotal = "wva_optimizer_cache_hits_total"
+
+	// WVAOptimizerCacheMissesTotal is a counter of optimizer allocations that had to be recomputed.
+	WVAOptimizerCacheMissesTotal = "wva_optimizer_cache_misses_total"
+
+	// WVALastOptimizationTimestamp is a gauge holding the Unix time of the last successful
+	// optimization of each model. Alert on time() minus this value to catch a stalled loop.
+	// Labels: model_name, namespace
+	WVALastOptimizationTimestamp = "wva_last_optimization_timestamp_seconds"
+
+	// WVAMaxReplicasCap is a gauge holding the maximum replicas of each variant derived from
+	// the number of accelerators of its type in the cluster (maxReplicasFromInventory).
+	// Labels: variant_name, namespace, accelerator_type
+	WVAMaxReplicasCap = "wva_max_replicas_cap"
+
+	// WVACollectorDiscrepancyTotal is a counter of replica metrics on which the shadow
+	// collector disagreed with the primary collector.
+	// Labels: model_name, namespace, metric (kv_cache_usage, queue_length or replica)
+	WVACollectorDiscrepancyTotal = "wva_collector_discrepancy_total"
+
+	// WVALimiterNoInventoryTotal is a counter of optimization cycles in which the GPU limiter
+	// found no accelerator inventory and left scaling decisions unlimited.
+	WVALimiterNoInventoryTotal = "wva_limiter_no_inventory_total"
+
+	// WVAOptimizePanicsTotal is a counter of optimization cycles that panicked and were
+	// recovered by the executor.
+	WVAOptimizePanicsTotal = "wva_optimize_panics_total"
+
+	// WVAAcceleratorUtilization is a gauge holding the average KV cache usage (0.0-1.0) of
+	// all replicas on each accelerator type, across models, for capacity planning.
+	// Labels: accelerator_type
+	WVAAcceleratorUtilization = "wva_accelerator_utilization"
+
+	// WVADecisionConfidence is a gauge holding the confidence (0.0-1.0) of each variant's
+	// latest decision, from the coverage and freshness of its metrics. Consumers may ignore
+	// recommendations below a confidence of their choosing.
+	// Labels: variant_name, namespace, accelerator_type
+	WVADecisionConfidence = "wva_decision_confidence"
 )
 
 // Metric Label Names
@@ -85,6 +160,16 @@ const (
 	LabelReason             = "reason"
 	LabelAcceleratorType    = "accelerator_type"
 	LabelControllerInstance = "controller_instance"
+	LabelTargetName         = "target_name"
+	LabelMetric             = "metric"
+
+	// LabelTenantNamespace holds the VariantAutoscaling namespace on replica metrics when the
+	// tenant namespace label is enabled. Unlike namespace, it is not renamed at scrape time.
+	LabelTenantNamespace = "tenant_namespace"
+
+	// LabelExportedNamespace is the label Prometheus stores the namespace label under when
+	// scraping WVA, since the scrape target's own namespace label takes precedence.
+	LabelExportedNamespace = "exported_namespace"
 )
 
 // Kubernetes Label Keys
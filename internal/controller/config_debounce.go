@@ -0,0 +1,68 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// configUpdateDebouncer coalesces rapid updates of a watched ConfigMap. The first update of a key
+// starts a window; updates arriving within it replace the pending one, and only the latest is
+// applied when the window ends. It is safe for concurrent use.
+type configUpdateDebouncer struct {
+	mu      sync.Mutex
+	clock   clock.WithDelayedExecution
+	window  time.Duration
+	pending map[string]func()
+}
+
+// newConfigUpdateDebouncer creates a debouncer using the given clock and window.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+func newConfigUpdateDebouncer(clk clock.WithDelayedExecution, window time.Duration) *configUpdateDebouncer {
+	return &configUpdateDebouncer{
+		clock:   clk,
+		window:  window,
+		pending: make(map[string]func()),
+	}
+}
+
+// Apply schedules apply for key at the end of the current window, replacing any update of key
+// still pending. With a non-positive window apply runs immediately.
+func (d *configUpdateDebouncer) Apply(key string, apply func()) {
+	if d.window <= 0 {
+		apply()
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, scheduled := d.pending[key]
+	d.pending[key] = apply
+	if scheduled {
+		return
+	}
+	d.clock.AfterFunc(d.window, func() {
+		d.mu.Lock()
+		latest := d.pending[key]
+		delete(d.pending, key)
+		d.mu.Unlock()
+		latest()
+	})
+}
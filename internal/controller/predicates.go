@@ -15,7 +15,10 @@ import (
 func ConfigMapPredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		name := obj.GetName()
-		return (name == getConfigMapName() || name == getSaturationConfigMapName() || name == config.DefaultScaleToZeroConfigMapName) && obj.GetNamespace() == configMapNamespace
+		return (name == config.GetConfigMapName() || name == config.GetSaturationConfigMapName() || name == config.GetAcceleratorAliasesConfigMapName() ||
+			name == config.GetServiceClassesConfigMapName() || name == config.DefaultScaleToZeroConfigMapName ||
+			name == config.GetMaintenanceWindowsConfigMapName()) &&
+			obj.GetNamespace() == configMapNamespace
 	})
 }
 
@@ -19,20 +19,30 @@ package controller
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/go-logr/logr"
 	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
-	yaml "gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -42,6 +52,7 @@ import (
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/common"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 )
 
@@ -51,6 +62,38 @@ type VariantAutoscalingReconciler struct {
 	Scheme *runtime.Scheme
 
 	Recorder record.EventRecorder
+
+	// StatusBatchWindow delays reconciles triggered by Engine decisions so that several decisions
+	// for the same VA within the window coalesce into a single status update. Zero disables batching.
+	StatusBatchWindow time.Duration
+
+	// ConfigUpdateDebounceWindow coalesces updates of a watched ConfigMap within the window into
+	// a single application of its latest data. Zero applies every update immediately.
+	ConfigUpdateDebounceWindow time.Duration
+
+	// DeploymentEventDebounceWindow delays reconciles triggered by Deployment creates so that
+	// rapid creates of a VA's scale target during a rollout coalesce into a single reconcile.
+	// Zero enqueues every create immediately.
+	DeploymentEventDebounceWindow time.Duration
+
+	// AnnotateScaleReason sets the reason of the latest applied decision as an informational
+	// annotation on each VA's scale target Deployment. Off by default to avoid annotation churn.
+	AnnotateScaleReason bool
+
+	// ReconcilePeriod requeues each VA this long after a successful reconcile, so its status
+	// and conditions are refreshed even when no watched event arrives. Zero disables the
+	// periodic reconcile.
+	ReconcilePeriod time.Duration
+
+	// PendingDecisionRequeue requeues a VA whose scale target exists but that has no Engine
+	// decision in the cache yet, e.g. right after it was created, so it picks up the first
+	// decision promptly instead of waiting for the next periodic reconcile. Zero disables it.
+	PendingDecisionRequeue time.Duration
+
+	// PendingDecisionMaxAge bounds PendingDecisionRequeue: a VA older than this that still has
+	// no decision, e.g. because its model has no metrics or no saturation config, falls back
+	// to ReconcilePeriod instead of being requeued every PendingDecisionRequeue forever.
+	PendingDecisionMaxAge time.Duration
 }
 
 // +kubebuilder:rbac:groups=llmd.ai,resources=variantautoscalings,verbs=get;list;watch;create;update;patch;delete
@@ -59,40 +102,43 @@ type VariantAutoscalingReconciler struct {
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=nodes/status,verbs=get;list;update;patch;watch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments/scale,verbs=get;update
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;update;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update;list;watch
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=custom.metrics.k8s.io,resources=*,verbs=get;list
 
 const (
-	defaultConfigMapName = "workload-variant-autoscaler-variantautoscaling-config"
 	// ServiceMonitor constants for watching controller's own metrics ServiceMonitor
 	defaultServiceMonitorName = "workload-variant-autoscaler-controller-manager-metrics-monitor"
 
-	defaultSaturationConfigMapName = "saturation-scaling-config"
+	// reconcilePeriodEnvVar overrides defaultReconcilePeriod
+	reconcilePeriodEnvVar  = "WVA_RECONCILE_PERIOD"
+	defaultReconcilePeriod = 60 * time.Second
+
+	// actuationTolerance is the fraction of desired replicas (rounded down) by which the
+	// scale target may differ and still count as actuated
+	actuationTolerance = 0.1
 )
 
-func getNamespace() string {
-	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
-		return ns
+// ReconcilePeriodFromEnv returns the periodic reconcile interval set in WVA_RECONCILE_PERIOD,
+// e.g. "30s" or "0" to disable it, or defaultReconcilePeriod when unset.
+func ReconcilePeriodFromEnv() (time.Duration, error) {
+	value := os.Getenv(reconcilePeriodEnvVar)
+	if value == "" {
+		return defaultReconcilePeriod, nil
 	}
-	return "workload-variant-autoscaler-system"
-}
-
-func getConfigMapName() string {
-	if name := os.Getenv("CONFIG_MAP_NAME"); name != "" {
-		return name
+	period, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", reconcilePeriodEnvVar, value, err)
 	}
-	return defaultConfigMapName
-}
-
-func getSaturationConfigMapName() string {
-	if name := os.Getenv("SATURATION_CONFIG_MAP_NAME"); name != "" {
-		return name
+	if period < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must not be negative", reconcilePeriodEnvVar, value)
 	}
-	return defaultSaturationConfigMapName
+	return period, nil
 }
 
 var (
@@ -102,7 +148,7 @@ var (
 		Version: "v1",
 		Kind:    "ServiceMonitor",
 	}
-	configMapNamespace = getNamespace()
+	configMapNamespace = config.GetNamespace()
 )
 
 func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -111,7 +157,7 @@ func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.R
 	// - reconcile loop will process one VA at a time. During the refactoring it does both, one and all
 
 	// BEGIN: Per VA logic
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Controller)
 
 	// Get the specific VA object that triggered this reconciliation
 	var va llmdVariantAutoscalingV1alpha1.VariantAutoscaling
@@ -154,6 +200,21 @@ func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.R
 				"name", scaleTargetName,
 				"namespace", va.Namespace)
 
+			// A deployment deleted after it was resolved leaves the replica metrics last emitted
+			// for the VA behind, and the Engine skips VAs without a deployment, so clear them
+			if llmdVariantAutoscalingV1alpha1.IsConditionTrue(&va, llmdVariantAutoscalingV1alpha1.TypeTargetResolved) {
+				deleted := metrics.NewMetricsEmitter().DeleteReplicaMetrics(&va)
+				logger.Info("Scale target Deployment deleted, cleared replica metrics",
+					"name", scaleTargetName,
+					"namespace", va.Namespace,
+					"seriesDeleted", deleted)
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeScaleTargetDeleted,
+					metav1.ConditionTrue,
+					llmdVariantAutoscalingV1alpha1.ReasonDeploymentDeleted,
+					fmt.Sprintf("Scale target Deployment %s was deleted, replica metrics were cleared", scaleTargetName))
+			}
+
 			// Update status to reflect target not found
 			llmdVariantAutoscalingV1alpha1.SetCondition(&va,
 				llmdVariantAutoscalingV1alpha1.TypeTargetResolved,
@@ -161,7 +222,17 @@ func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.R
 				llmdVariantAutoscalingV1alpha1.ReasonTargetNotFound,
 				fmt.Sprintf("Scale target Deployment %s not found", scaleTargetName))
 
-			if err := r.Status().Patch(ctx, &va, client.MergeFrom(originalVA)); err != nil {
+			// The Engine skips VAs without a deployment, so a MetricsAvailable condition set
+			// before the deployment was deleted would otherwise stay True
+			if llmdVariantAutoscalingV1alpha1.IsConditionTrue(&va, llmdVariantAutoscalingV1alpha1.TypeMetricsAvailable) {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeMetricsAvailable,
+					metav1.ConditionFalse,
+					llmdVariantAutoscalingV1alpha1.ReasonScaleTargetMissing,
+					fmt.Sprintf("No metrics are collected while scale target Deployment %s is missing", scaleTargetName))
+			}
+
+			if err := r.patchStatus(ctx, &va, originalVA); err != nil {
 				logger.Error(err, "Failed to update VariantAutoscaling status")
 				return ctrl.Result{}, err
 			}
@@ -182,6 +253,13 @@ func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.R
 		metav1.ConditionTrue,
 		llmdVariantAutoscalingV1alpha1.ReasonTargetFound,
 		fmt.Sprintf("Scale target Deployment %s found", scaleTargetName))
+	if llmdVariantAutoscalingV1alpha1.IsConditionTrue(&va, llmdVariantAutoscalingV1alpha1.TypeScaleTargetDeleted) {
+		llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+			llmdVariantAutoscalingV1alpha1.TypeScaleTargetDeleted,
+			metav1.ConditionFalse,
+			llmdVariantAutoscalingV1alpha1.ReasonDeploymentRecreated,
+			fmt.Sprintf("Scale target Deployment %s exists again", scaleTargetName))
+	}
 
 	logger.V(logging.DEBUG).Info(
 		fmt.Sprintf("Scale target Deployment found: name=%s, namespace=%s", scaleTargetName, va.Namespace),
@@ -189,8 +267,22 @@ func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 	// Process Engine Decisions from Shared Cache
 	// This mechanism allows the Engine to trigger updates without touching the API server directly.
-	if decision, ok := common.DecisionCache.Get(va.Name, va.Namespace); ok {
-		logger.Info("Found decision in cache", "va", va.Name, "namespace", va.Namespace, "metricsAvailable", decision.MetricsAvailable)
+	var exportedAnalysis, previewDecision, scaleReason string
+	var clearAnalysis bool
+	decisionPending := false
+	if decision, ok := common.DecisionCache.Get(va.Name, va.Namespace); ok && decision.OptimizationFailed {
+		// The engine's safety net is disabled and the analysis failed: report the failure loudly
+		// and leave the desired allocation untouched
+		logger.Info("Found failed optimization in cache", "va", va.Name, "namespace", va.Namespace,
+			logging.TraceIDKey, decision.TraceID)
+		llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+			llmdVariantAutoscalingV1alpha1.TypeOptimizationReady,
+			metav1.ConditionFalse,
+			llmdVariantAutoscalingV1alpha1.ReasonOptimizationFailed,
+			decision.OptimizationMessage)
+	} else if ok {
+		logger.Info("Found decision in cache", "va", va.Name, "namespace", va.Namespace, "metricsAvailable", decision.MetricsAvailable,
+			logging.TraceIDKey, decision.TraceID)
 		// Only apply if the decision is fresher than the last one applied or if we haven't applied it
 		// Note: We blindly apply for now, assuming the Engine acts as the source of truth for "Desired" state
 		numReplicas, accelerator, lastRunTime := common.DecisionToOptimizedAlloc(decision)
@@ -198,12 +290,24 @@ func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.R
 		// Only update DesiredOptimizedAlloc if we have a valid accelerator (required by CRD).
 		// Note: numReplicas may legitimately be 0 for scale-to-zero scenarios.
 		// Replace the entire struct to ensure all required fields are included in the patch.
-		if accelerator != "" {
+		// A preview decision is only published and leaves the desired allocation unchanged.
+		if accelerator != "" && decision.PreviewDecision == "" {
 			va.Status.DesiredOptimizedAlloc = llmdVariantAutoscalingV1alpha1.OptimizedAlloc{
 				NumReplicas: numReplicas,
 				Accelerator: accelerator,
 				LastRunTime: lastRunTime,
+				ReasonCode:  string(decision.ReasonCode),
+				Confidence:  llmdVariantAutoscalingV1alpha1.FormatConfidence(decision.Confidence),
+			}
+			scaleReason = decision.Reason
+			if scaleReason == "" {
+				scaleReason = string(decision.ReasonCode)
 			}
+			llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+				llmdVariantAutoscalingV1alpha1.TypeOptimizationReady,
+				metav1.ConditionTrue,
+				llmdVariantAutoscalingV1alpha1.ReasonOptimizationSucceeded,
+				"Optimization completed and replicas calculated")
 		}
 
 		// Always apply MetricsAvailable condition from cache
@@ -217,23 +321,379 @@ func (r *VariantAutoscalingReconciler) Reconcile(ctx context.Context, req ctrl.R
 			decision.MetricsReason,
 			decision.MetricsMessage)
 
+		// Apply PartialMetrics condition when a minimum metrics coverage is configured
+		if decision.MinMetricsCoverage > 0 {
+			if decision.PartialMetrics {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypePartialMetrics,
+					metav1.ConditionTrue,
+					llmdVariantAutoscalingV1alpha1.ReasonMetricsCoverageLow,
+					fmt.Sprintf("Metrics coverage %.0f%% is below minimum %.0f%%, scaling decisions are held",
+						decision.MetricsCoverage*100, decision.MinMetricsCoverage*100))
+			} else {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypePartialMetrics,
+					metav1.ConditionFalse,
+					llmdVariantAutoscalingV1alpha1.ReasonMetricsCoverageSufficient,
+					fmt.Sprintf("Metrics coverage %.0f%% meets minimum %.0f%%",
+						decision.MetricsCoverage*100, decision.MinMetricsCoverage*100))
+			}
+		}
+
+		// Apply MetricLabelDrift condition once the model's metrics have been collected
+		setMetricLabelDriftCondition(&va, decision)
+
+		// Apply MaintenanceHold condition while a maintenance window freezes scaling
+		setMaintenanceHoldCondition(&va, decision)
+
+		// Apply Observing condition when a minimum observation window is configured
+		if decision.MinObservationCycles > 0 {
+			if decision.Observing {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeObserving,
+					metav1.ConditionTrue,
+					llmdVariantAutoscalingV1alpha1.ReasonCollectingSamples,
+					fmt.Sprintf("Observed %d of %d cycles, replicas are held at their current count",
+						decision.ObservedCycles, decision.MinObservationCycles))
+			} else {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeObserving,
+					metav1.ConditionFalse,
+					llmdVariantAutoscalingV1alpha1.ReasonObservationComplete,
+					fmt.Sprintf("Observed at least %d cycles, scaling decisions are applied",
+						decision.MinObservationCycles))
+			}
+		}
+
+		// Apply ElevatedErrorRate condition when an error rate threshold is configured
+		if decision.ErrorRateThreshold > 0 {
+			if decision.ElevatedErrorRate {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeElevatedErrorRate,
+					metav1.ConditionTrue,
+					llmdVariantAutoscalingV1alpha1.ReasonErrorRateHigh,
+					fmt.Sprintf("Error rate %.1f%% is at or above %.1f%%, scale-down is blocked",
+						decision.ErrorRate*100, decision.ErrorRateThreshold*100))
+			} else {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeElevatedErrorRate,
+					metav1.ConditionFalse,
+					llmdVariantAutoscalingV1alpha1.ReasonErrorRateNormal,
+					fmt.Sprintf("Error rate %.1f%% is below %.1f%%",
+						decision.ErrorRate*100, decision.ErrorRateThreshold*100))
+			}
+		}
+
+		// Apply StuckPending condition when a maximum pending age is configured
+		if decision.MaxPendingAge > 0 {
+			if decision.StuckPending {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeStuckPending,
+					metav1.ConditionTrue,
+					llmdVariantAutoscalingV1alpha1.ReasonPendingTooLong,
+					fmt.Sprintf("Replicas have been pending for more than %s, other variants may scale up instead",
+						decision.MaxPendingAge))
+			} else {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeStuckPending,
+					metav1.ConditionFalse,
+					llmdVariantAutoscalingV1alpha1.ReasonPendingWithinLimit,
+					fmt.Sprintf("No replicas have been pending for more than %s", decision.MaxPendingAge))
+			}
+		}
+
+		// Apply Flapping condition when flap detection is configured
+		setFlappingCondition(&va, decision)
+
+		// Apply NoInventory condition when the GPU limiter is enabled
+		if decision.LimiterEnabled {
+			if decision.NoInventory {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeNoInventory,
+					metav1.ConditionTrue,
+					llmdVariantAutoscalingV1alpha1.ReasonNoAcceleratorInventory,
+					"No accelerator inventory found in the cluster, scaling is not limited by available GPUs")
+			} else {
+				llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+					llmdVariantAutoscalingV1alpha1.TypeNoInventory,
+					metav1.ConditionFalse,
+					llmdVariantAutoscalingV1alpha1.ReasonAcceleratorInventoryFound,
+					"Scaling is limited by the GPUs available in the cluster")
+			}
+		}
+
+		// Record that the scale target was brought back from an unexpected zero
+		if decision.RecoveredFromZero {
+			llmdVariantAutoscalingV1alpha1.SetCondition(&va,
+				llmdVariantAutoscalingV1alpha1.TypeRecoveredFromZero,
+				metav1.ConditionTrue,
+				llmdVariantAutoscalingV1alpha1.ReasonZeroReplicasWithoutScaleToZero,
+				fmt.Sprintf("Scale target had 0 replicas with scale-to-zero disabled, scaled back to %d", decision.TargetReplicas))
+		}
+
+		exportedAnalysis = decision.SaturationAnalysis
+		clearAnalysis = decision.ClearSaturationAnalysis
+		previewDecision = decision.PreviewDecision
+
+		// Apply DeploymentPaused condition while the deployment rollout is paused
+		setDeploymentPausedCondition(&va, decision)
+
 		// Note: CurrentAlloc is removed from Status.
 		// Internal allocation state is managed by the Engine and Actuator.
 	} else {
 		logger.Info("No decision found in cache for VA", "va", va.Name, "namespace", va.Namespace)
+		decisionPending = true
 	}
 
+	// Report whether the scale target has caught up with the desired replicas
+	setActuatedCondition(&va, &deployment)
+
 	// Update Status if we have changes (Conditions or OptimizedAlloc)
 	// We use Patch to only send changed fields, avoiding validation errors on unchanged fields
-	if err := r.Status().Patch(ctx, &va, client.MergeFrom(originalVA)); err != nil {
+	if err := r.patchStatus(ctx, &va, originalVA); err != nil {
 		logger.Error(err, "Failed to update VariantAutoscaling status",
 			"name", va.Name)
 		return ctrl.Result{}, err
 	}
 
+	// Publish the model's saturation analysis when the engine exported it for this VA, removing
+	// it once another VA of the model became the representative, and the next decision of a VA
+	// in preview mode
+	var removeAnnotations []string
+	if clearAnalysis {
+		removeAnnotations = append(removeAnnotations, llmdVariantAutoscalingV1alpha1.SaturationAnalysisAnnotation)
+	}
+	if err := r.patchAnnotations(ctx, &va, map[string]string{
+		llmdVariantAutoscalingV1alpha1.SaturationAnalysisAnnotation: exportedAnalysis,
+		llmdVariantAutoscalingV1alpha1.PreviewDecisionAnnotation:    previewDecision,
+	}, removeAnnotations...); err != nil {
+		logger.Error(err, "Failed to update VariantAutoscaling annotations",
+			"name", va.Name)
+		return ctrl.Result{}, err
+	}
+
+	// Surface the reason of the applied decision on the scale target. The annotation is
+	// informational only, so failing to set it does not fail the reconcile.
+	if r.AnnotateScaleReason && scaleReason != "" {
+		if err := r.patchDeploymentScaleReason(ctx, &deployment, scaleReason); err != nil {
+			logger.Error(err, "Failed to annotate scale target Deployment with the scale reason",
+				"name", scaleTargetName,
+				"namespace", va.Namespace)
+		}
+	}
+
 	// END: Per VA logic
 
-	return ctrl.Result{}, nil
+	// The Engine may not have run for a freshly created VA yet: check back soon for its first
+	// decision, but only for so long, as some VAs never get one
+	requeueAfter := r.ReconcilePeriod
+	if decisionPending && r.PendingDecisionRequeue > 0 && (requeueAfter == 0 || r.PendingDecisionRequeue < requeueAfter) {
+		if age := time.Since(va.CreationTimestamp.Time); age < r.PendingDecisionMaxAge {
+			requeueAfter = r.PendingDecisionRequeue
+		} else {
+			logger.V(logging.DEBUG).Info("No scaling decision yet, falling back to the periodic reconcile",
+				"name", va.Name,
+				"namespace", va.Namespace,
+				"age", age)
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// setDeploymentPausedCondition sets the DeploymentPaused condition while the variant's deployment
+// rollout is paused, and clears it once the rollout is resumed.
+func setDeploymentPausedCondition(va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) {
+	if decision.DeploymentPaused {
+		llmdVariantAutoscalingV1alpha1.SetCondition(va,
+			llmdVariantAutoscalingV1alpha1.TypeDeploymentPaused,
+			metav1.ConditionTrue,
+			llmdVariantAutoscalingV1alpha1.ReasonRolloutPaused,
+			"Deployment rollout is paused, replicas are held at their desired count")
+		return
+	}
+	if llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeDeploymentPaused) == nil {
+		return
+	}
+	llmdVariantAutoscalingV1alpha1.SetCondition(va,
+		llmdVariantAutoscalingV1alpha1.TypeDeploymentPaused,
+		metav1.ConditionFalse,
+		llmdVariantAutoscalingV1alpha1.ReasonRolloutResumed,
+		"Deployment rollout is not paused, scaling decisions are applied")
+}
+
+// setActuatedCondition compares the scale target's replicas with the desired replicas and
+// sets the Actuated condition. The target counts as actuated when it is within
+// actuationTolerance of the desired replicas. Nothing is set until a desired allocation exists.
+func setActuatedCondition(va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, deployment *appsv1.Deployment) {
+	if va.Status.DesiredOptimizedAlloc.Accelerator == "" {
+		return
+	}
+	desired := va.Status.DesiredOptimizedAlloc.NumReplicas
+
+	// The spec reflects what the external autoscaler requested, even while pods are starting
+	current := int(deployment.Status.Replicas)
+	if deployment.Spec.Replicas != nil {
+		current = int(*deployment.Spec.Replicas)
+	}
+
+	tolerance := int(math.Floor(float64(desired) * actuationTolerance))
+	drift := current - desired
+	if drift < 0 {
+		drift = -drift
+	}
+
+	if drift <= tolerance {
+		llmdVariantAutoscalingV1alpha1.SetCondition(va,
+			llmdVariantAutoscalingV1alpha1.TypeActuated,
+			metav1.ConditionTrue,
+			llmdVariantAutoscalingV1alpha1.ReasonDesiredReplicasReached,
+			fmt.Sprintf("Scale target has %d replicas, desired %d", current, desired))
+		return
+	}
+	llmdVariantAutoscalingV1alpha1.SetCondition(va,
+		llmdVariantAutoscalingV1alpha1.TypeActuated,
+		metav1.ConditionFalse,
+		llmdVariantAutoscalingV1alpha1.ReasonReplicaDrift,
+		fmt.Sprintf("Scale target has %d replicas, desired %d", current, desired))
+}
+
+// setMetricLabelDriftCondition sets the MetricLabelDrift condition from the label drift the
+// collector found in the model's latest metrics. Nothing is set until they have been collected.
+func setMetricLabelDriftCondition(va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) {
+	if !decision.LabelDriftChecked {
+		return
+	}
+	if len(decision.MissingPodLabels) > 0 {
+		llmdVariantAutoscalingV1alpha1.SetCondition(va,
+			llmdVariantAutoscalingV1alpha1.TypeMetricLabelDrift,
+			metav1.ConditionTrue,
+			llmdVariantAutoscalingV1alpha1.ReasonPodLabelMissing,
+			fmt.Sprintf("Series of queries %s have neither a pod nor a pod_name label and cannot be attributed to replicas; "+
+				"check the labels exported by the model server", strings.Join(decision.MissingPodLabels, ", ")))
+		return
+	}
+	llmdVariantAutoscalingV1alpha1.SetCondition(va,
+		llmdVariantAutoscalingV1alpha1.TypeMetricLabelDrift,
+		metav1.ConditionFalse,
+		llmdVariantAutoscalingV1alpha1.ReasonExpectedLabelsPresent,
+		"All metric series carry a pod or pod_name label")
+}
+
+// setMaintenanceHoldCondition sets the MaintenanceHold condition while the decision was made
+// within a maintenance window, and clears it once the window has closed.
+func setMaintenanceHoldCondition(va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) {
+	if decision.MaintenanceWindow != "" {
+		llmdVariantAutoscalingV1alpha1.SetCondition(va,
+			llmdVariantAutoscalingV1alpha1.TypeMaintenanceHold,
+			metav1.ConditionTrue,
+			llmdVariantAutoscalingV1alpha1.ReasonMaintenanceWindowActive,
+			fmt.Sprintf("Maintenance window %s is active, replicas are held at their desired count", decision.MaintenanceWindow))
+		return
+	}
+	if llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeMaintenanceHold) == nil {
+		return
+	}
+	llmdVariantAutoscalingV1alpha1.SetCondition(va,
+		llmdVariantAutoscalingV1alpha1.TypeMaintenanceHold,
+		metav1.ConditionFalse,
+		llmdVariantAutoscalingV1alpha1.ReasonNoMaintenanceWindow,
+		"No maintenance window is active, scaling decisions are applied")
+}
+
+// setFlappingCondition reports whether the variant's desired replicas reversed direction too often
+// within the flap window, with guidance on the triggers to adjust. It is only set while flap
+// detection is configured for the model.
+func setFlappingCondition(va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) {
+	if decision.FlapWindow <= 0 {
+		return
+	}
+	if decision.Flapping {
+		llmdVariantAutoscalingV1alpha1.SetCondition(va,
+			llmdVariantAutoscalingV1alpha1.TypeFlapping,
+			metav1.ConditionTrue,
+			llmdVariantAutoscalingV1alpha1.ReasonFrequentDirectionChanges,
+			fmt.Sprintf("Desired replicas reversed direction %d times in the last %s; widen the hysteresis band "+
+				"between the scale-up thresholds and spare triggers, or set scaleDownDelay",
+				decision.DirectionChanges, decision.FlapWindow))
+		return
+	}
+	llmdVariantAutoscalingV1alpha1.SetCondition(va,
+		llmdVariantAutoscalingV1alpha1.TypeFlapping,
+		metav1.ConditionFalse,
+		llmdVariantAutoscalingV1alpha1.ReasonStableScalingDirection,
+		fmt.Sprintf("Desired replicas reversed direction %d times in the last %s", decision.DirectionChanges, decision.FlapWindow))
+}
+
+// patchStatus patches the status computed for va during this reconcile.
+// The patch is guarded by the resourceVersion of originalVA, so it fails with a conflict
+// when the engine trigger and the periodic reconcile update the same VA concurrently.
+// On conflict the latest VA is fetched and only the status fields this reconcile changed
+// are re-applied on top of it, so concurrent updates to other fields are not lost.
+func (r *VariantAutoscalingReconciler) patchStatus(ctx context.Context, va, originalVA *llmdVariantAutoscalingV1alpha1.VariantAutoscaling) error {
+	computed := va.Status.DeepCopy()
+	base := originalVA.Status.DeepCopy()
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := r.Status().Patch(ctx, va, client.MergeFromWithOptions(originalVA, client.MergeFromWithOptimisticLock{}))
+		if !apierrors.IsConflict(err) {
+			return err
+		}
+		logging.FromContext(ctx, logging.Controller).V(logging.DEBUG).Info("Conflict updating VariantAutoscaling status, retrying with latest version",
+			"name", va.Name,
+			"namespace", va.Namespace)
+
+		var latest llmdVariantAutoscalingV1alpha1.VariantAutoscaling
+		if getErr := r.Get(ctx, client.ObjectKeyFromObject(va), &latest); getErr != nil {
+			return getErr
+		}
+		originalVA = latest.DeepCopy()
+		reapplyStatusChanges(&latest, base, computed)
+		*va = latest
+		return err
+	})
+}
+
+// patchAnnotations sets the given annotations of va and removes those listed in remove. An
+// empty value leaves its annotation untouched, so it keeps the last value until a newer one
+// arrives. The preview decision is removed once the VA leaves preview mode. Nothing is sent
+// when va is already up to date.
+func (r *VariantAutoscalingReconciler) patchAnnotations(ctx context.Context, va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, annotations map[string]string, remove ...string) error {
+	original := va.DeepCopy()
+	for _, key := range remove {
+		delete(va.Annotations, key)
+	}
+	for key, value := range annotations {
+		if value == "" || va.Annotations[key] == value {
+			continue
+		}
+		if va.Annotations == nil {
+			va.Annotations = make(map[string]string)
+		}
+		va.Annotations[key] = value
+	}
+	if !va.IsPreview() {
+		delete(va.Annotations, llmdVariantAutoscalingV1alpha1.PreviewDecisionAnnotation)
+	}
+	if equality.Semantic.DeepEqual(original.Annotations, va.Annotations) {
+		return nil
+	}
+	return r.Patch(ctx, va, client.MergeFrom(original))
+}
+
+// reapplyStatusChanges applies to va the status fields that differ between base
+// (the status as read at the start of the reconcile) and computed (the status the
+// reconcile produced). Fields the reconcile did not change keep their latest values.
+func reapplyStatusChanges(va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, base, computed *llmdVariantAutoscalingV1alpha1.VariantAutoscalingStatus) {
+	if !equality.Semantic.DeepEqual(base.DesiredOptimizedAlloc, computed.DesiredOptimizedAlloc) {
+		va.Status.DesiredOptimizedAlloc = computed.DesiredOptimizedAlloc
+	}
+	for _, condition := range computed.Conditions {
+		previous := meta.FindStatusCondition(base.Conditions, condition.Type)
+		if previous != nil && equality.Semantic.DeepEqual(*previous, condition) {
+			continue
+		}
+		meta.SetStatusCondition(&va.Status.Conditions, condition)
+	}
 }
 
 // handleDeploymentEvent maps Deployment events to VA reconcile requests.
@@ -245,7 +705,7 @@ func (r *VariantAutoscalingReconciler) handleDeploymentEvent(ctx context.Context
 		return nil
 	}
 
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Controller)
 
 	// List all VAs in the same namespace
 	var vaList llmdVariantAutoscalingV1alpha1.VariantAutoscalingList
@@ -276,6 +736,7 @@ func (r *VariantAutoscalingReconciler) handleDeploymentEvent(ctx context.Context
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *VariantAutoscalingReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	configUpdates := newConfigUpdateDebouncer(clock.RealClock{}, r.ConfigUpdateDebounceWindow)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&llmdVariantAutoscalingV1alpha1.VariantAutoscaling{},
 			// Filter VAs by controller-instance label for multi-controller isolation
@@ -291,59 +752,18 @@ func (r *VariantAutoscalingReconciler) SetupWithManager(mgr ctrl.Manager) error
 					return nil
 				}
 
-				logger := ctrl.LoggerFrom(ctx)
-				name := cm.GetName()
-				namespace := cm.GetNamespace()
-
 				// Only interested in config maps in the configured namespace
-				if namespace != configMapNamespace {
-					return nil
-				}
-
-				if name == getConfigMapName() {
-					// Optimization Config (Global Interval)
-					if interval, ok := cm.Data["GLOBAL_OPT_INTERVAL"]; ok {
-						common.Config.UpdateOptimizationConfig(interval)
-						logger.Info("Updated global optimization config from ConfigMap", "interval", interval)
-					}
-					// Global config update is handled by the Engine loop which reads the new configuration.
-					// No need to trigger immediate reconciliation for individual VAs.
-					return nil
-				} else if name == getSaturationConfigMapName() {
-					// Saturation Scaling Config
-					configs := make(map[string]interfaces.SaturationScalingConfig)
-					count := 0
-					for key, yamlStr := range cm.Data {
-						var satConfig interfaces.SaturationScalingConfig
-						if err := yaml.Unmarshal([]byte(yamlStr), &satConfig); err != nil {
-							logger.Error(err, "Failed to parse saturation scaling config entry", "key", key)
-							continue
-						}
-						// Validate
-						if err := satConfig.Validate(); err != nil {
-							logger.Error(err, "Invalid saturation scaling config entry", "key", key)
-							continue
-						}
-						configs[key] = satConfig
-						count++
-					}
-					common.Config.UpdateSaturationConfig(configs)
-					logger.Info("Updated global saturation config from ConfigMap", "entries", count)
-
-					// Global saturation config update is handled by the Engine loop.
-					// No need to trigger immediate reconciliation for individual VAs.
-					return nil
-				} else if name == config.DefaultScaleToZeroConfigMapName {
-					// Scale-to-Zero Config
-					scaleToZeroConfig := config.ParseScaleToZeroConfigMap(cm.Data)
-					common.Config.UpdateScaleToZeroConfig(scaleToZeroConfig)
-					logger.Info("Updated global scale-to-zero config from ConfigMap", "modelCount", len(scaleToZeroConfig))
-
-					// Global config update is handled by the Engine loop.
-					// No need to trigger immediate reconciliation for individual VAs.
+				if cm.GetNamespace() != configMapNamespace {
 					return nil
 				}
 
+				// Rapid edits of a ConfigMap are coalesced into one application of its latest data.
+				// Global config updates are handled by the Engine loop which reads the new configuration,
+				// so no reconciliation of individual VAs is triggered.
+				logger := logging.FromContext(ctx, logging.Controller)
+				configUpdates.Apply(cm.GetName(), func() {
+					applyConfigMap(logger, cm)
+				})
 				return nil
 			}),
 			// Predicate to filter only the target configmap
@@ -359,19 +779,119 @@ func (r *VariantAutoscalingReconciler) SetupWithManager(mgr ctrl.Manager) error
 		// This handles the race condition where VA is created before its target deployment
 		Watches(
 			&appsv1.Deployment{},
-			handler.EnqueueRequestsFromMapFunc(r.handleDeploymentEvent),
+			deploymentEventHandler(r.handleDeploymentEvent, r.DeploymentEventDebounceWindow),
 			builder.WithPredicates(DeploymentPredicate()),
 		).
 		// Watch DecisionTrigger channel for Engine decisions
 		// This enables the Engine to trigger reconciliation without updating the object in API server
 		WatchesRawSource(
-			source.Channel(common.DecisionTrigger, &handler.EnqueueRequestForObject{}),
+			source.Channel(common.DecisionTrigger, decisionTriggerHandler(r.StatusBatchWindow)),
 		).
 		Named("variantAutoscaling").
 		WithEventFilter(EventFilter()).
 		Complete(r)
 }
 
+// applyConfigMap updates the shared configuration from one of the watched ConfigMaps.
+func applyConfigMap(logger logr.Logger, cm *corev1.ConfigMap) {
+	switch cm.GetName() {
+	case config.GetConfigMapName():
+		// Optimization Config (Global Interval)
+		if interval, ok := cm.Data["GLOBAL_OPT_INTERVAL"]; ok {
+			common.Config.UpdateOptimizationConfig(interval)
+			logger.Info("Updated global optimization config from ConfigMap", "interval", interval)
+		}
+	case config.GetSaturationConfigMapName():
+		// Saturation Scaling Config
+		configs := config.ParseSaturationScalingConfigMap(cm.Data)
+		common.Config.UpdateSaturationConfig(configs)
+		logger.Info("Updated global saturation config from ConfigMap", "entries", len(configs))
+	case config.GetAcceleratorAliasesConfigMapName():
+		// Accelerator Aliases
+		aliases := utils.ParseAcceleratorAliases(cm.Data)
+		common.Config.UpdateAcceleratorAliases(aliases)
+		logger.Info("Updated accelerator aliases from ConfigMap", "aliases", len(aliases))
+	case config.GetServiceClassesConfigMapName():
+		// Service Classes
+		classes := config.ParseServiceClassConfigMap(cm.Data)
+		common.Config.UpdateServiceClasses(classes)
+		logger.Info("Updated service classes from ConfigMap", "classes", len(classes))
+	case config.GetMaintenanceWindowsConfigMapName():
+		// Maintenance Windows
+		windows := config.ParseMaintenanceWindowConfigMap(cm.Data)
+		common.Config.UpdateMaintenanceWindows(windows)
+		logger.Info("Updated maintenance windows from ConfigMap", "windows", len(windows))
+	case config.DefaultScaleToZeroConfigMapName:
+		// Scale-to-Zero Config
+		scaleToZeroConfig := config.ParseScaleToZeroConfigMap(cm.Data)
+		common.Config.UpdateScaleToZeroConfig(scaleToZeroConfig)
+		logger.Info("Updated global scale-to-zero config from ConfigMap", "modelCount", len(scaleToZeroConfig))
+	}
+}
+
+// decisionTriggerHandler enqueues the VA of each Engine decision. With a positive window the
+// request is delayed instead of added immediately; the workqueue keeps a single pending entry
+// per VA, so every decision arriving within the window is applied by one reconcile and one
+// status patch. Decisions are read from the DecisionCache at reconcile time, so the latest wins.
+func decisionTriggerHandler(window time.Duration) handler.EventHandler {
+	if window <= 0 {
+		return &handler.EnqueueRequestForObject{}
+	}
+	return handler.Funcs{
+		GenericFunc: func(_ context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if e.Object == nil {
+				return
+			}
+			q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      e.Object.GetName(),
+				Namespace: e.Object.GetNamespace(),
+			}}, window)
+		},
+	}
+}
+
+// patchDeploymentScaleReason sets the LastScaleReasonAnnotation of deployment to reason.
+// Nothing is sent when the annotation already holds reason.
+func (r *VariantAutoscalingReconciler) patchDeploymentScaleReason(ctx context.Context, deployment *appsv1.Deployment, reason string) error {
+	if deployment.Annotations[llmdVariantAutoscalingV1alpha1.LastScaleReasonAnnotation] == reason {
+		return nil
+	}
+	original := deployment.DeepCopy()
+	if deployment.Annotations == nil {
+		deployment.Annotations = make(map[string]string)
+	}
+	deployment.Annotations[llmdVariantAutoscalingV1alpha1.LastScaleReasonAnnotation] = reason
+	return r.Patch(ctx, deployment, client.MergeFrom(original))
+}
+
+// deploymentEventHandler enqueues the VAs that mapFunc maps a Deployment event to. With a positive
+// window, requests from create events are delayed instead of added immediately; the workqueue keeps
+// a single pending entry per VA, so all creates of its scale target within the window are handled
+// by one reconcile. Delete events are always enqueued immediately so status reflects the removal.
+func deploymentEventHandler(mapFunc handler.MapFunc, window time.Duration) handler.EventHandler {
+	if window <= 0 {
+		return handler.EnqueueRequestsFromMapFunc(mapFunc)
+	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if e.Object == nil {
+				return
+			}
+			for _, req := range mapFunc(ctx, e.Object) {
+				q.AddAfter(req, window)
+			}
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if e.Object == nil {
+				return
+			}
+			for _, req := range mapFunc(ctx, e.Object) {
+				q.Add(req)
+			}
+		},
+	}
+}
+
 // handleServiceMonitorEvent handles events for the controller's own ServiceMonitor.
 // When ServiceMonitor is deleted, it logs an error and emits a Kubernetes event.
 // This ensures that administrators are aware when the ServiceMonitor that enables
@@ -387,7 +907,7 @@ func (r *VariantAutoscalingReconciler) handleServiceMonitorEvent(ctx context.Con
 		return nil
 	}
 
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Controller)
 	name := serviceMonitor.Name
 	namespace := serviceMonitor.Namespace
 
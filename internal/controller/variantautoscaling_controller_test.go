@@ -18,23 +18,36 @@ package controller
 
 import (
 	"context"
+	"os"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/common"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/saturation"
 	testutils "github.com/llm-d-incubation/workload-variant-autoscaler/test/utils"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/test/utils/resources"
 )
@@ -71,7 +84,7 @@ var _ = Describe("VariantAutoscalings Controller", func() {
 			configMap = testutils.CreateAcceleratorUnitCostConfigMap(ns.Name)
 			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
 
-			configMap = testutils.CreateVariantAutoscalingConfigMap(defaultConfigMapName, ns.Name)
+			configMap = testutils.CreateVariantAutoscalingConfigMap(config.DefaultConfigMapName, ns.Name)
 			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
 
 			By("creating the custom resource for the Kind VariantAutoscalings")
@@ -126,7 +139,7 @@ var _ = Describe("VariantAutoscalings Controller", func() {
 
 			configMap = &v1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      defaultConfigMapName,
+					Name:      config.DefaultConfigMapName,
 					Namespace: configMapNamespace,
 				},
 			}
@@ -169,7 +182,7 @@ var _ = Describe("VariantAutoscalings Controller", func() {
 			configMap = testutils.CreateAcceleratorUnitCostConfigMap(ns.Name)
 			Expect(k8sClient.Create(ctx, configMap)).NotTo(HaveOccurred())
 
-			configMap = testutils.CreateVariantAutoscalingConfigMap(defaultConfigMapName, ns.Name)
+			configMap = testutils.CreateVariantAutoscalingConfigMap(config.DefaultConfigMapName, ns.Name)
 			Expect(k8sClient.Create(ctx, configMap)).NotTo(HaveOccurred())
 		})
 
@@ -195,7 +208,7 @@ var _ = Describe("VariantAutoscalings Controller", func() {
 
 			configMap = &v1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      defaultConfigMapName,
+					Name:      config.DefaultConfigMapName,
 					Namespace: configMapNamespace,
 				},
 			}
@@ -380,6 +393,801 @@ var _ = Describe("VariantAutoscalings Controller", func() {
 			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
 			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
 		})
+
+		It("should clear a stale MetricsAvailable condition when the deployment is deleted", func() {
+			By("Creating VariantAutoscaling and its target deployment")
+			resource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: resourceName,
+					},
+					ModelID: "default-default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			deployment := resources.CreateLlmdSimDeployment("default", resourceName, "default-default", "default", "8000", 0, 0, 1)
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+
+			By("Marking metrics as available, as the Engine would")
+			fetchedResource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, fetchedResource)).To(Succeed())
+			llmdVariantAutoscalingV1alpha1.SetCondition(fetchedResource,
+				llmdVariantAutoscalingV1alpha1.TypeMetricsAvailable,
+				metav1.ConditionTrue,
+				llmdVariantAutoscalingV1alpha1.ReasonMetricsFound,
+				"Saturation metrics data is available for scaling decisions")
+			Expect(k8sClient.Status().Update(ctx, fetchedResource)).To(Succeed())
+
+			By("Deleting the target deployment and reconciling")
+			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+			controllerReconciler := &VariantAutoscalingReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Verifying MetricsAvailable is False with reason ScaleTargetMissing")
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, fetchedResource)).To(Succeed())
+			condition := llmdVariantAutoscalingV1alpha1.GetCondition(fetchedResource, llmdVariantAutoscalingV1alpha1.TypeMetricsAvailable)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonScaleTargetMissing))
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should clear the replica metrics and set ScaleTargetDeleted when the deployment is deleted", func() {
+			registry := prometheus.NewRegistry()
+			Expect(metrics.InitMetrics(registry)).To(Succeed())
+			countDesiredSeries := func() int {
+				families, err := registry.Gather()
+				Expect(err).NotTo(HaveOccurred())
+				for _, family := range families {
+					if family.GetName() == constants.WVADesiredReplicas {
+						return len(family.GetMetric())
+					}
+				}
+				return 0
+			}
+
+			By("Creating VariantAutoscaling and its target deployment")
+			resource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: resourceName,
+					},
+					ModelID: "default-default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			deployment := resources.CreateLlmdSimDeployment("default", resourceName, "default-default", "default", "8000", 0, 0, 1)
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+
+			controllerReconciler := &VariantAutoscalingReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Emitting replica metrics, as the Engine would")
+			Expect(metrics.NewMetricsEmitter().EmitReplicaMetrics(ctx, resource, 1, 2, "H100")).To(Succeed())
+			Expect(countDesiredSeries()).To(Equal(1))
+
+			By("Deleting the target deployment and reconciling")
+			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Verifying the series is removed and ScaleTargetDeleted is True")
+			Expect(countDesiredSeries()).To(Equal(0))
+			fetchedResource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, fetchedResource)).To(Succeed())
+			condition := llmdVariantAutoscalingV1alpha1.GetCondition(fetchedResource, llmdVariantAutoscalingV1alpha1.TypeScaleTargetDeleted)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonDeploymentDeleted))
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+	})
+
+	Context("Preview Mode", func() {
+		const resourceName = "preview-test"
+
+		BeforeEach(func() {
+			logging.NewTestLogger()
+		})
+
+		It("should publish the preview decision while desired replicas remain unchanged", func() {
+			By("Creating a VariantAutoscaling in preview mode and its target deployment")
+			resource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        resourceName,
+					Namespace:   "default",
+					Annotations: map[string]string{llmdVariantAutoscalingV1alpha1.PreviewAnnotation: "true"},
+				},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: resourceName,
+					},
+					ModelID: "default-default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			resource.Status.DesiredOptimizedAlloc = llmdVariantAutoscalingV1alpha1.OptimizedAlloc{
+				Accelerator: "A100",
+				NumReplicas: 2,
+				LastRunTime: metav1.Now(),
+			}
+			Expect(k8sClient.Status().Update(ctx, resource)).To(Succeed())
+			deployment := resources.CreateLlmdSimDeployment("default", resourceName, "default-default", "default", "8000", 0, 0, 1)
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+
+			By("Caching a preview decision, as the Engine would")
+			preview := `{"targetReplicas":4,"desiredReplicas":2,"accelerator":"A100","action":"scale-up","time":null}`
+			common.DecisionCache.Set(resourceName, "default", interfaces.VariantDecision{
+				VariantName:      resourceName,
+				Namespace:        "default",
+				MetricsAvailable: true,
+				MetricsReason:    llmdVariantAutoscalingV1alpha1.ReasonMetricsFound,
+				PreviewDecision:  preview,
+			})
+			defer common.DecisionCache.Delete(resourceName, "default")
+
+			controllerReconciler := &VariantAutoscalingReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Verifying the preview annotation is populated and desired replicas are unchanged")
+			fetched := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, fetched)).To(Succeed())
+			Expect(fetched.Annotations).To(HaveKeyWithValue(llmdVariantAutoscalingV1alpha1.PreviewDecisionAnnotation, preview))
+			Expect(fetched.Status.DesiredOptimizedAlloc.NumReplicas).To(Equal(2))
+
+			By("Leaving preview mode removes the preview decision")
+			common.DecisionCache.Delete(resourceName, "default")
+			delete(fetched.Annotations, llmdVariantAutoscalingV1alpha1.PreviewAnnotation)
+			Expect(k8sClient.Update(ctx, fetched)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, fetched)).To(Succeed())
+			Expect(fetched.Annotations).NotTo(HaveKey(llmdVariantAutoscalingV1alpha1.PreviewDecisionAnnotation))
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+		})
+	})
+
+	Context("Scale Reason Annotation", func() {
+		const resourceName = "scale-reason-test"
+
+		BeforeEach(func() {
+			logging.NewTestLogger()
+		})
+
+		It("should annotate the deployment with the latest decision reason", func() {
+			By("Creating a VariantAutoscaling and its target deployment")
+			resource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: resourceName,
+					},
+					ModelID: "default-default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			deployment := resources.CreateLlmdSimDeployment("default", resourceName, "default-default", "default", "8000", 0, 0, 1)
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+			defer common.DecisionCache.Delete(resourceName, "default")
+
+			controllerReconciler := &VariantAutoscalingReconciler{
+				Client:              k8sClient,
+				Scheme:              k8sClient.Scheme(),
+				AnnotateScaleReason: true,
+			}
+			reconcileWithDecision := func(targetReplicas int, reasonCode interfaces.ReasonCode, reason string) string {
+				common.DecisionCache.Set(resourceName, "default", interfaces.VariantDecision{
+					VariantName:      resourceName,
+					Namespace:        "default",
+					AcceleratorName:  "A100",
+					TargetReplicas:   targetReplicas,
+					Reason:           reason,
+					ReasonCode:       reasonCode,
+					MetricsAvailable: true,
+					MetricsReason:    llmdVariantAutoscalingV1alpha1.ReasonMetricsFound,
+				})
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				fetched := &appsv1.Deployment{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, fetched)).To(Succeed())
+				return fetched.Annotations[llmdVariantAutoscalingV1alpha1.LastScaleReasonAnnotation]
+			}
+
+			By("Applying a scale-up decision")
+			Expect(reconcileWithDecision(3, interfaces.ReasonCodeKvSpareLow, "KV spare capacity low")).
+				To(Equal("KV spare capacity low"))
+
+			By("Applying a later decision replaces the reason")
+			Expect(reconcileWithDecision(2, interfaces.ReasonCodeScaleDownSafe, "")).
+				To(Equal(string(interfaces.ReasonCodeScaleDownSafe)))
+
+			By("Leaving the deployment untouched when the option is disabled")
+			controllerReconciler.AnnotateScaleReason = false
+			Expect(reconcileWithDecision(4, interfaces.ReasonCodeKvSpareLow, "KV spare capacity low")).
+				To(Equal(string(interfaces.ReasonCodeScaleDownSafe)))
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+		})
+	})
+
+	Context("Periodic Reconcile", func() {
+		const resourceName = "reconcile-period-test"
+
+		BeforeEach(func() {
+			logging.NewTestLogger()
+		})
+
+		It("should read the period from the environment", func() {
+			DeferCleanup(os.Unsetenv, reconcilePeriodEnvVar)
+
+			By("Using the default when unset")
+			Expect(os.Unsetenv(reconcilePeriodEnvVar)).To(Succeed())
+			Expect(ReconcilePeriodFromEnv()).To(Equal(defaultReconcilePeriod))
+
+			By("Using the configured period")
+			Expect(os.Setenv(reconcilePeriodEnvVar, "30s")).To(Succeed())
+			Expect(ReconcilePeriodFromEnv()).To(Equal(30 * time.Second))
+
+			By("Allowing zero to disable the periodic reconcile")
+			Expect(os.Setenv(reconcilePeriodEnvVar, "0")).To(Succeed())
+			Expect(ReconcilePeriodFromEnv()).To(BeZero())
+
+			By("Rejecting invalid and negative periods")
+			for _, value := range []string{"soon", "-5s"} {
+				Expect(os.Setenv(reconcilePeriodEnvVar, value)).To(Succeed())
+				_, err := ReconcilePeriodFromEnv()
+				Expect(err).To(HaveOccurred(), value)
+			}
+		})
+
+		It("should requeue a reconciled VA after the configured period", func() {
+			By("Creating a VariantAutoscaling and its target deployment")
+			resource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: resourceName,
+					},
+					ModelID: "default-default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			deployment := resources.CreateLlmdSimDeployment("default", resourceName, "default-default", "default", "8000", 0, 0, 1)
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+
+			controllerReconciler := &VariantAutoscalingReconciler{
+				Client:          k8sClient,
+				Scheme:          k8sClient.Scheme(),
+				ReconcilePeriod: 45 * time.Second,
+			}
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"}}
+			result, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(45 * time.Second))
+
+			By("Not requeueing when the periodic reconcile is disabled")
+			controllerReconciler.ReconcilePeriod = 0
+			result, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			By("Not requeueing a deleted VA")
+			controllerReconciler.ReconcilePeriod = 45 * time.Second
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			result, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, deployment)).To(Succeed())
+		})
+	})
+
+	Context("Pending Decision Requeue", func() {
+		const resourceName = "pending-decision-test"
+
+		BeforeEach(func() {
+			logging.NewTestLogger()
+		})
+
+		It("should requeue a new VA promptly until the engine's first decision populates its status", func() {
+			By("Creating a VariantAutoscaling and its target deployment")
+			resource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: resourceName,
+					},
+					ModelID: "default-default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			deployment := resources.CreateLlmdSimDeployment("default", resourceName, "default-default", "default", "8000", 0, 0, 1)
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+			DeferCleanup(func() {
+				common.DecisionCache.Delete(resourceName, "default")
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, resource))).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, deployment))).To(Succeed())
+			})
+
+			controllerReconciler := &VariantAutoscalingReconciler{
+				Client:                 k8sClient,
+				Scheme:                 k8sClient.Scheme(),
+				ReconcilePeriod:        60 * time.Second,
+				PendingDecisionRequeue: 5 * time.Second,
+				PendingDecisionMaxAge:  time.Hour,
+			}
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: resourceName, Namespace: "default"}}
+
+			By("Requeueing after the short delay while the engine has not decided yet")
+			result, err := controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(5 * time.Second))
+
+			updated := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.DesiredOptimizedAlloc.Accelerator).To(BeEmpty())
+
+			By("Populating the status on the requeued reconcile after the engine's first cycle")
+			common.DecisionCache.Set(resourceName, "default", interfaces.VariantDecision{
+				VariantName:     resourceName,
+				Namespace:       "default",
+				AcceleratorName: "A100",
+				TargetReplicas:  2,
+				ReasonCode:      interfaces.ReasonCodeKvSpareLow,
+			})
+			result, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(60 * time.Second))
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.DesiredOptimizedAlloc.NumReplicas).To(Equal(2))
+			Expect(updated.Status.DesiredOptimizedAlloc.Accelerator).To(Equal("A100"))
+
+			By("Falling back to the periodic reconcile once the VA is older than the max age")
+			common.DecisionCache.Delete(resourceName, "default")
+			controllerReconciler.PendingDecisionMaxAge = time.Nanosecond
+			result, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(60 * time.Second))
+
+			By("Falling back to the periodic reconcile when the pending requeue is disabled")
+			controllerReconciler.PendingDecisionMaxAge = time.Hour
+			controllerReconciler.PendingDecisionRequeue = 0
+			result, err = controllerReconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(60 * time.Second))
+		})
+	})
+
+	Context("Status Conflict Retry", func() {
+		const resourceName = "status-conflict-test"
+
+		BeforeEach(func() {
+			logging.NewTestLogger()
+		})
+
+		It("should retry a conflicting status patch without losing concurrent updates", func() {
+			By("Creating VariantAutoscaling")
+			resource := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: resourceName,
+					},
+					ModelID: "default-default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+
+			controllerReconciler := &VariantAutoscalingReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reading the VA as a reconcile would")
+			va := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, va)).To(Succeed())
+			originalVA := va.DeepCopy()
+
+			By("Updating the status concurrently so the reconcile's copy is stale")
+			concurrent := va.DeepCopy()
+			llmdVariantAutoscalingV1alpha1.SetCondition(concurrent,
+				llmdVariantAutoscalingV1alpha1.TypeMetricsAvailable,
+				metav1.ConditionTrue,
+				llmdVariantAutoscalingV1alpha1.ReasonMetricsFound,
+				"updated concurrently")
+			Expect(k8sClient.Status().Update(ctx, concurrent)).To(Succeed())
+
+			By("Patching the status computed from the stale copy")
+			llmdVariantAutoscalingV1alpha1.SetCondition(va,
+				llmdVariantAutoscalingV1alpha1.TypeTargetResolved,
+				metav1.ConditionFalse,
+				llmdVariantAutoscalingV1alpha1.ReasonTargetNotFound,
+				"computed by reconcile")
+			Expect(controllerReconciler.patchStatus(ctx, va, originalVA)).To(Succeed())
+
+			By("Verifying both updates are present")
+			fetched := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName, Namespace: "default"}, fetched)).To(Succeed())
+
+			target := llmdVariantAutoscalingV1alpha1.GetCondition(fetched, llmdVariantAutoscalingV1alpha1.TypeTargetResolved)
+			Expect(target).NotTo(BeNil())
+			Expect(target.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonTargetNotFound))
+
+			metricsCondition := llmdVariantAutoscalingV1alpha1.GetCondition(fetched, llmdVariantAutoscalingV1alpha1.TypeMetricsAvailable)
+			Expect(metricsCondition).NotTo(BeNil())
+			Expect(metricsCondition.Message).To(Equal("updated concurrently"))
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+	})
+
+	Context("Actuated Condition", func() {
+		newVA := func(desired int) *llmdVariantAutoscalingV1alpha1.VariantAutoscaling {
+			return &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "actuated-test", Namespace: "default"},
+				Status: llmdVariantAutoscalingV1alpha1.VariantAutoscalingStatus{
+					DesiredOptimizedAlloc: llmdVariantAutoscalingV1alpha1.OptimizedAlloc{
+						Accelerator: "A100",
+						NumReplicas: desired,
+					},
+				},
+			}
+		}
+		newDeployment := func(replicas int32) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "actuated-test", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+			}
+		}
+
+		It("should not set the condition before a desired allocation exists", func() {
+			va := newVA(3)
+			va.Status.DesiredOptimizedAlloc.Accelerator = ""
+			setActuatedCondition(va, newDeployment(3))
+			Expect(llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeActuated)).To(BeNil())
+		})
+
+		It("should flip between True and False as the deployment drifts from desired", func() {
+			va := newVA(4)
+
+			By("Reporting drift while the deployment has not been scaled yet")
+			setActuatedCondition(va, newDeployment(2))
+			condition := llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeActuated)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonReplicaDrift))
+
+			By("Reporting actuated once the deployment reaches desired replicas")
+			setActuatedCondition(va, newDeployment(4))
+			condition = llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeActuated)
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonDesiredReplicasReached))
+
+			By("Reporting drift again when the deployment is scaled away from desired")
+			setActuatedCondition(va, newDeployment(1))
+			condition = llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeActuated)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("should treat replicas within tolerance as actuated", func() {
+			va := newVA(20)
+			setActuatedCondition(va, newDeployment(18))
+			Expect(llmdVariantAutoscalingV1alpha1.IsConditionTrue(va, llmdVariantAutoscalingV1alpha1.TypeActuated)).To(BeTrue())
+
+			setActuatedCondition(va, newDeployment(17))
+			Expect(llmdVariantAutoscalingV1alpha1.IsConditionFalse(va, llmdVariantAutoscalingV1alpha1.TypeActuated)).To(BeTrue())
+		})
+	})
+
+	Context("MetricLabelDrift Condition", func() {
+		newVA := func() *llmdVariantAutoscalingV1alpha1.VariantAutoscaling {
+			return &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "label-drift-test", Namespace: "default"},
+			}
+		}
+
+		It("should not set the condition before the model's metrics are collected", func() {
+			va := newVA()
+			setMetricLabelDriftCondition(va, interfaces.VariantDecision{})
+			Expect(llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeMetricLabelDrift)).To(BeNil())
+		})
+
+		It("should report series missing the pod label and clear once it is back", func() {
+			va := newVA()
+
+			By("Reporting the queries whose series lack the expected label")
+			setMetricLabelDriftCondition(va, interfaces.VariantDecision{
+				LabelDriftChecked: true,
+				MissingPodLabels:  []string{"kv_cache_usage", "queue_length"},
+			})
+			condition := llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeMetricLabelDrift)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonPodLabelMissing))
+			Expect(condition.Message).To(ContainSubstring("kv_cache_usage, queue_length"))
+
+			By("Clearing the condition when every series carries the label")
+			setMetricLabelDriftCondition(va, interfaces.VariantDecision{LabelDriftChecked: true})
+			condition = llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeMetricLabelDrift)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonExpectedLabelsPresent))
+		})
+	})
+
+	Context("MaintenanceHold Condition", func() {
+		It("should be set during a maintenance window and cleared after it", func() {
+			va := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "maintenance-test", Namespace: "default"},
+			}
+
+			By("Not adding the condition when no window was ever active")
+			setMaintenanceHoldCondition(va, interfaces.VariantDecision{})
+			Expect(llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeMaintenanceHold)).To(BeNil())
+
+			By("Reporting the active window")
+			setMaintenanceHoldCondition(va, interfaces.VariantDecision{MaintenanceWindow: "network-upgrade"})
+			condition := llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeMaintenanceHold)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonMaintenanceWindowActive))
+			Expect(condition.Message).To(ContainSubstring("network-upgrade"))
+
+			By("Clearing the condition once the window has closed")
+			setMaintenanceHoldCondition(va, interfaces.VariantDecision{})
+			condition = llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeMaintenanceHold)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonNoMaintenanceWindow))
+		})
+	})
+
+	Context("Flapping Condition", func() {
+		It("should be set once an oscillating variant reaches the threshold", func() {
+			va := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "flapping-test", Namespace: "default"},
+			}
+
+			By("Not adding the condition when flap detection is disabled")
+			setFlappingCondition(va, interfaces.VariantDecision{DirectionChanges: 5, Flapping: true})
+			Expect(llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeFlapping)).To(BeNil())
+
+			By("Feeding an oscillating series of desired replicas")
+			fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+			detector := saturation.NewFlapDetector(fakeClock)
+			const threshold = 3
+			var decision interfaces.VariantDecision
+			for _, desired := range []int{2, 3, 2, 3} {
+				changes := detector.Observe("default/flapping-test", desired, 15*time.Minute)
+				decision = interfaces.VariantDecision{
+					FlapWindow:       15 * time.Minute,
+					FlapThreshold:    threshold,
+					DirectionChanges: changes,
+					Flapping:         saturation.Flapping(changes, threshold),
+				}
+				setFlappingCondition(va, decision)
+				fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+			}
+			condition := llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeFlapping)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse), "two reversals are below the threshold")
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonStableScalingDirection))
+
+			By("Reporting flapping after the threshold number of flips")
+			changes := detector.Observe("default/flapping-test", 2, 15*time.Minute)
+			setFlappingCondition(va, interfaces.VariantDecision{
+				FlapWindow:       15 * time.Minute,
+				FlapThreshold:    threshold,
+				DirectionChanges: changes,
+				Flapping:         saturation.Flapping(changes, threshold),
+			})
+			condition = llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeFlapping)
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonFrequentDirectionChanges))
+			Expect(condition.Message).To(ContainSubstring("hysteresis"))
+		})
+	})
+
+	Context("DeploymentPaused Condition", func() {
+		It("should be set while the deployment rollout is paused and cleared once resumed", func() {
+			va := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "paused-test", Namespace: "default"},
+			}
+
+			By("Not adding the condition when the rollout was never paused")
+			setDeploymentPausedCondition(va, interfaces.VariantDecision{})
+			Expect(llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeDeploymentPaused)).To(BeNil())
+
+			By("Reporting the paused rollout")
+			setDeploymentPausedCondition(va, interfaces.VariantDecision{DeploymentPaused: true})
+			condition := llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeDeploymentPaused)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonRolloutPaused))
+
+			By("Clearing the condition once the rollout is resumed")
+			setDeploymentPausedCondition(va, interfaces.VariantDecision{})
+			condition = llmdVariantAutoscalingV1alpha1.GetCondition(va, llmdVariantAutoscalingV1alpha1.TypeDeploymentPaused)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(llmdVariantAutoscalingV1alpha1.ReasonRolloutResumed))
+		})
+	})
+
+	Context("Decision Trigger Batching", func() {
+		decisionFor := func(name string) event.GenericEvent {
+			return event.GenericEvent{Object: &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			}}
+		}
+
+		It("should coalesce rapid successive decisions for a VA into one reconcile", func() {
+			queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+			h := decisionTriggerHandler(200 * time.Millisecond)
+
+			By("Sending several decisions for the same VA within the window")
+			for range 5 {
+				h.Generic(ctx, decisionFor("batched-va"), queue)
+			}
+			h.Generic(ctx, decisionFor("other-va"), queue)
+			Expect(queue.Len()).To(Equal(0), "decisions should wait for the batch window")
+
+			By("Verifying a single request per VA once the window elapses")
+			Eventually(queue.Len).WithTimeout(2 * time.Second).Should(Equal(2))
+			Consistently(queue.Len).WithTimeout(300 * time.Millisecond).Should(Equal(2))
+
+			first, _ := queue.Get()
+			second, _ := queue.Get()
+			Expect([]string{first.Name, second.Name}).To(ConsistOf("batched-va", "other-va"))
+		})
+
+		It("should enqueue immediately when batching is disabled", func() {
+			queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+			h := decisionTriggerHandler(0)
+
+			h.Generic(ctx, decisionFor("unbatched-va"), queue)
+			Expect(queue.Len()).To(Equal(1))
+		})
+	})
+
+	Context("Deployment Event Debouncing", func() {
+		// mapToVA maps a Deployment to the VA of the same name, as handleDeploymentEvent
+		// does for VAs whose scale target is the Deployment
+		mapToVA := func(_ context.Context, obj client.Object) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+		}
+		deploymentNamed := func(name string) *appsv1.Deployment {
+			return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+		}
+
+		It("should coalesce rapid deployment creates for a VA into one reconcile", func() {
+			queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+			h := deploymentEventHandler(mapToVA, 200*time.Millisecond)
+
+			By("Sending several creates of the same deployment within the window")
+			for range 5 {
+				h.Create(ctx, event.CreateEvent{Object: deploymentNamed("churny-va")}, queue)
+			}
+			h.Create(ctx, event.CreateEvent{Object: deploymentNamed("other-va")}, queue)
+			Expect(queue.Len()).To(Equal(0), "creates should wait for the debounce window")
+
+			By("Verifying a single request per VA once the window elapses")
+			Eventually(queue.Len).WithTimeout(2 * time.Second).Should(Equal(2))
+			Consistently(queue.Len).WithTimeout(300 * time.Millisecond).Should(Equal(2))
+
+			first, _ := queue.Get()
+			second, _ := queue.Get()
+			Expect([]string{first.Name, second.Name}).To(ConsistOf("churny-va", "other-va"))
+		})
+
+		It("should enqueue deletes immediately", func() {
+			queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+			h := deploymentEventHandler(mapToVA, time.Minute)
+
+			h.Delete(ctx, event.DeleteEvent{Object: deploymentNamed("deleted-va")}, queue)
+			Expect(queue.Len()).To(Equal(1))
+		})
+
+		It("should enqueue creates immediately when debouncing is disabled", func() {
+			queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+			h := deploymentEventHandler(mapToVA, 0)
+
+			h.Create(ctx, event.CreateEvent{Object: deploymentNamed("undebounced-va")}, queue)
+			Expect(queue.Len()).To(Equal(1))
+		})
+	})
+
+	Context("ConfigMap Update Debouncing", func() {
+		It("should apply rapid successive updates of a ConfigMap once with the latest data", func() {
+			fakeClock := clocktesting.NewFakeClock(time.Now())
+			d := newConfigUpdateDebouncer(fakeClock, time.Second)
+
+			var applied []int
+			var other int
+			By("Sending several updates of the same ConfigMap within the window")
+			for i := range 5 {
+				d.Apply("saturation-scaling-config", func() { applied = append(applied, i) })
+			}
+			d.Apply("accelerator-aliases", func() { other++ })
+			Expect(applied).To(BeEmpty(), "updates should wait for the debounce window")
+			Expect(other).To(Equal(0))
+
+			By("Verifying a single application per ConfigMap once the window elapses")
+			fakeClock.Step(time.Second)
+			Expect(applied).To(Equal([]int{4}))
+			Expect(other).To(Equal(1))
+
+			By("Verifying a later update starts a new window")
+			d.Apply("saturation-scaling-config", func() { applied = append(applied, 5) })
+			fakeClock.Step(time.Second)
+			Expect(applied).To(Equal([]int{4, 5}))
+		})
+
+		It("should apply immediately when debouncing is disabled", func() {
+			d := newConfigUpdateDebouncer(clocktesting.NewFakeClock(time.Now()), 0)
+
+			count := 0
+			d.Apply("saturation-scaling-config", func() { count++ })
+			d.Apply("saturation-scaling-config", func() { count++ })
+			Expect(count).To(Equal(2))
+		})
 	})
 
 })
@@ -0,0 +1,179 @@
+// Package defaulting implements the optional mutating webhook that fills in the fields a
+// VariantAutoscaling omits on creation from cluster-wide defaults, so that variants sharing the
+// same cost, floor, accelerator and scale-to-zero settings need not repeat them. It only sets
+// fields; VariantAutoscalings are validated separately (see the validation package).
+package defaulting
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
+)
+
+// Keys of the autoscaler ConfigMap holding the cluster defaults.
+const (
+	VariantCostKey                = "WVA_DEFAULT_VARIANT_COST"
+	ScaleDownFloorKey             = "WVA_DEFAULT_SCALE_DOWN_FLOOR"
+	AcceleratorKey                = "WVA_DEFAULT_ACCELERATOR"
+	ScaleToZeroEnabledKey         = "WVA_DEFAULT_SCALE_TO_ZERO_ENABLED"
+	ScaleToZeroRetentionPeriodKey = "WVA_DEFAULT_SCALE_TO_ZERO_RETENTION_PERIOD"
+)
+
+// schemaDefaultVariantCost is the variantCost the CRD schema defaults an omitted variantCost to
+// before admission webhooks run, so it is treated as omitted.
+const schemaDefaultVariantCost = "10.0"
+
+// Defaults are the cluster defaults of VariantAutoscaling fields. Zero values leave the field unset.
+type Defaults struct {
+	// VariantCost is the default spec.variantCost
+	VariantCost string
+	// ScaleDownFloor is the default spec.scaleDownFloor, the variant's minimum replicas
+	ScaleDownFloor *int32
+	// Accelerator is the default value of the accelerator name label
+	Accelerator string
+	// ScaleToZeroEnabled is the default spec.scaleToZero.enabled
+	ScaleToZeroEnabled *bool
+	// ScaleToZeroRetentionPeriod is the default spec.scaleToZero.retentionPeriod
+	ScaleToZeroRetentionPeriod string
+}
+
+// ParseDefaults reads the cluster defaults from the data of the autoscaler ConfigMap.
+// Missing keys leave the corresponding field undefaulted.
+func ParseDefaults(data map[string]string) (Defaults, error) {
+	var d Defaults
+	if v := data[VariantCostKey]; v != "" {
+		cost, err := strconv.ParseFloat(v, 64)
+		if err != nil || cost < 0 {
+			return Defaults{}, fmt.Errorf("%s must be a non-negative number, got %q", VariantCostKey, v)
+		}
+		d.VariantCost = v
+	}
+	if v := data[ScaleDownFloorKey]; v != "" {
+		floor, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || floor < 1 {
+			return Defaults{}, fmt.Errorf("%s must be an integer >= 1, got %q", ScaleDownFloorKey, v)
+		}
+		d.ScaleDownFloor = ptr.To(int32(floor))
+	}
+	d.Accelerator = data[AcceleratorKey]
+	if v := data[ScaleToZeroEnabledKey]; v != "" {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return Defaults{}, fmt.Errorf("%s must be a boolean, got %q", ScaleToZeroEnabledKey, v)
+		}
+		d.ScaleToZeroEnabled = &enabled
+	}
+	if v := data[ScaleToZeroRetentionPeriodKey]; v != "" {
+		if _, err := time.ParseDuration(v); err != nil {
+			return Defaults{}, fmt.Errorf("%s must be a duration, got %q: %w", ScaleToZeroRetentionPeriodKey, v, err)
+		}
+		d.ScaleToZeroRetentionPeriod = v
+	}
+	return d, nil
+}
+
+// Apply sets the fields va omits to the defaults and returns the paths of the fields it set.
+// A variantCost equal to the CRD's schema default counts as omitted unless spec.cost is set.
+func Apply(va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, d Defaults) []string {
+	var defaulted []string
+	if d.VariantCost != "" && va.Spec.Cost == nil &&
+		(va.Spec.VariantCost == "" || va.Spec.VariantCost == schemaDefaultVariantCost) &&
+		va.Spec.VariantCost != d.VariantCost {
+		va.Spec.VariantCost = d.VariantCost
+		defaulted = append(defaulted, "spec.variantCost")
+	}
+	if d.ScaleDownFloor != nil && va.Spec.ScaleDownFloor == nil {
+		va.Spec.ScaleDownFloor = ptr.To(*d.ScaleDownFloor)
+		defaulted = append(defaulted, "spec.scaleDownFloor")
+	}
+	if key := utils.AcceleratorLabelKey(); d.Accelerator != "" && va.Labels[key] == "" {
+		if va.Labels == nil {
+			va.Labels = make(map[string]string)
+		}
+		va.Labels[key] = d.Accelerator
+		defaulted = append(defaulted, "metadata.labels["+key+"]")
+	}
+	if d.ScaleToZeroEnabled != nil && (va.Spec.ScaleToZero == nil || va.Spec.ScaleToZero.Enabled == nil) {
+		if va.Spec.ScaleToZero == nil {
+			va.Spec.ScaleToZero = &llmdVariantAutoscalingV1alpha1.ScaleToZeroSpec{}
+		}
+		va.Spec.ScaleToZero.Enabled = ptr.To(*d.ScaleToZeroEnabled)
+		defaulted = append(defaulted, "spec.scaleToZero.enabled")
+	}
+	if d.ScaleToZeroRetentionPeriod != "" && (va.Spec.ScaleToZero == nil || va.Spec.ScaleToZero.RetentionPeriod == "") {
+		if va.Spec.ScaleToZero == nil {
+			va.Spec.ScaleToZero = &llmdVariantAutoscalingV1alpha1.ScaleToZeroSpec{}
+		}
+		va.Spec.ScaleToZero.RetentionPeriod = d.ScaleToZeroRetentionPeriod
+		defaulted = append(defaulted, "spec.scaleToZero.retentionPeriod")
+	}
+	return defaulted
+}
+
+// +kubebuilder:webhook:path=/mutate-llmd-ai-v1alpha1-variantautoscaling,mutating=true,failurePolicy=ignore,sideEffects=None,groups=llmd.ai,resources=variantautoscalings,verbs=create,versions=v1alpha1,name=mvariantautoscaling.llmd.ai,admissionReviewVersions=v1
+
+// VariantAutoscalingDefaulter defaults the fields of VariantAutoscalings on creation from the
+// cluster defaults in the autoscaler ConfigMap, read on every request so that changes apply
+// without a restart.
+type VariantAutoscalingDefaulter struct {
+	// Reader reads the ConfigMap. An uncached reader avoids watching ConfigMaps for a rare request.
+	Reader        client.Reader
+	ConfigMapName string
+	Namespace     string
+}
+
+var _ admission.CustomDefaulter = &VariantAutoscalingDefaulter{}
+
+// SetupWithManager registers the defaulting webhook with the manager's webhook server.
+func (d *VariantAutoscalingDefaulter) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}).
+		WithDefaulter(d).
+		Complete()
+}
+
+// Default sets the fields a VariantAutoscaling being created omits to the cluster defaults.
+// Updates are left untouched. When the defaults cannot be read the VariantAutoscaling is admitted
+// as is, since every field has a built-in fallback.
+func (d *VariantAutoscalingDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	va, ok := obj.(*llmdVariantAutoscalingV1alpha1.VariantAutoscaling)
+	if !ok {
+		return fmt.Errorf("expected a VariantAutoscaling, got %T", obj)
+	}
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation != admissionv1.Create {
+		return nil
+	}
+	logger := ctrl.LoggerFrom(ctx).WithValues("variantAutoscaling", client.ObjectKeyFromObject(va))
+
+	cm := &corev1.ConfigMap{}
+	if err := d.Reader.Get(ctx, client.ObjectKey{Name: d.ConfigMapName, Namespace: d.Namespace}, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to read VariantAutoscaling defaults, admitting without defaults",
+				"configMap", d.ConfigMapName)
+		}
+		return nil
+	}
+	defaults, err := ParseDefaults(cm.Data)
+	if err != nil {
+		logger.Error(err, "Invalid VariantAutoscaling defaults, admitting without defaults",
+			"configMap", d.ConfigMapName)
+		return nil
+	}
+	if defaulted := Apply(va, defaults); len(defaulted) > 0 {
+		logger.Info("Defaulted VariantAutoscaling fields", "fields", defaulted)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package defaulting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
+)
+
+const (
+	testNamespace     = "wva-system"
+	testConfigMapName = "variantautoscaling-config"
+)
+
+var testDefaults = map[string]string{
+	VariantCostKey:                "25.5",
+	ScaleDownFloorKey:             "2",
+	AcceleratorKey:                "H100",
+	ScaleToZeroEnabledKey:         "true",
+	ScaleToZeroRetentionPeriodKey: "15m",
+}
+
+func newVA() *llmdVariantAutoscalingV1alpha1.VariantAutoscaling {
+	return &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-h100", Namespace: "llm"},
+		Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-h100"},
+			ModelID:        "llama",
+		},
+	}
+}
+
+func newDefaulter(data map[string]string) *VariantAutoscalingDefaulter {
+	builder := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme)
+	if data != nil {
+		builder = builder.WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: testConfigMapName, Namespace: testNamespace},
+			Data:       data,
+		})
+	}
+	return &VariantAutoscalingDefaulter{Reader: builder.Build(), ConfigMapName: testConfigMapName, Namespace: testNamespace}
+}
+
+func requestContext(op admissionv1.Operation) context.Context {
+	return admission.NewContextWithRequest(context.Background(),
+		admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: op}})
+}
+
+func TestDefault_SetsOmittedFieldsOnCreate(t *testing.T) {
+	va := newVA()
+	require.NoError(t, newDefaulter(testDefaults).Default(requestContext(admissionv1.Create), va))
+
+	assert.Equal(t, "25.5", va.Spec.VariantCost)
+	assert.Equal(t, ptr.To(int32(2)), va.Spec.ScaleDownFloor)
+	assert.Equal(t, "H100", va.Labels[utils.AcceleratorLabelKey()])
+	require.NotNil(t, va.Spec.ScaleToZero)
+	assert.Equal(t, ptr.To(true), va.Spec.ScaleToZero.Enabled)
+	assert.Equal(t, "15m", va.Spec.ScaleToZero.RetentionPeriod)
+}
+
+func TestDefault_KeepsSetFields(t *testing.T) {
+	va := newVA()
+	va.Labels = map[string]string{utils.AcceleratorLabelKey(): "A100"}
+	va.Spec.Cost = &llmdVariantAutoscalingV1alpha1.VariantCostSpec{PerReplica: resource.MustParse("40")}
+	va.Spec.ScaleDownFloor = ptr.To(int32(1))
+	va.Spec.ScaleToZero = &llmdVariantAutoscalingV1alpha1.ScaleToZeroSpec{Enabled: ptr.To(false)}
+
+	require.NoError(t, newDefaulter(testDefaults).Default(requestContext(admissionv1.Create), va))
+
+	assert.Empty(t, va.Spec.VariantCost, "variantCost is not defaulted when cost is set")
+	assert.Equal(t, ptr.To(int32(1)), va.Spec.ScaleDownFloor)
+	assert.Equal(t, "A100", va.Labels[utils.AcceleratorLabelKey()])
+	assert.Equal(t, ptr.To(false), va.Spec.ScaleToZero.Enabled)
+	assert.Equal(t, "15m", va.Spec.ScaleToZero.RetentionPeriod, "unset scale-to-zero fields are still defaulted")
+}
+
+func TestDefault_TreatsSchemaDefaultCostAsOmitted(t *testing.T) {
+	va := newVA()
+	va.Spec.VariantCost = schemaDefaultVariantCost
+	require.NoError(t, newDefaulter(testDefaults).Default(requestContext(admissionv1.Create), va))
+	assert.Equal(t, "25.5", va.Spec.VariantCost)
+
+	va = newVA()
+	va.Spec.VariantCost = "12"
+	require.NoError(t, newDefaulter(testDefaults).Default(requestContext(admissionv1.Create), va))
+	assert.Equal(t, "12", va.Spec.VariantCost)
+}
+
+func TestDefault_LeavesUpdatesUntouched(t *testing.T) {
+	va := newVA()
+	require.NoError(t, newDefaulter(testDefaults).Default(requestContext(admissionv1.Update), va))
+	assert.Equal(t, newVA(), va)
+}
+
+func TestDefault_AdmitsWithoutDefaults(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]string
+	}{
+		{name: "missing ConfigMap", data: nil},
+		{name: "no default keys", data: map[string]string{"GLOBAL_OPT_INTERVAL": "60s"}},
+		{name: "invalid defaults", data: map[string]string{VariantCostKey: "cheap", ScaleDownFloorKey: "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			va := newVA()
+			require.NoError(t, newDefaulter(tt.data).Default(requestContext(admissionv1.Create), va))
+			assert.Equal(t, newVA(), va)
+		})
+	}
+}
+
+func TestParseDefaults(t *testing.T) {
+	d, err := ParseDefaults(testDefaults)
+	require.NoError(t, err)
+	assert.Equal(t, Defaults{
+		VariantCost:                "25.5",
+		ScaleDownFloor:             ptr.To(int32(2)),
+		Accelerator:                "H100",
+		ScaleToZeroEnabled:         ptr.To(true),
+		ScaleToZeroRetentionPeriod: "15m",
+	}, d)
+
+	for key, value := range map[string]string{
+		VariantCostKey:                "-1",
+		ScaleDownFloorKey:             "0",
+		ScaleToZeroEnabledKey:         "sometimes",
+		ScaleToZeroRetentionPeriodKey: "15 minutes",
+	} {
+		_, err := ParseDefaults(map[string]string{key: value})
+		assert.Error(t, err, "%s=%q", key, value)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
 	interfaces "github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 )
@@ -37,6 +38,13 @@ func (c *InternalDecisionCache) Get(name, namespace string) (interfaces.VariantD
 	return val, ok
 }
 
+// Delete removes the decision for a VA, e.g. once the VA has been deleted.
+func (c *InternalDecisionCache) Delete(name, namespace string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.items, cacheKey(name, namespace))
+}
+
 // Global cache instance
 var DecisionCache = &InternalDecisionCache{
 	items: make(map[string]interfaces.VariantDecision),
@@ -59,6 +67,9 @@ type GlobalConfig struct {
 	OptimizationInterval string
 	SaturationConfig     map[string]interfaces.SaturationScalingConfig
 	ScaleToZeroConfig    config.ScaleToZeroConfigData
+	AcceleratorAliases   utils.AcceleratorAliases
+	ServiceClasses       []interfaces.ServiceClass
+	MaintenanceWindows   []interfaces.MaintenanceWindow
 }
 
 // UpdateOptimizationConfig updates the optimization interval.
@@ -109,6 +120,48 @@ func (c *GlobalConfig) GetScaleToZeroConfig() config.ScaleToZeroConfigData {
 	return c.ScaleToZeroConfig
 }
 
+// UpdateAcceleratorAliases updates the accelerator name aliases.
+func (c *GlobalConfig) UpdateAcceleratorAliases(aliases utils.AcceleratorAliases) {
+	c.Lock()
+	defer c.Unlock()
+	c.AcceleratorAliases = aliases
+}
+
+// GetAcceleratorAliases returns the current accelerator name aliases.
+func (c *GlobalConfig) GetAcceleratorAliases() utils.AcceleratorAliases {
+	c.RLock()
+	defer c.RUnlock()
+	return c.AcceleratorAliases
+}
+
+// UpdateServiceClasses updates the service classes.
+func (c *GlobalConfig) UpdateServiceClasses(classes []interfaces.ServiceClass) {
+	c.Lock()
+	defer c.Unlock()
+	c.ServiceClasses = classes
+}
+
+// GetServiceClasses returns the current service classes.
+func (c *GlobalConfig) GetServiceClasses() []interfaces.ServiceClass {
+	c.RLock()
+	defer c.RUnlock()
+	return c.ServiceClasses
+}
+
+// UpdateMaintenanceWindows updates the maintenance windows.
+func (c *GlobalConfig) UpdateMaintenanceWindows(windows []interfaces.MaintenanceWindow) {
+	c.Lock()
+	defer c.Unlock()
+	c.MaintenanceWindows = windows
+}
+
+// GetMaintenanceWindows returns the current maintenance windows.
+func (c *GlobalConfig) GetMaintenanceWindows() []interfaces.MaintenanceWindow {
+	c.RLock()
+	defer c.RUnlock()
+	return c.MaintenanceWindows
+}
+
 // TransformationConfig is the global singleton for configuration.
 // (Using name TransformationConfig as a placeholder/legacy name if suitable, or just Config)
 var Config = &GlobalConfig{}
@@ -0,0 +1,61 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	wvav1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// ModelScaleToZeroConfig applies spec.scaleToZero from the model's VAs to the scale-to-zero config.
+// Scale-to-zero acts on the whole model, so when variants disagree a variant disabling it wins
+// and the longest retention period wins, keeping replicas around. Invalid periods are logged and ignored.
+// Both the saturation and the scale-from-zero engine resolve scale-to-zero through it, so they
+// always agree on whether a model may sit at zero replicas.
+func ModelScaleToZeroConfig(
+	ctx context.Context,
+	base config.ScaleToZeroConfigData,
+	modelID string,
+	modelVAs []wvav1alpha1.VariantAutoscaling,
+) config.ScaleToZeroConfigData {
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	var override config.ModelScaleToZeroConfig
+	var longest time.Duration
+	for i := range modelVAs {
+		va := &modelVAs[i]
+		spec := va.Spec.ScaleToZero
+		if spec == nil {
+			continue
+		}
+		if spec.Enabled != nil && (override.EnableScaleToZero == nil || !*spec.Enabled) {
+			override.EnableScaleToZero = spec.Enabled
+		}
+		if spec.RetentionPeriod == "" {
+			continue
+		}
+		period, err := config.ValidateRetentionPeriod(spec.RetentionPeriod)
+		if err != nil {
+			logger.Info("Ignoring invalid scaleToZero.retentionPeriod",
+				"variant", va.Name,
+				"namespace", va.Namespace,
+				"retentionPeriod", spec.RetentionPeriod,
+				"error", err)
+			continue
+		}
+		if period > longest {
+			longest = period
+			override.RetentionPeriod = spec.RetentionPeriod
+		}
+	}
+
+	if override.EnableScaleToZero != nil || override.RetentionPeriod != "" {
+		logger.V(logging.DEBUG).Info("Scale-to-zero config overridden by VariantAutoscaling spec",
+			"modelID", modelID,
+			"enabled", override.EnableScaleToZero,
+			"retentionPeriod", override.RetentionPeriod)
+	}
+	return config.WithScaleToZeroOverride(base, modelID, override)
+}
@@ -18,17 +18,22 @@ package executor
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/wait"
-	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
 )
 
 // PollingExecutor executes the optimization function at fixed intervals.
 type PollingExecutor struct {
-	config       Config
-	interval     time.Duration // polling interval
-	retryBackoff time.Duration // backoff duration between retries
+	config         Config
+	interval       time.Duration           // polling interval
+	retryBackoff   time.Duration           // backoff duration between retries
+	metricsEmitter *metrics.MetricsEmitter // counts recovered panics, may be nil
 }
 
 // PollingConfig holds polling-specific configuration.
@@ -36,14 +41,17 @@ type PollingConfig struct {
 	Config
 	Interval     time.Duration
 	RetryBackoff time.Duration
+	// MetricsEmitter is the engine's emitter, used to count recovered panics. Nil skips counting.
+	MetricsEmitter *metrics.MetricsEmitter
 }
 
 // NewPollingExecutor creates a new polling executor.
 func NewPollingExecutor(config PollingConfig) *PollingExecutor {
 	return &PollingExecutor{
-		config:       config.Config,
-		interval:     config.Interval,
-		retryBackoff: config.RetryBackoff,
+		config:         config.Config,
+		interval:       config.Interval,
+		retryBackoff:   config.RetryBackoff,
+		metricsEmitter: config.MetricsEmitter,
 	}
 }
 
@@ -54,7 +62,7 @@ func (e *PollingExecutor) Start(ctx context.Context) {
 }
 
 func (e *PollingExecutor) executeWithRetry(ctx context.Context) {
-	logger := log.FromContext(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 	backoff := e.retryBackoff
 	for { // infinite retry loop
 		select {
@@ -64,7 +72,11 @@ func (e *PollingExecutor) executeWithRetry(ctx context.Context) {
 		default:
 		}
 
-		err := e.config.OptimizeFunc(ctx)
+		panicked, err := e.optimize(ctx)
+		if panicked {
+			// Retrying would likely panic again; wait for the next interval instead
+			return
+		}
 		if err == nil {
 			return
 		}
@@ -84,3 +96,26 @@ func (e *PollingExecutor) executeWithRetry(ctx context.Context) {
 		}
 	}
 }
+
+// optimize runs the optimization function, recovering from a panic so that a bug in one cycle
+// does not stop the loop. A panic is logged with its stack, counted in the
+// wva_optimize_panics_total metric when the executor has a metrics emitter, and reported
+// through the first return value.
+func (e *PollingExecutor) optimize(ctx context.Context) (panicked bool, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		panicked, err = true, fmt.Errorf("optimization panicked: %v", r)
+		logger := logging.FromContext(ctx, logging.Engine)
+		logger.Error(err, "Recovered from panic in optimization loop", "stack", string(debug.Stack()))
+		if e.metricsEmitter == nil {
+			return
+		}
+		if emitErr := e.metricsEmitter.EmitOptimizePanic(ctx); emitErr != nil {
+			logger.V(logging.DEBUG).Info("Failed to emit optimize panic metric", "error", emitErr)
+		}
+	}()
+	return false, e.config.OptimizeFunc(ctx)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The llm-d Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+)
+
+// gatherCounter returns the value of the named counter in registry, or 0 if it has no series.
+func gatherCounter(t *testing.T, registry *prometheus.Registry, name string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			return metric.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestPollingExecutor_SurvivesPanic(t *testing.T) {
+	t.Setenv(metrics.ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	// The first cycle panics, later cycles succeed
+	var calls atomic.Int32
+	e := NewPollingExecutor(PollingConfig{
+		Config: Config{OptimizeFunc: func(ctx context.Context) error {
+			if calls.Add(1) == 1 {
+				panic("assignment to entry in nil map")
+			}
+			return nil
+		}},
+		Interval:       10 * time.Millisecond,
+		RetryBackoff:   10 * time.Millisecond,
+		MetricsEmitter: metrics.NewMetricsEmitter(),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.Start(ctx)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for calls.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if got := calls.Load(); got < 3 {
+		t.Fatalf("expected the loop to keep running after the panic, got %d cycles", got)
+	}
+	if got := gatherCounter(t, registry, constants.WVAOptimizePanicsTotal); got != 1 {
+		t.Errorf("expected 1 recovered panic, got %v", got)
+	}
+}
+
+func TestPollingExecutor_PanicIsNotRetried(t *testing.T) {
+	t.Setenv(metrics.ControllerInstanceEnvVar, "")
+	if err := metrics.InitMetrics(prometheus.NewRegistry()); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	calls := 0
+	e := NewPollingExecutor(PollingConfig{
+		Config: Config{OptimizeFunc: func(ctx context.Context) error {
+			calls++
+			panic("unexpected state")
+		}},
+		RetryBackoff: time.Millisecond,
+	})
+
+	e.executeWithRetry(context.Background())
+	if calls != 1 {
+		t.Errorf("expected a panicking cycle to run once, got %d calls", calls)
+	}
+}
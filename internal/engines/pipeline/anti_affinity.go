@@ -0,0 +1,95 @@
+package pipeline
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// gpuVendors are the label/resource prefixes set by the GPU operators, as in discovery
+var gpuVendors = []string{"nvidia.com", "amd.com", "intel.com"}
+
+// HasRequiredSelfAntiAffinity reports whether pods created from template must run on
+// different nodes from each other: a required pod anti-affinity term on the hostname
+// topology whose selector matches the template's own labels. Such a workload can run
+// at most one replica per node.
+func HasRequiredSelfAntiAffinity(template *corev1.PodTemplateSpec) bool {
+	affinity := template.Spec.Affinity
+	if affinity == nil || affinity.PodAntiAffinity == nil {
+		return false
+	}
+	podLabels := labels.Set(template.Labels)
+	for _, term := range affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		if term.TopologyKey != corev1.LabelHostname || term.LabelSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(podLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// SchedulableNodeCount returns how many of nodes can host a replica of a variant on accType
+// needing gpusPerReplica GPUs: Ready, not cordoned, carrying the accelerator and with at least
+// gpusPerReplica allocatable GPUs. ok is false if no node carries the accelerator at all
+// (e.g. nodes without GPU operator labels), so such variants are not flagged.
+func SchedulableNodeCount(nodes []corev1.Node, accType string, gpusPerReplica int) (int, bool) {
+	if gpusPerReplica <= 0 {
+		gpusPerReplica = 1
+	}
+	accType = normalizeAcceleratorName(accType)
+
+	count := 0
+	found := false
+	for i := range nodes {
+		node := &nodes[i]
+		for _, vendor := range gpuVendors {
+			model, ok := node.Labels[vendor+"/gpu.product"]
+			if !ok || normalizeAcceleratorName(model) != accType {
+				continue
+			}
+			found = true
+			allocatable := node.Status.Allocatable[corev1.ResourceName(vendor+"/gpu")]
+			if !node.Spec.Unschedulable && isNodeReady(node) && int(allocatable.Value()) >= gpusPerReplica {
+				count++
+			}
+			break
+		}
+	}
+	return count, found
+}
+
+// MaxNodeGPUs returns the largest allocatable GPU count of the nodes carrying accType, whether
+// or not they are schedulable. A variant needing more GPUs per replica can never be scheduled.
+func MaxNodeGPUs(nodes []corev1.Node, accType string) int {
+	accType = normalizeAcceleratorName(accType)
+
+	maxGPUs := 0
+	for i := range nodes {
+		node := &nodes[i]
+		for _, vendor := range gpuVendors {
+			model, ok := node.Labels[vendor+"/gpu.product"]
+			if !ok || normalizeAcceleratorName(model) != accType {
+				continue
+			}
+			allocatable := node.Status.Allocatable[corev1.ResourceName(vendor+"/gpu")]
+			maxGPUs = max(maxGPUs, int(allocatable.Value()))
+			break
+		}
+	}
+	return maxGPUs
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
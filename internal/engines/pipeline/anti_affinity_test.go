@@ -0,0 +1,107 @@
+package pipeline
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// gpuNode returns a Ready node with the given NVIDIA GPU product and allocatable GPU count.
+func gpuNode(name, product string, gpus int64) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"nvidia.com/gpu.product": product},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{"nvidia.com/gpu": *resource.NewQuantity(gpus, resource.DecimalSI)},
+			Conditions:  []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+// podTemplate returns a template labeled app=llama with the given anti-affinity terms.
+func podTemplate(terms ...corev1.PodAffinityTerm) *corev1.PodTemplateSpec {
+	template := &corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "llama"}}}
+	if len(terms) > 0 {
+		template.Spec.Affinity = &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: terms,
+		}}
+	}
+	return template
+}
+
+var _ = Describe("Anti-affinity awareness", func() {
+	Describe("HasRequiredSelfAntiAffinity", func() {
+		selfTerm := corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "llama"}},
+			TopologyKey:   corev1.LabelHostname,
+		}
+
+		It("should detect one-replica-per-node anti-affinity", func() {
+			Expect(HasRequiredSelfAntiAffinity(podTemplate(selfTerm))).To(BeTrue())
+		})
+
+		It("should ignore templates without anti-affinity", func() {
+			Expect(HasRequiredSelfAntiAffinity(podTemplate())).To(BeFalse())
+		})
+
+		It("should ignore anti-affinity on other topologies", func() {
+			zoneTerm := selfTerm
+			zoneTerm.TopologyKey = corev1.LabelTopologyZone
+			Expect(HasRequiredSelfAntiAffinity(podTemplate(zoneTerm))).To(BeFalse())
+		})
+
+		It("should ignore anti-affinity against other workloads", func() {
+			otherTerm := selfTerm
+			otherTerm.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}}
+			Expect(HasRequiredSelfAntiAffinity(podTemplate(otherTerm))).To(BeFalse())
+		})
+	})
+
+	Describe("SchedulableNodeCount", func() {
+		It("should count Ready, uncordoned nodes with the accelerator and enough GPUs", func() {
+			cordoned := gpuNode("node-3", "NVIDIA-H100-SXM5-80GB", 8)
+			cordoned.Spec.Unschedulable = true
+			notReady := gpuNode("node-4", "NVIDIA-H100-SXM5-80GB", 8)
+			notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+			nodes := []corev1.Node{
+				gpuNode("node-1", "NVIDIA-H100-SXM5-80GB", 8),
+				gpuNode("node-2", "NVIDIA-H100-SXM5-80GB", 8),
+				cordoned,
+				notReady,
+				gpuNode("node-5", "NVIDIA-H100-SXM5-80GB", 1),
+				gpuNode("node-6", "NVIDIA-A100-PCIE-80GB", 8),
+			}
+
+			count, ok := SchedulableNodeCount(nodes, "H100", 2)
+			Expect(ok).To(BeTrue())
+			Expect(count).To(Equal(2))
+
+			count, _ = SchedulableNodeCount(nodes, "H100", 1)
+			Expect(count).To(Equal(3))
+		})
+
+		It("should report accelerators missing from every node", func() {
+			_, ok := SchedulableNodeCount([]corev1.Node{gpuNode("node-1", "NVIDIA-A100-PCIE-80GB", 8)}, "MI300X", 1)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("MaxNodeGPUs", func() {
+		It("should return the largest allocatable GPU count of the nodes with the accelerator", func() {
+			cordoned := gpuNode("node-2", "NVIDIA-H100-SXM5-80GB", 4)
+			cordoned.Spec.Unschedulable = true
+			nodes := []corev1.Node{
+				gpuNode("node-1", "NVIDIA-H100-SXM5-80GB", 2),
+				cordoned,
+				gpuNode("node-3", "NVIDIA-A100-PCIE-80GB", 8),
+			}
+
+			Expect(MaxNodeGPUs(nodes, "H100")).To(Equal(4))
+			Expect(MaxNodeGPUs(nodes, "MI300X")).To(Equal(0))
+		})
+	})
+})
@@ -2,16 +2,23 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 )
 
+// ErrNoInventory is returned by Limit when the inventory holds no accelerators at all, e.g.
+// because no node carries GPU operator labels. Decisions are then left unchanged rather than
+// capped to zero capacity.
+var ErrNoInventory = errors.New("no accelerator inventory found")
+
 // DefaultLimiter combines an Inventory with an AllocationAlgorithm to constrain
 // scaling decisions based on resource availability.
 //
 // The limiter follows the pipeline pattern:
-//  1. Refresh inventory to get latest resource limits from cluster
+//  1. Refresh inventory to get latest resource limits from cluster, stopping with
+//     ErrNoInventory when it is empty
 //  2. Calculate current GPU usage from decisions
 //  3. Create allocator with available resources
 //  4. Run allocation algorithm to distribute resources
@@ -48,6 +55,10 @@ func (l *DefaultLimiter) Limit(ctx context.Context, decisions []*interfaces.Vari
 	if err := l.inventory.Refresh(ctx); err != nil {
 		return fmt.Errorf("failed to refresh inventory: %w", err)
 	}
+	// An empty inventory means capacity is unknown, not zero
+	if l.inventory.TotalLimit() == 0 {
+		return ErrNoInventory
+	}
 
 	// Step 2: Calculate current GPU usage from decisions
 	usedByType := l.calculateUsedGPUs(decisions)
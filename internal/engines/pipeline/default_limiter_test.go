@@ -148,6 +148,23 @@ var _ = Describe("DefaultLimiter", func() {
 			})
 		})
 
+		Context("with an empty inventory", func() {
+			It("should return ErrNoInventory and leave decisions unchanged", func() {
+				inventory = newMockInventory("inv", map[string]int{})
+				algorithm = &mockAlgorithm{name: "algo"}
+				limiter = NewDefaultLimiter("limiter", inventory, algorithm)
+				decisions = []*interfaces.VariantDecision{
+					{VariantName: "v1", AcceleratorName: "A100", CurrentReplicas: 2, TargetReplicas: 4, GPUsPerReplica: 1},
+				}
+
+				err := limiter.Limit(ctx, decisions)
+				Expect(err).To(MatchError(ErrNoInventory))
+				Expect(decisions[0].TargetReplicas).To(Equal(4))
+				Expect(decisions[0].WasLimited).To(BeFalse())
+				Expect(decisions[0].DecisionSteps).To(BeEmpty())
+			})
+		})
+
 		Context("with scale-up decisions", func() {
 			BeforeEach(func() {
 				inventory = newMockInventory("type-inv", map[string]int{"A100": 8})
@@ -4,8 +4,6 @@ import (
 	"context"
 	"time"
 
-	ctrl "sigs.k8s.io/controller-runtime"
-
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
@@ -60,7 +58,7 @@ func (e *Enforcer) EnforcePolicy(
 	variantAnalyses []interfaces.VariantSaturationAnalysis,
 	scaleToZeroConfig config.ScaleToZeroConfigData,
 ) (map[string]int, bool) {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 
 	// Check if scale-to-zero is enabled for this model
 	scaleToZeroEnabled := config.IsScaleToZeroEnabled(scaleToZeroConfig, modelID)
@@ -91,7 +89,7 @@ func (e *Enforcer) applyScaleToZero(
 	targets map[string]int,
 	scaleToZeroConfig config.ScaleToZeroConfigData,
 ) (map[string]int, bool) {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 
 	// Get retention period for this model
 	retentionPeriod := config.GetScaleToZeroRetentionPeriod(scaleToZeroConfig, modelID)
@@ -134,7 +132,7 @@ func (e *Enforcer) ensureMinimumReplicas(
 	targets map[string]int,
 	variantAnalyses []interfaces.VariantSaturationAnalysis,
 ) (map[string]int, bool) {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 
 	// Calculate total replicas
 	totalReplicas := 0
@@ -331,5 +331,78 @@ var _ = Describe("Enforcer", func() {
 				Expect(result["variant-missing"]).To(Equal(1))
 			})
 		})
+
+		Context("when a VariantAutoscaling spec overrides the ConfigMap", func() {
+			var retentionSeen time.Duration
+
+			BeforeEach(func() {
+				retentionSeen = 0
+				enforcer = NewEnforcer(func(ctx context.Context, modelID, namespace string, retentionPeriod time.Duration) (float64, error) {
+					retentionSeen = retentionPeriod
+					return 0, nil
+				})
+				targets = map[string]int{
+					"variant-a": 1,
+					"variant-b": 1,
+				}
+				variantAnalyses = []interfaces.VariantSaturationAnalysis{
+					{VariantName: "variant-a", Cost: 1.0},
+					{VariantName: "variant-b", Cost: 2.0},
+				}
+			})
+
+			It("should scale to zero when the spec enables what the ConfigMap disables", func() {
+				configMapData := config.ScaleToZeroConfigData{
+					"test-model": {
+						EnableScaleToZero: boolPtr(false),
+						RetentionPeriod:   "10m",
+					},
+				}
+				scaleToZeroConfig := config.WithScaleToZeroOverride(configMapData, "test-model", config.ModelScaleToZeroConfig{
+					EnableScaleToZero: boolPtr(true),
+					RetentionPeriod:   "2m",
+				})
+
+				result, applied := enforcer.EnforcePolicy(
+					ctx,
+					"test-model",
+					"test-ns",
+					targets,
+					variantAnalyses,
+					scaleToZeroConfig,
+				)
+
+				Expect(applied).To(BeTrue())
+				Expect(result["variant-a"]).To(Equal(0))
+				Expect(result["variant-b"]).To(Equal(0))
+				Expect(retentionSeen).To(Equal(2 * time.Minute))
+				// The ConfigMap data itself is left untouched
+				Expect(*configMapData["test-model"].EnableScaleToZero).To(BeFalse())
+			})
+
+			It("should inherit unset fields from the ConfigMap defaults", func() {
+				scaleToZeroConfig := config.WithScaleToZeroOverride(config.ScaleToZeroConfigData{
+					config.GlobalDefaultsKey: {
+						EnableScaleToZero: boolPtr(false),
+						RetentionPeriod:   "5m",
+					},
+				}, "test-model", config.ModelScaleToZeroConfig{
+					EnableScaleToZero: boolPtr(true),
+				})
+
+				result, applied := enforcer.EnforcePolicy(
+					ctx,
+					"test-model",
+					"test-ns",
+					targets,
+					variantAnalyses,
+					scaleToZeroConfig,
+				)
+
+				Expect(applied).To(BeTrue())
+				Expect(result["variant-a"]).To(Equal(0))
+				Expect(retentionSeen).To(Equal(5 * time.Minute))
+			})
+		})
 	})
 })
@@ -0,0 +1,112 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/discovery"
+)
+
+// DefaultInventoryRefreshInterval is how long discovered accelerator counts are reused
+// before the cluster inventory is collected again.
+const DefaultInventoryRefreshInterval = 5 * time.Minute
+
+// InventoryFunc returns the accelerator inventory of the cluster as
+// node -> accelerator model -> info, the shape returned by collector.CollectInventoryK8S.
+type InventoryFunc func(ctx context.Context) (map[string]map[string]discovery.AcceleratorModelInfo, error)
+
+// InventoryCap derives a maximum replica count per variant from how many accelerators
+// of the variant's type exist in the cluster, so a variant is never scaled beyond what
+// the cluster could hold even if it had every accelerator of its type to itself.
+//
+// Unlike the GPU limiter, which allocates currently available GPUs between variants,
+// the cap only looks at total capacity and is applied to each variant independently.
+// The inventory is cached and refreshed at most once per refresh interval.
+type InventoryCap struct {
+	mu              sync.Mutex
+	clock           clock.PassiveClock
+	refreshInterval time.Duration
+	inventory       InventoryFunc
+
+	// countByType maps the normalized accelerator type (e.g., "H100") to total GPU count
+	countByType map[string]int
+	lastRefresh time.Time
+	refreshed   bool
+}
+
+// NewInventoryCap creates an InventoryCap that collects the inventory with inventory.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+// A non-positive refresh interval uses DefaultInventoryRefreshInterval.
+func NewInventoryCap(clk clock.PassiveClock, refreshInterval time.Duration, inventory InventoryFunc) *InventoryCap {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultInventoryRefreshInterval
+	}
+	return &InventoryCap{
+		clock:           clk,
+		refreshInterval: refreshInterval,
+		inventory:       inventory,
+		countByType:     make(map[string]int),
+	}
+}
+
+// SetRefreshInterval changes how often the inventory is collected.
+// A non-positive interval uses DefaultInventoryRefreshInterval.
+func (c *InventoryCap) SetRefreshInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultInventoryRefreshInterval
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshInterval = interval
+}
+
+// Refresh collects the inventory if it has never been collected or the cached counts are
+// older than the refresh interval. On failure the previous counts are kept.
+func (c *InventoryCap) Refresh(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if c.refreshed && now.Sub(c.lastRefresh) < c.refreshInterval {
+		return nil
+	}
+
+	nodeInventory, err := c.inventory(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to collect accelerator inventory: %w", err)
+	}
+
+	byType := make(map[string]int)
+	for _, accelerators := range nodeInventory {
+		for fullModelName, info := range accelerators {
+			byType[normalizeAcceleratorName(fullModelName)] += info.Count
+		}
+	}
+
+	c.countByType = byType
+	c.lastRefresh = now
+	c.refreshed = true
+	return nil
+}
+
+// MaxReplicas returns the maximum replicas of a variant running on accType with
+// gpusPerReplica GPUs each. ok is false until the inventory has been collected and for
+// accelerator types not found in it, so a variant whose accelerator is not discovered
+// (e.g. nodes without GPU operator labels) is left uncapped rather than capped at zero.
+func (c *InventoryCap) MaxReplicas(accType string, gpusPerReplica int) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count, found := c.countByType[normalizeAcceleratorName(accType)]
+	if !c.refreshed || !found {
+		return 0, false
+	}
+	if gpusPerReplica <= 0 {
+		gpusPerReplica = 1
+	}
+	return count / gpusPerReplica, true
+}
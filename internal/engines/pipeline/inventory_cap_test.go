@@ -0,0 +1,105 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/discovery"
+)
+
+var _ = Describe("InventoryCap", func() {
+	var (
+		ctx       context.Context
+		fakeClock *clocktesting.FakePassiveClock
+		disc      *mockDiscovery
+		calls     int
+		invCap    *InventoryCap
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClock = clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		disc = &mockDiscovery{inventory: map[string]map[string]discovery.AcceleratorModelInfo{
+			"node-1": {"NVIDIA-H100-SXM5-80GB": {Count: 8}},
+			"node-2": {"NVIDIA-H100-SXM5-80GB": {Count: 8}, "NVIDIA-A100-PCIE-80GB": {Count: 4}},
+		}}
+		calls = 0
+		invCap = NewInventoryCap(fakeClock, time.Minute, func(ctx context.Context) (map[string]map[string]discovery.AcceleratorModelInfo, error) {
+			calls++
+			return disc.Discover(ctx)
+		})
+	})
+
+	It("should report no cap before the inventory is collected", func() {
+		_, ok := invCap.MaxReplicas("H100", 1)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should derive the cap from accelerator count and GPUs per replica", func() {
+		Expect(invCap.Refresh(ctx)).To(Succeed())
+
+		maxReplicas, ok := invCap.MaxReplicas("H100", 4)
+		Expect(ok).To(BeTrue())
+		Expect(maxReplicas).To(Equal(4))
+
+		maxReplicas, _ = invCap.MaxReplicas("A100", 1)
+		Expect(maxReplicas).To(Equal(4))
+
+		maxReplicas, _ = invCap.MaxReplicas("A100", 3)
+		Expect(maxReplicas).To(Equal(1))
+
+	})
+
+	It("should not cap accelerator types missing from the inventory", func() {
+		Expect(invCap.Refresh(ctx)).To(Succeed())
+
+		_, ok := invCap.MaxReplicas("MI300X", 1)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should track inventory changes once the refresh interval elapses", func() {
+		Expect(invCap.Refresh(ctx)).To(Succeed())
+		maxReplicas, _ := invCap.MaxReplicas("H100", 2)
+		Expect(maxReplicas).To(Equal(8))
+
+		// A node is added; the cached counts are reused until the interval elapses
+		disc.inventory["node-3"] = map[string]discovery.AcceleratorModelInfo{"NVIDIA-H100-SXM5-80GB": {Count: 8}}
+		fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+		Expect(invCap.Refresh(ctx)).To(Succeed())
+		maxReplicas, _ = invCap.MaxReplicas("H100", 2)
+		Expect(maxReplicas).To(Equal(8))
+		Expect(calls).To(Equal(1))
+
+		fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+		Expect(invCap.Refresh(ctx)).To(Succeed())
+		maxReplicas, _ = invCap.MaxReplicas("H100", 2)
+		Expect(maxReplicas).To(Equal(12))
+
+		// Nodes are removed
+		delete(disc.inventory, "node-2")
+		delete(disc.inventory, "node-3")
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+		Expect(invCap.Refresh(ctx)).To(Succeed())
+		maxReplicas, _ = invCap.MaxReplicas("H100", 2)
+		Expect(maxReplicas).To(Equal(4))
+		_, ok := invCap.MaxReplicas("A100", 1)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should keep the previous counts when collection fails", func() {
+		Expect(invCap.Refresh(ctx)).To(Succeed())
+
+		disc.err = errors.New("api unavailable")
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+		Expect(invCap.Refresh(ctx)).NotTo(Succeed())
+
+		maxReplicas, ok := invCap.MaxReplicas("H100", 1)
+		Expect(ok).To(BeTrue())
+		Expect(maxReplicas).To(Equal(16))
+	})
+})
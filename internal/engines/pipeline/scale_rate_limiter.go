@@ -0,0 +1,191 @@
+package pipeline
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// ScaleRateWindow is the sliding window over which scale rates are measured.
+// Rates configured on a VariantAutoscaling are expressed in replicas per minute.
+const ScaleRateWindow = time.Minute
+
+// scaleEvent records replicas added to or removed from a variant at a point in time.
+type scaleEvent struct {
+	at       time.Time
+	replicas int
+}
+
+// ScaleRateLimiter caps how fast a variant can grow or shrink, independent of how
+// often the optimization loop runs. It keeps a per-variant history of granted scale-ups
+// and scale-downs and only allows as many replicas to be added or removed as remain in
+// the current one-minute window.
+type ScaleRateLimiter struct {
+	mu    sync.Mutex
+	clock clock.PassiveClock
+
+	// history holds the scale-up events granted within the window, keyed by variant.
+	history map[string][]scaleEvent
+	// lastTarget is the last target handed out per variant. Scale-up is measured
+	// against it so replicas that are still starting are not counted twice.
+	lastTarget map[string]int
+
+	// downHistory holds the scale-down events granted within the window, keyed by variant.
+	downHistory map[string][]scaleEvent
+	// lastDownTarget is the last target handed out per variant by LimitScaleDown. Scale-down
+	// is measured against it so replicas that are still terminating are not counted twice.
+	lastDownTarget map[string]int
+}
+
+// NewScaleRateLimiter creates a rate limiter using the given clock.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+func NewScaleRateLimiter(clk clock.PassiveClock) *ScaleRateLimiter {
+	return &ScaleRateLimiter{
+		clock:          clk,
+		history:        make(map[string][]scaleEvent),
+		lastTarget:     make(map[string]int),
+		downHistory:    make(map[string][]scaleEvent),
+		lastDownTarget: make(map[string]int),
+	}
+}
+
+// LimitScaleUp clamps target so that the variant identified by key adds at most
+// maxPerMinute replicas within any one-minute window.
+//
+// Growth is measured from the larger of the current replica count and the last
+// target granted for the variant. Scale-downs and no-ops pass through unchanged
+// and only update the baseline.
+//
+// Returns the (possibly reduced) target and whether it was limited.
+func (l *ScaleRateLimiter) LimitScaleUp(key string, current, target int, maxPerMinute int32) (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	events := prune(l.history, key, now)
+
+	baseline := current
+	if last, ok := l.lastTarget[key]; ok && last > baseline {
+		baseline = last
+	}
+
+	if target <= baseline {
+		l.lastTarget[key] = target
+		return target, false
+	}
+
+	used := 0
+	for _, ev := range events {
+		used += ev.replicas
+	}
+	allowed := max(int(maxPerMinute)-used, 0)
+
+	limited := false
+	if target-baseline > allowed {
+		target = baseline + allowed
+		limited = true
+	}
+
+	if added := target - baseline; added > 0 {
+		l.history[key] = append(events, scaleEvent{at: now, replicas: added})
+	}
+	l.lastTarget[key] = target
+	return target, limited
+}
+
+// LimitScaleDown clamps target so that the variant identified by key removes at most
+// maxPerMinute replicas within any one-minute window, so that capacity is drained
+// gradually even when removing more replicas at once looks safe.
+//
+// Shrinkage is measured from the smaller of the current replica count and the last
+// target granted for the variant. Scale-ups and no-ops pass through unchanged and
+// only update the baseline.
+//
+// Returns the (possibly raised) target and whether it was limited.
+func (l *ScaleRateLimiter) LimitScaleDown(key string, current, target int, maxPerMinute int32) (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	events := prune(l.downHistory, key, now)
+
+	baseline := current
+	if last, ok := l.lastDownTarget[key]; ok && last < baseline {
+		baseline = last
+	}
+
+	if target >= baseline {
+		l.lastDownTarget[key] = target
+		return target, false
+	}
+
+	used := 0
+	for _, ev := range events {
+		used += ev.replicas
+	}
+	allowed := max(int(maxPerMinute)-used, 0)
+
+	limited := false
+	if baseline-target > allowed {
+		target = baseline - allowed
+		limited = true
+	}
+
+	if removed := baseline - target; removed > 0 {
+		l.downHistory[key] = append(events, scaleEvent{at: now, replicas: removed})
+	}
+	l.lastDownTarget[key] = target
+	return target, limited
+}
+
+// Forget drops the scale history and last targets of the variant identified by key,
+// e.g. once its VariantAutoscaling has been deleted.
+func (l *ScaleRateLimiter) Forget(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.history, key)
+	delete(l.lastTarget, key)
+	delete(l.downHistory, key)
+	delete(l.lastDownTarget, key)
+}
+
+// Retain drops the scale history and last targets of every variant not in keys, e.g.
+// variants whose VariantAutoscaling no longer exists.
+func (l *ScaleRateLimiter) Retain(keys map[string]bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, m := range []map[string]int{l.lastTarget, l.lastDownTarget} {
+		for key := range m {
+			if !keys[key] {
+				delete(m, key)
+			}
+		}
+	}
+	for _, m := range []map[string][]scaleEvent{l.history, l.downHistory} {
+		for key := range m {
+			if !keys[key] {
+				delete(m, key)
+			}
+		}
+	}
+}
+
+// prune removes the events of key in history that fell out of the window and returns
+// the remaining ones. Must be called with l.mu held.
+func prune(history map[string][]scaleEvent, key string, now time.Time) []scaleEvent {
+	events := history[key]
+	cutoff := now.Add(-ScaleRateWindow)
+	kept := events[:0]
+	for _, ev := range events {
+		if ev.at.After(cutoff) {
+			kept = append(kept, ev)
+		}
+	}
+	if len(kept) == 0 {
+		delete(history, key)
+		return nil
+	}
+	history[key] = kept
+	return kept
+}
@@ -0,0 +1,205 @@
+package pipeline
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("ScaleRateLimiter", func() {
+	var (
+		fakeClock *clocktesting.FakePassiveClock
+		limiter   *ScaleRateLimiter
+	)
+
+	BeforeEach(func() {
+		fakeClock = clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		limiter = NewScaleRateLimiter(fakeClock)
+	})
+
+	It("should pass through scale-ups within the rate", func() {
+		target, limited := limiter.LimitScaleUp("ns/v1", 2, 4, 3)
+		Expect(limited).To(BeFalse())
+		Expect(target).To(Equal(4))
+	})
+
+	It("should clamp a single large scale-up to the rate", func() {
+		target, limited := limiter.LimitScaleUp("ns/v1", 2, 10, 3)
+		Expect(limited).To(BeTrue())
+		Expect(target).To(Equal(5))
+	})
+
+	It("should pass through scale-downs and no-ops", func() {
+		target, limited := limiter.LimitScaleUp("ns/v1", 5, 3, 1)
+		Expect(limited).To(BeFalse())
+		Expect(target).To(Equal(3))
+
+		target, limited = limiter.LimitScaleUp("ns/v1", 3, 3, 1)
+		Expect(limited).To(BeFalse())
+		Expect(target).To(Equal(3))
+	})
+
+	It("should enforce the rate across multiple rapid cycles", func() {
+		// 5s poll interval, 4 replicas/min cap, analyzer asks for +1 each cycle.
+		// Current replicas lag behind because pods are still starting.
+		current := 1
+		granted := 0
+		for i := 0; i < 12; i++ {
+			target, _ := limiter.LimitScaleUp("ns/v1", current, 1+granted+1, 4)
+			granted = target - 1
+			fakeClock.SetTime(fakeClock.Now().Add(5 * time.Second))
+		}
+		Expect(granted).To(Equal(4))
+	})
+
+	It("should not double-count replicas that are still starting", func() {
+		target, _ := limiter.LimitScaleUp("ns/v1", 1, 3, 2)
+		Expect(target).To(Equal(3))
+
+		// Current has not caught up yet; asking for the same target again is not growth.
+		fakeClock.SetTime(fakeClock.Now().Add(10 * time.Second))
+		target, limited := limiter.LimitScaleUp("ns/v1", 1, 3, 2)
+		Expect(limited).To(BeFalse())
+		Expect(target).To(Equal(3))
+
+		// Any further growth within the window is blocked.
+		target, limited = limiter.LimitScaleUp("ns/v1", 1, 4, 2)
+		Expect(limited).To(BeTrue())
+		Expect(target).To(Equal(3))
+	})
+
+	It("should allow more growth once the window has passed", func() {
+		target, _ := limiter.LimitScaleUp("ns/v1", 1, 10, 2)
+		Expect(target).To(Equal(3))
+
+		fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+		target, limited := limiter.LimitScaleUp("ns/v1", 3, 10, 2)
+		Expect(limited).To(BeTrue())
+		Expect(target).To(Equal(3))
+
+		fakeClock.SetTime(fakeClock.Now().Add(31 * time.Second))
+		target, limited = limiter.LimitScaleUp("ns/v1", 3, 10, 2)
+		Expect(limited).To(BeTrue())
+		Expect(target).To(Equal(5))
+	})
+
+	It("should track variants independently", func() {
+		target, _ := limiter.LimitScaleUp("ns/v1", 1, 10, 1)
+		Expect(target).To(Equal(2))
+
+		target, limited := limiter.LimitScaleUp("ns/v2", 1, 3, 2)
+		Expect(limited).To(BeFalse())
+		Expect(target).To(Equal(3))
+	})
+
+	Describe("LimitScaleDown", func() {
+		It("should pass through scale-downs within the rate", func() {
+			target, limited := limiter.LimitScaleDown("ns/v1", 6, 4, 3)
+			Expect(limited).To(BeFalse())
+			Expect(target).To(Equal(4))
+		})
+
+		It("should clamp a single large scale-down to the rate", func() {
+			target, limited := limiter.LimitScaleDown("ns/v1", 10, 1, 3)
+			Expect(limited).To(BeTrue())
+			Expect(target).To(Equal(7))
+		})
+
+		It("should pass through scale-ups and no-ops", func() {
+			target, limited := limiter.LimitScaleDown("ns/v1", 3, 5, 1)
+			Expect(limited).To(BeFalse())
+			Expect(target).To(Equal(5))
+
+			target, limited = limiter.LimitScaleDown("ns/v1", 5, 5, 1)
+			Expect(limited).To(BeFalse())
+			Expect(target).To(Equal(5))
+		})
+
+		It("should never exceed the rate across rapid cycles", func() {
+			// 5s poll interval, 2 replicas/min cap, analyzer asks for -1 each cycle.
+			// Current replicas lag behind because pods are still terminating.
+			current := 10
+			lastTarget := current
+			var removals []int
+			for i := 0; i < 36; i++ {
+				target, _ := limiter.LimitScaleDown("ns/v1", current, lastTarget-1, 2)
+				Expect(target).To(BeNumerically(">=", 1))
+				removals = append(removals, lastTarget-target)
+				lastTarget = target
+				if i%3 == 2 {
+					current = target
+				}
+				fakeClock.SetTime(fakeClock.Now().Add(5 * time.Second))
+			}
+
+			// Any 12 consecutive cycles span one minute
+			for start := 0; start+12 <= len(removals); start++ {
+				removed := 0
+				for _, r := range removals[start : start+12] {
+					removed += r
+				}
+				Expect(removed).To(BeNumerically("<=", 2), "cycles %d-%d removed %d replicas", start, start+11, removed)
+			}
+			Expect(lastTarget).To(Equal(4))
+		})
+
+		It("should not double-count replicas that are still terminating", func() {
+			target, _ := limiter.LimitScaleDown("ns/v1", 5, 3, 2)
+			Expect(target).To(Equal(3))
+
+			// Current has not caught up yet; asking for the same target again is not shrinkage.
+			fakeClock.SetTime(fakeClock.Now().Add(10 * time.Second))
+			target, limited := limiter.LimitScaleDown("ns/v1", 5, 3, 2)
+			Expect(limited).To(BeFalse())
+			Expect(target).To(Equal(3))
+
+			// Any further shrinkage within the window is blocked.
+			target, limited = limiter.LimitScaleDown("ns/v1", 5, 2, 2)
+			Expect(limited).To(BeTrue())
+			Expect(target).To(Equal(3))
+		})
+
+		It("should be tracked independently of scale-up", func() {
+			target, _ := limiter.LimitScaleUp("ns/v1", 2, 3, 1)
+			Expect(target).To(Equal(3))
+
+			target, limited := limiter.LimitScaleDown("ns/v1", 3, 2, 1)
+			Expect(limited).To(BeFalse())
+			Expect(target).To(Equal(2))
+		})
+	})
+
+	Context("forgetting variants", func() {
+		It("should restart the rate window of a forgotten variant", func() {
+			limiter.LimitScaleUp("ns/v1", 2, 5, 3)
+			limiter.LimitScaleDown("ns/v1", 5, 2, 3)
+
+			limiter.Forget("ns/v1")
+			Expect(limiter.history).NotTo(HaveKey("ns/v1"))
+			Expect(limiter.lastTarget).NotTo(HaveKey("ns/v1"))
+			Expect(limiter.downHistory).NotTo(HaveKey("ns/v1"))
+			Expect(limiter.lastDownTarget).NotTo(HaveKey("ns/v1"))
+
+			target, limited := limiter.LimitScaleUp("ns/v1", 2, 5, 3)
+			Expect(limited).To(BeFalse())
+			Expect(target).To(Equal(5))
+		})
+
+		It("should only keep variants that are retained", func() {
+			limiter.LimitScaleUp("ns/v1", 2, 3, 3)
+			limiter.LimitScaleUp("ns/v2", 2, 3, 3)
+			limiter.LimitScaleDown("ns/v1", 3, 2, 3)
+			limiter.LimitScaleDown("ns/v2", 3, 2, 3)
+
+			limiter.Retain(map[string]bool{"ns/v2": true})
+			Expect(limiter.history).To(HaveLen(1))
+			Expect(limiter.history).To(HaveKey("ns/v2"))
+			Expect(limiter.lastTarget).To(HaveLen(1))
+			Expect(limiter.downHistory).To(HaveLen(1))
+			Expect(limiter.lastDownTarget).To(HaveLen(1))
+			Expect(limiter.lastDownTarget).To(HaveKey("ns/v2"))
+		})
+	})
+})
@@ -18,7 +18,10 @@ package saturation
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"strconv"
 	"strings"
@@ -26,10 +29,12 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
-	ctrl "sigs.k8s.io/controller-runtime"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
@@ -38,13 +43,16 @@ import (
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/registration"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/discovery"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/common"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/executor"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/pipeline"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/saturation"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/sinks"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 )
 
@@ -63,8 +71,17 @@ type Engine struct {
 
 	Recorder record.EventRecorder
 
-	// ReplicaMetricsCollector is the collector for replica metrics using the source infrastructure
-	ReplicaMetricsCollector *collector.ReplicaMetricsCollector
+	// Clock tells the time of day for the traffic schedule.
+	Clock clock.PassiveClock
+
+	// ReplicaMetricsCollector is the collector for replica metrics using the source infrastructure.
+	// When a shadow source is registered it is a collector.ShadowCollector, which still returns
+	// the primary (Prometheus) metrics.
+	ReplicaMetricsCollector interfaces.MetricsCollector
+
+	// ScaleTargets creates the provider through which BuildVariantStates reads a variant's
+	// replicas. Defaults to utils.NewDeploymentScaleTarget when nil.
+	ScaleTargets func(k8sClient client.Client, deploy *appsv1.Deployment) interfaces.ScaleTargetProvider
 
 	// ScaleToZeroEnforcer applies scale-to-zero and minimum replica enforcement
 	ScaleToZeroEnforcer *pipeline.Enforcer
@@ -72,6 +89,74 @@ type Engine struct {
 	// GPULimiter constrains scaling decisions based on available GPU resources.
 	// Only applied when EnableLimiter is true in the saturation config.
 	GPULimiter pipeline.Limiter
+
+	// ScaleRateLimiter caps per-variant scale speed for VAs that set spec.maxScaleUpRate or
+	// spec.maxScaleDownRate.
+	ScaleRateLimiter *pipeline.ScaleRateLimiter
+
+	// InventoryCap caps variant targets at what the cluster's accelerators can hold.
+	// Only applied to models with maxReplicasFromInventory in the saturation config.
+	InventoryCap *pipeline.InventoryCap
+
+	// GoodputTracker keeps per-model goodput history for the goodput plateau scale-up trigger.
+	GoodputTracker *saturation.GoodputTracker
+
+	// QueueHistory keeps per-replica queue lengths across cycles, for queuePercentile.
+	QueueHistory *saturation.QueueHistory
+
+	// ScaleDownStabilizer tracks per-model how long scale-down has been safe, for scaleDownDelay.
+	ScaleDownStabilizer *saturation.ScaleDownStabilizer
+
+	// ColdStartGrace records per-model scale-ups, for coldStartGracePeriod.
+	ColdStartGrace *saturation.ColdStartGrace
+
+	// ObservationTracker counts per-model cycles since startup, for minObservationCycles.
+	ObservationTracker *saturation.ObservationTracker
+
+	// LabelDriftTracker records per model the queries whose series the collector could not
+	// attribute to a pod, for the MetricLabelDrift condition.
+	LabelDriftTracker *collector.LabelDriftTracker
+
+	// StaleDesiredTracker tracks per-variant how long desired replicas have differed from current,
+	// for staleDesiredTimeout.
+	StaleDesiredTracker *saturation.StaleDesiredTracker
+
+	// PendingReplicaTracker tracks per-variant how long replicas have been pending, for maxPendingAge.
+	PendingReplicaTracker *saturation.PendingReplicaTracker
+
+	// FlapDetector counts per-variant reversals of the published desired replicas, for flapWindow.
+	FlapDetector *saturation.FlapDetector
+
+	// MetricsSink publishes the replica metrics external autoscalers (HPA/KEDA) scale on.
+	// A decision is marked as applied once this sink published it.
+	MetricsSink interfaces.DecisionSink
+
+	// CommitDelay holds back changes of the published desired replicas until they are stable. It
+	// is shared by MetricsSink and the safety net so both publish the same committed value. Nil
+	// unless WVA_DESIRED_COMMIT_DELAY is set.
+	CommitDelay *sinks.CommitDelay
+
+	// DecisionSinks receives every applied decision besides MetricsSink. It starts with the log
+	// sink, and the event sink when the engine has a recorder; more can be added with
+	// RegisterDecisionSink and RegisterExternalDecisionSink. Their errors are only logged.
+	DecisionSinks *sinks.FanOut
+
+	// MetricsEmitter records the last successful optimization time of each model.
+	MetricsEmitter *metrics.MetricsEmitter
+
+	// DisableSafetyNet turns off the fallback metrics emitted when a model's analysis fails.
+	// The model's VAs get an OptimizationFailed condition instead and nothing is emitted for them.
+	// Set from WVA_DISABLE_SAFETY_NET; meant for test environments where failures should be loud.
+	DisableSafetyNet bool
+
+	// AnalysisExport controls how much of each model's saturation analysis is written to the
+	// saturation analysis annotation of one representative VA per model. Off by default.
+	// Set from WVA_SATURATION_ANALYSIS_EXPORT ("summary" or "full").
+	AnalysisExport saturation.AnalysisExportLevel
+
+	// NodeCostCollector prices each variant from a label on the nodes its pods run on, taking
+	// precedence over the cost in the VA spec. Nil unless WVA_NODE_COST_LABEL is set.
+	NodeCostCollector *collector.NodeLabelCostCollector
 }
 
 // getVariantKey returns a unique key for a variant combining namespace and name.
@@ -80,9 +165,74 @@ func getVariantKey(namespace, name string) string {
 	return namespace + "/" + name
 }
 
+// retainActiveVariants drops the state kept across cycles for variants and models that have no
+// active VA left, so that it does not grow with every VA ever created.
+func (e *Engine) retainActiveVariants(activeVAs []llmdVariantAutoscalingV1alpha1.VariantAutoscaling) {
+	variantKeys := make(map[string]bool, len(activeVAs))
+	modelKeys := make(map[string]bool, len(activeVAs))
+	commitKeys := make(map[string]bool, len(activeVAs))
+	vaKeys := make(map[types.NamespacedName]bool, len(activeVAs))
+	for i := range activeVAs {
+		va := &activeVAs[i]
+		variantKeys[getVariantKey(va.Namespace, va.GetScaleTargetName())] = true
+		modelKeys[va.Namespace+"/"+va.Spec.ModelID] = true
+		commitKeys[sinks.CommitKey(va.Namespace, va.Name)] = true
+		vaKeys[types.NamespacedName{Namespace: va.Namespace, Name: va.Name}] = true
+	}
+
+	e.ScaleRateLimiter.Retain(variantKeys)
+	if e.CommitDelay != nil {
+		e.CommitDelay.Retain(commitKeys)
+	}
+	if e.StaleDesiredTracker != nil {
+		e.StaleDesiredTracker.Retain(variantKeys)
+	}
+	if e.FlapDetector != nil {
+		e.FlapDetector.Retain(variantKeys)
+	}
+	if e.GoodputTracker != nil {
+		e.GoodputTracker.Retain(modelKeys)
+	}
+	if e.QueueHistory != nil {
+		e.QueueHistory.Retain(modelKeys)
+	}
+	if e.DecisionSinks != nil {
+		e.DecisionSinks.Retain(vaKeys)
+	}
+}
+
+// forgetVariant drops the per-variant state of va, keyed by variantKey, once it is deleted
+// mid-cycle. Per-model state is left to retainActiveVariants, as other VAs may serve the model.
+func (e *Engine) forgetVariant(variantKey string, va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling) {
+	e.ScaleRateLimiter.Forget(variantKey)
+	if e.CommitDelay != nil {
+		e.CommitDelay.Forget(sinks.CommitKey(va.Namespace, va.Name))
+	}
+	if e.StaleDesiredTracker != nil {
+		e.StaleDesiredTracker.Forget(variantKey)
+	}
+	if e.FlapDetector != nil {
+		e.FlapDetector.Forget(variantKey)
+	}
+	if e.DecisionSinks != nil {
+		e.DecisionSinks.Forget(types.NamespacedName{Namespace: va.Namespace, Name: va.Name})
+	}
+}
+
+// previousDesiredReplicas returns the desired replicas of alloc to keep when no new target is
+// computed for a variant with current replicas. A positive target is always kept; a target of 0
+// only while the variant is at zero replicas, so that a stale 0 left by an earlier scale-to-zero
+// never scales a serving variant down. It returns false when there is nothing to keep.
+func previousDesiredReplicas(alloc llmdVariantAutoscalingV1alpha1.OptimizedAlloc, current int) (int, bool) {
+	if alloc.Accelerator == "" || (alloc.NumReplicas == 0 && current > 0) {
+		return 0, false
+	}
+	return alloc.NumReplicas, true
+}
+
 // NewEngine creates a new instance of the saturation engine.
 func NewEngine(client client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, metricsRegistry *source.SourceRegistry) *Engine {
-	promSource := metricsRegistry.Get("prometheus") // assume prometheus source is registered
+	promSource := metricsRegistry.Get(source.PrimarySourceName) // assume the primary source is registered
 
 	// Create request count function wrapper for scale-to-zero enforcer
 	requestCountFunc := func(ctx context.Context, modelID, namespace string, retentionPeriod time.Duration) (float64, error) {
@@ -95,21 +245,73 @@ func NewEngine(client client.Client, scheme *runtime.Scheme, recorder record.Eve
 	gpuAlgorithm := pipeline.NewGreedyBySaturation()
 	gpuLimiter := pipeline.NewDefaultLimiter("gpu-limiter", gpuInventory, gpuAlgorithm)
 
+	// Create inventory cap for maxReplicasFromInventory, refreshed from the cluster inventory
+	inventoryCap := pipeline.NewInventoryCap(clock.RealClock{}, pipeline.DefaultInventoryRefreshInterval,
+		func(ctx context.Context) (map[string]map[string]discovery.AcceleratorModelInfo, error) {
+			return collector.CollectInventoryK8S(ctx, client)
+		})
+
+	// Compare against a secondary source (e.g. EPP) without affecting decisions when one is registered
+	metricsEmitter := metrics.NewMetricsEmitter()
+	labelDriftTracker := collector.NewLabelDriftTracker()
+	var replicaMetricsCollector interfaces.MetricsCollector = collector.NewReplicaMetricsCollector(promSource, client).
+		WithLabelDriftTracker(labelDriftTracker).
+		WithKvCacheFromBytes(os.Getenv(collector.KvCacheBytesFallbackEnvVar) == "true")
+	if shadowSource := metricsRegistry.Get(source.ShadowSourceName); shadowSource != nil {
+		replicaMetricsCollector = collector.NewShadowCollector(replicaMetricsCollector,
+			collector.NewReplicaMetricsCollector(shadowSource, client), metricsEmitter)
+	}
+
+	// Publish desired replicas only once the target is stable when a commit delay is configured
+	var commitDelay *sinks.CommitDelay
+	if delay := sinks.ParseCommitDelay(os.Getenv(sinks.CommitDelayEnvVar)); delay > 0 {
+		commitDelay = sinks.NewCommitDelay(clock.RealClock{}, delay)
+	}
+	prometheusSink := sinks.NewPrometheusSink(client).WithCommitDelay(commitDelay)
+
 	engine := Engine{
 		client:                  client,
 		scheme:                  scheme,
 		Recorder:                recorder,
-		ReplicaMetricsCollector: collector.NewReplicaMetricsCollector(promSource, client),
+		Clock:                   clock.RealClock{},
+		ReplicaMetricsCollector: replicaMetricsCollector,
 		ScaleToZeroEnforcer:     pipeline.NewEnforcer(requestCountFunc),
 		GPULimiter:              gpuLimiter,
+		ScaleRateLimiter:        pipeline.NewScaleRateLimiter(clock.RealClock{}),
+		InventoryCap:            inventoryCap,
+		GoodputTracker:          saturation.NewGoodputTracker(),
+		QueueHistory:            saturation.NewQueueHistory(),
+		ScaleDownStabilizer:     saturation.NewScaleDownStabilizer(clock.RealClock{}),
+		ColdStartGrace:          saturation.NewColdStartGrace(clock.RealClock{}),
+		ObservationTracker:      saturation.NewObservationTracker(),
+		LabelDriftTracker:       labelDriftTracker,
+		StaleDesiredTracker:     saturation.NewStaleDesiredTracker(clock.RealClock{}),
+		PendingReplicaTracker:   saturation.NewPendingReplicaTracker(clock.RealClock{}),
+		FlapDetector:            saturation.NewFlapDetector(clock.RealClock{}),
+		MetricsSink:             prometheusSink,
+		CommitDelay:             commitDelay,
+		DecisionSinks:           sinks.NewFanOut(sinks.LogSink{}),
+		MetricsEmitter:          metricsEmitter,
+		DisableSafetyNet:        strings.EqualFold(os.Getenv("WVA_DISABLE_SAFETY_NET"), "true"),
+		AnalysisExport:          saturation.ParseAnalysisExportLevel(os.Getenv("WVA_SATURATION_ANALYSIS_EXPORT")),
+	}
+
+	// Record scaling decisions as events on the VA, folding repeats so flapping does not spam
+	if recorder != nil {
+		engine.DecisionSinks.Register(sinks.NewEventSink(recorder, clock.RealClock{}, sinks.DefaultEventAggregationWindow))
+	}
+
+	if labelKey := os.Getenv(collector.NodeCostLabelEnvVar); labelKey != "" {
+		engine.NodeCostCollector = collector.NewNodeLabelCostCollector(client, labelKey, clock.RealClock{})
 	}
 
 	engine.executor = executor.NewPollingExecutor(executor.PollingConfig{
 		Config: executor.Config{
 			OptimizeFunc: engine.optimize,
 		},
-		Interval:     30 * time.Second,
-		RetryBackoff: 100 * time.Millisecond,
+		Interval:       30 * time.Second,
+		RetryBackoff:   100 * time.Millisecond,
+		MetricsEmitter: metricsEmitter,
 	})
 
 	// Register saturation-specific queries in the metrics registry
@@ -121,15 +323,35 @@ func NewEngine(client client.Client, scheme *runtime.Scheme, recorder record.Eve
 	return &engine
 }
 
+// RegisterDecisionSink adds a sink that receives every decision applied by the engine.
+// The sink is called from the optimization loop, so it must not block.
+func (e *Engine) RegisterDecisionSink(sink interfaces.DecisionSink) {
+	e.DecisionSinks.Register(sink)
+}
+
+// RegisterExternalDecisionSink adds a sink that publishes decisions to an external system.
+// Decisions reach it through a bounded queue drained in the background until ctx is done,
+// so a slow or unreachable system cannot stall the optimization loop.
+func (e *Engine) RegisterExternalDecisionSink(ctx context.Context, sink interfaces.DecisionSink) {
+	e.DecisionSinks.Register(sinks.NewAsyncSink(ctx, sink, sinks.DefaultAsyncQueueSize))
+}
+
 // StartOptimizeLoop starts the optimization loop for the saturation engine.
 // It runs until the context is cancelled.
 func (e *Engine) StartOptimizeLoop(ctx context.Context) {
 	e.executor.Start(ctx)
 }
 
+// OptimizeOnce runs a single optimization cycle outside the polling loop, e.g. for the self-test.
+func (e *Engine) OptimizeOnce(ctx context.Context) error {
+	return e.optimize(ctx)
+}
+
 // optimize performs the optimization logic.
 func (e *Engine) optimize(ctx context.Context) error {
-	logger := ctrl.LoggerFrom(ctx)
+	// Tag every log line and cached decision of this cycle with one trace ID
+	ctx = logging.WithTraceID(ctx, logging.NewTraceID())
+	logger := logging.FromContext(ctx, logging.Engine)
 
 	//TODO: move interval to manager.yaml
 	interval := common.Config.GetOptimizationInterval()
@@ -156,11 +378,18 @@ func (e *Engine) optimize(ctx context.Context) error {
 		return err
 	}
 
+	// Drop the per-variant and per-model state of VAs that no longer exist
+	e.retainActiveVariants(activeVAs)
+
 	if len(activeVAs) == 0 {
 		logger.Info("No active VariantAutoscalings found, skipping optimization")
 		return nil
 	}
 
+	// Normalize accelerator names so that variants labeled with different aliases of the
+	// same accelerator are treated as one type by grouping, GPU limiting and cost lookup
+	utils.NormalizeAcceleratorLabels(activeVAs, common.Config.GetAcceleratorAliases())
+
 	// Collected accelerator inventory (only in limited mode)
 	if strings.EqualFold(os.Getenv("WVA_LIMITED_MODE"), "true") {
 		inventory, err := collector.CollectInventoryK8S(ctx, e.client)
@@ -179,11 +408,8 @@ func (e *Engine) optimize(ctx context.Context) error {
 		return nil
 	}
 
-	saturationConfig, ok := saturationConfigMap["default"]
-	if !ok {
-		logger.Info("Default saturation scaling config not found, skipping optimization")
-		return nil
-	}
+	// Cluster-wide settings such as the GPU limiter come from the default entry
+	globalConfig := saturationConfigMap[config.GlobalDefaultsKey]
 
 	// Group VAs by model for per-model capacity analysis
 	modelGroups := utils.GroupVariantAutoscalingByModel(activeVAs)
@@ -193,6 +419,10 @@ func (e *Engine) optimize(ctx context.Context) error {
 
 	// Process each model independently
 	allDecisions := make([]interfaces.VariantDecision, 0)
+	// Models whose analysis succeeded this cycle
+	optimizedModels := make([]optimizedModel, 0, len(modelGroups))
+	// Their analyses, aggregated into the per-accelerator utilization
+	optimizedAnalyses := make([]*interfaces.ModelSaturationAnalysis, 0, len(modelGroups))
 
 	// Create VA lookup map for applySaturationDecisions (used to access VA status and update decisions)
 	// Copy slice elements to local variable to ensure stable pointers
@@ -217,49 +447,172 @@ func (e *Engine) optimize(ctx context.Context) error {
 			"variantCount", len(modelVAs),
 			"groupKey", groupKey)
 
-		saturationTargets, saturationAnalysis, variantStates, err := e.RunSaturationAnalysis(ctx, modelID, modelVAs, saturationConfig, e.client)
+		saturationConfig, configKey, ok := config.ResolveSaturationScalingConfig(saturationConfigMap, modelID, modelVAs[0].Namespace)
+		if !ok {
+			logger.Info("No saturation scaling config applies to model, skipping",
+				"modelID", modelID,
+				"namespace", modelVAs[0].Namespace)
+			continue
+		}
+		if configKey != config.GlobalDefaultsKey {
+			logger.V(logging.DEBUG).Info("Applied saturation scaling override",
+				"key", configKey,
+				"modelID", modelID,
+				"namespace", modelVAs[0].Namespace,
+				"config", saturationConfig)
+		}
+
+		modelConfig := modelSaturationConfig(ctx, saturationConfig, modelVAs)
+		// Compare the allow-list with the normalized accelerator labels by canonical name
+		modelConfig.AllowedAccelerators = utils.NormalizeAcceleratorNames(
+			modelConfig.AllowedAccelerators, common.Config.GetAcceleratorAliases())
+		// Let the model's service class tier set how long its queues may grow
+		if class := saturation.ServiceClassFor(common.Config.GetServiceClasses(), modelID); class != nil && class.QueueLengthThreshold > 0 {
+			modelConfig = saturation.WithServiceClassQueueThreshold(modelConfig, class)
+			logger.V(logging.DEBUG).Info("Queue length threshold set by service class",
+				"modelID", modelID,
+				"serviceClass", class.Name,
+				"queueLengthThreshold", modelConfig.QueueLengthThreshold)
+		}
+		if boost, className := saturation.ServiceClassBoost(common.Config.GetServiceClasses(), modelID, modelConfig); boost > 1 {
+			modelConfig = saturation.WithServiceClassBoost(modelConfig, boost)
+			logger.V(logging.DEBUG).Info("Spare triggers raised for service class",
+				"modelID", modelID,
+				"serviceClass", className,
+				"boost", boost,
+				"kvSpareTrigger", modelConfig.KvSpareTrigger,
+				"queueSpareTrigger", modelConfig.QueueSpareTrigger)
+		}
+		// Keep more headroom during scheduled peaks; the triggers are raised like for service classes
+		if len(modelConfig.TrafficSchedule) > 0 && e.Clock != nil {
+			if boost, window := saturation.TrafficScheduleBoost(modelConfig, e.Clock.Now()); boost > 1 {
+				modelConfig = saturation.WithServiceClassBoost(modelConfig, boost)
+				logger.V(logging.DEBUG).Info("Spare triggers raised for scheduled traffic peak",
+					"modelID", modelID,
+					"windowStart", window.Start,
+					"windowEnd", window.End,
+					"boost", boost,
+					"kvSpareTrigger", modelConfig.KvSpareTrigger,
+					"queueSpareTrigger", modelConfig.QueueSpareTrigger)
+			}
+		}
+		saturationTargets, saturationAnalysis, variantStates, err := e.RunSaturationAnalysis(ctx, modelID, modelVAs, modelConfig, e.client)
 		if err != nil {
 			logger.Error(err, "Saturation analysis failed",
 				"modelID", modelID)
 
-			// Activate safety net to ensure HPA doesn't scale to zero on partial failure
-			e.emitSafetyNetMetrics(ctx, modelVAs, currentAllocations)
+			e.handleAnalysisFailure(ctx, modelVAs, err, vaMap, currentAllocations)
 			continue
 		}
 
 		var finalDecisions []interfaces.VariantDecision
 		if saturationAnalysis != nil {
-			// Apply scale-to-zero enforcement after saturation analysis
-			// This either scales to zero if enabled and no requests, or ensures minimum replicas
-			scaleToZeroConfig := common.Config.GetScaleToZeroConfig()
-
-			// Copy original targets for logging (enforcer modifies map in place)
-			originalTargets := make(map[string]int, len(saturationTargets))
-			for k, v := range saturationTargets {
-				originalTargets[k] = v
-			}
-
-			enforcedTargets, scaledToZero := e.ScaleToZeroEnforcer.EnforcePolicy(
-				ctx,
-				modelID,
-				modelVAs[0].Namespace,
-				saturationTargets,
-				saturationAnalysis.VariantAnalyses,
-				scaleToZeroConfig,
-			)
-			if scaledToZero {
-				logger.Info("Scale-to-zero enforcement applied",
-					"modelID", modelID,
-					"originalTargets", originalTargets,
-					"enforcedTargets", enforcedTargets)
+			// Keep the analysis' recommendation, to publish next to the target that policies and limits leave
+			recommendedTargets := maps.Clone(saturationTargets)
+
+			// Hold all targets at current replicas until the model has been observed long enough
+			// after startup, so the first decisions are not based on a single sample
+			observedCycles := 0
+			if e.ObservationTracker != nil {
+				observedCycles = e.ObservationTracker.Observe(modelVAs[0].Namespace, modelID)
+			}
+			var observing bool
+			saturationTargets, observing = saturation.HoldForObservation(
+				ctx, saturationAnalysis, variantStates, saturationTargets, observedCycles, saturationConfig.MinObservationCycles)
+
+			// Hold all targets when too few replicas report metrics to trust the analysis.
+			// Scale-to-zero enforcement is skipped too, since it would act on the same partial view.
+			var coverage float64
+			var partialMetrics bool
+			if observing {
+				coverage, _ = saturation.MetricsCoverage(saturationAnalysis, variantStates)
+			} else {
+				saturationTargets, coverage, partialMetrics = saturation.GateOnMetricsCoverage(
+					ctx, saturationAnalysis, variantStates, saturationTargets, saturationConfig.MinMetricsCoverage)
+				if !partialMetrics {
+					// A variant without any metrics is either left unchanged or holds the whole model
+					saturationTargets, partialMetrics = saturation.ApplyNoMetricsVariantPolicy(
+						ctx, saturationAnalysis, variantStates, saturationTargets, saturationConfig.NoMetricsVariantPolicy)
+				}
+			}
+			if !observing && !partialMetrics {
+				// Apply scale-to-zero enforcement after saturation analysis
+				// This either scales to zero if enabled and no requests, or ensures minimum replicas.
+				// spec.scaleToZero of the model's VAs takes precedence over the ConfigMap
+				scaleToZeroConfig := common.ModelScaleToZeroConfig(ctx, common.Config.GetScaleToZeroConfig(), modelID, modelVAs)
+
+				// Copy original targets for logging (enforcer modifies map in place)
+				originalTargets := make(map[string]int, len(saturationTargets))
+				for k, v := range saturationTargets {
+					originalTargets[k] = v
+				}
+
+				enforcedTargets, scaledToZero := e.ScaleToZeroEnforcer.EnforcePolicy(
+					ctx,
+					modelID,
+					modelVAs[0].Namespace,
+					saturationTargets,
+					saturationAnalysis.VariantAnalyses,
+					scaleToZeroConfig,
+				)
+				// Record which variants the enforcer changed so their decisions carry the policy's reason code
+				for variant, target := range enforcedTargets {
+					if target == originalTargets[variant] {
+						continue
+					}
+					if saturationAnalysis.TargetReasonCodes == nil {
+						saturationAnalysis.TargetReasonCodes = make(map[string]interfaces.ReasonCode)
+					}
+					if scaledToZero {
+						saturationAnalysis.TargetReasonCodes[variant] = interfaces.ReasonCodeScaleToZero
+					} else {
+						saturationAnalysis.TargetReasonCodes[variant] = interfaces.ReasonCodeMinReplicas
+					}
+				}
+				if scaledToZero {
+					logger.Info("Scale-to-zero enforcement applied",
+						"modelID", modelID,
+						"originalTargets", originalTargets,
+						"enforcedTargets", enforcedTargets)
+				}
+				saturationTargets = enforcedTargets
 			}
-			saturationTargets = enforcedTargets
 
 			finalDecisions = e.convertSaturationTargetsToDecisions(ctx, saturationTargets, saturationAnalysis, variantStates)
+			variantAnalyses := make(map[string]*interfaces.VariantSaturationAnalysis, len(saturationAnalysis.VariantAnalyses))
+			for i := range saturationAnalysis.VariantAnalyses {
+				variantAnalyses[saturationAnalysis.VariantAnalyses[i].VariantName] = &saturationAnalysis.VariantAnalyses[i]
+			}
+			for i := range finalDecisions {
+				finalDecisions[i].MetricsCoverage = coverage
+				finalDecisions[i].MinMetricsCoverage = saturationConfig.MinMetricsCoverage
+				finalDecisions[i].PartialMetrics = partialMetrics
+				finalDecisions[i].ObservedCycles = observedCycles
+				finalDecisions[i].MinObservationCycles = saturationConfig.MinObservationCycles
+				finalDecisions[i].Observing = observing
+				finalDecisions[i].ErrorRate = saturationAnalysis.AvgErrorRate
+				finalDecisions[i].ErrorRateThreshold = modelConfig.ErrorRateThreshold
+				finalDecisions[i].ElevatedErrorRate = saturationAnalysis.ErrorRateElevated
+				finalDecisions[i].MaxPendingAge = modelConfig.MaxPendingAge
+				finalDecisions[i].FlapWindow = modelConfig.FlapWindow
+				finalDecisions[i].FlapThreshold = modelConfig.FlapThreshold
+				finalDecisions[i].RecommendedReplicas = recommendedTargets[finalDecisions[i].VariantName]
+				finalDecisions[i].Confidence = saturation.DecisionConfidence(variantAnalyses[finalDecisions[i].VariantName],
+					finalDecisions[i].CurrentReplicas, modelConfig.MetricsFreshnessHalfLife)
+			}
+			if saturationConfig.MaxReplicasFromInventory {
+				e.applyInventoryCap(ctx, finalDecisions, globalConfig.InventoryRefreshInterval)
+			}
+			if saturationConfig.AntiAffinityAware {
+				e.applyAntiAffinityLimit(ctx, finalDecisions)
+			}
+			e.attachAnalysisExport(ctx, saturationAnalysis, finalDecisions)
 			logger.Info("Saturation-only decisions made for model",
 				"modelID", modelID,
 				"decisionCount", len(finalDecisions))
 			allDecisions = append(allDecisions, finalDecisions...)
+			optimizedModels = append(optimizedModels, optimizedModel{modelID: modelID, namespace: modelVAs[0].Namespace})
+			optimizedAnalyses = append(optimizedAnalyses, saturationAnalysis)
 		} else {
 			// If saturationAnalysis is nil (e.g. no metrics), we just skip this model
 			logger.V(logging.DEBUG).Info("Skipping decision application for model: saturation analysis is nil (likely no metrics)",
@@ -269,17 +622,32 @@ func (e *Engine) optimize(ctx context.Context) error {
 
 	// STEP 2.5: Apply GPU limiter if enabled
 	// This constrains scaling decisions based on available GPU resources
-	if saturationConfig.EnableLimiter && len(allDecisions) > 0 {
+	if globalConfig.EnableLimiter && len(allDecisions) > 0 {
 		logger.Info("Applying GPU limiter to scaling decisions",
 			"decisionCount", len(allDecisions))
 
 		// Convert to pointer slice for limiter interface
 		decisionPtrs := make([]*interfaces.VariantDecision, len(allDecisions))
 		for i := range allDecisions {
+			allDecisions[i].LimiterEnabled = true
 			decisionPtrs[i] = &allDecisions[i]
 		}
 
-		if err := e.GPULimiter.Limit(ctx, decisionPtrs); err != nil {
+		if err := e.GPULimiter.Limit(ctx, decisionPtrs); errors.Is(err, pipeline.ErrNoInventory) {
+			// Without inventory, capacity is unknown rather than zero: leave targets uncapped
+			logger.Info("GPU limiter found no accelerator inventory, scaling decisions are not limited. "+
+				"Check that GPU nodes carry the GPU operator labels",
+				"decisionCount", len(decisionPtrs))
+			for _, d := range decisionPtrs {
+				d.NoInventory = true
+				d.AddDecisionStep(e.GPULimiter.Name(), "skipped: no accelerator inventory found", false)
+			}
+			if e.MetricsEmitter != nil {
+				if err := e.MetricsEmitter.EmitLimiterNoInventory(ctx); err != nil {
+					logger.V(logging.DEBUG).Info("Failed to emit limiter no-inventory metric", "error", err)
+				}
+			}
+		} else if err != nil {
 			logger.Error(err, "GPU limiter failed, proceeding with original decisions")
 			// Continue with original decisions on limiter failure
 		} else {
@@ -310,6 +678,8 @@ func (e *Engine) optimize(ctx context.Context) error {
 		logger.Error(err, "Failed to apply saturation decisions")
 		return err
 	}
+	e.recordOptimizedModels(ctx, optimizedModels)
+	e.recordAcceleratorUtilization(ctx, optimizedAnalyses)
 
 	logger.Info("Optimization completed successfully",
 		"mode", "saturation-only",
@@ -319,6 +689,216 @@ func (e *Engine) optimize(ctx context.Context) error {
 	return nil
 }
 
+// attachAnalysisExport serializes the model's saturation analysis onto the decision of one
+// representative variant (the first by name), so the controller can write it to that VA's
+// saturation analysis annotation. The other variants are marked so the controller removes the
+// annotation a previous representative still carries. Does nothing when the export is disabled.
+func (e *Engine) attachAnalysisExport(
+	ctx context.Context,
+	analysis *interfaces.ModelSaturationAnalysis,
+	decisions []interfaces.VariantDecision,
+) {
+	if e.AnalysisExport == saturation.AnalysisExportOff || len(decisions) == 0 {
+		return
+	}
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	exported, err := saturation.ExportAnalysis(analysis, e.AnalysisExport)
+	if err != nil {
+		logger.Error(err, "Failed to export saturation analysis",
+			"modelID", analysis.ModelID,
+			"namespace", analysis.Namespace)
+		return
+	}
+
+	representative := 0
+	for i := range decisions {
+		if decisions[i].VariantName < decisions[representative].VariantName {
+			representative = i
+		}
+	}
+	for i := range decisions {
+		decisions[i].SaturationAnalysis = ""
+		decisions[i].ClearSaturationAnalysis = i != representative
+	}
+	decisions[representative].SaturationAnalysis = string(exported)
+}
+
+// optimizedModel identifies a model whose saturation analysis succeeded in a cycle.
+type optimizedModel struct {
+	modelID   string
+	namespace string
+}
+
+// recordOptimizedModels updates the last optimization timestamp of each model optimized this cycle.
+func (e *Engine) recordOptimizedModels(ctx context.Context, models []optimizedModel) {
+	if e.MetricsEmitter == nil {
+		return
+	}
+	now := time.Now()
+	for _, model := range models {
+		if err := e.MetricsEmitter.EmitLastOptimizationTimestamp(ctx, model.modelID, model.namespace, now); err != nil {
+			logging.FromContext(ctx, logging.Engine).V(logging.DEBUG).Info("Failed to emit last optimization timestamp",
+				"modelID", model.modelID,
+				"namespace", model.namespace,
+				"error", err)
+		}
+	}
+}
+
+// recordAcceleratorUtilization emits the utilization of each accelerator type aggregated from the
+// analyses of the models optimized this cycle. The previous values are kept when no model was.
+func (e *Engine) recordAcceleratorUtilization(ctx context.Context, analyses []*interfaces.ModelSaturationAnalysis) {
+	if e.MetricsEmitter == nil || len(analyses) == 0 {
+		return
+	}
+	if err := e.MetricsEmitter.EmitAcceleratorUtilization(ctx, saturation.AcceleratorUtilization(analyses)); err != nil {
+		logging.FromContext(ctx, logging.Engine).V(logging.DEBUG).Info("Failed to emit accelerator utilization",
+			"error", err)
+	}
+}
+
+// applyInventoryCap clamps each decision's target to the replicas the cluster's accelerators of the
+// variant's type can hold, and records the cap as a metric. Variants whose accelerator is not in the
+// inventory are left uncapped. If the inventory can't be refreshed, the last known counts are used.
+//
+// The cap only limits growth: a target is never lowered below the variant's current replicas or
+// scale-down floor. A cap of zero, where not even one replica fits the accelerators of the
+// variant's type, is reported as a misconfiguration and the target is left uncapped.
+func (e *Engine) applyInventoryCap(ctx context.Context, decisions []interfaces.VariantDecision, refreshInterval time.Duration) {
+	if e.InventoryCap == nil {
+		return
+	}
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	e.InventoryCap.SetRefreshInterval(refreshInterval)
+	if err := e.InventoryCap.Refresh(ctx); err != nil {
+		logger.Error(err, "Failed to refresh accelerator inventory, using last known counts")
+	}
+
+	for i := range decisions {
+		d := &decisions[i]
+		maxReplicas, ok := e.InventoryCap.MaxReplicas(d.AcceleratorName, d.GPUsPerReplica)
+		if !ok {
+			logger.V(logging.DEBUG).Info("No accelerator inventory for variant, not capping",
+				"variant", d.VariantName,
+				"accelerator", d.AcceleratorName)
+			continue
+		}
+		if maxReplicas == 0 {
+			logger.Error(fmt.Errorf("%d GPUs per replica exceed the %s inventory", d.GPUsPerReplica, d.AcceleratorName),
+				"Variant is misconfigured: accelerator inventory holds no replica, not capping",
+				"variant", d.VariantName,
+				"target", d.TargetReplicas)
+			continue
+		}
+		if e.MetricsEmitter != nil {
+			if err := e.MetricsEmitter.EmitMaxReplicasCap(ctx, d.VariantName, d.Namespace, d.AcceleratorName, maxReplicas); err != nil {
+				logger.V(logging.DEBUG).Info("Failed to emit max replicas cap",
+					"variant", d.VariantName,
+					"error", err)
+			}
+		}
+		limit := max(maxReplicas, d.CurrentReplicas, d.ScaleDownFloor)
+		if d.TargetReplicas <= limit {
+			continue
+		}
+
+		logger.Info("Target capped by accelerator inventory",
+			"variant", d.VariantName,
+			"accelerator", d.AcceleratorName,
+			"target", d.TargetReplicas,
+			"maxReplicas", maxReplicas,
+			"cappedTarget", limit)
+		d.TargetReplicas = limit
+		if d.TargetReplicas > d.CurrentReplicas {
+			d.Action = interfaces.ActionScaleUp
+		} else {
+			d.Action = interfaces.ActionNoChange
+		}
+		d.Reason = "saturation-only mode: " + string(d.Action) + " (capped by accelerator inventory)"
+		d.ReasonCode = interfaces.ReasonCodeInventoryCap
+		d.AddDecisionStep("inventory-cap", fmt.Sprintf("capped at %d replicas by %s inventory", maxReplicas, d.AcceleratorName), true)
+	}
+}
+
+// applyAntiAffinityLimit limits scale-up of variants whose pods require one replica per node to the
+// number of schedulable nodes with their accelerator. Without it, replicas beyond the node count
+// stay pending forever. Limited decisions keep at least their current replicas and are flagged
+// with ReasonCodeUnschedulable. A variant needing more GPUs per replica than any node has is
+// reported as misconfigured and held at its current replicas.
+func (e *Engine) applyAntiAffinityLimit(ctx context.Context, decisions []interfaces.VariantDecision) {
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	var nodes *corev1.NodeList
+	for i := range decisions {
+		d := &decisions[i]
+		if d.Action != interfaces.ActionScaleUp {
+			continue
+		}
+		if d.ScaleTargetRef != nil && d.ScaleTargetRef.Kind != "" && d.ScaleTargetRef.Kind != "Deployment" {
+			continue
+		}
+		deployName := d.VariantName
+		if d.ScaleTargetRef != nil && d.ScaleTargetRef.Name != "" {
+			deployName = d.ScaleTargetRef.Name
+		}
+
+		deploy := &appsv1.Deployment{}
+		if err := e.client.Get(ctx, client.ObjectKey{Namespace: d.Namespace, Name: deployName}, deploy); err != nil {
+			logger.V(logging.DEBUG).Info("Could not get deployment for anti-affinity check, skipping",
+				"variant", d.VariantName,
+				"error", err)
+			continue
+		}
+		if !pipeline.HasRequiredSelfAntiAffinity(&deploy.Spec.Template) {
+			continue
+		}
+
+		// Nodes are listed once per model, only when a variant needs them
+		if nodes == nil {
+			nodes = &corev1.NodeList{}
+			if err := e.client.List(ctx, nodes); err != nil {
+				logger.Error(err, "Failed to list nodes for anti-affinity check, not limiting scale-up")
+				return
+			}
+		}
+		schedulableNodes, ok := pipeline.SchedulableNodeCount(nodes.Items, d.AcceleratorName, d.GPUsPerReplica)
+		if !ok || d.TargetReplicas <= schedulableNodes {
+			continue
+		}
+
+		target := max(schedulableNodes, d.CurrentReplicas)
+		step := fmt.Sprintf("limited to %d schedulable %s nodes", schedulableNodes, d.AcceleratorName)
+		if maxGPUs := pipeline.MaxNodeGPUs(nodes.Items, d.AcceleratorName); maxGPUs < max(d.GPUsPerReplica, 1) {
+			// No node can ever host a replica, so the node count is no useful cap
+			logger.Error(fmt.Errorf("%d GPUs per replica exceed the %d of the largest %s node", d.GPUsPerReplica, maxGPUs, d.AcceleratorName),
+				"Variant is misconfigured: no node can host a replica, holding at current replicas",
+				"variant", d.VariantName,
+				"target", d.TargetReplicas,
+				"limitedTarget", target)
+			step = fmt.Sprintf("held at current replicas: no %s node has the %d GPUs a replica needs (largest has %d)",
+				d.AcceleratorName, d.GPUsPerReplica, maxGPUs)
+		} else {
+			logger.Info("Scale-up limited by pod anti-affinity: not enough schedulable nodes",
+				"variant", d.VariantName,
+				"accelerator", d.AcceleratorName,
+				"target", d.TargetReplicas,
+				"schedulableNodes", schedulableNodes,
+				"limitedTarget", target)
+		}
+		d.TargetReplicas = target
+		if d.TargetReplicas > d.CurrentReplicas {
+			d.Action = interfaces.ActionScaleUp
+		} else {
+			d.Action = interfaces.ActionNoChange
+		}
+		d.Reason = "saturation-only mode: " + string(d.Action) + " (unschedulable: one replica per node)"
+		d.ReasonCode = interfaces.ReasonCodeUnschedulable
+		d.AddDecisionStep("anti-affinity", step, true)
+	}
+}
+
 // BuildVariantStates extracts current and desired replica counts from VAs for capacity analysis.
 func (e *Engine) BuildVariantStates(
 	ctx context.Context,
@@ -346,50 +926,87 @@ func (e *Engine) BuildVariantStates(
 			// Fallback to API call
 			fetchedDeploy := &appsv1.Deployment{}
 			if err := utils.GetDeploymentWithBackoff(ctx, k8sClient, va.GetScaleTargetName(), va.Namespace, fetchedDeploy); err != nil {
-				ctrl.LoggerFrom(ctx).V(logging.DEBUG).Info("Could not get deployment for VA, skipping",
+				logging.FromContext(ctx, logging.Engine).V(logging.DEBUG).Info("Could not get deployment for VA, skipping",
 					"variant", va.Name,
 					"error", err)
 				continue
 			}
 			deploy = fetchedDeploy
-			ctrl.LoggerFrom(ctx).V(1).Info("BuildVariantStates fallback lookup", "variant", va.Name, "deployName", deploy.Name, "specReplicas", deploy.Spec.Replicas, "statusReplicas", deploy.Status.Replicas, "readyReplicas", deploy.Status.ReadyReplicas)
+			logging.FromContext(ctx, logging.Engine).V(1).Info("BuildVariantStates fallback lookup", "variant", va.Name, "deployName", deploy.Name, "specReplicas", deploy.Spec.Replicas, "statusReplicas", deploy.Status.Replicas, "readyReplicas", deploy.Status.ReadyReplicas)
 		} else {
-			ctrl.LoggerFrom(ctx).V(1).Info("BuildVariantStates map lookup", "variant", va.Name, "deployName", deploy.Name, "specReplicas", deploy.Spec.Replicas, "statusReplicas", deploy.Status.Replicas, "readyReplicas", deploy.Status.ReadyReplicas)
+			logging.FromContext(ctx, logging.Engine).V(1).Info("BuildVariantStates map lookup", "variant", va.Name, "deployName", deploy.Name, "specReplicas", deploy.Spec.Replicas, "statusReplicas", deploy.Status.Replicas, "readyReplicas", deploy.Status.ReadyReplicas)
 		}
 
-		currentReplicas := int(deploy.Status.Replicas)
-		if currentReplicas == 0 && deploy.Spec.Replicas != nil {
-			currentReplicas = int(*deploy.Spec.Replicas)
+		// Read current and ready replicas through the scale target provider, which reads the
+		// scale subresource for consistency with HPA
+		scaleTarget := e.scaleTarget(k8sClient, deploy)
+		currentReplicas, err := scaleTarget.GetReplicas(ctx)
+		if err != nil {
+			logging.FromContext(ctx, logging.Engine).V(logging.DEBUG).Info("Could not get current replicas for VA, skipping",
+				"variant", va.Name,
+				"error", err)
+			continue
+		}
+		readyReplicas, err := scaleTarget.GetReadyReplicas(ctx)
+		if err != nil {
+			logging.FromContext(ctx, logging.Engine).V(logging.DEBUG).Info("Could not get ready replicas for VA, skipping",
+				"variant", va.Name,
+				"error", err)
+			continue
 		}
 
 		// Calculate pending replicas (not yet ready)
-		readyReplicas := int(deploy.Status.ReadyReplicas)
 		pendingReplicas := currentReplicas - readyReplicas
+		var excessReadyReplicas int
 		if pendingReplicas < 0 {
-			// This indicates an unexpected state where readyReplicas exceeds currentReplicas.
+			// readyReplicas exceeds currentReplicas, e.g. during a rollback. Record the excess so
+			// the model's excessReadyPolicy can handle it.
 			// Log at Info level since this inconsistency should be visible to operators.
-			ctrl.LoggerFrom(ctx).Info("Unexpected state: readyReplicas exceeds currentReplicas, clamping pendingReplicas to 0",
+			logging.FromContext(ctx, logging.Engine).Info("readyReplicas exceeds currentReplicas, clamping pendingReplicas to 0",
 				"variant", va.Name, "currentReplicas", currentReplicas, "readyReplicas", readyReplicas)
+			excessReadyReplicas = -pendingReplicas
 			pendingReplicas = 0
 		}
 
 		// Extract GPUs per replica from deployment's pod template
 		gpusPerReplica := getDeploymentGPUsPerReplica(deploy)
 
-		ctrl.LoggerFrom(ctx).V(1).Info("BuildVariantStates result", "variant", va.Name, "currentReplicas", currentReplicas, "readyReplicas", readyReplicas, "pendingReplicas", pendingReplicas, "gpusPerReplica", gpusPerReplica)
+		logging.FromContext(ctx, logging.Engine).V(1).Info("BuildVariantStates result", "variant", va.Name, "currentReplicas", currentReplicas, "readyReplicas", readyReplicas, "pendingReplicas", pendingReplicas, "gpusPerReplica", gpusPerReplica)
+
+		var scaleDownFloor int
+		if va.Spec.ScaleDownFloor != nil {
+			scaleDownFloor = int(*va.Spec.ScaleDownFloor)
+		}
+
+		// Scaling a deployment whose rollout is paused is moot, so its recommendation is held
+		if deploy.Spec.Paused {
+			logging.FromContext(ctx, logging.Engine).Info("Deployment rollout is paused, holding scaling recommendation",
+				"variant", va.Name, "deployment", deploy.Name)
+		}
 
 		states = append(states, interfaces.VariantReplicaState{
-			VariantName:     deploy.Name,
-			CurrentReplicas: currentReplicas,
-			DesiredReplicas: va.Status.DesiredOptimizedAlloc.NumReplicas,
-			PendingReplicas: pendingReplicas,
-			GPUsPerReplica:  gpusPerReplica,
+			VariantName:         deploy.Name,
+			CurrentReplicas:     currentReplicas,
+			DesiredReplicas:     va.Status.DesiredOptimizedAlloc.NumReplicas,
+			PendingReplicas:     pendingReplicas,
+			GPUsPerReplica:      gpusPerReplica,
+			ScaleDownFloor:      scaleDownFloor,
+			ExcessReadyReplicas: excessReadyReplicas,
+			Paused:              deploy.Spec.Paused,
 		})
 	}
 
 	return states
 }
 
+// scaleTarget returns the provider for a variant's scale target, using ScaleTargets when set.
+func (e *Engine) scaleTarget(k8sClient client.Client, deploy *appsv1.Deployment) interfaces.ScaleTargetProvider {
+	if e.ScaleTargets != nil {
+		return e.ScaleTargets(k8sClient, deploy)
+	}
+	return utils.NewDeploymentScaleTarget(k8sClient, deploy)
+}
+
 // gpuVendors lists the resource name prefixes for GPU vendors
 var gpuVendors = []string{"nvidia.com", "amd.com", "intel.com"}
 
@@ -427,7 +1044,7 @@ func (e *Engine) convertSaturationTargetsToDecisions(
 	saturationAnalysis *interfaces.ModelSaturationAnalysis,
 	variantStates []interfaces.VariantReplicaState,
 ) []interfaces.VariantDecision {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 	decisions := make([]interfaces.VariantDecision, 0, len(saturationTargets))
 
 	// Build variant analysis map for quick lookup
@@ -470,6 +1087,7 @@ func (e *Engine) convertSaturationTargetsToDecisions(
 			TargetReplicas:         targetReplicas,
 			OriginalTargetReplicas: targetReplicas, // Store original before limiter modifies it
 			DesiredReplicas:        state.DesiredReplicas,
+			ScaleDownFloor:         state.ScaleDownFloor,
 			Action:                 action,
 			SaturationBased:        true,
 			SaturationOnly:         true,
@@ -477,6 +1095,12 @@ func (e *Engine) convertSaturationTargetsToDecisions(
 			SafetyOverride:         false,
 			Reason:                 "saturation-only mode: " + string(action),
 			GPUsPerReplica:         gpusPerReplica,
+			ReasonCode:             interfaces.ReasonCodeSteady,
+			StuckPending:           state.StuckPending,
+			DeploymentPaused:       state.Paused,
+		}
+		if code, ok := saturationAnalysis.TargetReasonCodes[variantName]; ok && code != "" {
+			decision.ReasonCode = code
 		}
 
 		if va != nil {
@@ -495,6 +1119,50 @@ func (e *Engine) convertSaturationTargetsToDecisions(
 	return decisions
 }
 
+// modelSaturationConfig applies spec.targetKvUtilization from the model's VAs to the saturation config.
+// Saturation is analyzed per model, so when variants set different targets the lowest one wins,
+// keeping the most headroom. Invalid targets are logged and ignored.
+func modelSaturationConfig(
+	ctx context.Context,
+	base interfaces.SaturationScalingConfig,
+	modelVAs []llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+) interfaces.SaturationScalingConfig {
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	config := base
+	derived := false
+	for i := range modelVAs {
+		va := &modelVAs[i]
+		if va.Spec.TargetKvUtilization == "" {
+			continue
+		}
+		target, err := strconv.ParseFloat(va.Spec.TargetKvUtilization, 64)
+		if err == nil {
+			var candidate interfaces.SaturationScalingConfig
+			candidate, err = saturation.WithTargetKvUtilization(base, target)
+			if err == nil && (!derived || candidate.KvSpareTrigger > config.KvSpareTrigger) {
+				config = candidate
+				derived = true
+			}
+		}
+		if err != nil {
+			logger.Info("Ignoring invalid targetKvUtilization",
+				"variant", va.Name,
+				"namespace", va.Namespace,
+				"targetKvUtilization", va.Spec.TargetKvUtilization,
+				"error", err)
+		}
+	}
+
+	if derived {
+		logger.V(logging.DEBUG).Info("KV spare trigger derived from targetKvUtilization",
+			"modelID", modelVAs[0].Spec.ModelID,
+			"kvCacheThreshold", config.KvCacheThreshold,
+			"kvSpareTrigger", config.KvSpareTrigger)
+	}
+	return config
+}
+
 // RunSaturationAnalysis performs saturation analysis for a model and returns Saturation targets.
 func (e *Engine) RunSaturationAnalysis(
 	ctx context.Context,
@@ -507,11 +1175,12 @@ func (e *Engine) RunSaturationAnalysis(
 		return nil, nil, nil, fmt.Errorf("no VAs provided for model %s", modelID)
 	}
 
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 	namespace := modelVAs[0].Namespace // All VAs of same model are in same namespace
 
 	// Build variant costs map, deployments map, and VAs map for metrics collection
 	variantCosts := make(map[string]float64)
+	batchSizes := make(map[string]int)
 	deployments := make(map[string]*appsv1.Deployment)
 	variantAutoscalings := make(map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling)
 
@@ -531,24 +1200,35 @@ func (e *Engine) RunSaturationAnalysis(
 
 		// Parse variant cost
 		cost := saturation.DefaultVariantCost // default
-		if va.Spec.VariantCost != "" {
-			if parsedCost, err := strconv.ParseFloat(va.Spec.VariantCost, 64); err == nil {
-				cost = parsedCost
+		if parsedCost, ok, err := va.GetVariantCost(); ok && err == nil {
+			cost = parsedCost
+		}
+		// Prefer the price of the nodes the variant runs on when node label pricing is enabled.
+		// The collector logs lookup failures, rate-limited, and the configured cost is kept.
+		if e.NodeCostCollector != nil {
+			if nodeCost, ok, err := e.NodeCostCollector.VariantCost(ctx, &deploy); err == nil && ok {
+				cost = nodeCost
 			}
 		}
+		// Blend in the accelerator's energy factor when a carbon weight is configured
+		cost = saturation.EffectiveCost(cost, utils.GetAcceleratorType(va), SaturationConfig)
 
 		// Use deployment name as key (not VA name) since getExistingPods uses
 		// the key to build pod name regex filters for Prometheus queries
 		deployments[deploy.Name] = &deploy
 		variantAutoscalings[deploy.Name] = va
 		variantCosts[deploy.Name] = cost
+		if batchSize, ok := utils.DeploymentMaxBatchSize(&deploy); ok {
+			batchSizes[deploy.Name] = batchSize
+		}
 	}
 
 	// Collect Saturation metrics using source infrastructure
 	logger.V(logging.DEBUG).Info("Using source infrastructure for replica metrics",
 		"modelID", modelID,
 		"namespace", namespace)
-	replicaMetrics, err := e.ReplicaMetricsCollector.CollectReplicaMetrics(ctx, modelID, namespace, deployments, variantAutoscalings, variantCosts)
+	replicaMetrics, err := e.ReplicaMetricsCollector.CollectReplicaMetrics(ctx, modelID, namespace, deployments, variantAutoscalings, variantCosts,
+		SaturationConfig.OptionalReplicaMetrics())
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to collect Saturation metrics for model %s: %w", modelID, err)
 	}
@@ -567,8 +1247,18 @@ func (e *Engine) RunSaturationAnalysis(
 		return nil, nil, nil, nil // Return nil to signal skip due to metrics unavailable, not error
 	}
 
+	// Compare queue lengths relative to each variant's batch size when configured
+	if SaturationConfig.NormalizeQueueByBatchSize {
+		logger.V(logging.DEBUG).Info("Normalizing queue lengths by variant batch size",
+			"modelID", modelID,
+			"referenceBatchSize", SaturationConfig.MaxBatchSize,
+			"batchSizes", batchSizes)
+		replicaMetrics = saturation.NormalizeQueueLengths(replicaMetrics, batchSizes, SaturationConfig.MaxBatchSize)
+	}
+
 	// Analyze saturation across all variants
-	saturationAnalyzer := saturation.NewAnalyzer()
+	saturationAnalyzer := saturation.NewAnalyzerWithGoodputTracker(e.GoodputTracker).WithScaleDownStabilizer(e.ScaleDownStabilizer).
+		WithColdStartGrace(e.ColdStartGrace).WithQueueHistory(e.QueueHistory)
 	saturationAnalysis, err := saturationAnalyzer.AnalyzeModelSaturation(ctx, modelID, namespace, replicaMetrics, SaturationConfig)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to analyze Saturation for model %s: %w", modelID, err)
@@ -584,6 +1274,19 @@ func (e *Engine) RunSaturationAnalysis(
 	// Build variant states (current and desired replicas)
 	variantStates := e.BuildVariantStates(ctx, modelVAs, deployments, k8sClient)
 
+	// Handle variants with more ready replicas than spec replicas, e.g. during a rollback
+	saturation.ApplyExcessReadyPolicy(ctx, variantStates, SaturationConfig.ExcessReadyPolicy)
+
+	// Stop preserving a desired that actuation has not caught up with within staleDesiredTimeout
+	if SaturationConfig.StaleDesiredTimeout > 0 && e.StaleDesiredTracker != nil {
+		e.StaleDesiredTracker.DiscardStale(ctx, namespace, variantStates, SaturationConfig.StaleDesiredTimeout)
+	}
+
+	// Stop holding back scaling for replicas pending longer than maxPendingAge
+	if SaturationConfig.MaxPendingAge > 0 && e.PendingReplicaTracker != nil {
+		e.PendingReplicaTracker.MarkStuck(ctx, namespace, variantStates, SaturationConfig.MaxPendingAge)
+	}
+
 	// Calculate saturation-based targets
 	saturationTargets := saturationAnalyzer.CalculateSaturationTargets(ctx, saturationAnalysis, variantStates)
 
@@ -601,7 +1304,23 @@ func (e *Engine) applySaturationDecisions(
 	vaMap map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
 	currentAllocations map[string]*interfaces.Allocation,
 ) error {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	// Freeze all scaling while a maintenance window is active. Metrics are still collected and
+	// the held targets published, so they stay fresh for when the window closes.
+	var maintenance *interfaces.MaintenanceWindow
+	if e.Clock != nil {
+		maintenance = saturation.ActiveMaintenanceWindow(common.Config.GetMaintenanceWindows(), e.Clock.Now())
+	}
+	var maintenanceWindow string
+	if maintenance != nil {
+		maintenanceWindow = maintenance.Name
+		logger.Info("Maintenance window active, scaling is frozen",
+			"window", maintenance.Name,
+			"end", maintenance.End,
+			"reason", maintenance.Reason)
+	}
+
 	// Create a map of decisions for O(1) lookup
 	// Use namespace/variantName as key to match vaMap and avoid collisions
 	decisionMap := make(map[string]interfaces.VariantDecision)
@@ -627,6 +1346,15 @@ func (e *Engine) applySaturationDecisions(
 		// Fetch latest version from API server to avoid conflicts
 		var updateVa llmdVariantAutoscalingV1alpha1.VariantAutoscaling
 		if err := utils.GetVariantAutoscalingWithBackoff(ctx, e.client, va.Name, va.Namespace, &updateVa); err != nil {
+			if apierrors.IsNotFound(err) {
+				// The VA was deleted mid-cycle: drop its cached decision so nothing is applied for it
+				logger.V(logging.DEBUG).Info("VA deleted during optimization cycle, skipping",
+					"name", va.Name,
+					"namespace", va.Namespace)
+				common.DecisionCache.Delete(va.Name, va.Namespace)
+				e.forgetVariant(vaName, va)
+				continue
+			}
 			logger.Error(err, "Failed to get latest VA from API server",
 				"name", va.Name)
 			continue
@@ -643,6 +1371,13 @@ func (e *Engine) applySaturationDecisions(
 			// Now we just don't update status with it.
 		}
 
+		// Report series the collector could not attribute to pods, often the reason metrics are missing
+		var missingPodLabels []string
+		var labelDriftChecked bool
+		if e.LabelDriftTracker != nil {
+			missingPodLabels, labelDriftChecked = e.LabelDriftTracker.Drift(va.Namespace, updateVa.Spec.ModelID)
+		}
+
 		// Check if we have metrics data for this VA (used for cache below)
 		_, hasAllocation := currentAllocations[vaName]
 
@@ -650,23 +1385,95 @@ func (e *Engine) applySaturationDecisions(
 		var targetReplicas int
 		var acceleratorName string
 		var reason string
+		var reasonCode interfaces.ReasonCode
 
 		if hasDecision {
 			targetReplicas = decision.TargetReplicas
 			acceleratorName = decision.AcceleratorName
 			reason = decision.Reason
+			reasonCode = decision.ReasonCode
+
+			// Hold the desired replicas during maintenance; the rate limits are skipped so that
+			// the held target does not use up their budget
+			desired := decision.CurrentReplicas
+			if previous, ok := previousDesiredReplicas(updateVa.Status.DesiredOptimizedAlloc, decision.CurrentReplicas); ok {
+				desired = previous
+			}
+			if held, wasHeld := saturation.HoldForMaintenance(maintenance, targetReplicas, desired); wasHeld {
+				if held != targetReplicas {
+					logger.Info("Scaling held by maintenance window",
+						"variant", vaName,
+						"requested", targetReplicas,
+						"held", held,
+						"window", maintenance.Name)
+				}
+				targetReplicas = held
+				reason = fmt.Sprintf("held by maintenance window %s", maintenance.Name)
+				reasonCode = interfaces.ReasonCodeMaintenance
+				decision.Action = interfaces.ActionNoChange
+			}
+
+			// Likewise hold the desired replicas while the deployment rollout is paused
+			if held, wasHeld := saturation.HoldForPausedDeployment(decision.DeploymentPaused, targetReplicas, desired); wasHeld {
+				if held != targetReplicas {
+					logger.Info("Scaling held while deployment rollout is paused",
+						"variant", vaName,
+						"requested", targetReplicas,
+						"held", held)
+				}
+				targetReplicas = held
+				reason = "held while deployment rollout is paused"
+				reasonCode = interfaces.ReasonCodeDeploymentPaused
+				decision.Action = interfaces.ActionNoChange
+			}
+
+			// Enforce the per-variant scale-up rate. This is time based rather than
+			// cycle based, so a short polling interval cannot grow the variant faster.
+			// A preview does not scale, so it must not use up the rate budget.
+			if maxRate := updateVa.Spec.MaxScaleUpRate; maxRate != nil && !updateVa.IsPreview() && maintenance == nil && !decision.DeploymentPaused {
+				limited, wasLimited := e.ScaleRateLimiter.LimitScaleUp(vaName, decision.CurrentReplicas, targetReplicas, *maxRate)
+				if wasLimited {
+					logger.Info("Scale-up limited by maxScaleUpRate",
+						"variant", vaName,
+						"requested", targetReplicas,
+						"allowed", limited,
+						"maxScaleUpRate", *maxRate)
+					reason = fmt.Sprintf("%s (limited by maxScaleUpRate: %d replicas/min)", reason, *maxRate)
+					targetReplicas = limited
+				}
+			}
+			// Likewise drain the variant at most at its scale-down rate
+			if maxRate := updateVa.Spec.MaxScaleDownRate; maxRate != nil && !updateVa.IsPreview() && maintenance == nil && !decision.DeploymentPaused {
+				limited, wasLimited := e.ScaleRateLimiter.LimitScaleDown(vaName, decision.CurrentReplicas, targetReplicas, *maxRate)
+				if wasLimited {
+					logger.Info("Scale-down limited by maxScaleDownRate",
+						"variant", vaName,
+						"requested", targetReplicas,
+						"allowed", limited,
+						"maxScaleDownRate", *maxRate)
+					reason = fmt.Sprintf("%s (limited by maxScaleDownRate: %d replicas/min)", reason, *maxRate)
+					targetReplicas = limited
+				}
+			}
 		} else {
 			// No change/decision: Keep current target or default to current replicas
-			// We effectively explicitly "decide" to keep things as they are if no decision was made
-			if updateVa.Status.DesiredOptimizedAlloc.NumReplicas > 0 {
-				targetReplicas = updateVa.Status.DesiredOptimizedAlloc.NumReplicas
-			} else if curr, ok := currentAllocations[vaName]; ok {
-				targetReplicas = curr.NumReplicas
+			// We effectively explicitly "decide" to keep things as they are if no decision was made.
+			// A desired allocation of 0 replicas is kept while the variant is at zero, so a model
+			// scaled to zero keeps emitting an explicit 0.
+			curr, hasCurr := currentAllocations[vaName]
+			currentReplicas := 0
+			if hasCurr {
+				currentReplicas = curr.NumReplicas
+			}
+			if previous, ok := previousDesiredReplicas(updateVa.Status.DesiredOptimizedAlloc, currentReplicas); ok {
+				targetReplicas = previous
+			} else {
+				targetReplicas = currentReplicas
 			}
 			// Keep existing accelerator or use current
 			if updateVa.Status.DesiredOptimizedAlloc.Accelerator != "" {
 				acceleratorName = updateVa.Status.DesiredOptimizedAlloc.Accelerator
-			} else if curr, ok := currentAllocations[vaName]; ok {
+			} else if hasCurr {
 				acceleratorName = curr.Accelerator
 			}
 			reason = "No scaling decision (optimization loop)"
@@ -682,11 +1489,15 @@ func (e *Engine) applySaturationDecisions(
 			// TargetReplicas and AcceleratorName are left at zero values since we don't
 			// have enough information to set them.
 			common.DecisionCache.Set(va.Name, va.Namespace, interfaces.VariantDecision{
-				VariantName:      vaName,
-				Namespace:        va.Namespace,
-				MetricsAvailable: false,
-				MetricsReason:    MetricsReasonUnavailable,
-				MetricsMessage:   MetricsMessageUnavailable,
+				VariantName:       vaName,
+				Namespace:         va.Namespace,
+				TraceID:           logging.TraceIDFromContext(ctx),
+				MetricsAvailable:  false,
+				MetricsReason:     MetricsReasonUnavailable,
+				MetricsMessage:    MetricsMessageUnavailable,
+				LabelDriftChecked: labelDriftChecked,
+				MissingPodLabels:  missingPodLabels,
+				MaintenanceWindow: maintenanceWindow,
 			})
 			// Trigger reconciler to apply the condition
 			common.DecisionTrigger <- event.GenericEvent{
@@ -695,12 +1506,91 @@ func (e *Engine) applySaturationDecisions(
 			continue
 		}
 
+		// Determine MetricsAvailable status for the cache.
+		// - hasAllocation is true when we successfully collected current replica metrics
+		//   for this variant during this loop (metrics pipeline is working).
+		// - hasDecision is true when the optimizer produced a scaling decision based on
+		//   saturation metrics in this run.
+		// Either condition implies saturation metrics were available and usable.
+		metricsAvailable := hasAllocation || hasDecision
+		metricsReason := MetricsReasonUnavailable
+		metricsMessage := MetricsMessageUnavailable
+		if metricsAvailable {
+			metricsReason = MetricsReasonAvailable
+			metricsMessage = MetricsMessageAvailable
+		}
+
+		previousDesired := updateVa.Status.DesiredOptimizedAlloc.NumReplicas
+
+		// In preview mode the decision is only published: neither the desired allocation
+		// nor the sinks are updated, so nothing acts on it
+		if updateVa.IsPreview() {
+			action := decision.Action
+			if !hasDecision {
+				action = interfaces.ActionNoChange
+			}
+			preview, err := json.Marshal(interfaces.DecisionPreview{
+				TargetReplicas:  targetReplicas,
+				DesiredReplicas: previousDesired,
+				Accelerator:     acceleratorName,
+				Action:          action,
+				Reason:          reason,
+				ReasonCode:      reasonCode,
+				Time:            metav1.Now(),
+			})
+			if err != nil {
+				logger.Error(err, "Failed to serialize preview decision", "variant", vaName)
+				continue
+			}
+			common.DecisionCache.Set(va.Name, va.Namespace, interfaces.VariantDecision{
+				VariantName:             vaName,
+				Namespace:               va.Namespace,
+				TraceID:                 logging.TraceIDFromContext(ctx),
+				LastRunTime:             metav1.Now(),
+				MetricsCoverage:         decision.MetricsCoverage,
+				MinMetricsCoverage:      decision.MinMetricsCoverage,
+				PartialMetrics:          decision.PartialMetrics,
+				LabelDriftChecked:       labelDriftChecked,
+				MissingPodLabels:        missingPodLabels,
+				ObservedCycles:          decision.ObservedCycles,
+				MinObservationCycles:    decision.MinObservationCycles,
+				Observing:               decision.Observing,
+				MaintenanceWindow:       maintenanceWindow,
+				ErrorRate:               decision.ErrorRate,
+				ErrorRateThreshold:      decision.ErrorRateThreshold,
+				ElevatedErrorRate:       decision.ElevatedErrorRate,
+				MaxPendingAge:           decision.MaxPendingAge,
+				StuckPending:            decision.StuckPending,
+				DeploymentPaused:        decision.DeploymentPaused,
+				LimiterEnabled:          decision.LimiterEnabled,
+				NoInventory:             decision.NoInventory,
+				SaturationAnalysis:      decision.SaturationAnalysis,
+				ClearSaturationAnalysis: decision.ClearSaturationAnalysis,
+				CurrentAllocation:       currentAllocations[vaName],
+				MetricsAvailable:        metricsAvailable,
+				MetricsReason:           metricsReason,
+				MetricsMessage:          metricsMessage,
+				PreviewDecision:         string(preview),
+			})
+			common.DecisionTrigger <- event.GenericEvent{
+				Object: &updateVa,
+			}
+			logger.Info("Published preview decision for VA, not applied",
+				"variant", vaName,
+				"action", action,
+				"target", targetReplicas,
+				"desired", previousDesired)
+			continue
+		}
+
 		// Update DesiredOptimizedAlloc
 		// ALWAYS update LastRunTime to trigger reconciliation in the controller
 		updateVa.Status.DesiredOptimizedAlloc = llmdVariantAutoscalingV1alpha1.OptimizedAlloc{
 			NumReplicas: targetReplicas,
 			Accelerator: acceleratorName,
 			LastRunTime: metav1.Now(),
+			ReasonCode:  string(reasonCode),
+			Confidence:  llmdVariantAutoscalingV1alpha1.FormatConfidence(decision.Confidence),
 		}
 		updateVa.Status.Actuation.Applied = false // Reset applied status until Actuator handles it (if needed)
 
@@ -734,64 +1624,92 @@ func (e *Engine) applySaturationDecisions(
 				"Optimization loop ran (no scaling change needed)")
 		}
 
-		// Emit metrics for external autoscalers (Important: Actuator emits these)
-		// We should emit metrics even if no decision changed, to keep HPA alive
-		act := actuator.NewActuator(e.client)
-		/*
-		   NOTE: emitSafetyNetMetrics handles cases where optimization FAILS.
-		   Here we are in the success path (optimization ran, even if no change).
-		   We should ensure metrics are emitted for the External Scaler.
-		*/
-
-		// Ensure we have a valid SAT/Model decision "SaturationOnly" flag for metric emission context if needed
-		// For now we assume if no decision, it's not saturation-only forced override, just normal op.
-		// isSaturationOnly := false
-		// if hasDecision {
-		// 	isSaturationOnly = decision.SaturationOnly
-		// }
-
-		if err := act.EmitMetrics(ctx, &updateVa); err != nil {
+		// Publish the decision to all sinks (Prometheus metrics for external autoscalers, logs, ...).
+		// We emit even if no decision changed, to keep HPA alive.
+		sinkDecision := decision
+		sinkDecision.VariantName = va.GetScaleTargetName()
+		sinkDecision.Namespace = va.Namespace
+		sinkDecision.ModelID = updateVa.Spec.ModelID
+		sinkDecision.AcceleratorName = acceleratorName
+		sinkDecision.DesiredReplicas = previousDesired
+		sinkDecision.TargetReplicas = targetReplicas
+		sinkDecision.Reason = reason
+		sinkDecision.ReasonCode = reasonCode
+		sinkDecision.LastRunTime = updateVa.Status.DesiredOptimizedAlloc.LastRunTime
+		if !hasDecision {
+			sinkDecision.Action = interfaces.ActionNoChange
+			sinkDecision.RecommendedReplicas = targetReplicas
+		}
+		if err := e.MetricsSink.Emit(ctx, &updateVa, sinkDecision); err != nil {
 			logger.Error(err, "Failed to emit metrics for external autoscalers",
 				"variant", updateVa.Name)
 		} else {
-			// Only log detail if we had a decision or periodically (to avoid spamming logs on every loop for no-ops)
-			if hasDecision {
-				logger.Info("Successfully emitted metrics",
-					"variant", updateVa.Name,
-					"target", targetReplicas,
-					"accelerator", acceleratorName)
-			}
 			updateVa.Status.Actuation.Applied = true
 		}
+		if err := e.DecisionSinks.Emit(ctx, &updateVa, sinkDecision); err != nil {
+			logger.Error(err, "Failed to publish decision to one or more sinks",
+				"variant", updateVa.Name)
+		}
+
+		// Count reversals of the published desired replicas, so oscillating triggers are reported
+		var directionChanges int
+		var flapping bool
+		if hasDecision && decision.FlapWindow > 0 && e.FlapDetector != nil {
+			directionChanges = e.FlapDetector.Observe(vaName, targetReplicas, decision.FlapWindow)
+			flapping = saturation.Flapping(directionChanges, decision.FlapThreshold)
+			if flapping {
+				logger.Info("Variant is flapping, consider widening the gap between scale-up and scale-down triggers",
+					"variant", vaName,
+					"directionChanges", directionChanges,
+					"flapWindow", decision.FlapWindow)
+			}
+		}
+
+		// Start the model's cold start grace period on an applied scale-up
+		if hasDecision && decision.Action == interfaces.ActionScaleUp && targetReplicas > previousDesired && e.ColdStartGrace != nil {
+			e.ColdStartGrace.RecordScaleUp(va.Namespace, updateVa.Spec.ModelID)
+		}
 
 		// Update Shared State and Trigger Reconcile via Channel
 		// This avoids any API server interaction from the Engine.
 
 		// 1. Update Cache
-		// Determine MetricsAvailable status for the cache.
-		// - hasAllocation is true when we successfully collected current replica metrics
-		//   for this variant during this loop (metrics pipeline is working).
-		// - hasDecision is true when the optimizer produced a scaling decision based on
-		//   saturation metrics in this run.
-		// Either condition implies saturation metrics were available and usable.
-		metricsAvailable := hasAllocation || hasDecision
-		metricsReason := MetricsReasonUnavailable
-		metricsMessage := MetricsMessageUnavailable
-		if metricsAvailable {
-			metricsReason = MetricsReasonAvailable
-			metricsMessage = MetricsMessageAvailable
-		}
-
 		common.DecisionCache.Set(va.Name, va.Namespace, interfaces.VariantDecision{
-			VariantName:       vaName,
-			Namespace:         va.Namespace,
-			TargetReplicas:    targetReplicas,
-			AcceleratorName:   acceleratorName,
-			LastRunTime:       metav1.Now(),
-			CurrentAllocation: currentAllocations[vaName],
-			MetricsAvailable:  metricsAvailable,
-			MetricsReason:     metricsReason,
-			MetricsMessage:    metricsMessage,
+			VariantName:             vaName,
+			Namespace:               va.Namespace,
+			TraceID:                 logging.TraceIDFromContext(ctx),
+			TargetReplicas:          targetReplicas,
+			AcceleratorName:         acceleratorName,
+			ReasonCode:              reasonCode,
+			LastRunTime:             metav1.Now(),
+			MetricsCoverage:         decision.MetricsCoverage,
+			MinMetricsCoverage:      decision.MinMetricsCoverage,
+			PartialMetrics:          decision.PartialMetrics,
+			LabelDriftChecked:       labelDriftChecked,
+			MissingPodLabels:        missingPodLabels,
+			ObservedCycles:          decision.ObservedCycles,
+			MinObservationCycles:    decision.MinObservationCycles,
+			Observing:               decision.Observing,
+			MaintenanceWindow:       maintenanceWindow,
+			Confidence:              decision.Confidence,
+			ErrorRate:               decision.ErrorRate,
+			ErrorRateThreshold:      decision.ErrorRateThreshold,
+			ElevatedErrorRate:       decision.ElevatedErrorRate,
+			MaxPendingAge:           decision.MaxPendingAge,
+			StuckPending:            decision.StuckPending,
+			FlapWindow:              decision.FlapWindow,
+			FlapThreshold:           decision.FlapThreshold,
+			DirectionChanges:        directionChanges,
+			Flapping:                flapping,
+			DeploymentPaused:        decision.DeploymentPaused,
+			LimiterEnabled:          decision.LimiterEnabled,
+			NoInventory:             decision.NoInventory,
+			SaturationAnalysis:      decision.SaturationAnalysis,
+			ClearSaturationAnalysis: decision.ClearSaturationAnalysis,
+			CurrentAllocation:       currentAllocations[vaName],
+			MetricsAvailable:        metricsAvailable,
+			MetricsReason:           metricsReason,
+			MetricsMessage:          metricsMessage,
 		})
 
 		// 2. Trigger Reconciler
@@ -804,20 +1722,75 @@ func (e *Engine) applySaturationDecisions(
 				"variant", vaName,
 				"action", decision.Action,
 				"target", targetReplicas,
-				"reason", reason)
+				"reason", reason,
+				"reasonCode", reasonCode)
 		}
 	}
 
 	return nil
 }
 
+// handleAnalysisFailure reacts to a failed saturation analysis of a model. By default the safety net
+// emits fallback metrics so the HPA keeps its last target. With the safety net disabled the failure
+// is reported on the model's VAs instead, and they are dropped from vaMap so nothing is emitted for them.
+func (e *Engine) handleAnalysisFailure(
+	ctx context.Context,
+	modelVAs []llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+	analysisErr error,
+	vaMap map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+	currentAllocations map[string]*interfaces.Allocation,
+) {
+	if !e.DisableSafetyNet {
+		// Activate safety net to ensure HPA doesn't scale to zero on partial failure
+		e.emitSafetyNetMetrics(ctx, modelVAs, currentAllocations)
+		return
+	}
+
+	// Make the failure visible instead of masking it with fallback metrics
+	e.markOptimizationFailed(ctx, modelVAs, analysisErr)
+	for _, va := range modelVAs {
+		delete(vaMap, getVariantKey(va.Namespace, va.GetScaleTargetName()))
+	}
+}
+
+// markOptimizationFailed records a failed analysis for each of the model's VAs so the controller sets
+// the OptimizationReady condition to OptimizationFailed. Used instead of the safety net when it is
+// disabled; no target or metrics are emitted for the VAs.
+func (e *Engine) markOptimizationFailed(
+	ctx context.Context,
+	modelVAs []llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+	analysisErr error,
+) {
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	for i := range modelVAs {
+		va := &modelVAs[i]
+		common.DecisionCache.Set(va.Name, va.Namespace, interfaces.VariantDecision{
+			VariantName:         getVariantKey(va.Namespace, va.GetScaleTargetName()),
+			Namespace:           va.Namespace,
+			TraceID:             logging.TraceIDFromContext(ctx),
+			ModelID:             va.Spec.ModelID,
+			OptimizationFailed:  true,
+			OptimizationMessage: fmt.Sprintf("Saturation analysis failed: %v", analysisErr),
+			LastRunTime:         metav1.Now(),
+		})
+		common.DecisionTrigger <- event.GenericEvent{
+			Object: va,
+		}
+
+		logger.Info("Safety net disabled: marked optimization as failed, no metrics emitted",
+			"variant", va.Name,
+			"namespace", va.Namespace)
+	}
+}
+
 // emitSafetyNetMetrics emits fallback metrics when saturation analysis fails.
 func (e *Engine) emitSafetyNetMetrics(
 	ctx context.Context,
 	modelVAs []llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
 	currentAllocations map[string]*interfaces.Allocation,
 ) {
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 	act := actuator.NewActuator(e.client)
 
 	for _, va := range modelVAs {
@@ -835,14 +1808,21 @@ func (e *Engine) emitSafetyNetMetrics(
 			}
 		}
 
-		// Strategy 1: Use previous desired replicas if available
-		if va.Status.DesiredOptimizedAlloc.NumReplicas > 0 {
-			desiredReplicas = int32(va.Status.DesiredOptimizedAlloc.NumReplicas)
+		// Strategy 1: Use previous desired replicas if available, including 0 while scaled to zero
+		if previous, ok := previousDesiredReplicas(va.Status.DesiredOptimizedAlloc, int(currentReplicas)); ok {
+			desiredReplicas = int32(previous)
 			fallbackSource = "previous-desired"
 		} else {
 			desiredReplicas = currentReplicas
 			fallbackSource = "current-replicas"
 		}
+		// Publish the fallback through the same commit delay as the metrics sink, so that a
+		// failed analysis does not bypass a change that is being held back
+		if e.CommitDelay != nil {
+			commit := e.CommitDelay.Commit(sinks.CommitKey(va.Namespace, va.Name), int(desiredReplicas),
+				string(interfaces.ReasonCodeNoAnalysis))
+			desiredReplicas = int32(commit.Replicas)
+		}
 
 		// Determine accelerator - try status first, then labels, skip if unavailable
 		// TODO: remove this checks when we will move to a new version of the CRD
@@ -855,7 +1835,7 @@ func (e *Engine) emitSafetyNetMetrics(
 		}
 		if accelerator == "" {
 			// Try to get from VA labels as last resort
-			if val, ok := va.Labels["inference.optimization/acceleratorName"]; ok && val != "" {
+			if val := utils.GetAcceleratorType(&va); val != "" {
 				accelerator = val
 			}
 		}
@@ -878,6 +1858,13 @@ func (e *Engine) emitSafetyNetMetrics(
 			continue
 		}
 
+		act.EmitLinkedMetrics(ctx, &va, desiredReplicas, accelerator)
+		// Without an analysis there is nothing to trust the fallback by
+		if err := act.MetricsEmitter.EmitDecisionConfidence(ctx, &va, 0, accelerator); err != nil {
+			logger.V(logging.DEBUG).Info("Safety net: failed to emit decision confidence",
+				"variant", va.Name, "error", err)
+		}
+
 		logger.Info("Safety net activated: emitted fallback metrics",
 			"variant", va.Name,
 			"currentReplicas", currentReplicas,
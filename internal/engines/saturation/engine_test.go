@@ -18,25 +18,37 @@ package saturation
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	prom "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source/prometheus"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/discovery"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/common"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/pipeline"
 	interfaces "github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/saturation"
 	utils "github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 	testutils "github.com/llm-d-incubation/workload-variant-autoscaler/test/utils"
 )
@@ -423,6 +435,209 @@ data:
 		})
 	})
 
+	Context("applySaturationDecisions with a VA deleted mid-cycle", func() {
+		BeforeEach(func() {
+			logging.NewTestLogger()
+		})
+
+		It("should skip the deleted VA without error and drop its cached decision", func() {
+			By("Creating a VA as it was listed at the start of the cycle")
+			va := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "deleted-mid-cycle", Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: "deleted-mid-cycle",
+					},
+					ModelID: "default/default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, va)).To(Succeed())
+			listed := va.DeepCopy()
+
+			common.DecisionCache.Set(va.Name, va.Namespace, interfaces.VariantDecision{
+				VariantName:    va.Name,
+				Namespace:      va.Namespace,
+				TargetReplicas: 2,
+			})
+
+			By("Deleting the VA before decisions are applied")
+			Expect(k8sClient.Delete(ctx, va)).To(Succeed())
+
+			sourceRegistry := source.NewSourceRegistry()
+			sourceRegistry.Register("prometheus", source.NewNoOpSource()) // nolint:errcheck
+			engine := NewEngine(k8sClient, k8sClient.Scheme(), nil, sourceRegistry)
+
+			vaMap := map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				getVariantKey(listed.Namespace, listed.GetScaleTargetName()): listed,
+			}
+			decisions := []interfaces.VariantDecision{{
+				VariantName:    listed.GetScaleTargetName(),
+				Namespace:      listed.Namespace,
+				TargetReplicas: 3,
+				Action:         interfaces.ActionScaleUp,
+			}}
+
+			By("Applying decisions")
+			Expect(engine.applySaturationDecisions(ctx, decisions, vaMap, map[string]*interfaces.Allocation{})).To(Succeed())
+
+			By("Verifying the cached decision was removed")
+			_, found := common.DecisionCache.Get(listed.Name, listed.Namespace)
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("applySaturationDecisions with a VA in preview mode", func() {
+		BeforeEach(func() {
+			logging.NewTestLogger()
+		})
+
+		It("should publish the decision without changing desired replicas", func() {
+			registry := prom.NewRegistry()
+			Expect(metrics.InitMetrics(registry)).To(Succeed())
+
+			By("Creating a VA in preview mode with 2 desired replicas")
+			va := &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "preview-va",
+					Namespace:   "default",
+					Annotations: map[string]string{llmdVariantAutoscalingV1alpha1.PreviewAnnotation: "true"},
+				},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: "preview-va",
+					},
+					ModelID: "default/default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, va)).To(Succeed())
+			va.Status.DesiredOptimizedAlloc = llmdVariantAutoscalingV1alpha1.OptimizedAlloc{
+				Accelerator: "A100",
+				NumReplicas: 2,
+				LastRunTime: metav1.Now(),
+			}
+			Expect(k8sClient.Status().Update(ctx, va)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, va)).To(Succeed())
+				common.DecisionCache.Delete(va.Name, va.Namespace)
+			})
+
+			sourceRegistry := source.NewSourceRegistry()
+			sourceRegistry.Register("prometheus", source.NewNoOpSource()) // nolint:errcheck
+			engine := NewEngine(k8sClient, k8sClient.Scheme(), nil, sourceRegistry)
+
+			vaMap := map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				getVariantKey(va.Namespace, va.GetScaleTargetName()): va,
+			}
+			decisions := []interfaces.VariantDecision{{
+				VariantName:     va.GetScaleTargetName(),
+				Namespace:       va.Namespace,
+				AcceleratorName: "A100",
+				CurrentReplicas: 2,
+				TargetReplicas:  4,
+				Action:          interfaces.ActionScaleUp,
+				ReasonCode:      interfaces.ReasonCodeKvSpareLow,
+			}}
+
+			By("Applying decisions")
+			Expect(engine.applySaturationDecisions(ctx, decisions, vaMap, map[string]*interfaces.Allocation{})).To(Succeed())
+
+			By("Verifying the preview holds the decision")
+			cached, found := common.DecisionCache.Get(va.Name, va.Namespace)
+			Expect(found).To(BeTrue())
+			var preview interfaces.DecisionPreview
+			Expect(json.Unmarshal([]byte(cached.PreviewDecision), &preview)).To(Succeed())
+			Expect(preview.TargetReplicas).To(Equal(4))
+			Expect(preview.DesiredReplicas).To(Equal(2))
+			Expect(preview.Action).To(Equal(interfaces.ActionScaleUp))
+			Expect(preview.ReasonCode).To(Equal(interfaces.ReasonCodeKvSpareLow))
+
+			By("Verifying nothing acts on the decision")
+			Expect(cached.AcceleratorName).To(BeEmpty(), "the controller must not update the desired allocation")
+			families, err := registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			for _, family := range families {
+				if family.GetName() == constants.WVADesiredReplicas {
+					Expect(family.GetMetric()).To(BeEmpty())
+				}
+			}
+		})
+	})
+
+	Context("Analysis failure with and without the safety net", func() {
+		var (
+			registry           *prom.Registry
+			engine             *Engine
+			va                 llmdVariantAutoscalingV1alpha1.VariantAutoscaling
+			vaMap              map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling
+			currentAllocations map[string]*interfaces.Allocation
+			analysisErr        error
+		)
+
+		BeforeEach(func() {
+			logging.NewTestLogger()
+			registry = prom.NewRegistry()
+			Expect(metrics.InitMetrics(registry)).To(Succeed())
+
+			sourceRegistry := source.NewSourceRegistry()
+			sourceRegistry.Register("prometheus", source.NewNoOpSource()) // nolint:errcheck
+			engine = NewEngine(k8sClient, k8sClient.Scheme(), nil, sourceRegistry)
+
+			va = llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "failing-analysis", Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+						Kind: "Deployment",
+						Name: "failing-analysis",
+					},
+					ModelID: "default/default",
+				},
+			}
+			vaMap = map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				getVariantKey(va.Namespace, va.GetScaleTargetName()): &va,
+			}
+			// No Deployment exists, so the safety net falls back to the collected allocation
+			currentAllocations = map[string]*interfaces.Allocation{
+				va.GetScaleTargetName(): {NumReplicas: 2, Accelerator: "A100"},
+			}
+			analysisErr = fmt.Errorf("simulated metrics collection failure")
+			common.DecisionCache.Delete(va.Name, va.Namespace)
+		})
+
+		desiredReplicasEmitted := func() bool {
+			families, err := registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			for _, family := range families {
+				if family.GetName() == constants.WVADesiredReplicas {
+					return len(family.GetMetric()) > 0
+				}
+			}
+			return false
+		}
+
+		It("should emit fallback metrics when the safety net is enabled", func() {
+			engine.handleAnalysisFailure(ctx, []llmdVariantAutoscalingV1alpha1.VariantAutoscaling{va}, analysisErr, vaMap, currentAllocations)
+
+			Expect(desiredReplicasEmitted()).To(BeTrue())
+			Expect(vaMap).To(HaveLen(1))
+			_, found := common.DecisionCache.Get(va.Name, va.Namespace)
+			Expect(found).To(BeFalse())
+		})
+
+		It("should mark optimization as failed and emit nothing when the safety net is disabled", func() {
+			engine.DisableSafetyNet = true
+			engine.handleAnalysisFailure(ctx, []llmdVariantAutoscalingV1alpha1.VariantAutoscaling{va}, analysisErr, vaMap, currentAllocations)
+
+			Expect(desiredReplicasEmitted()).To(BeFalse())
+			Expect(vaMap).To(BeEmpty())
+			decision, found := common.DecisionCache.Get(va.Name, va.Namespace)
+			Expect(found).To(BeTrue())
+			Expect(decision.OptimizationFailed).To(BeTrue())
+			Expect(decision.OptimizationMessage).To(ContainSubstring("simulated metrics collection failure"))
+		})
+	})
+
 	Context("convertSaturationTargetsToDecisions", func() {
 		BeforeEach(func() {
 			logging.NewTestLogger()
@@ -472,6 +687,78 @@ data:
 			Expect(decisionMap["variant-b"].Action).To(Equal(interfaces.ActionScaleUp))
 			Expect(decisionMap["variant-c"].Action).To(Equal(interfaces.ActionNoChange))
 		})
+
+		It("should carry the analyzer reason codes onto decisions", func() {
+			saturationTargets := map[string]int{
+				"variant-a": 4,
+				"variant-b": 2,
+			}
+
+			saturationAnalysis := &interfaces.ModelSaturationAnalysis{
+				ModelID:   "test-model",
+				Namespace: "test-ns",
+				VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+					{VariantName: "variant-a", AcceleratorName: "A100", Cost: 5.0},
+					{VariantName: "variant-b", AcceleratorName: "A100", Cost: 10.0},
+				},
+				TargetReasonCodes: map[string]interfaces.ReasonCode{
+					"variant-a": interfaces.ReasonCodeQueueSpareLow,
+				},
+			}
+
+			variantStates := []interfaces.VariantReplicaState{
+				{VariantName: "variant-a", CurrentReplicas: 3},
+				{VariantName: "variant-b", CurrentReplicas: 2},
+			}
+
+			sourceRegistry := source.NewSourceRegistry()
+			sourceRegistry.Register("prometheus", source.NewNoOpSource()) // nolint:errcheck
+			engine := NewEngine(k8sClient, k8sClient.Scheme(), nil, sourceRegistry)
+			decisions := engine.convertSaturationTargetsToDecisions(context.Background(), saturationTargets, saturationAnalysis, variantStates)
+
+			decisionMap := make(map[string]interfaces.VariantDecision)
+			for _, d := range decisions {
+				decisionMap[d.VariantName] = d
+			}
+
+			Expect(decisionMap["variant-a"].ReasonCode).To(Equal(interfaces.ReasonCodeQueueSpareLow))
+			By("Defaulting variants without a recorded code to Steady")
+			Expect(decisionMap["variant-b"].ReasonCode).To(Equal(interfaces.ReasonCodeSteady))
+		})
+
+		It("should mark decisions of variants whose deployment rollout is paused", func() {
+			saturationTargets := map[string]int{
+				"variant-a": 2,
+				"variant-b": 3,
+			}
+
+			saturationAnalysis := &interfaces.ModelSaturationAnalysis{
+				ModelID:   "test-model",
+				Namespace: "test-ns",
+				VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+					{VariantName: "variant-a", AcceleratorName: "A100", Cost: 5.0},
+					{VariantName: "variant-b", AcceleratorName: "A100", Cost: 10.0},
+				},
+			}
+
+			variantStates := []interfaces.VariantReplicaState{
+				{VariantName: "variant-a", CurrentReplicas: 2, Paused: true},
+				{VariantName: "variant-b", CurrentReplicas: 2},
+			}
+
+			sourceRegistry := source.NewSourceRegistry()
+			sourceRegistry.Register("prometheus", source.NewNoOpSource()) // nolint:errcheck
+			engine := NewEngine(k8sClient, k8sClient.Scheme(), nil, sourceRegistry)
+			decisions := engine.convertSaturationTargetsToDecisions(context.Background(), saturationTargets, saturationAnalysis, variantStates)
+
+			decisionMap := make(map[string]interfaces.VariantDecision)
+			for _, d := range decisions {
+				decisionMap[d.VariantName] = d
+			}
+
+			Expect(decisionMap["variant-a"].DeploymentPaused).To(BeTrue())
+			Expect(decisionMap["variant-b"].DeploymentPaused).To(BeFalse())
+		})
 	})
 
 	Context("Source Infrastructure Optimization Tests", func() {
@@ -660,4 +947,379 @@ data:
 
 	})
 
+	Context("Last optimization timestamp", func() {
+		It("should advance the gauge after each successful cycle", func() {
+			registry := prom.NewRegistry()
+			Expect(metrics.InitMetrics(registry)).To(Succeed())
+
+			engine := &Engine{MetricsEmitter: metrics.NewMetricsEmitter()}
+			models := []optimizedModel{{modelID: "default/default", namespace: "default"}}
+
+			timestamp := func() float64 {
+				families, err := registry.Gather()
+				Expect(err).NotTo(HaveOccurred())
+				for _, family := range families {
+					if family.GetName() == constants.WVALastOptimizationTimestamp {
+						return family.GetMetric()[0].GetGauge().GetValue()
+					}
+				}
+				return 0
+			}
+
+			engine.recordOptimizedModels(ctx, models)
+			first := timestamp()
+			Expect(first).To(BeNumerically(">", 0))
+
+			time.Sleep(10 * time.Millisecond)
+			engine.recordOptimizedModels(ctx, models)
+			Expect(timestamp()).To(BeNumerically(">", first))
+		})
+	})
+
+	Context("previousDesiredReplicas", func() {
+		It("should keep a previous 0 only while the variant is at zero replicas", func() {
+			cases := []struct {
+				alloc    llmdVariantAutoscalingV1alpha1.OptimizedAlloc
+				current  int
+				expected int
+				ok       bool
+			}{
+				{alloc: llmdVariantAutoscalingV1alpha1.OptimizedAlloc{Accelerator: "H100", NumReplicas: 3}, current: 2, expected: 3, ok: true},
+				{alloc: llmdVariantAutoscalingV1alpha1.OptimizedAlloc{Accelerator: "H100", NumReplicas: 0}, current: 0, expected: 0, ok: true},
+				// A stale 0 from an earlier scale-to-zero must not scale a serving variant down
+				{alloc: llmdVariantAutoscalingV1alpha1.OptimizedAlloc{Accelerator: "H100", NumReplicas: 0}, current: 2, ok: false},
+				{alloc: llmdVariantAutoscalingV1alpha1.OptimizedAlloc{}, current: 2, ok: false},
+			}
+			for _, c := range cases {
+				desired, ok := previousDesiredReplicas(c.alloc, c.current)
+				Expect(ok).To(Equal(c.ok), "alloc %+v, current %d", c.alloc, c.current)
+				Expect(desired).To(Equal(c.expected), "alloc %+v, current %d", c.alloc, c.current)
+			}
+		})
+	})
+
+	Context("Inventory-derived max replicas", func() {
+		It("should cap targets at the accelerator inventory and track inventory changes", func() {
+			registry := prom.NewRegistry()
+			Expect(metrics.InitMetrics(registry)).To(Succeed())
+
+			fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+			nodeInventory := map[string]map[string]discovery.AcceleratorModelInfo{
+				"node-1": {"NVIDIA-H100-SXM5-80GB": {Count: 8}},
+			}
+			engine := &Engine{
+				MetricsEmitter: metrics.NewMetricsEmitter(),
+				InventoryCap: pipeline.NewInventoryCap(fakeClock, time.Minute,
+					func(ctx context.Context) (map[string]map[string]discovery.AcceleratorModelInfo, error) {
+						return nodeInventory, nil
+					}),
+			}
+
+			capGauge := func() float64 {
+				families, err := registry.Gather()
+				Expect(err).NotTo(HaveOccurred())
+				for _, family := range families {
+					if family.GetName() == constants.WVAMaxReplicasCap {
+						return family.GetMetric()[0].GetGauge().GetValue()
+					}
+				}
+				return -1
+			}
+			decide := func(target int) interfaces.VariantDecision {
+				decisions := []interfaces.VariantDecision{{
+					VariantName:     "llama-h100",
+					Namespace:       "default",
+					AcceleratorName: "H100",
+					GPUsPerReplica:  2,
+					CurrentReplicas: 2,
+					TargetReplicas:  target,
+					Action:          interfaces.ActionScaleUp,
+				}}
+				engine.applyInventoryCap(ctx, decisions, time.Minute)
+				return decisions[0]
+			}
+
+			// 8 H100s with 2 GPUs per replica hold at most 4 replicas
+			decision := decide(6)
+			Expect(decision.TargetReplicas).To(Equal(4))
+			Expect(decision.ReasonCode).To(Equal(interfaces.ReasonCodeInventoryCap))
+			Expect(capGauge()).To(Equal(4.0))
+
+			// Targets within the cap pass through
+			decision = decide(3)
+			Expect(decision.TargetReplicas).To(Equal(3))
+			Expect(decision.DecisionSteps).To(BeEmpty())
+
+			// A second node shows up in the inventory after the refresh interval
+			nodeInventory["node-2"] = map[string]discovery.AcceleratorModelInfo{"NVIDIA-H100-SXM5-80GB": {Count: 8}}
+			fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+			decision = decide(10)
+			Expect(decision.TargetReplicas).To(Equal(8))
+			Expect(capGauge()).To(Equal(8.0))
+
+			// The node goes away again, and the target is scaled back to the cap
+			delete(nodeInventory, "node-2")
+			fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+			decision = decide(6)
+			Expect(decision.TargetReplicas).To(Equal(4))
+			Expect(capGauge()).To(Equal(4.0))
+		})
+
+		It("should never cap below the current replicas or scale-down floor", func() {
+			engine := &Engine{
+				InventoryCap: pipeline.NewInventoryCap(clocktesting.NewFakePassiveClock(time.Now()), time.Minute,
+					func(ctx context.Context) (map[string]map[string]discovery.AcceleratorModelInfo, error) {
+						return map[string]map[string]discovery.AcceleratorModelInfo{
+							"node-1": {"NVIDIA-H100-SXM5-80GB": {Count: 8}},
+						}, nil
+					}),
+			}
+			decisions := []interfaces.VariantDecision{
+				// 8 H100s with 2 GPUs per replica hold at most 4 replicas
+				{VariantName: "above-cap", AcceleratorName: "H100", GPUsPerReplica: 2,
+					CurrentReplicas: 6, TargetReplicas: 7, Action: interfaces.ActionScaleUp},
+				{VariantName: "floor-above-cap", AcceleratorName: "H100", GPUsPerReplica: 2,
+					CurrentReplicas: 3, ScaleDownFloor: 5, TargetReplicas: 7, Action: interfaces.ActionScaleUp},
+				{VariantName: "scale-down-above-cap", AcceleratorName: "H100", GPUsPerReplica: 2,
+					CurrentReplicas: 6, TargetReplicas: 5, Action: interfaces.ActionScaleDown},
+			}
+			engine.applyInventoryCap(ctx, decisions, time.Minute)
+
+			Expect(decisions[0].TargetReplicas).To(Equal(6))
+			Expect(decisions[0].Action).To(Equal(interfaces.ActionNoChange))
+			Expect(decisions[0].ReasonCode).To(Equal(interfaces.ReasonCodeInventoryCap))
+			Expect(decisions[1].TargetReplicas).To(Equal(5))
+			Expect(decisions[1].Action).To(Equal(interfaces.ActionScaleUp))
+			Expect(decisions[2].TargetReplicas).To(Equal(5))
+			Expect(decisions[2].Action).To(Equal(interfaces.ActionScaleDown))
+			Expect(decisions[2].DecisionSteps).To(BeEmpty())
+		})
+
+		It("should not cap a variant when not even one replica fits the inventory", func() {
+			engine := &Engine{
+				InventoryCap: pipeline.NewInventoryCap(clocktesting.NewFakePassiveClock(time.Now()), time.Minute,
+					func(ctx context.Context) (map[string]map[string]discovery.AcceleratorModelInfo, error) {
+						return map[string]map[string]discovery.AcceleratorModelInfo{
+							"node-1": {"NVIDIA-H100-SXM5-80GB": {Count: 4}},
+						}, nil
+					}),
+			}
+			decisions := []interfaces.VariantDecision{{
+				VariantName:     "llama-h100",
+				AcceleratorName: "H100",
+				GPUsPerReplica:  8,
+				CurrentReplicas: 2,
+				TargetReplicas:  3,
+				Action:          interfaces.ActionScaleUp,
+			}}
+			engine.applyInventoryCap(ctx, decisions, time.Minute)
+
+			Expect(decisions[0].TargetReplicas).To(Equal(3))
+			Expect(decisions[0].Action).To(Equal(interfaces.ActionScaleUp))
+			Expect(decisions[0].DecisionSteps).To(BeEmpty())
+		})
+	})
+
+	Context("Anti-affinity aware scale-up", func() {
+		It("should flag scale-up beyond the schedulable nodes instead of emitting it", func() {
+			gpuNode := func(name string) *v1.Node {
+				return &v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   name,
+						Labels: map[string]string{"nvidia.com/gpu.product": "NVIDIA-H100-SXM5-80GB"},
+					},
+					Status: v1.NodeStatus{
+						Allocatable: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+						Conditions:  []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+					},
+				}
+			}
+			deployment := func(name string, oneReplicaPerNode bool) *appsv1.Deployment {
+				podLabels := map[string]string{"app": name}
+				deploy := &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec: appsv1.DeploymentSpec{
+						Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+						Template: v1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: podLabels}},
+					},
+				}
+				if oneReplicaPerNode {
+					deploy.Spec.Template.Spec.Affinity = &v1.Affinity{PodAntiAffinity: &v1.PodAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{{
+							LabelSelector: &metav1.LabelSelector{MatchLabels: podLabels},
+							TopologyKey:   v1.LabelHostname,
+						}},
+					}}
+				}
+				return deploy
+			}
+			scaleUp := func(name string, current, target int) interfaces.VariantDecision {
+				return interfaces.VariantDecision{
+					VariantName:     name,
+					Namespace:       "default",
+					AcceleratorName: "H100",
+					GPUsPerReplica:  1,
+					CurrentReplicas: current,
+					TargetReplicas:  target,
+					Action:          interfaces.ActionScaleUp,
+				}
+			}
+
+			// Two nodes are left, and both already run a replica of the spread variant
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				gpuNode("node-1"), gpuNode("node-2"),
+				deployment("llama-spread", true), deployment("llama-packed", false),
+			).Build()
+			engine := &Engine{client: fakeClient}
+
+			decisions := []interfaces.VariantDecision{
+				scaleUp("llama-spread", 2, 3),
+				scaleUp("llama-packed", 2, 3),
+			}
+			engine.applyAntiAffinityLimit(ctx, decisions)
+
+			Expect(decisions[0].TargetReplicas).To(Equal(2))
+			Expect(decisions[0].Action).To(Equal(interfaces.ActionNoChange))
+			Expect(decisions[0].ReasonCode).To(Equal(interfaces.ReasonCodeUnschedulable))
+
+			// Variants without one-replica-per-node anti-affinity are not limited
+			Expect(decisions[1].TargetReplicas).To(Equal(3))
+			Expect(decisions[1].DecisionSteps).To(BeEmpty())
+
+			// With a node added, the spread variant can scale up again, up to the node count
+			Expect(fakeClient.Create(ctx, gpuNode("node-3"))).To(Succeed())
+			decisions = []interfaces.VariantDecision{scaleUp("llama-spread", 2, 5)}
+			engine.applyAntiAffinityLimit(ctx, decisions)
+			Expect(decisions[0].TargetReplicas).To(Equal(3))
+			Expect(decisions[0].Action).To(Equal(interfaces.ActionScaleUp))
+			Expect(decisions[0].ReasonCode).To(Equal(interfaces.ReasonCodeUnschedulable))
+		})
+	})
+
+	Context("Saturation analysis export", func() {
+		It("should attach the analysis to the first variant by name only when enabled", func() {
+			analysis := &interfaces.ModelSaturationAnalysis{
+				ModelID:       "test-model",
+				Namespace:     "default",
+				TotalReplicas: 3,
+				ShouldScaleUp: true,
+				VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+					{VariantName: "llama-b", ReplicaCount: 2, SaturatedReplicas: []string{"llama-b-1"}},
+					{VariantName: "llama-a", ReplicaCount: 1, SaturatedReplicas: []string{}},
+				},
+			}
+			decisions := []interfaces.VariantDecision{{VariantName: "llama-b"}, {VariantName: "llama-a"}}
+
+			engine := &Engine{}
+			engine.attachAnalysisExport(ctx, analysis, decisions)
+			Expect(decisions[0].SaturationAnalysis).To(BeEmpty())
+			Expect(decisions[1].SaturationAnalysis).To(BeEmpty())
+
+			engine.AnalysisExport = saturation.AnalysisExportFull
+			engine.attachAnalysisExport(ctx, analysis, decisions)
+			Expect(decisions[0].SaturationAnalysis).To(BeEmpty())
+			Expect(decisions[0].ClearSaturationAnalysis).To(BeTrue())
+			Expect(decisions[1].ClearSaturationAnalysis).To(BeFalse())
+
+			var exported interfaces.ModelSaturationAnalysis
+			Expect(json.Unmarshal([]byte(decisions[1].SaturationAnalysis), &exported)).To(Succeed())
+			Expect(exported.AnalyzedAt.Equal(analysis.AnalyzedAt)).To(BeTrue())
+			exported.AnalyzedAt = analysis.AnalyzedAt
+			Expect(exported).To(Equal(*analysis))
+		})
+	})
+
+	Context("Scale target provider", func() {
+		It("should build variant states from the scale target provider", func() {
+			deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "llama-a100", Namespace: "default"}}
+			va := llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "llama-a100", Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-a100"},
+				},
+			}
+			va.Status.DesiredOptimizedAlloc.NumReplicas = 4
+
+			engine := &Engine{
+				ScaleTargets: func(_ client.Client, d *appsv1.Deployment) interfaces.ScaleTargetProvider {
+					Expect(d).To(BeIdenticalTo(deploy))
+					return &fakeScaleTarget{replicas: 3, readyReplicas: 1}
+				},
+			}
+			states := engine.BuildVariantStates(ctx, []llmdVariantAutoscalingV1alpha1.VariantAutoscaling{va},
+				map[string]*appsv1.Deployment{"llama-a100": deploy}, nil)
+
+			Expect(states).To(Equal([]interfaces.VariantReplicaState{{
+				VariantName:     "llama-a100",
+				CurrentReplicas: 3,
+				DesiredReplicas: 4,
+				PendingReplicas: 2,
+				GPUsPerReplica:  1,
+			}}))
+		})
+
+		It("should record ready replicas exceeding current replicas", func() {
+			deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "llama-a100", Namespace: "default"}}
+			va := llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "llama-a100", Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-a100"},
+				},
+			}
+			engine := &Engine{
+				ScaleTargets: func(client.Client, *appsv1.Deployment) interfaces.ScaleTargetProvider {
+					return &fakeScaleTarget{replicas: 2, readyReplicas: 3}
+				},
+			}
+			states := engine.BuildVariantStates(ctx, []llmdVariantAutoscalingV1alpha1.VariantAutoscaling{va},
+				map[string]*appsv1.Deployment{"llama-a100": deploy}, nil)
+
+			Expect(states).To(HaveLen(1))
+			Expect(states[0].CurrentReplicas).To(Equal(2))
+			Expect(states[0].PendingReplicas).To(Equal(0))
+			Expect(states[0].ExcessReadyReplicas).To(Equal(1))
+		})
+
+		It("should skip variants whose replicas cannot be read", func() {
+			deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "llama-a100", Namespace: "default"}}
+			va := llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+				ObjectMeta: metav1.ObjectMeta{Name: "llama-a100", Namespace: "default"},
+				Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-a100"},
+				},
+			}
+			engine := &Engine{
+				ScaleTargets: func(client.Client, *appsv1.Deployment) interfaces.ScaleTargetProvider {
+					return &fakeScaleTarget{err: fmt.Errorf("scale subresource unavailable")}
+				},
+			}
+			states := engine.BuildVariantStates(ctx, []llmdVariantAutoscalingV1alpha1.VariantAutoscaling{va},
+				map[string]*appsv1.Deployment{"llama-a100": deploy}, nil)
+			Expect(states).To(BeEmpty())
+		})
+	})
+
 })
+
+// fakeScaleTarget is a ScaleTargetProvider with fixed replica counts
+type fakeScaleTarget struct {
+	replicas      int
+	readyReplicas int
+	err           error
+}
+
+func (f *fakeScaleTarget) GetReplicas(context.Context) (int, error) {
+	return f.replicas, f.err
+}
+
+func (f *fakeScaleTarget) GetReadyReplicas(context.Context) (int, error) {
+	return f.readyReplicas, f.err
+}
+
+func (f *fakeScaleTarget) SetReplicas(_ context.Context, replicas int32) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.replicas = int(replicas)
+	return nil
+}
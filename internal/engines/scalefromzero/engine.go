@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 	"sync"
 	"time"
 
@@ -29,20 +28,23 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/env"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/controller-runtime/pkg/event"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	wvav1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/actuator"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/datastore"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/common"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/executor"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/saturation"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 	poolutil "github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils/pool"
 )
@@ -55,16 +57,40 @@ const (
 	targetEPPMetricName               = "inference_extension_flow_control_queue_size"
 	targetEPPMetricLabel              = "target_model_name"
 	scaleFromZeroEngineMaxConcurrency = "SCALE_FROM_ZERO_ENGINE_MAX_CONCURRENCY"
+	scaleFromZeroMinRequestRate       = "SCALE_FROM_ZERO_MIN_REQUEST_RATE"
+	scaleFromZeroRateWindow           = "SCALE_FROM_ZERO_RATE_WINDOW"
+	defaultScaleFromZeroRateWindow    = 10 * time.Second
+	scaleFromZeroRecoverWhenDisabled  = "SCALE_FROM_ZERO_RECOVER_WHEN_DISABLED"
+	MetricsReasonRecovered            = "RecoveredFromZero"
+	MetricsMessageRecovered           = "Scaled back from zero replicas because scale-to-zero is disabled"
+	recoveredReason                   = "scalefromzero mode: zero replicas with scale-to-zero disabled - scale-up to minimum"
 )
 
+// TargetScaler sets the replicas of a scale target.
+type TargetScaler interface {
+	ScaleTargetObject(ctx context.Context, scaledObject *unstructured.Unstructured, replicas int32) error
+}
+
 type Engine struct {
 	client         client.Client
 	executor       executor.Executor
 	Datastore      datastore.Datastore
 	DynamicClient  dynamic.Interface
-	Actuator       *actuator.DirectActuator
+	Actuator       TargetScaler
 	Mapper         meta.RESTMapper
 	maxConcurrency int
+
+	// recoverZeroReplicas scales VAs with zero replicas whose model has scale-to-zero disabled
+	// back to their minimum, instead of leaving them to wait for pending requests.
+	recoverZeroReplicas bool
+
+	// rateTracker requires a sustained request arrival rate before waking a model.
+	// nil disables the floor, so any pending request wakes the model.
+	rateTracker *arrivalRateTracker
+
+	// clock tells whether a maintenance window is active, which freezes scale-up from zero like
+	// any other scaling. nil ignores maintenance windows.
+	clock clock.PassiveClock
 }
 
 // NewEngine creates a new instance of the scale-from-zero engine.
@@ -75,6 +101,30 @@ func NewEngine(client client.Client, mapper meta.RESTMapper, config *rest.Config
 		return nil, fmt.Errorf("invalid value for %s: expected integer: %w", scaleFromZeroEngineMaxConcurrency, err)
 	}
 
+	minRequestRate, err := env.GetFloat64(scaleFromZeroMinRequestRate, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s: expected number: %w", scaleFromZeroMinRequestRate, err)
+	}
+	if minRequestRate < 0 {
+		return nil, fmt.Errorf("invalid value for %s: must be >= 0, got %v", scaleFromZeroMinRequestRate, minRequestRate)
+	}
+
+	rateWindow := defaultScaleFromZeroRateWindow
+	if val := env.GetString(scaleFromZeroRateWindow, ""); val != "" {
+		rateWindow, err = time.ParseDuration(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: expected duration: %w", scaleFromZeroRateWindow, err)
+		}
+		if rateWindow <= 0 {
+			return nil, fmt.Errorf("invalid value for %s: must be > 0, got %s", scaleFromZeroRateWindow, rateWindow)
+		}
+	}
+
+	recoverZeroReplicas, err := env.GetBool(scaleFromZeroRecoverWhenDisabled, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s: expected boolean: %w", scaleFromZeroRecoverWhenDisabled, err)
+	}
+
 	dynamicClient, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -92,6 +142,12 @@ func NewEngine(client client.Client, mapper meta.RESTMapper, config *rest.Config
 		Actuator:       actuator,
 		Mapper:         mapper,
 		maxConcurrency: maxConcurrency,
+
+		recoverZeroReplicas: recoverZeroReplicas,
+		clock:               clock.RealClock{},
+	}
+	if minRequestRate > 0 {
+		engine.rateTracker = newArrivalRateTracker(clock.RealClock{}, rateWindow, minRequestRate)
 	}
 
 	// TODO: replace by an hybrid, polling and reactive executor when available
@@ -99,8 +155,9 @@ func NewEngine(client client.Client, mapper meta.RESTMapper, config *rest.Config
 		Config: executor.Config{
 			OptimizeFunc: engine.optimize,
 		},
-		Interval:     100 * time.Millisecond, // frequent polling to quickly detect scale-from-zero opportunities
-		RetryBackoff: 100 * time.Millisecond,
+		Interval:       100 * time.Millisecond, // frequent polling to quickly detect scale-from-zero opportunities
+		RetryBackoff:   100 * time.Millisecond,
+		MetricsEmitter: metrics.NewMetricsEmitter(),
 	})
 
 	return &engine, nil
@@ -114,7 +171,7 @@ func (e *Engine) StartOptimizeLoop(ctx context.Context) {
 
 // optimize performs the optimization logic.
 func (e *Engine) optimize(ctx context.Context) error {
-	logger := log.FromContext(ctx)
+	logger := logging.FromContext(ctx, logging.Engine)
 
 	// Get all inactive (replicas == 0) VAs
 	inactiveVAs, err := utils.InactiveVariantAutoscaling(ctx, e.client)
@@ -124,6 +181,23 @@ func (e *Engine) optimize(ctx context.Context) error {
 
 	logger.V(logging.DEBUG).Info("Found inactive VariantAutoscaling resources", "count", len(inactiveVAs))
 
+	// Drop arrival history of models that are no longer scaled to zero
+	inactiveKeys := make(map[string]bool, len(inactiveVAs))
+	for _, va := range inactiveVAs {
+		inactiveKeys[rateTrackerKey(va)] = true
+	}
+	e.rateTracker.Retain(inactiveKeys)
+
+	// Resolve scale-to-zero over all variants of a model, as the saturation engine does
+	var modelVAs map[string][]wvav1alpha1.VariantAutoscaling
+	if e.recoverZeroReplicas {
+		var vaList wvav1alpha1.VariantAutoscalingList
+		if err := e.client.List(ctx, &vaList); err != nil {
+			return fmt.Errorf("failed to list VariantAutoscalings: %w", err)
+		}
+		modelVAs = utils.GroupVariantAutoscalingByModel(vaList.Items)
+	}
+
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, e.maxConcurrency)
 	errorCh := make(chan error, e.maxConcurrency)
@@ -156,18 +230,18 @@ variantLoop:
 
 		// This call blocks if the channel is full (concurrency limit reached)
 		sem <- struct{}{}
-		go func(variant wvav1alpha1.VariantAutoscaling) {
+		go func(variant wvav1alpha1.VariantAutoscaling, siblings []wvav1alpha1.VariantAutoscaling) {
 			defer wg.Done()
 			defer func() { <-sem }()
 
-			err := e.processInactiveVariant(ctx, variant, 1)
+			err := e.processInactiveVariant(ctx, variant, siblings, 1)
 			if err != nil {
 				logger.V(logging.DEBUG).Error(err, "Error Processing variant", "name", variant.Name)
 				errorCh <- err
 			} else {
 				errorCh <- nil
 			}
-		}(va)
+		}(va, modelVAs[va.Spec.ModelID+"|"+va.Namespace])
 	}
 
 	// Wait for all goroutines to complete, then close error channel
@@ -188,9 +262,20 @@ variantLoop:
 	return nil
 }
 
-// ProcessInactiveVariant processes a single inactive VariantAutoscaling resource.
-func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.VariantAutoscaling, targetWorkloadReplicas int) error {
-	logger := log.FromContext(ctx)
+// ProcessInactiveVariant processes a single inactive VariantAutoscaling resource. modelVAs holds
+// all VAs of its model, for resolving scale-to-zero; it may be empty when recovery is disabled.
+func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.VariantAutoscaling, modelVAs []wvav1alpha1.VariantAutoscaling, targetWorkloadReplicas int) error {
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	// Scaling is frozen while a maintenance window is active, waking models from zero included
+	if e.clock != nil {
+		if window := saturation.ActiveMaintenanceWindow(common.Config.GetMaintenanceWindows(), e.clock.Now()); window != nil {
+			logger.V(logging.DEBUG).Info("Maintenance window active - skipping scaling up from zero",
+				"variant", va.Name, "window", window.Name, "end", window.End)
+			return nil
+		}
+	}
+
 	objAPI := va.GetScaleTargetAPI()
 	objKind := va.GetScaleTargetKind()
 	objName := va.GetScaleTargetName()
@@ -206,6 +291,12 @@ func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.Vari
 		return err
 	}
 
+	// WVA never scales a model to zero while scale-to-zero is disabled, so zero replicas were
+	// set by hand; bring the target back without waiting for pending requests
+	if e.recoverZeroReplicas && !scaleToZeroEnabled(ctx, va, modelVAs) {
+		return e.recoverFromZero(ctx, va, unstructuredObj)
+	}
+
 	// Extract Labels for the pods created by the ScaleTarget object
 	labels, found, err := unstructured.NestedStringMap(unstructuredObj.Object, "spec", "template", "metadata", "labels")
 	if err != nil {
@@ -243,25 +334,38 @@ func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.Vari
 
 	// Check for pending requests using EPP flowcontrol queue size metrics
 	result := results["all_metrics"]
-	pendingRequestExist := false
+	var queueSize float64
 	for _, value := range result.Values {
 		metricName := value.Labels["__name__"]
 		if metricName == targetEPPMetricName && value.Value > 0 {
 			if value.Labels[targetEPPMetricLabel] == va.Spec.ModelID {
-				logger.Info(
-					"Target workload has pending requests, scaling up from zero", "metricName", metricName,
-					"metric", value.Labels, "value", value.Value)
-				pendingRequestExist = true
-				break
+				queueSize += value.Value
 			}
 		}
 	}
 
-	if !pendingRequestExist {
+	if queueSize == 0 {
+		e.rateTracker.Observe(rateTrackerKey(va), queueSize)
 		logger.V(logging.DEBUG).Info("No pending requests found in the flowcontrol queue - skipping scaling up from zero")
 		return nil
 	}
 
+	// Require sustained traffic so that a lone probe request doesn't trigger a cold start
+	rate, sustained := e.rateTracker.Observe(rateTrackerKey(va), queueSize)
+	if !sustained {
+		logger.V(logging.DEBUG).Info("Pending requests below the scale-from-zero request rate floor - skipping scaling up from zero",
+			"variant", va.Name, "queueSize", queueSize, "arrivalRate", rate)
+		return nil
+	}
+	logger.Info("Target workload has pending requests, scaling up from zero",
+		"variant", va.Name, "metricName", targetEPPMetricName, "queueSize", queueSize, "arrivalRate", rate)
+
+	// A VA in preview mode is never scaled
+	if va.IsPreview() {
+		logger.Info("Variant is in preview mode - skipping scaling up from zero", "variant", va.Name)
+		return nil
+	}
+
 	// 1.  Scale up from zero to one
 	// TODO: Right now we are scaling all the VA for the same target model. We need to scale only the VA that has the lowest cost.
 	err = e.Actuator.ScaleTargetObject(ctx, unstructuredObj, int32(targetWorkloadReplicas))
@@ -270,6 +374,43 @@ func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.Vari
 		return err
 	}
 	logger.Info("Successfully scaled up Target Workload", "variant", va.Name, "target VA model", va.Spec.ModelID, "inferencepool", pool.EndpointPicker.ServiceName)
+	e.rateTracker.Forget(rateTrackerKey(va))
+
+	return e.publishScaleUp(ctx, va, targetWorkloadReplicas, reason, MetricsReasonAvailable, MetricsMessageAvailable, false)
+}
+
+// recoverFromZero scales a VA whose model has scale-to-zero disabled back from zero replicas to its
+// minimum: spec.scaleDownFloor when set, otherwise 1.
+func (e *Engine) recoverFromZero(ctx context.Context, va wvav1alpha1.VariantAutoscaling, unstructuredObj *unstructured.Unstructured) error {
+	logger := logging.FromContext(ctx, logging.Engine)
+
+	// A VA in preview mode is never scaled
+	if va.IsPreview() {
+		logger.Info("Variant is in preview mode - skipping recovery from zero replicas", "variant", va.Name)
+		return nil
+	}
+
+	minReplicas := 1
+	if floor := va.Spec.ScaleDownFloor; floor != nil && *floor > 1 {
+		minReplicas = int(*floor)
+	}
+	logger.Info("Target workload has zero replicas but scale-to-zero is disabled, scaling back to the minimum",
+		"variant", va.Name, "target VA model", va.Spec.ModelID, "minReplicas", minReplicas)
+
+	if err := e.Actuator.ScaleTargetObject(ctx, unstructuredObj, int32(minReplicas)); err != nil {
+		logger.Error(err, "Error scaling up Target Workload", "variant", va.Name, "target VA model", va.Spec.ModelID)
+		return err
+	}
+	e.rateTracker.Forget(rateTrackerKey(va))
+
+	return e.publishScaleUp(ctx, va, minReplicas, recoveredReason, MetricsReasonRecovered, MetricsMessageRecovered, true)
+}
+
+// publishScaleUp records a scale-up from zero applied to va in the decision cache and triggers
+// the reconciler to update the VA status.
+func (e *Engine) publishScaleUp(ctx context.Context, va wvav1alpha1.VariantAutoscaling, targetWorkloadReplicas int,
+	reason, metricsReason, metricsMessage string, recovered bool) error {
+	logger := logging.FromContext(ctx, logging.Engine)
 
 	// 2. Create or update VariantDecision
 	va.Status.Actuation.Applied = false
@@ -278,23 +419,30 @@ func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.Vari
 	accelerator = va.Status.DesiredOptimizedAlloc.Accelerator
 	if accelerator == "" {
 		// Try to get from VA labels as last resort
-		if val, ok := va.Labels["inference.optimization/acceleratorName"]; ok && val != "" {
-			accelerator = val
+		if val := utils.GetAcceleratorType(&va); val != "" {
+			accelerator = common.Config.GetAcceleratorAliases().Normalize(val)
 		}
 	}
 
 	decision, hasDecision := common.DecisionCache.Get(va.Name, va.Namespace)
 	if !hasDecision {
-		cost, err := strconv.ParseFloat(va.Spec.VariantCost, 64)
+		cost, ok, err := va.GetVariantCost()
 		if err != nil {
 			return err
 		}
+		if !ok {
+			cost = saturation.DefaultVariantCost
+			logger.V(logging.DEBUG).Info("Variant has no cost set, using the default cost",
+				"variant", va.Name,
+				"namespace", va.Namespace,
+				"cost", cost)
+		}
 		common.DecisionCache.Set(va.Name, va.Namespace, interfaces.VariantDecision{
 			VariantName:        va.Name,
 			Namespace:          va.Namespace,
 			ModelID:            va.Spec.ModelID,
 			Cost:               cost,
-			TargetReplicas:     targetWorkloadReplicas, // Scale up to 1 replica
+			TargetReplicas:     targetWorkloadReplicas,
 			CurrentReplicas:    targetWorkloadReplicas,
 			DesiredReplicas:    targetWorkloadReplicas,
 			LastRunTime:        metav1.Now(),
@@ -304,11 +452,13 @@ func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.Vari
 			AcceleratorName:    accelerator,
 			Reason:             reason, // Reason for scaling up
 			MetricsAvailable:   true,
-			MetricsReason:      MetricsReasonAvailable,
-			MetricsMessage:     MetricsMessageAvailable,
+			MetricsReason:      metricsReason,
+			MetricsMessage:     metricsMessage,
+			RecoveredFromZero:  recovered,
 		})
 	} else {
-		if decision.CurrentReplicas == 0 {
+		// A recovered target was zeroed by hand, so its cached decision is stale whatever it says
+		if decision.CurrentReplicas == 0 || recovered {
 			decision.TargetReplicas = targetWorkloadReplicas
 			decision.CurrentReplicas = targetWorkloadReplicas
 			decision.DesiredReplicas = targetWorkloadReplicas
@@ -319,8 +469,9 @@ func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.Vari
 			decision.Reason = reason
 			decision.AcceleratorName = accelerator
 			decision.MetricsAvailable = true
-			decision.MetricsReason = MetricsReasonAvailable
-			decision.MetricsMessage = MetricsMessageAvailable
+			decision.MetricsReason = metricsReason
+			decision.MetricsMessage = metricsMessage
+			decision.RecoveredFromZero = recovered
 			common.DecisionCache.Set(va.Name, va.Namespace, decision)
 		} else {
 			logger.Info("Target variant decision.CurrentReplicas is not zero", "value", decision.CurrentReplicas)
@@ -350,3 +501,19 @@ func (e *Engine) processInactiveVariant(ctx context.Context, va wvav1alpha1.Vari
 
 	return nil
 }
+
+// rateTrackerKey returns the arrival rate tracker key for a VA.
+func rateTrackerKey(va wvav1alpha1.VariantAutoscaling) string {
+	return va.Namespace + "/" + va.Name
+}
+
+// scaleToZeroEnabled reports whether scale-to-zero is enabled for the VA's model, resolved with
+// common.ModelScaleToZeroConfig over the spec.scaleToZero of all the model's VAs (modelVAs, or
+// va alone when empty) on top of the scale-to-zero ConfigMap.
+func scaleToZeroEnabled(ctx context.Context, va wvav1alpha1.VariantAutoscaling, modelVAs []wvav1alpha1.VariantAutoscaling) bool {
+	if len(modelVAs) == 0 {
+		modelVAs = []wvav1alpha1.VariantAutoscaling{va}
+	}
+	configData := common.ModelScaleToZeroConfig(ctx, common.Config.GetScaleToZeroConfig(), va.Spec.ModelID, modelVAs)
+	return config.IsScaleToZeroEnabled(configData, va.Spec.ModelID)
+}
@@ -19,20 +19,27 @@ package scalefromzero
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsV1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 
 	vav1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
 	poolreconciler "github.com/llm-d-incubation/workload-variant-autoscaler/internal/controller"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/datastore"
+	enginecommon "github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/common"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/saturation"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
 	unittestutil "github.com/llm-d-incubation/workload-variant-autoscaler/test/utils"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
@@ -324,3 +331,173 @@ func TestEmptyInactiveVariants(t *testing.T) {
 	err := engine.optimize(ctx)
 	assert.NoError(t, err, "Should not error when no inactive VAs exist")
 }
+
+// recordingScaler records the replicas each scale target was scaled to.
+type recordingScaler struct {
+	replicas map[string]int32
+}
+
+func (s *recordingScaler) ScaleTargetObject(_ context.Context, scaledObject *unstructured.Unstructured, replicas int32) error {
+	s.replicas[scaledObject.GetName()] = replicas
+	return nil
+}
+
+func TestRecoverFromZeroWithScaleToZeroDisabled(t *testing.T) {
+	t.Setenv("WVA_SCALE_TO_ZERO", "false")
+
+	tests := []struct {
+		name         string
+		recover      bool
+		scaleToZero  *bool
+		sibling      *bool
+		floor        *int32
+		wantReplicas int32
+		wantScaled   bool
+	}{
+		{name: "scaled back to one replica", recover: true, wantReplicas: 1, wantScaled: true},
+		{name: "scaled back to the scale-down floor", recover: true, floor: ptr.To(int32(2)), wantReplicas: 2, wantScaled: true},
+		{name: "left at zero when scale-to-zero is enabled", recover: true, scaleToZero: ptr.To(true)},
+		{name: "left at zero when recovery is disabled", recover: false},
+		// Scale-to-zero is resolved for the whole model: a variant disabling it wins
+		{name: "scaled back when another variant of the model disables scale-to-zero", recover: true,
+			scaleToZero: ptr.To(true), sibling: ptr.To(false), wantReplicas: 1, wantScaled: true},
+		{name: "left at zero when another variant of the model enables scale-to-zero", recover: true,
+			scaleToZero: ptr.To(true), sibling: ptr.To(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			va := unittestutil.CreateVariantAutoscalingResource(namespace, resourceName, deploymentName, modelId, acceleratorName, variantCost)
+			va.Spec.ScaleDownFloor = tt.floor
+			if tt.scaleToZero != nil {
+				va.Spec.ScaleToZero = &vav1alpha1.ScaleToZeroSpec{Enabled: tt.scaleToZero}
+			}
+			dp := unittestutil.MakeDeployment(deploymentName, namespace, 0, selector_v1)
+			objects := []client.Object{dp, va}
+			if tt.sibling != nil {
+				// Another variant of the model, whose scale target does not exist
+				sibling := unittestutil.CreateVariantAutoscalingResource(namespace, resourceName+"-sibling", deploymentName+"-sibling", modelId, acceleratorName, variantCost)
+				sibling.Spec.ScaleToZero = &vav1alpha1.ScaleToZeroSpec{Enabled: tt.sibling}
+				objects = append(objects, sibling)
+			}
+
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = vav1alpha1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			scaler := &recordingScaler{replicas: map[string]int32{}}
+			engine := &Engine{
+				client:              fakeClient,
+				Datastore:           datastore.NewDatastore(),
+				DynamicClient:       dynamicfake.NewSimpleDynamicClient(scheme, dp),
+				Actuator:            scaler,
+				Mapper:              testrestmapper.TestOnlyStaticRESTMapper(scheme, schema.GroupVersion{Group: "apps", Version: "v1"}),
+				maxConcurrency:      30,
+				recoverZeroReplicas: tt.recover,
+			}
+			t.Cleanup(func() { enginecommon.DecisionCache.Delete(resourceName, namespace) })
+
+			require.NoError(t, engine.optimize(context.Background()))
+
+			replicas, scaled := scaler.replicas[deploymentName]
+			require.Equal(t, tt.wantScaled, scaled)
+			if !tt.wantScaled {
+				return
+			}
+			assert.Equal(t, tt.wantReplicas, replicas)
+
+			decision, ok := enginecommon.DecisionCache.Get(resourceName, namespace)
+			require.True(t, ok, "expected a decision for the recovered VA")
+			assert.True(t, decision.RecoveredFromZero)
+			assert.Equal(t, int(tt.wantReplicas), decision.TargetReplicas)
+			<-enginecommon.DecisionTrigger
+		})
+	}
+}
+
+func TestNoScaleFromZeroDuringMaintenanceWindow(t *testing.T) {
+	t.Setenv("WVA_SCALE_TO_ZERO", "false")
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	enginecommon.Config.UpdateMaintenanceWindows([]interfaces.MaintenanceWindow{{
+		Name:  "upgrade",
+		Start: now.Add(-time.Hour).Format(time.RFC3339),
+		End:   now.Add(time.Hour).Format(time.RFC3339),
+	}})
+	t.Cleanup(func() { enginecommon.Config.UpdateMaintenanceWindows(nil) })
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		wantScaled bool
+	}{
+		{name: "held inside the window", now: now},
+		{name: "recovered after the window", now: now.Add(2 * time.Hour), wantScaled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			va := unittestutil.CreateVariantAutoscalingResource(namespace, resourceName, deploymentName, modelId, acceleratorName, variantCost)
+			dp := unittestutil.MakeDeployment(deploymentName, namespace, 0, selector_v1)
+
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = vav1alpha1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dp, va).Build()
+
+			scaler := &recordingScaler{replicas: map[string]int32{}}
+			engine := &Engine{
+				client:              fakeClient,
+				Datastore:           datastore.NewDatastore(),
+				DynamicClient:       dynamicfake.NewSimpleDynamicClient(scheme, dp),
+				Actuator:            scaler,
+				Mapper:              testrestmapper.TestOnlyStaticRESTMapper(scheme, schema.GroupVersion{Group: "apps", Version: "v1"}),
+				maxConcurrency:      30,
+				recoverZeroReplicas: true,
+				clock:               clocktesting.NewFakePassiveClock(tt.now),
+			}
+			t.Cleanup(func() { enginecommon.DecisionCache.Delete(resourceName, namespace) })
+
+			require.NoError(t, engine.optimize(context.Background()))
+
+			_, scaled := scaler.replicas[deploymentName]
+			require.Equal(t, tt.wantScaled, scaled)
+			if scaled {
+				<-enginecommon.DecisionTrigger
+			}
+		})
+	}
+}
+
+func TestRecoverFromZeroWithoutVariantCost(t *testing.T) {
+	t.Setenv("WVA_SCALE_TO_ZERO", "false")
+
+	va := unittestutil.CreateVariantAutoscalingResource(namespace, resourceName, deploymentName, modelId, acceleratorName, variantCost)
+	va.Spec.Cost = nil
+	va.Spec.VariantCost = ""
+	dp := unittestutil.MakeDeployment(deploymentName, namespace, 0, selector_v1)
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = vav1alpha1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dp, va).Build()
+
+	engine := &Engine{
+		client:              fakeClient,
+		Datastore:           datastore.NewDatastore(),
+		DynamicClient:       dynamicfake.NewSimpleDynamicClient(scheme, dp),
+		Actuator:            &recordingScaler{replicas: map[string]int32{}},
+		Mapper:              testrestmapper.TestOnlyStaticRESTMapper(scheme, schema.GroupVersion{Group: "apps", Version: "v1"}),
+		maxConcurrency:      30,
+		recoverZeroReplicas: true,
+	}
+	t.Cleanup(func() { enginecommon.DecisionCache.Delete(resourceName, namespace) })
+
+	require.NoError(t, engine.optimize(context.Background()))
+
+	decision, ok := enginecommon.DecisionCache.Get(resourceName, namespace)
+	require.True(t, ok, "expected a decision for the recovered VA")
+	assert.Equal(t, saturation.DefaultVariantCost, decision.Cost)
+	<-enginecommon.DecisionTrigger
+}
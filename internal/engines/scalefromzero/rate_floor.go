@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The llm-d Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalefromzero
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// queueSample is a flow control queue size observed at a point in time.
+type queueSample struct {
+	at   time.Time
+	size float64
+}
+
+// arrivalRateTracker estimates the request arrival rate of scaled-to-zero models from the EPP
+// flow control queue size. While a model has no replicas nothing drains the queue, so each
+// increase in queue size is a new arrival. A model only counts as woken once the arrival rate
+// over a full window reaches the floor, so a lone health-check or probe request sitting in the
+// queue does not trigger a cold start.
+type arrivalRateTracker struct {
+	mu      sync.Mutex
+	clock   clock.PassiveClock
+	window  time.Duration
+	minRate float64 // requests per second
+	samples map[string][]queueSample
+}
+
+// newArrivalRateTracker creates a tracker requiring minRate requests/sec sustained over window.
+func newArrivalRateTracker(clk clock.PassiveClock, window time.Duration, minRate float64) *arrivalRateTracker {
+	return &arrivalRateTracker{
+		clock:   clk,
+		window:  window,
+		minRate: minRate,
+		samples: make(map[string][]queueSample),
+	}
+}
+
+// Observe records the queue size for key and returns the arrival rate over the window and
+// whether it is sustained at or above the floor. A nil tracker has no floor: any pending
+// request counts as sustained.
+func (t *arrivalRateTracker) Observe(key string, queueSize float64) (float64, bool) {
+	if t == nil {
+		return 0, queueSize > 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	samples := append(t.samples[key], queueSample{at: now, size: queueSize})
+
+	// Keep the newest sample at or before the window start as the baseline for the first delta
+	start := now.Add(-t.window)
+	first := 0
+	for i := range samples {
+		if samples[i].at.After(start) {
+			break
+		}
+		first = i
+	}
+	samples = samples[first:]
+	t.samples[key] = samples
+
+	// Not enough history yet to judge whether traffic is sustained
+	if now.Sub(samples[0].at) < t.window {
+		return 0, false
+	}
+
+	var arrivals float64
+	for i := 1; i < len(samples); i++ {
+		if delta := samples[i].size - samples[i-1].size; delta > 0 {
+			arrivals += delta
+		}
+	}
+	rate := arrivals / t.window.Seconds()
+	return rate, queueSize > 0 && rate >= t.minRate
+}
+
+// Forget drops the history for key, e.g. once the model has been scaled up.
+func (t *arrivalRateTracker) Forget(key string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.samples, key)
+}
+
+// Retain drops the history of every key not in keys, e.g. models that are no longer scaled to zero.
+func (t *arrivalRateTracker) Retain(keys map[string]bool) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key := range t.samples {
+		if !keys[key] {
+			delete(t.samples, key)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The llm-d Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalefromzero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+const pollInterval = 100 * time.Millisecond
+
+// poll observes queue sizes for key at the engine's polling interval and reports whether
+// any observation was sustained.
+func poll(clk *clocktesting.FakePassiveClock, tracker *arrivalRateTracker, key string, sizes []float64) bool {
+	woken := false
+	for _, size := range sizes {
+		clk.SetTime(clk.Now().Add(pollInterval))
+		if _, sustained := tracker.Observe(key, size); sustained {
+			woken = true
+		}
+	}
+	return woken
+}
+
+// repeat returns n observations of the same queue size.
+func repeat(size float64, n int) []float64 {
+	sizes := make([]float64, n)
+	for i := range sizes {
+		sizes[i] = size
+	}
+	return sizes
+}
+
+func TestArrivalRateTracker_LoneProbeDoesNotWake(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	tracker := newArrivalRateTracker(clk, 10*time.Second, 0.5)
+
+	// Idle, then a single probe request that stays queued while the model has no replicas
+	assert.False(t, poll(clk, tracker, "ns/va", repeat(0, 50)))
+	assert.False(t, poll(clk, tracker, "ns/va", repeat(1, 300)), "a lone probe should not wake the model")
+}
+
+func TestArrivalRateTracker_SustainedTrafficWakes(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	tracker := newArrivalRateTracker(clk, 10*time.Second, 0.5)
+
+	// One new request per second queues up
+	sizes := repeat(0, 10)
+	for i := 1; i <= 15; i++ {
+		sizes = append(sizes, repeat(float64(i), 10)...)
+	}
+
+	assert.False(t, poll(clk, tracker, "ns/va", sizes[:50]), "should wait for a full window")
+	assert.True(t, poll(clk, tracker, "ns/va", sizes[50:]), "sustained traffic should wake the model")
+
+	rate, _ := tracker.Observe("ns/va", 15)
+	assert.InDelta(t, 1.0, rate, 0.11)
+}
+
+func TestArrivalRateTracker_KeysAreIndependent(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	tracker := newArrivalRateTracker(clk, time.Second, 5)
+
+	for i := 0; i <= 20; i++ {
+		clk.SetTime(clk.Now().Add(pollInterval))
+		tracker.Observe("ns/busy", float64(i))
+		tracker.Observe("ns/probed", 1)
+	}
+	_, busy := tracker.Observe("ns/busy", 21)
+	_, probed := tracker.Observe("ns/probed", 1)
+	assert.True(t, busy)
+	assert.False(t, probed)
+
+	tracker.Forget("ns/busy")
+	_, busy = tracker.Observe("ns/busy", 22)
+	assert.False(t, busy, "history should restart after Forget")
+
+	tracker.Retain(map[string]bool{"ns/busy": true})
+	assert.NotContains(t, tracker.samples, "ns/probed")
+}
+
+func TestArrivalRateTracker_NilTrackerHasNoFloor(t *testing.T) {
+	var tracker *arrivalRateTracker
+
+	_, sustained := tracker.Observe("ns/va", 1)
+	assert.True(t, sustained)
+	_, sustained = tracker.Observe("ns/va", 0)
+	assert.False(t, sustained)
+}
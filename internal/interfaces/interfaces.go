@@ -31,3 +31,14 @@ type Actuator interface {
 		VariantAutoscalings *llmdOptv1alpha1.VariantAutoscaling,
 	) error
 }
+
+// DecisionSink receives every scaling decision applied by the engine, e.g. to publish it
+// as metrics, log it, or forward it to an external system.
+// The decision's DesiredReplicas holds the previously desired replica count and
+// TargetReplicas the new one; va already carries the updated status.
+type DecisionSink interface {
+	// Name identifies the sink in logs and errors.
+	Name() string
+	// Emit publishes a single decision. Errors are reported but do not stop other sinks.
+	Emit(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision VariantDecision) error
+}
@@ -1,5 +1,39 @@
 package interfaces
 
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+)
+
+// MetricsCollector collects the per-replica metrics used for saturation analysis of a model.
+// The maps passed in are keyed by deployment name. KV cache usage and queue length are always
+// collected; the metrics selected in optional are collected besides them.
+type MetricsCollector interface {
+	CollectReplicaMetrics(
+		ctx context.Context,
+		modelID string,
+		namespace string,
+		deployments map[string]*appsv1.Deployment,
+		variantAutoscalings map[string]*llmdOptv1alpha1.VariantAutoscaling,
+		variantCosts map[string]float64,
+		optional OptionalReplicaMetrics,
+	) ([]ReplicaMetrics, error)
+}
+
+// OptionalReplicaMetrics selects the per-replica metrics that only some features use, so that
+// they are queried only for models that enable one of them. Unselected metrics are left at 0.
+type OptionalReplicaMetrics struct {
+	OutputTokenRate          bool // goodput plateau trigger
+	SpecDecodeAcceptanceRate bool // speculative decoding acceptance trigger
+	ErrorRate                bool // error rate scale-down block
+	RejectedRequestRate      bool // rejected request trigger
+	TokensInFlight           bool // tokens-in-flight trigger
+	ArrivalRate              bool // arrival rate and request sizes, for the scale-down simulation
+}
+
 // MetricsValidationResult contains the result of metrics availability check
 type MetricsValidationResult struct {
 	Available bool
@@ -12,12 +12,35 @@ import (
 type ReplicaMetrics struct {
 	PodName         string
 	KvCacheUsage    float64 // KV cache utilization (0.0-1.0)
-	QueueLength     int     // Number of requests waiting
+	QueueLength     float64 // Number of requests waiting; may be fractional when averaged over time
 	VariantName     string  // Name of the variant this replica belongs to
 	Namespace       string
 	ModelID         string  // Model ID for grouping variants
 	AcceleratorName string  // Accelerator type for this variant
 	Cost            float64 // Cost per replica (from CRD spec, default 10)
+	OutputTokenRate float64 // Generated tokens per second (goodput), 0 if unavailable
+	// SpecDecodeAcceptanceRate is the fraction of speculative draft tokens accepted (0.0-1.0),
+	// valid only when HasSpecDecodeAcceptanceRate is set
+	SpecDecodeAcceptanceRate float64
+	// HasSpecDecodeAcceptanceRate is set when the replica reported an acceptance rate; it is unset
+	// if unavailable or the engine does not use speculative decoding
+	HasSpecDecodeAcceptanceRate bool
+	// ErrorRate is the fraction of HTTP requests failing with a 5xx status (0.0-1.0),
+	// 0 if unavailable
+	ErrorRate float64
+	// RejectedRequestRate is the number of HTTP requests per second rejected with a 429 status,
+	// 0 if unavailable
+	RejectedRequestRate float64
+	// TokensInFlight is the number of prompt and generation tokens held by the replica's running
+	// and waiting requests, estimated from the request count and average request size.
+	// 0 if unavailable
+	TokensInFlight float64
+	// ArrivalRate is the number of requests per second served by the replica, 0 if unavailable
+	ArrivalRate float64
+	// AvgInputTokens and AvgOutputTokens are the average prompt and generated tokens per request,
+	// 0 if unavailable
+	AvgInputTokens  float64
+	AvgOutputTokens float64
 	// Metadata contains freshness information (optional)
 	Metadata *ReplicaMetricsMetadata `json:"metadata,omitempty"`
 }
@@ -34,38 +57,85 @@ type ReplicaMetricsMetadata struct {
 
 // ModelSaturationAnalysis holds saturation analysis results for a model (across all variants)
 type ModelSaturationAnalysis struct {
-	ModelID    string
-	Namespace  string
-	AnalyzedAt time.Time // Timestamp when analysis was performed
+	ModelID    string    `json:"modelID"`
+	Namespace  string    `json:"namespace"`
+	AnalyzedAt time.Time `json:"analyzedAt"` // Timestamp when analysis was performed
 
 	// Aggregated metrics across all variants of this model
-	TotalReplicas       int
-	NonSaturatedCount   int // Replicas below saturation thresholds
-	AvgSpareKvCapacity  float64
-	AvgSpareQueueLength float64
+	TotalReplicas        int     `json:"totalReplicas"`
+	NonSaturatedCount    int     `json:"nonSaturatedCount"` // Replicas below saturation thresholds
+	AvgSpareKvCapacity   float64 `json:"avgSpareKvCapacity"`
+	AvgSpareQueueLength  float64 `json:"avgSpareQueueLength"`
+	TotalQueueLength     float64 `json:"totalQueueLength"`     // Sum of queue lengths across all replicas
+	TotalOutputTokenRate float64 `json:"totalOutputTokenRate"` // Aggregate goodput (output tokens/sec) across all replicas
+	// AvgSpecDecodeAcceptanceRate is the mean draft acceptance rate across replicas reporting one,
+	// nil if no replica uses speculative decoding
+	AvgSpecDecodeAcceptanceRate *float64 `json:"avgSpecDecodeAcceptanceRate,omitempty"`
+	// AvgErrorRate is the mean HTTP 5xx error rate across replicas, 0 if unavailable
+	AvgErrorRate float64 `json:"avgErrorRate,omitempty"`
+	// ErrorRateElevated is true when AvgErrorRate reached ErrorRateThreshold and scale-down was blocked
+	ErrorRateElevated bool `json:"errorRateElevated,omitempty"`
+	// TotalRejectedRequestRate is the rate of HTTP 429 responses per second summed across replicas,
+	// 0 if unavailable
+	TotalRejectedRequestRate float64 `json:"totalRejectedRequestRate,omitempty"`
+	// AvgTokensInFlightSpare is the mean fraction of per-replica token capacity not held by
+	// tokens in flight (0.0-1.0), 0 unless TokensInFlightSpareTrigger is set
+	AvgTokensInFlightSpare float64 `json:"avgTokensInFlightSpare,omitempty"`
+	// TotalArrivalRate is the request arrival rate per second summed across replicas,
+	// 0 if unavailable
+	TotalArrivalRate float64 `json:"totalArrivalRate,omitempty"`
+	// InvalidMetricPods lists the replicas whose KV cache usage or queue length was NaN or Inf,
+	// handled according to InvalidMetricPolicy
+	InvalidMetricPods []string `json:"invalidMetricPods,omitempty"`
 
 	// Scale decision recommendations
-	ShouldScaleUp bool
+	ShouldScaleUp bool `json:"shouldScaleUp"`
 
-	ScaleUpReason string
-	ScaleDownSafe bool // Indicates if scale-down simulation passed
+	ScaleUpReason     string     `json:"scaleUpReason,omitempty"`
+	ScaleUpReasonCode ReasonCode `json:"scaleUpReasonCode,omitempty"` // Which trigger fired when ShouldScaleUp is true
+	ScaleDownSafe     bool       `json:"scaleDownSafe"`               // Indicates if scale-down simulation passed
+	// ScaleDownSafeReplicas is how many replicas the scale-down simulation showed can be removed
+	// at once. A proportional scale-down step is capped at it, and at one replica when unset.
+	ScaleDownSafeReplicas int             `json:"scaleDownSafeReplicas,omitempty"`
+	ScaleDownPolicy       ScaleDownPolicy `json:"scaleDownPolicy,omitempty"` // Which variant CalculateSaturationTargets scales down
+	// TargetStrategy selects how CalculateSaturationTargets assigns scale-up and scale-down to variants
+	TargetStrategy TargetStrategyName `json:"targetStrategy,omitempty"`
+	// VariantWeights weighs variants by name for the priority-weighted target strategy
+	VariantWeights map[string]float64 `json:"variantWeights,omitempty"`
+	// ScaleStepFraction sizes the scale step as a fraction of the scaled variant's replicas,
+	// 0 for one replica. ScaleUpRounding and ScaleDownRounding round the fractional target.
+	ScaleStepFraction float64        `json:"scaleStepFraction,omitempty"`
+	ScaleUpRounding   RoundingPolicy `json:"scaleUpRounding,omitempty"`
+	ScaleDownRounding RoundingPolicy `json:"scaleDownRounding,omitempty"`
+
+	// AllowedAccelerators lists the accelerators CalculateSaturationTargets may scale up on.
+	// Empty allows all.
+	AllowedAccelerators []string `json:"allowedAccelerators,omitempty"`
+
+	// TargetReasonCodes records why each variant received its target.
+	// Populated by CalculateSaturationTargets, keyed by variant name.
+	TargetReasonCodes map[string]ReasonCode `json:"targetReasonCodes,omitempty"`
 
 	// Detailed variant breakdown
-	VariantAnalyses []VariantSaturationAnalysis
+	VariantAnalyses []VariantSaturationAnalysis `json:"variantAnalyses,omitempty"`
 }
 
 // VariantSaturationAnalysis holds saturation analysis for a single variant
 type VariantSaturationAnalysis struct {
-	VariantName         string
-	AcceleratorName     string
-	Cost                float64 // Cost per replica for this variant
-	ReplicaCount        int
-	NonSaturatedCount   int
-	MaxKvCacheUsage     float64
-	MaxQueueLength      int
-	AvgSpareKvCapacity  float64
-	AvgSpareQueueLength float64
-	SaturatedReplicas   []string // Pod names of saturated replicas
+	VariantName         string   `json:"variantName"`
+	AcceleratorName     string   `json:"acceleratorName"`
+	Cost                float64  `json:"cost"` // Cost per replica for this variant
+	ReplicaCount        int      `json:"replicaCount"`
+	NonSaturatedCount   int      `json:"nonSaturatedCount"`
+	NonSaturatedWeight  float64  `json:"nonSaturatedWeight"` // Sum of the freshness weights of non-saturated replicas
+	MaxKvCacheUsage     float64  `json:"maxKvCacheUsage"`
+	AvgKvCacheUsage     float64  `json:"avgKvCacheUsage"` // Across all replicas, saturated or not
+	MaxQueueLength      float64  `json:"maxQueueLength"`
+	AvgSpareKvCapacity  float64  `json:"avgSpareKvCapacity"`
+	AvgSpareQueueLength float64  `json:"avgSpareQueueLength"`
+	SaturatedReplicas   []string `json:"saturatedReplicas"` // Pod names of saturated replicas
+	// AvgMetricAge is the mean age of the replicas' metrics, 0 when their age is unknown
+	AvgMetricAge time.Duration `json:"avgMetricAge,omitempty"`
 }
 
 // DecisionStep represents a single step in the decision pipeline.
@@ -108,7 +178,9 @@ type VariantDecision struct {
 	CurrentReplicas        int
 	TargetReplicas         int // Current target (modified by pipeline stages)
 	OriginalTargetReplicas int // Original target before resource limiting (for logging)
+	RecommendedReplicas    int // Target recommended by saturation analysis, before any policy or limit
 	DesiredReplicas        int // Original desired replicas from optimizer (from CRD status)
+	ScaleDownFloor         int // Fewest replicas scale-down may leave the variant with (spec.scaleDownFloor), 0 if unset
 
 	// --- Resource requirements (for resource limiting) ---
 	GPUsPerReplica int // GPUs required per replica
@@ -125,6 +197,8 @@ type VariantDecision struct {
 	DecisionSteps []DecisionStep
 	// Reason is kept for backward compatibility and contains the final/summary reason
 	Reason string
+	// ReasonCode is the machine-readable counterpart of Reason, suitable for alerting
+	ReasonCode ReasonCode
 
 	// --- Saturation-specific flags ---
 	SaturationBased    bool        // True if decision is primarily saturation-driven
@@ -132,6 +206,8 @@ type VariantDecision struct {
 	SafetyOverride     bool        // True if saturation veto overrode model-based decision
 	LastRunTime        metav1.Time // Time when decision was made (for status updates)
 	SaturationOnly     bool        // True if operating in saturation-only mode (no model-based analysis)
+	// TraceID identifies the optimization cycle that made the decision, as logged under traceID
+	TraceID string
 
 	// --- Allocation state ---
 	// CurrentAllocation carries the collected metrics/allocation state
@@ -145,6 +221,11 @@ type VariantDecision struct {
 	WasLimited bool
 	// LimitedBy identifies which limiter constrained the decision (if any)
 	LimitedBy string
+	// LimiterEnabled is true when the GPU limiter was applied to the decision (enableLimiter)
+	LimiterEnabled bool
+	// NoInventory is true when the GPU limiter found no accelerator inventory and left the
+	// target uncapped instead of limiting it to zero capacity
+	NoInventory bool
 
 	// --- Metrics availability ---
 	// MetricsAvailable indicates whether saturation metrics were available for this decision
@@ -153,6 +234,108 @@ type VariantDecision struct {
 	MetricsReason string
 	// MetricsMessage is the human-readable message for the MetricsAvailable condition
 	MetricsMessage string
+
+	// --- Metrics coverage ---
+	// MetricsCoverage is the lowest per-variant fraction of current replicas reporting
+	// metrics across the model (1.0 = every replica reporting)
+	MetricsCoverage float64
+	// MinMetricsCoverage is the configured minimum coverage; 0 means the check is disabled
+	MinMetricsCoverage float64
+	// PartialMetrics is true when MetricsCoverage was below MinMetricsCoverage and targets were held
+	PartialMetrics bool
+
+	// --- Metric label drift ---
+	// LabelDriftChecked is true when the model's metrics were collected, so MissingPodLabels is known
+	LabelDriftChecked bool
+	// MissingPodLabels are the queries that returned series without a pod label, which
+	// cannot be attributed to replicas
+	MissingPodLabels []string
+
+	// --- Observation window ---
+	// ObservedCycles is the number of cycles the model has been observed since the controller started
+	ObservedCycles int
+	// MinObservationCycles is the configured minimum; 0 means the warm-up is disabled
+	MinObservationCycles int
+	// Observing is true when ObservedCycles was below MinObservationCycles and targets were held
+	Observing bool
+	// MaintenanceWindow names the maintenance window the decision was held by; empty outside windows
+	MaintenanceWindow string
+	// Confidence is how far the decision can be trusted given the coverage and freshness of the
+	// variant's metrics, from 0 (no usable metrics) to 1 (every replica reporting fresh metrics)
+	Confidence float64
+
+	// --- Error rate ---
+	// ErrorRate is the model's mean HTTP 5xx error rate across replicas
+	ErrorRate float64
+	// ErrorRateThreshold is the configured threshold; 0 means the check is disabled
+	ErrorRateThreshold float64
+	// ElevatedErrorRate is true when ErrorRate reached ErrorRateThreshold and scale-down was blocked
+	ElevatedErrorRate bool
+
+	// --- Stuck pending replicas ---
+	// MaxPendingAge is the configured maximum pending age; 0 means the check is disabled
+	MaxPendingAge time.Duration
+	// StuckPending is true when the variant's pending replicas exceeded MaxPendingAge
+	StuckPending bool
+
+	// --- Flapping ---
+	// FlapWindow is the configured window reversals are counted over; 0 means detection is disabled
+	FlapWindow time.Duration
+	// FlapThreshold is the configured number of reversals that marks the variant as flapping
+	FlapThreshold int
+	// DirectionChanges is the number of times the desired replicas reversed direction within FlapWindow
+	DirectionChanges int
+	// Flapping is true when DirectionChanges reached FlapThreshold
+	Flapping bool
+
+	// --- Optimization failure ---
+	// OptimizationFailed is true when the model's analysis failed and the safety net is disabled,
+	// so no target was computed or emitted for the variant
+	OptimizationFailed bool
+	// OptimizationMessage describes the failure for the OptimizationReady condition
+	OptimizationMessage string
+
+	// --- Analysis export ---
+	// SaturationAnalysis is the model's serialized ModelSaturationAnalysis. Only set on the
+	// decision of the model's representative variant, and only when the export is enabled.
+	SaturationAnalysis string
+	// ClearSaturationAnalysis is set on the decisions of the model's other variants when the
+	// export is enabled, so that a VA that is no longer the representative drops its stale
+	// analysis annotation.
+	ClearSaturationAnalysis bool
+
+	// --- Preview ---
+	// PreviewDecision is the serialized DecisionPreview of a VA in preview mode. When set, the
+	// decision was only published and the VA's desired replicas must be left unchanged.
+	PreviewDecision string
+
+	// --- Recovery from zero ---
+	// RecoveredFromZero is true when the scale target had zero replicas although scale-to-zero
+	// is disabled for the model, and was scaled back to its minimum
+	RecoveredFromZero bool
+
+	// --- Paused rollout ---
+	// DeploymentPaused is true when the variant's deployment rollout is paused (spec.paused) and
+	// its desired replicas were held
+	DeploymentPaused bool
+}
+
+// DecisionPreview is the decision that would be applied to a VA in preview mode.
+type DecisionPreview struct {
+	// TargetReplicas is the number of replicas that would be requested
+	TargetReplicas int `json:"targetReplicas"`
+	// DesiredReplicas is the currently desired number of replicas, which is left unchanged
+	DesiredReplicas int `json:"desiredReplicas"`
+	// Accelerator is the accelerator of the variant
+	Accelerator string `json:"accelerator"`
+	// Action is the scaling action that would be taken
+	Action SaturationAction `json:"action"`
+	// Reason explains the decision
+	Reason string `json:"reason,omitempty"`
+	// ReasonCode is the machine-readable counterpart of Reason
+	ReasonCode ReasonCode `json:"reasonCode,omitempty"`
+	// Time is when the decision was computed
+	Time metav1.Time `json:"time"`
 }
 
 // AddDecisionStep adds a step to the decision pipeline history.
@@ -186,6 +369,64 @@ const (
 	ActionNoChange  SaturationAction = "no-change"
 )
 
+// ReasonCode is a stable, machine-readable code explaining a scaling decision.
+// Unlike the free-text Reason, it has a small fixed set of values and is safe
+// to use as a metric label or in alerting rules.
+type ReasonCode string
+
+const (
+	// ReasonCodeRequestsRejected means the model's replicas rejected requests (HTTP 429) at or
+	// above the trigger rate.
+	ReasonCodeRequestsRejected ReasonCode = "RequestsRejected"
+	// ReasonCodeKvSpareLow means average spare KV cache capacity fell below the trigger.
+	ReasonCodeKvSpareLow ReasonCode = "KvSpareLow"
+	// ReasonCodeQueueSpareLow means average spare queue capacity fell below the trigger.
+	ReasonCodeQueueSpareLow ReasonCode = "QueueSpareLow"
+	// ReasonCodeGoodputPlateau means output token throughput stopped growing while the queue kept growing.
+	ReasonCodeGoodputPlateau ReasonCode = "GoodputPlateau"
+	// ReasonCodeSpecDecodeDegraded means the speculative decoding acceptance rate fell below
+	// the threshold while requests were queued.
+	ReasonCodeSpecDecodeDegraded ReasonCode = "SpecDecodeDegraded"
+	// ReasonCodeTokensInFlightSpareLow means average spare token capacity, measured against
+	// MaxBatchSize * ContextLength, fell below the trigger.
+	ReasonCodeTokensInFlightSpareLow ReasonCode = "TokensInFlightSpareLow"
+	// ReasonCodeScaleDownSafe means the scale-down simulation passed and this variant was chosen.
+	ReasonCodeScaleDownSafe ReasonCode = "ScaleDownSafe"
+	// ReasonCodePendingGuard means scale-up was needed but skipped this variant
+	// because it still has pending replicas.
+	ReasonCodePendingGuard ReasonCode = "PendingGuard"
+	// ReasonCodePreserved means the model is transitioning and the previous target was kept.
+	ReasonCodePreserved ReasonCode = "Preserved"
+	// ReasonCodeSteady means no scaling was needed for this variant.
+	ReasonCodeSteady ReasonCode = "Steady"
+	// ReasonCodeNoAnalysis means no saturation analysis was available for the model.
+	ReasonCodeNoAnalysis ReasonCode = "NoAnalysis"
+	// ReasonCodeScaleToZero means the scale-to-zero policy set the target to zero.
+	ReasonCodeScaleToZero ReasonCode = "ScaleToZero"
+	// ReasonCodeMinReplicas means the minimum replica policy raised the target.
+	ReasonCodeMinReplicas ReasonCode = "MinReplicas"
+	// ReasonCodePartialMetrics means too few replicas reported metrics and the target was held.
+	ReasonCodePartialMetrics ReasonCode = "PartialMetrics"
+	// ReasonCodeObserving means the model has not been observed for minObservationCycles since
+	// the controller started and the target was held at the current replicas.
+	ReasonCodeObserving ReasonCode = "Observing"
+	// ReasonCodeMaintenance means a maintenance window was active and the target was held at the
+	// desired replicas.
+	ReasonCodeMaintenance ReasonCode = "Maintenance"
+	// ReasonCodeInventoryCap means the target was clamped to the replicas the cluster's
+	// accelerators of the variant's type can hold.
+	ReasonCodeInventoryCap ReasonCode = "InventoryCap"
+	// ReasonCodeUnschedulable means scale-up was limited because the variant's pods require one
+	// replica per node and there are no more schedulable nodes with its accelerator.
+	ReasonCodeUnschedulable ReasonCode = "Unschedulable"
+	// ReasonCodeAcceleratorNotAllowed means scale-up was needed but skipped this variant
+	// because its accelerator is not in the model's allowed accelerators.
+	ReasonCodeAcceleratorNotAllowed ReasonCode = "AcceleratorNotAllowed"
+	// ReasonCodeDeploymentPaused means the variant's deployment rollout is paused and the target
+	// was held at the desired replicas.
+	ReasonCodeDeploymentPaused ReasonCode = "DeploymentPaused"
+)
+
 // VariantReplicaState holds the current and desired replica counts for a variant
 type VariantReplicaState struct {
 	VariantName     string
@@ -202,6 +443,21 @@ type VariantReplicaState struct {
 	// the deployment's container resource requests (nvidia.com/gpu, amd.com/gpu, etc.).
 	// Defaults to 1 if no GPU requests are found.
 	GPUsPerReplica int
+	// ScaleDownFloor is the fewest replicas scale-down may leave the variant with, from the
+	// VA's spec.scaleDownFloor. 0 means no floor beyond the minimum of 1 replica.
+	ScaleDownFloor int
+	// StuckPending is true when PendingReplicas have been pending for longer than the configured
+	// MaxPendingAge. They then no longer hold back scaling of the model's other variants.
+	StuckPending bool
+	// ExcessReadyReplicas is how many more replicas are ready than CurrentReplicas, which happens
+	// transiently e.g. during a rollback. 0 when ready replicas do not exceed current.
+	ExcessReadyReplicas int
+	// HoldForExcessReady is true when the variant's excess ready replicas hold the model's
+	// scaling decisions for the cycle (ExcessReadyPolicyHold).
+	HoldForExcessReady bool
+	// Paused is true when the variant's deployment has spec.paused set. A paused variant is never
+	// chosen to scale up or down, and its desired replicas are held while paused.
+	Paused bool
 }
 
 // SaturationAnalyzer analyzes replica saturation metrics and recommends scaling decisions
@@ -220,7 +476,8 @@ type SaturationAnalyzer interface {
 	// Step 1: Pure saturation-based target calculation
 	// - Uses ready replica count (those with metrics) to avoid excessive scale-up
 	// - Preserves desired replicas when desired ≠ current (from previous optimizer run)
-	// - Uses cost-based selection (cheapest for scale-up, most expensive for scale-down)
+	// - Selects the variant to scale by the analysis' TargetStrategy (cheapest for scale-up and
+	//   most expensive for scale-down by default)
 	// Returns: map[variantName]targetReplicas
 	CalculateSaturationTargets(
 		saturationAnalysis *ModelSaturationAnalysis,
@@ -1,6 +1,107 @@
 package interfaces
 
-import "fmt"
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ScaleDownPolicy selects which variant gives up a replica when scale-down is safe.
+type ScaleDownPolicy string
+
+const (
+	// ScaleDownPolicyCost removes a replica from the most expensive variant (default).
+	ScaleDownPolicyCost ScaleDownPolicy = "cost"
+	// ScaleDownPolicyLeastLoaded removes a replica from the variant with the most spare capacity.
+	ScaleDownPolicyLeastLoaded ScaleDownPolicy = "least-loaded"
+)
+
+// TargetStrategyName selects the strategy that decides which variant of a model gains a replica
+// on scale-up and which gives one up on scale-down.
+type TargetStrategyName string
+
+const (
+	// TargetStrategyCostAware adds a replica to the cheapest variant and removes one from the
+	// variant chosen by the scale-down policy (default).
+	TargetStrategyCostAware TargetStrategyName = "cost-aware"
+	// TargetStrategySpread adds a replica to the variant with the fewest and removes one from the
+	// variant with the most, keeping the variants even.
+	TargetStrategySpread TargetStrategyName = "spread"
+	// TargetStrategyBinPack adds a replica to the variant with the most and removes one from the
+	// variant with the fewest, concentrating the model on as few variants as possible.
+	TargetStrategyBinPack TargetStrategyName = "bin-pack"
+	// TargetStrategyPriorityWeighted keeps each variant's share of the model's replicas in
+	// proportion to its weight in variantWeights.
+	TargetStrategyPriorityWeighted TargetStrategyName = "priority-weighted"
+)
+
+// targetStrategyNames holds the names accepted for targetStrategy: the built-in strategies and
+// those registered with RegisterTargetStrategyName.
+var targetStrategyNames = map[TargetStrategyName]bool{
+	TargetStrategyCostAware:        true,
+	TargetStrategySpread:           true,
+	TargetStrategyBinPack:          true,
+	TargetStrategyPriorityWeighted: true,
+}
+
+// RegisterTargetStrategyName makes name selectable as targetStrategy. It is called by
+// saturation.RegisterTargetStrategy along with registering the strategy itself, and must be
+// called before configs are validated, e.g. from an init function.
+func RegisterTargetStrategyName(name TargetStrategyName) {
+	targetStrategyNames[name] = true
+}
+
+// RoundingPolicy selects how a fractional replica target, from a proportional scale step, is
+// rounded to whole replicas.
+type RoundingPolicy string
+
+const (
+	// RoundingPolicyCeil rounds up, favoring SLOs over cost (default for scale-up).
+	RoundingPolicyCeil RoundingPolicy = "ceil"
+	// RoundingPolicyFloor rounds down, favoring cost over SLOs (default for scale-down).
+	RoundingPolicyFloor RoundingPolicy = "floor"
+	// RoundingPolicyRound rounds to the nearest replica, halves away from zero.
+	RoundingPolicyRound RoundingPolicy = "round"
+)
+
+// ExcessReadyPolicy selects how a variant is handled while its scale target reports more ready
+// replicas than spec replicas, e.g. during a rollback.
+type ExcessReadyPolicy string
+
+const (
+	// ExcessReadyPolicyClamp keeps the spec replicas as current and treats no replicas as pending (default).
+	ExcessReadyPolicyClamp ExcessReadyPolicy = "clamp"
+	// ExcessReadyPolicyUseReady takes the ready replicas as the variant's current replicas.
+	ExcessReadyPolicyUseReady ExcessReadyPolicy = "use-ready"
+	// ExcessReadyPolicyHold holds the model's scaling decisions for the cycle.
+	ExcessReadyPolicyHold ExcessReadyPolicy = "hold"
+)
+
+// NoMetricsVariantPolicy selects how a model is scaled while one of its variants has replicas
+// but none of them reports metrics, and the others do.
+type NoMetricsVariantPolicy string
+
+const (
+	// NoMetricsVariantPolicyExclude scales the model on the reporting variants and never scales
+	// the variant without metrics, which keeps its desired replicas (default).
+	NoMetricsVariantPolicyExclude NoMetricsVariantPolicy = "exclude"
+	// NoMetricsVariantPolicyHold holds the scaling decisions of the whole model.
+	NoMetricsVariantPolicyHold NoMetricsVariantPolicy = "hold"
+)
+
+// InvalidMetricPolicy selects how a replica whose KV cache usage or queue length is NaN or Inf
+// is handled by the saturation analysis.
+type InvalidMetricPolicy string
+
+const (
+	// InvalidMetricPolicyDrop leaves the replica out of the analysis, as if it reported no metrics (default).
+	InvalidMetricPolicyDrop InvalidMetricPolicy = "drop"
+	// InvalidMetricPolicySaturated counts the replica as saturated.
+	InvalidMetricPolicySaturated InvalidMetricPolicy = "saturated"
+)
 
 // SaturationScalingConfig holds saturation-based scaling thresholds for a model variant.
 // Saturation scaling is enabled by default and uses these thresholds to determine when
@@ -24,14 +125,248 @@ type SaturationScalingConfig struct {
 	// QueueSpareTrigger: Scale-up if average spare queue capacity < this value
 	QueueSpareTrigger float64 `yaml:"queueSpareTrigger"`
 
+	// ScaleDownKvCacheThreshold: Scale-down is only safe if the average KV cache utilization of the
+	// remaining replicas after removing one stays below this value (0.0-1.0). Set it below the
+	// utilization at which KvSpareTrigger scales up to leave a band in which the model neither
+	// scales up nor down. Default is 0 (scale-down only checks the spare triggers).
+	ScaleDownKvCacheThreshold float64 `yaml:"scaleDownKvCacheThreshold,omitempty"`
+
+	// ScaleDownQueueLengthThreshold: Scale-down is only safe if the average queue length of the
+	// remaining replicas after removing one stays below this value. Default is 0 (scale-down only
+	// checks the spare triggers).
+	ScaleDownQueueLengthThreshold float64 `yaml:"scaleDownQueueLengthThreshold,omitempty"`
+
 	// EnableLimiter: When true, includes the GPU limiter in the scaling pipeline
 	// to constrain scaling decisions based on available cluster resources.
 	// Default is false (limiter disabled).
 	EnableLimiter bool `yaml:"enableLimiter,omitempty"`
+
+	// MinMetricsCoverage: Minimum fraction (0.0-1.0) of each variant's current replicas that
+	// must be reporting metrics before scaling decisions are made. Below it, targets are held
+	// and the PartialMetrics condition is set; a coverage equal to it proceeds.
+	// Default is 0 (check disabled).
+	MinMetricsCoverage float64 `yaml:"minMetricsCoverage,omitempty"`
+
+	// MinObservationCycles: Number of optimization cycles with metrics a model must be observed for
+	// after the controller starts before its first scaling decision. Until then its variants are
+	// held at their current replicas and the Observing condition is set. Default is 0 (no warm-up).
+	MinObservationCycles int `yaml:"minObservationCycles,omitempty"`
+
+	// NoMetricsVariantPolicy: How a model is scaled while one of its variants has replicas but
+	// none reporting metrics: "exclude" scales the model on the reporting variants and leaves that
+	// variant unchanged, "hold" holds the whole model. Default is "exclude".
+	NoMetricsVariantPolicy NoMetricsVariantPolicy `yaml:"noMetricsVariantPolicy,omitempty"`
+
+	// InvalidMetricPolicy: How a replica whose KV cache usage or queue length is NaN or Inf (e.g.
+	// from a division by zero in the exporter) is handled: "drop" leaves it out of the analysis and
+	// "saturated" counts it as saturated. Default is "drop".
+	InvalidMetricPolicy InvalidMetricPolicy `yaml:"invalidMetricPolicy,omitempty"`
+
+	// ScaleDownPolicy: How the variant to scale down is chosen, "cost" or "least-loaded".
+	// Default is "cost" (most expensive variant first).
+	ScaleDownPolicy ScaleDownPolicy `yaml:"scaleDownPolicy,omitempty"`
+
+	// TargetStrategy: Which variant gains a replica on scale-up and which gives one up on
+	// scale-down: "cost-aware", "spread", "bin-pack", "priority-weighted" or a strategy registered
+	// by an extension. Default is "cost-aware" (cheapest variant up, scaleDownPolicy down).
+	TargetStrategy TargetStrategyName `yaml:"targetStrategy,omitempty"`
+
+	// VariantWeights: Relative weight of each variant by variant name, for the "priority-weighted"
+	// target strategy, e.g. llama-h100: 3, llama-a100: 1 keeps three H100 replicas per A100
+	// replica. Variants without a weight weigh 1. Default is none (all variants equal).
+	VariantWeights map[string]float64 `yaml:"variantWeights,omitempty"`
+
+	// ScaleStepFraction: Size of a scale step as a fraction (0.0-1.0) of the scaled variant's
+	// replicas, e.g. 0.25 adds or removes a quarter of them. The fractional target is rounded by
+	// ScaleUpRounding or ScaleDownRounding, and a step is always at least one replica.
+	// Default is 0 (one replica per step).
+	ScaleStepFraction float64 `yaml:"scaleStepFraction,omitempty"`
+
+	// ScaleUpRounding: How a fractional scale-up target is rounded, "ceil", "floor" or "round".
+	// Default is "ceil".
+	ScaleUpRounding RoundingPolicy `yaml:"scaleUpRounding,omitempty"`
+
+	// ScaleDownRounding: How a fractional scale-down target is rounded, "ceil", "floor" or "round".
+	// Default is "floor".
+	ScaleDownRounding RoundingPolicy `yaml:"scaleDownRounding,omitempty"`
+
+	// GoodputPlateauThreshold: Scale-up if aggregate goodput (output tokens/sec) grew by less than
+	// this fraction (0.0-1.0) over recent cycles while the total queue kept growing.
+	// Default is 0 (goodput trigger disabled).
+	GoodputPlateauThreshold float64 `yaml:"goodputPlateauThreshold,omitempty"`
+
+	// SpecDecodeAcceptanceThreshold: Scale-up if the average speculative decoding acceptance rate
+	// (0.0-1.0) falls below this value while requests are queued. Only applies to engines running
+	// speculative decoding. Default is 0 (acceptance trigger disabled).
+	SpecDecodeAcceptanceThreshold float64 `yaml:"specDecodeAcceptanceThreshold,omitempty"`
+
+	// TokensInFlightSpareTrigger: Scale-up if the average fraction of per-replica token capacity
+	// (MaxBatchSize * ContextLength) not held by tokens in flight falls below this value (0.0-1.0).
+	// Catches a few very large requests that leave the queue short. Requires MaxBatchSize and
+	// ContextLength. Default is 0 (tokens-in-flight trigger disabled).
+	TokensInFlightSpareTrigger float64 `yaml:"tokensInFlightSpareTrigger,omitempty"`
+
+	// MaxBatchSize: Maximum number of sequences a replica runs concurrently, e.g. vLLM's
+	// --max-num-seqs. Used by TokensInFlightSpareTrigger, and as the batch size the queue
+	// thresholds are written for by NormalizeQueueByBatchSize. Default is 0 (unset).
+	MaxBatchSize int `yaml:"maxBatchSize,omitempty"`
+
+	// NormalizeQueueByBatchSize: Scale each replica's queue length by MaxBatchSize divided by the
+	// max batch size of its variant (the deployment's --max-num-seqs) before it is compared with
+	// the queue thresholds, so variants with larger batches tolerate proportionally longer queues.
+	// Variants whose batch size is unknown are compared as absolute. Requires MaxBatchSize.
+	// Default is false (absolute queue thresholds).
+	NormalizeQueueByBatchSize bool `yaml:"normalizeQueueByBatchSize,omitempty"`
+
+	// ContextLength: Maximum number of tokens per sequence, e.g. vLLM's --max-model-len.
+	// Used by TokensInFlightSpareTrigger. Default is 0 (unset).
+	ContextLength int `yaml:"contextLength,omitempty"`
+
+	// RejectedRequestRateTrigger: Scale-up when the model's total rate of rejected (HTTP 429)
+	// requests per second across its replicas is at or above this value. Rejections are a
+	// stronger signal than spare capacity, so this trigger takes precedence over the KV cache
+	// and queue triggers. Default is 0 (trigger disabled).
+	RejectedRequestRateTrigger float64 `yaml:"rejectedRequestRateTrigger,omitempty"`
+
+	// SaturatedQuorum: How many of the model's replicas must be saturated before the KV cache and
+	// queue spare triggers may scale up. A value below 1 is a fraction of the replicas (e.g. 0.5),
+	// a value of 1 or more a replica count (e.g. 2), capped at the number of replicas. The other
+	// scale-up triggers are not affected. Default is 0 (no quorum required).
+	SaturatedQuorum float64 `yaml:"saturatedQuorum,omitempty"`
+
+	// ErrorRateThreshold: Scale-down is blocked while the model's average HTTP 5xx error rate
+	// (0.0-1.0) is at or above this value, even when spare capacity would allow it, and the
+	// ElevatedErrorRate condition is set. Default is 0 (check disabled).
+	ErrorRateThreshold float64 `yaml:"errorRateThreshold,omitempty"`
+
+	// ArrivalRateSimulation: Also predict the load left after a scale-down by redistributing each
+	// replica's request arrival rate, weighted by its request sizes, and use the higher of that and
+	// the KV cache and queue snapshot prediction. Default is false (snapshot simulation only).
+	ArrivalRateSimulation bool `yaml:"arrivalRateSimulation,omitempty"`
+
+	// ScaleDownDelay: How long scale-down must be continuously safe for a model before a
+	// replica is removed, e.g. "5m". Default is 0 (scale down as soon as it is safe).
+	ScaleDownDelay time.Duration `yaml:"scaleDownDelay,omitempty"`
+
+	// ScaleDownStabilizationCycles: How many consecutive optimization cycles scale-down must be
+	// safe for a model before a replica is removed, so a one-cycle dip in load cannot approve it.
+	// Combined with ScaleDownDelay, both must be met. Default is 0 (a single safe cycle suffices).
+	ScaleDownStabilizationCycles int `yaml:"scaleDownStabilizationCycles,omitempty"`
+
+	// MaxPendingAge: How long a variant may have pending replicas before they stop holding back
+	// the model's scaling, e.g. "10m". Pods stuck pending (e.g. unschedulable) would otherwise
+	// block scaling forever; past this age the model may scale up other variants.
+	// Default is 0 (pending replicas always hold back scaling).
+	MaxPendingAge time.Duration `yaml:"maxPendingAge,omitempty"`
+
+	// ExcessReadyPolicy: How a variant whose ready replicas exceed its spec replicas is handled,
+	// a transient seen e.g. during rollbacks: "clamp" keeps spec replicas as current, "use-ready"
+	// takes the ready replicas as current, and "hold" holds the model's scaling for the cycle.
+	// Default is "clamp".
+	ExcessReadyPolicy ExcessReadyPolicy `yaml:"excessReadyPolicy,omitempty"`
+
+	// ColdStartGracePeriod: How long scale-down of a model is suppressed after one of its scale-ups
+	// is applied, while the new replicas are still picking up traffic and the model looks
+	// over-provisioned, e.g. "3m". Unlike ScaleDownDelay it is only started by a scale-up.
+	// Default is 0 (no grace period).
+	ColdStartGracePeriod time.Duration `yaml:"coldStartGracePeriod,omitempty"`
+
+	// StaleDesiredTimeout: How long a variant's desired replicas may differ from its current
+	// replicas before the desired is considered stale. While a model is in transition its desired
+	// is preserved; once stale it is discarded and the target recomputed from live saturation,
+	// e.g. "10m". Default is 0 (desired is preserved until actuation catches up).
+	StaleDesiredTimeout time.Duration `yaml:"staleDesiredTimeout,omitempty"`
+
+	// FlapWindow: The window over which reversals of a variant's desired replicas (a scale-up
+	// followed by a scale-down or vice versa) are counted for the Flapping condition, e.g. "15m".
+	// Default is 0 (flap detection disabled).
+	FlapWindow time.Duration `yaml:"flapWindow,omitempty"`
+
+	// FlapThreshold: How many reversals within FlapWindow mark a variant as flapping.
+	// Default is 0 (4 reversals).
+	FlapThreshold int `yaml:"flapThreshold,omitempty"`
+
+	// CarbonWeight: Weight (0.0-1.0) of the accelerator energy factor when comparing variant costs.
+	// Variants are compared by (1-carbonWeight)*variantCost + carbonWeight*energyFactor.
+	// Default is 0 (pure cost).
+	CarbonWeight float64 `yaml:"carbonWeight,omitempty"`
+
+	// AcceleratorEnergyFactors: Carbon cost per replica by accelerator name, in the same units as
+	// variantCost. Accelerators without a factor are compared by variantCost alone.
+	AcceleratorEnergyFactors map[string]float64 `yaml:"acceleratorEnergyFactors,omitempty"`
+
+	// AcceleratorCapacityFactors: Relative serving capacity of one replica by accelerator name,
+	// e.g. A100: 1, H100: 2. When a model's variants run on different accelerators, the model-level
+	// spare capacity averages weight each replica by its factor, so headroom on a larger accelerator
+	// counts for more. Accelerators without a factor weigh 1. Default is none (all replicas equal).
+	AcceleratorCapacityFactors map[string]float64 `yaml:"acceleratorCapacityFactors,omitempty"`
+
+	// MetricsFreshnessHalfLife: Metric age at which a replica's spare capacity counts half as much
+	// in the model-level averages, e.g. "30s". The weight halves again with every further half-life,
+	// so slightly stale replicas still count while fresher ones dominate.
+	// Default is 0 (all replicas weigh the same regardless of metric age).
+	MetricsFreshnessHalfLife time.Duration `yaml:"metricsFreshnessHalfLife,omitempty"`
+
+	// QueuePercentile: When set, each replica's queue length is taken as this percentile (0-100)
+	// of its queue lengths over the last queuePercentileWindow cycles before replicas are
+	// classified, e.g. 50 ignores spikes lasting less than half the window while sustained
+	// queuing still counts. Default is 0 (latest queue length).
+	QueuePercentile float64 `yaml:"queuePercentile,omitempty"`
+
+	// QueuePercentileWindow: Number of analysis cycles of queue history used by queuePercentile.
+	// Default is 0 (5 cycles).
+	QueuePercentileWindow int `yaml:"queuePercentileWindow,omitempty"`
+
+	// ServiceClassMaxBoost: Maximum factor by which the spare triggers of a model are raised when
+	// its service class has stricter SLOs than the loosest class, so higher tiers scale up earlier.
+	// The factor is the ratio of the loosest SLO to the model's SLO, capped at this value.
+	// Default is 0 (service classes ignored).
+	ServiceClassMaxBoost float64 `yaml:"serviceClassMaxBoost,omitempty"`
+
+	// TrafficSchedule: Daily time windows of expected high load, each raising the spare triggers
+	// by its boost factor while it is active, so the model keeps more headroom before known peaks.
+	// Default is none (no time-of-day bias).
+	TrafficSchedule []TrafficWindow `yaml:"trafficSchedule,omitempty"`
+
+	// TrafficScheduleTimeZone: IANA time zone of the trafficSchedule windows, e.g. "Europe/Berlin".
+	// Default is UTC.
+	TrafficScheduleTimeZone string `yaml:"trafficScheduleTimeZone,omitempty"`
+
+	// MaxReplicasFromInventory: When true, each variant's target is capped at the replicas the
+	// cluster's accelerators of its type can hold (total GPUs of the type / GPUs per replica).
+	// Default is false (no cap).
+	MaxReplicasFromInventory bool `yaml:"maxReplicasFromInventory,omitempty"`
+
+	// InventoryRefreshInterval: How often the accelerator inventory used by
+	// maxReplicasFromInventory is collected, e.g. "5m". Read from the default entry only.
+	// Default is 0 (5 minutes).
+	InventoryRefreshInterval time.Duration `yaml:"inventoryRefreshInterval,omitempty"`
+
+	// AntiAffinityAware: When true, scale-up of a variant whose pods require one replica per node
+	// (required pod anti-affinity on kubernetes.io/hostname) is limited to the number of schedulable
+	// nodes with its accelerator, so it does not create pods that stay pending. Default is false.
+	AntiAffinityAware bool `yaml:"antiAffinityAware,omitempty"`
+
+	// AllowedAccelerators: Accelerator names the model is compatible with, e.g. [H100, A100].
+	// Scale-up never picks a variant on another accelerator, even if it is the cheapest.
+	// Usually set per model. Default is none (all accelerators allowed).
+	AllowedAccelerators []string `yaml:"allowedAccelerators,omitempty"`
 }
 
 // Validate checks for invalid threshold values.
 // Returns error with descriptive message if validation fails.
+// OptionalReplicaMetrics returns the optional replica metrics used by the features enabled in c.
+func (c *SaturationScalingConfig) OptionalReplicaMetrics() OptionalReplicaMetrics {
+	return OptionalReplicaMetrics{
+		OutputTokenRate:          c.GoodputPlateauThreshold > 0,
+		SpecDecodeAcceptanceRate: c.SpecDecodeAcceptanceThreshold > 0,
+		ErrorRate:                c.ErrorRateThreshold > 0,
+		RejectedRequestRate:      c.RejectedRequestRateTrigger > 0,
+		TokensInFlight:           c.TokensInFlightSpareTrigger > 0,
+		ArrivalRate:              c.ArrivalRateSimulation,
+	}
+}
+
 func (c *SaturationScalingConfig) Validate() error {
 	if c.KvCacheThreshold < 0 || c.KvCacheThreshold > 1 {
 		return fmt.Errorf("kvCacheThreshold must be between 0 and 1, got %.2f", c.KvCacheThreshold)
@@ -45,6 +380,163 @@ func (c *SaturationScalingConfig) Validate() error {
 	if c.QueueSpareTrigger < 0 {
 		return fmt.Errorf("queueSpareTrigger must be >= 0, got %.1f", c.QueueSpareTrigger)
 	}
+	if c.ScaleDownKvCacheThreshold < 0 || c.ScaleDownKvCacheThreshold > c.KvCacheThreshold {
+		return fmt.Errorf("scaleDownKvCacheThreshold must be between 0 and kvCacheThreshold (%.2f), got %.2f",
+			c.KvCacheThreshold, c.ScaleDownKvCacheThreshold)
+	}
+	if c.ScaleDownQueueLengthThreshold < 0 || c.ScaleDownQueueLengthThreshold > c.QueueLengthThreshold {
+		return fmt.Errorf("scaleDownQueueLengthThreshold must be between 0 and queueLengthThreshold (%.1f), got %.1f",
+			c.QueueLengthThreshold, c.ScaleDownQueueLengthThreshold)
+	}
+	if c.MinMetricsCoverage < 0 || c.MinMetricsCoverage > 1 {
+		return fmt.Errorf("minMetricsCoverage must be between 0 and 1, got %.2f", c.MinMetricsCoverage)
+	}
+	if c.MinObservationCycles < 0 {
+		return fmt.Errorf("minObservationCycles must be >= 0, got %d", c.MinObservationCycles)
+	}
+	if c.GoodputPlateauThreshold < 0 || c.GoodputPlateauThreshold > 1 {
+		return fmt.Errorf("goodputPlateauThreshold must be between 0 and 1, got %.2f", c.GoodputPlateauThreshold)
+	}
+	if c.SpecDecodeAcceptanceThreshold < 0 || c.SpecDecodeAcceptanceThreshold > 1 {
+		return fmt.Errorf("specDecodeAcceptanceThreshold must be between 0 and 1, got %.2f", c.SpecDecodeAcceptanceThreshold)
+	}
+	if c.TokensInFlightSpareTrigger < 0 || c.TokensInFlightSpareTrigger > 1 {
+		return fmt.Errorf("tokensInFlightSpareTrigger must be between 0 and 1, got %.2f", c.TokensInFlightSpareTrigger)
+	}
+	if c.MaxBatchSize < 0 {
+		return fmt.Errorf("maxBatchSize must be >= 0, got %d", c.MaxBatchSize)
+	}
+	if c.ContextLength < 0 {
+		return fmt.Errorf("contextLength must be >= 0, got %d", c.ContextLength)
+	}
+	if c.TokensInFlightSpareTrigger > 0 && (c.MaxBatchSize == 0 || c.ContextLength == 0) {
+		return fmt.Errorf("tokensInFlightSpareTrigger requires maxBatchSize and contextLength to be set")
+	}
+	if c.NormalizeQueueByBatchSize && c.MaxBatchSize == 0 {
+		return fmt.Errorf("normalizeQueueByBatchSize requires maxBatchSize to be set")
+	}
+	if c.RejectedRequestRateTrigger < 0 {
+		return fmt.Errorf("rejectedRequestRateTrigger must be >= 0, got %.2f", c.RejectedRequestRateTrigger)
+	}
+	if c.SaturatedQuorum < 0 || (c.SaturatedQuorum >= 1 && c.SaturatedQuorum != math.Trunc(c.SaturatedQuorum)) {
+		return fmt.Errorf("saturatedQuorum must be a fraction below 1 or a whole number of replicas, got %.2f", c.SaturatedQuorum)
+	}
+	if c.ErrorRateThreshold < 0 || c.ErrorRateThreshold > 1 {
+		return fmt.Errorf("errorRateThreshold must be between 0 and 1, got %.2f", c.ErrorRateThreshold)
+	}
+	if c.CarbonWeight < 0 || c.CarbonWeight > 1 {
+		return fmt.Errorf("carbonWeight must be between 0 and 1, got %.2f", c.CarbonWeight)
+	}
+	for accelerator, factor := range c.AcceleratorEnergyFactors {
+		if factor < 0 {
+			return fmt.Errorf("acceleratorEnergyFactors[%s] must be >= 0, got %.2f", accelerator, factor)
+		}
+	}
+	if c.MetricsFreshnessHalfLife < 0 {
+		return fmt.Errorf("metricsFreshnessHalfLife must be >= 0, got %s", c.MetricsFreshnessHalfLife)
+	}
+	if c.QueuePercentile < 0 || c.QueuePercentile > 100 {
+		return fmt.Errorf("queuePercentile must be between 0 and 100, got %.1f", c.QueuePercentile)
+	}
+	if c.QueuePercentileWindow < 0 {
+		return fmt.Errorf("queuePercentileWindow must be >= 0, got %d", c.QueuePercentileWindow)
+	}
+	for accelerator, factor := range c.AcceleratorCapacityFactors {
+		if factor <= 0 {
+			return fmt.Errorf("acceleratorCapacityFactors[%s] must be > 0, got %.2f", accelerator, factor)
+		}
+	}
+	for _, accelerator := range c.AllowedAccelerators {
+		if accelerator == "" {
+			return fmt.Errorf("allowedAccelerators must not contain empty names")
+		}
+	}
+	for i, window := range c.TrafficSchedule {
+		if err := window.Validate(); err != nil {
+			return fmt.Errorf("trafficSchedule[%d]: %w", i, err)
+		}
+	}
+	if _, err := time.LoadLocation(c.TrafficScheduleTimeZone); err != nil {
+		return fmt.Errorf("trafficScheduleTimeZone %q is invalid: %w", c.TrafficScheduleTimeZone, err)
+	}
+	if c.ServiceClassMaxBoost != 0 && c.ServiceClassMaxBoost < 1 {
+		return fmt.Errorf("serviceClassMaxBoost must be 0 (disabled) or >= 1, got %.2f", c.ServiceClassMaxBoost)
+	}
+	if c.ScaleDownDelay < 0 {
+		return fmt.Errorf("scaleDownDelay must be >= 0, got %s", c.ScaleDownDelay)
+	}
+	if c.ScaleDownStabilizationCycles < 0 {
+		return fmt.Errorf("scaleDownStabilizationCycles must be >= 0, got %d", c.ScaleDownStabilizationCycles)
+	}
+	if c.MaxPendingAge < 0 {
+		return fmt.Errorf("maxPendingAge must be >= 0, got %s", c.MaxPendingAge)
+	}
+	if c.ColdStartGracePeriod < 0 {
+		return fmt.Errorf("coldStartGracePeriod must be >= 0, got %s", c.ColdStartGracePeriod)
+	}
+	if c.StaleDesiredTimeout < 0 {
+		return fmt.Errorf("staleDesiredTimeout must be >= 0, got %s", c.StaleDesiredTimeout)
+	}
+	if c.FlapWindow < 0 {
+		return fmt.Errorf("flapWindow must be >= 0, got %s", c.FlapWindow)
+	}
+	if c.FlapThreshold < 0 {
+		return fmt.Errorf("flapThreshold must be >= 0, got %d", c.FlapThreshold)
+	}
+	if c.InventoryRefreshInterval < 0 {
+		return fmt.Errorf("inventoryRefreshInterval must be >= 0, got %s", c.InventoryRefreshInterval)
+	}
+	switch c.ScaleDownPolicy {
+	case "", ScaleDownPolicyCost, ScaleDownPolicyLeastLoaded:
+	default:
+		return fmt.Errorf("scaleDownPolicy must be %q or %q, got %q",
+			ScaleDownPolicyCost, ScaleDownPolicyLeastLoaded, c.ScaleDownPolicy)
+	}
+	if c.TargetStrategy != "" && !targetStrategyNames[c.TargetStrategy] {
+		names := make([]string, 0, len(targetStrategyNames))
+		for _, name := range slices.Sorted(maps.Keys(targetStrategyNames)) {
+			names = append(names, fmt.Sprintf("%q", name))
+		}
+		return fmt.Errorf("targetStrategy must be one of %s, got %q", strings.Join(names, ", "), c.TargetStrategy)
+	}
+	for variant, weight := range c.VariantWeights {
+		if weight <= 0 {
+			return fmt.Errorf("variantWeights[%s] must be > 0, got %.2f", variant, weight)
+		}
+	}
+	if c.ScaleStepFraction < 0 || c.ScaleStepFraction > 1 {
+		return fmt.Errorf("scaleStepFraction must be between 0 and 1, got %.2f", c.ScaleStepFraction)
+	}
+	switch c.ScaleUpRounding {
+	case "", RoundingPolicyCeil, RoundingPolicyFloor, RoundingPolicyRound:
+	default:
+		return fmt.Errorf("scaleUpRounding must be %q, %q or %q, got %q",
+			RoundingPolicyCeil, RoundingPolicyFloor, RoundingPolicyRound, c.ScaleUpRounding)
+	}
+	switch c.ScaleDownRounding {
+	case "", RoundingPolicyCeil, RoundingPolicyFloor, RoundingPolicyRound:
+	default:
+		return fmt.Errorf("scaleDownRounding must be %q, %q or %q, got %q",
+			RoundingPolicyCeil, RoundingPolicyFloor, RoundingPolicyRound, c.ScaleDownRounding)
+	}
+	switch c.NoMetricsVariantPolicy {
+	case "", NoMetricsVariantPolicyExclude, NoMetricsVariantPolicyHold:
+	default:
+		return fmt.Errorf("noMetricsVariantPolicy must be %q or %q, got %q",
+			NoMetricsVariantPolicyExclude, NoMetricsVariantPolicyHold, c.NoMetricsVariantPolicy)
+	}
+	switch c.InvalidMetricPolicy {
+	case "", InvalidMetricPolicyDrop, InvalidMetricPolicySaturated:
+	default:
+		return fmt.Errorf("invalidMetricPolicy must be %q or %q, got %q",
+			InvalidMetricPolicyDrop, InvalidMetricPolicySaturated, c.InvalidMetricPolicy)
+	}
+	switch c.ExcessReadyPolicy {
+	case "", ExcessReadyPolicyClamp, ExcessReadyPolicyUseReady, ExcessReadyPolicyHold:
+	default:
+		return fmt.Errorf("excessReadyPolicy must be %q, %q or %q, got %q",
+			ExcessReadyPolicyClamp, ExcessReadyPolicyUseReady, ExcessReadyPolicyHold, c.ExcessReadyPolicy)
+	}
 	// KV cache threshold should be greater than spare trigger (otherwise contradictory)
 	if c.KvCacheThreshold < c.KvSpareTrigger {
 		return fmt.Errorf("kvCacheThreshold (%.2f) should be >= kvSpareTrigger (%.2f)",
@@ -52,3 +544,36 @@ func (c *SaturationScalingConfig) Validate() error {
 	}
 	return nil
 }
+
+// TrafficWindow is a daily time window of expected high load.
+type TrafficWindow struct {
+	// Start is the time of day the window opens, as "HH:MM"
+	Start string `yaml:"start"`
+	// End is the time of day the window closes, as "HH:MM". A window whose end is before its
+	// start wraps past midnight.
+	End string `yaml:"end"`
+	// Boost is the factor by which the spare triggers are raised during the window; must be >= 1
+	Boost float64 `yaml:"boost"`
+}
+
+// TrafficWindowTimeLayout is the layout of TrafficWindow start and end times.
+const TrafficWindowTimeLayout = "15:04"
+
+// Validate checks that the window's times parse and its boost is usable.
+func (w TrafficWindow) Validate() error {
+	start, err := time.Parse(TrafficWindowTimeLayout, w.Start)
+	if err != nil {
+		return fmt.Errorf("start must be HH:MM, got %q", w.Start)
+	}
+	end, err := time.Parse(TrafficWindowTimeLayout, w.End)
+	if err != nil {
+		return fmt.Errorf("end must be HH:MM, got %q", w.End)
+	}
+	if start.Equal(end) {
+		return fmt.Errorf("start and end must differ, got %s", w.Start)
+	}
+	if w.Boost < 1 {
+		return fmt.Errorf("boost must be >= 1, got %.2f", w.Boost)
+	}
+	return nil
+}
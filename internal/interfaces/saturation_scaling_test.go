@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"testing"
+	"time"
 )
 
 func TestSaturationScalingConfigValidate(t *testing.T) {
@@ -100,6 +101,727 @@ func TestSaturationScalingConfigValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid MinMetricsCoverage too high",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				MinMetricsCoverage:   1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid MinMetricsCoverage negative",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				MinMetricsCoverage:   -0.1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid least-loaded scale-down policy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ScaleDownPolicy:      ScaleDownPolicyLeastLoaded,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ScaleDownPolicy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ScaleDownPolicy:      "random",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid flap detection",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				FlapWindow:           15 * time.Minute,
+				FlapThreshold:        3,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid FlapWindow negative",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				FlapWindow:           -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid FlapThreshold negative",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				FlapThreshold:        -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid spread TargetStrategy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				TargetStrategy:       TargetStrategySpread,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid TargetStrategy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				TargetStrategy:       "priority",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid priority-weighted TargetStrategy with weights",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				TargetStrategy:       TargetStrategyPriorityWeighted,
+				VariantWeights:       map[string]float64{"llama-h100": 3, "llama-a100": 1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "non-positive variant weight",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				TargetStrategy:       TargetStrategyPriorityWeighted,
+				VariantWeights:       map[string]float64{"llama-h100": 0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid scale step rounding",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ScaleStepFraction:    0.25,
+				ScaleUpRounding:      RoundingPolicyRound,
+				ScaleDownRounding:    RoundingPolicyCeil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "ScaleStepFraction above 1",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ScaleStepFraction:    1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid ScaleDownRounding",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ScaleDownRounding:    "truncate",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid MinObservationCycles",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				MinObservationCycles: 3,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid MinObservationCycles negative",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				MinObservationCycles: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid hold no-metrics variant policy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:       0.8,
+				QueueLengthThreshold:   5,
+				KvSpareTrigger:         0.1,
+				QueueSpareTrigger:      3,
+				NoMetricsVariantPolicy: NoMetricsVariantPolicyHold,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid NoMetricsVariantPolicy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:       0.8,
+				QueueLengthThreshold:   5,
+				KvSpareTrigger:         0.1,
+				QueueSpareTrigger:      3,
+				NoMetricsVariantPolicy: "ignore",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid hold excess-ready policy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ExcessReadyPolicy:    ExcessReadyPolicyHold,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid InvalidMetricPolicy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				InvalidMetricPolicy:  InvalidMetricPolicySaturated,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid InvalidMetricPolicy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				InvalidMetricPolicy:  "zero",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid ExcessReadyPolicy",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ExcessReadyPolicy:    "ignore",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid GoodputPlateauThreshold too high",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:        0.8,
+				QueueLengthThreshold:    5,
+				KvSpareTrigger:          0.1,
+				QueueSpareTrigger:       3,
+				GoodputPlateauThreshold: 2,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid ScaleDownDelay",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ScaleDownDelay:       5 * time.Minute,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative ScaleDownDelay",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ScaleDownDelay:       -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative ScaleDownStabilizationCycles",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:             0.8,
+				QueueLengthThreshold:         5,
+				KvSpareTrigger:               0.1,
+				QueueSpareTrigger:            3,
+				ScaleDownStabilizationCycles: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid asymmetric scale-down thresholds",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:              0.8,
+				QueueLengthThreshold:          5,
+				KvSpareTrigger:                0.1,
+				QueueSpareTrigger:             3,
+				ScaleDownKvCacheThreshold:     0.5,
+				ScaleDownQueueLengthThreshold: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ScaleDownKvCacheThreshold above kvCacheThreshold",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:          0.8,
+				QueueLengthThreshold:      5,
+				KvSpareTrigger:            0.1,
+				QueueSpareTrigger:         3,
+				ScaleDownKvCacheThreshold: 0.9,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative ScaleDownQueueLengthThreshold",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:              0.8,
+				QueueLengthThreshold:          5,
+				KvSpareTrigger:                0.1,
+				QueueSpareTrigger:             3,
+				ScaleDownQueueLengthThreshold: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative ColdStartGracePeriod",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ColdStartGracePeriod: -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative StaleDesiredTimeout",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				StaleDesiredTimeout:  -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid MaxPendingAge",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				MaxPendingAge:        10 * time.Minute,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative MaxPendingAge",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				MaxPendingAge:        -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid MetricsFreshnessHalfLife",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:         0.8,
+				QueueLengthThreshold:     5,
+				KvSpareTrigger:           0.1,
+				QueueSpareTrigger:        3,
+				MetricsFreshnessHalfLife: 30 * time.Second,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative MetricsFreshnessHalfLife",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:         0.8,
+				QueueLengthThreshold:     5,
+				KvSpareTrigger:           0.1,
+				QueueSpareTrigger:        3,
+				MetricsFreshnessHalfLife: -time.Second,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid QueuePercentile",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:      0.8,
+				QueueLengthThreshold:  5,
+				KvSpareTrigger:        0.1,
+				QueueSpareTrigger:     3,
+				QueuePercentile:       50,
+				QueuePercentileWindow: 5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid QueuePercentile above 100",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				QueuePercentile:      150,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative QueuePercentileWindow",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:      0.8,
+				QueueLengthThreshold:  5,
+				KvSpareTrigger:        0.1,
+				QueueSpareTrigger:     3,
+				QueuePercentile:       50,
+				QueuePercentileWindow: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid AllowedAccelerators",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				AllowedAccelerators:  []string{"H100", "A100"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid empty name in AllowedAccelerators",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				AllowedAccelerators:  []string{"H100", ""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid TrafficSchedule",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:        0.8,
+				QueueLengthThreshold:    5,
+				KvSpareTrigger:          0.1,
+				QueueSpareTrigger:       3,
+				TrafficSchedule:         []TrafficWindow{{Start: "22:00", End: "02:00", Boost: 1.5}},
+				TrafficScheduleTimeZone: "America/New_York",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid TrafficSchedule time",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				TrafficSchedule:      []TrafficWindow{{Start: "8am", End: "10:00", Boost: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid TrafficSchedule boost below 1",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				TrafficSchedule:      []TrafficWindow{{Start: "08:00", End: "10:00", Boost: 0.5}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid TrafficSchedule empty window",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				TrafficSchedule:      []TrafficWindow{{Start: "08:00", End: "08:00", Boost: 2}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid TrafficScheduleTimeZone",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:        0.8,
+				QueueLengthThreshold:    5,
+				KvSpareTrigger:          0.1,
+				QueueSpareTrigger:       3,
+				TrafficScheduleTimeZone: "Mars/Olympus_Mons",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative InventoryRefreshInterval",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:         0.8,
+				QueueLengthThreshold:     5,
+				KvSpareTrigger:           0.1,
+				QueueSpareTrigger:        3,
+				MaxReplicasFromInventory: true,
+				InventoryRefreshInterval: -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid CarbonWeight too high",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				CarbonWeight:         1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative energy factor",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:         0.8,
+				QueueLengthThreshold:     5,
+				KvSpareTrigger:           0.1,
+				QueueSpareTrigger:        3,
+				CarbonWeight:             0.5,
+				AcceleratorEnergyFactors: map[string]float64{"A100": -1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid zero capacity factor",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:           0.8,
+				QueueLengthThreshold:       5,
+				KvSpareTrigger:             0.1,
+				QueueSpareTrigger:          3,
+				AcceleratorCapacityFactors: map[string]float64{"H100": 0},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid ErrorRateThreshold too high",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ErrorRateThreshold:   1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid RejectedRequestRateTrigger",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:           0.8,
+				QueueLengthThreshold:       5,
+				KvSpareTrigger:             0.1,
+				QueueSpareTrigger:          3,
+				RejectedRequestRateTrigger: 0.5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative RejectedRequestRateTrigger",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:           0.8,
+				QueueLengthThreshold:       5,
+				KvSpareTrigger:             0.1,
+				QueueSpareTrigger:          3,
+				RejectedRequestRateTrigger: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid fractional SaturatedQuorum",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				SaturatedQuorum:      0.5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid count SaturatedQuorum",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				SaturatedQuorum:      2,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid non-integral SaturatedQuorum count",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				SaturatedQuorum:      1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative SaturatedQuorum",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				SaturatedQuorum:      -0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid TokensInFlightSpareTrigger",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:           0.8,
+				QueueLengthThreshold:       5,
+				KvSpareTrigger:             0.1,
+				QueueSpareTrigger:          3,
+				TokensInFlightSpareTrigger: 0.2,
+				MaxBatchSize:               256,
+				ContextLength:              8192,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid TokensInFlightSpareTrigger too high",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:           0.8,
+				QueueLengthThreshold:       5,
+				KvSpareTrigger:             0.1,
+				QueueSpareTrigger:          3,
+				TokensInFlightSpareTrigger: 1.5,
+				MaxBatchSize:               256,
+				ContextLength:              8192,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid TokensInFlightSpareTrigger without ContextLength",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:           0.8,
+				QueueLengthThreshold:       5,
+				KvSpareTrigger:             0.1,
+				QueueSpareTrigger:          3,
+				TokensInFlightSpareTrigger: 0.2,
+				MaxBatchSize:               256,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative MaxBatchSize",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				MaxBatchSize:         -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid NormalizeQueueByBatchSize",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:          0.8,
+				QueueLengthThreshold:      5,
+				KvSpareTrigger:            0.1,
+				QueueSpareTrigger:         3,
+				MaxBatchSize:              64,
+				NormalizeQueueByBatchSize: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "NormalizeQueueByBatchSize without MaxBatchSize",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:          0.8,
+				QueueLengthThreshold:      5,
+				KvSpareTrigger:            0.1,
+				QueueSpareTrigger:         3,
+				NormalizeQueueByBatchSize: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid ServiceClassMaxBoost",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ServiceClassMaxBoost: 2,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ServiceClassMaxBoost below 1",
+			config: SaturationScalingConfig{
+				KvCacheThreshold:     0.8,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.1,
+				QueueSpareTrigger:    3,
+				ServiceClassMaxBoost: 0.5,
+			},
+			wantErr: true,
+		},
 		{
 			name: "edge case: zero values are valid",
 			config: SaturationScalingConfig{
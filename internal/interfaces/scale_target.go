@@ -0,0 +1,16 @@
+package interfaces
+
+import "context"
+
+// ScaleTargetProvider reads and sets the replicas of a VariantAutoscaling's scale target
+// without tying callers to a specific workload kind (Deployment, StatefulSet, ...).
+type ScaleTargetProvider interface {
+	// GetReplicas returns the current replicas of the scale target, as reported by its
+	// scale subresource (the same view HPA uses).
+	GetReplicas(ctx context.Context) (int, error)
+	// GetReadyReplicas returns how many of the current replicas are ready to serve traffic.
+	GetReadyReplicas(ctx context.Context) (int, error)
+	// SetReplicas sets the desired replicas of the scale target. Nothing is written when it
+	// already has that many. Providers that cannot scale their target return an error.
+	SetReplicas(ctx context.Context, replicas int32) error
+}
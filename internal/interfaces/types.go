@@ -1,6 +1,11 @@
 package interfaces
 
-import inferno "github.com/llm-d-incubation/workload-variant-autoscaler/pkg/core"
+import (
+	"fmt"
+	"time"
+
+	inferno "github.com/llm-d-incubation/workload-variant-autoscaler/pkg/core"
+)
 
 // Captures response from ModelAnalyzer(s) per model
 type ModelAnalyzeResponse struct {
@@ -27,6 +32,74 @@ type ServiceClass struct {
 	Name     string              `yaml:"name"`
 	Priority int                 `yaml:"priority"`
 	Data     []ServiceClassEntry `yaml:"data"`
+	// QueueLengthThreshold, when > 0, replaces the saturation config's queueLengthThreshold for
+	// the models of this class, so stricter tiers can queue less before scaling up
+	QueueLengthThreshold float64 `yaml:"queueLengthThreshold,omitempty"`
+}
+
+// Validate checks that the service class is named and that each entry names a model
+// with positive SLO targets.
+func (sc *ServiceClass) Validate() error {
+	if sc.Name == "" {
+		return fmt.Errorf("name must not be empty")
+	}
+	if sc.Priority < 0 {
+		return fmt.Errorf("priority must be >= 0, got %d", sc.Priority)
+	}
+	if sc.QueueLengthThreshold < 0 {
+		return fmt.Errorf("queueLengthThreshold must be >= 0, got %.1f", sc.QueueLengthThreshold)
+	}
+	for i, entry := range sc.Data {
+		if entry.Model == "" {
+			return fmt.Errorf("data[%d]: model must not be empty", i)
+		}
+		if entry.SLOTPOT <= 0 {
+			return fmt.Errorf("data[%d] (%s): slo-tpot must be > 0, got %d", i, entry.Model, entry.SLOTPOT)
+		}
+		if entry.SLOTTFT <= 0 {
+			return fmt.Errorf("data[%d] (%s): slo-ttft must be > 0, got %d", i, entry.Model, entry.SLOTTFT)
+		}
+	}
+	return nil
+}
+
+// MaintenanceWindow is a time range during which all scaling is frozen, e.g. for cluster-wide
+// maintenance. Start and End are RFC 3339 timestamps; the window includes Start and excludes End.
+type MaintenanceWindow struct {
+	// Name identifies the window, it is set from the ConfigMap key
+	Name   string `yaml:"-"`
+	Start  string `yaml:"start"`
+	End    string `yaml:"end"`
+	Reason string `yaml:"reason,omitempty"`
+}
+
+// Validate checks that the window's times parse and that it ends after it starts.
+func (w MaintenanceWindow) Validate() error {
+	start, err := time.Parse(time.RFC3339, w.Start)
+	if err != nil {
+		return fmt.Errorf("start must be an RFC 3339 time, got %q", w.Start)
+	}
+	end, err := time.Parse(time.RFC3339, w.End)
+	if err != nil {
+		return fmt.Errorf("end must be an RFC 3339 time, got %q", w.End)
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end %s must be after start %s", w.End, w.Start)
+	}
+	return nil
+}
+
+// Contains reports whether now falls within the window. An invalid window contains no time.
+func (w MaintenanceWindow) Contains(now time.Time) bool {
+	start, err := time.Parse(time.RFC3339, w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(time.RFC3339, w.End)
+	if err != nil {
+		return false
+	}
+	return !now.Before(start) && now.Before(end)
 }
 
 // PrometheusConfig holds complete Prometheus client configuration including TLS settings
@@ -18,7 +18,11 @@ const (
 )
 
 // InitLogging initializes the controller-runtime logger with zap backend.
+// Per-subsystem verbosity is read from the LOG_LEVEL_<SUBSYSTEM> environment variables;
+// see FromContext.
 func InitLogging(opts *zap.Options, logVerbosity *int) {
+	subsystemErr := LoadSubsystemLevels()
+
 	// Unless -zap-log-level is explicitly set, use -v
 	useV := true
 	flag.Visit(func(f *flag.Flag) {
@@ -26,14 +30,24 @@ func InitLogging(opts *zap.Options, logVerbosity *int) {
 			useV = false
 		}
 	})
+	// A subsystem can only log more than the global verbosity if the backend lets it through,
+	// so the backend runs at the highest configured level and the root logger filters back down.
+	raiseForSubsystems := useV && maxSubsystemLevel() > *logVerbosity
 	if useV {
 		// See https://pkg.go.dev/sigs.k8s.io/controller-runtime/pkg/log/zap#Options.Level
-		lvl := -1 * (*logVerbosity)
+		lvl := -1 * max(*logVerbosity, maxSubsystemLevel())
 		opts.Level = uberzap.NewAtomicLevelAt(zapcore.Level(int8(lvl)))
 	}
 
 	logger := zap.New(zap.UseFlagOptions(opts), zap.RawZapOpts(uberzap.AddCaller()))
+	if raiseForSubsystems {
+		logger = withVerbosity(logger, *logVerbosity)
+	}
 	ctrl.SetLogger(logger)
+
+	if subsystemErr != nil {
+		ctrl.Log.Error(subsystemErr, "Ignoring invalid subsystem log level")
+	}
 }
 
 // Sync flushes any buffered log entries.
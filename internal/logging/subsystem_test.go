@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// newRecordingLogger returns a logger that records every message it lets through.
+// The backend accepts all levels up to TRACE; the root filter applies the global verbosity,
+// as InitLogging does when a subsystem is more verbose than the global level.
+func newRecordingLogger(globalVerbosity int) (logr.Logger, *[]string) {
+	var messages []string
+	backend := funcr.New(func(prefix, args string) {
+		messages = append(messages, args)
+	}, funcr.Options{Verbosity: TRACE})
+	return withVerbosity(backend, globalVerbosity), &messages
+}
+
+func TestForSubsystem_DebugOnlyWhenEnabled(t *testing.T) {
+	defer ResetSubsystemLevels()
+
+	tests := []struct {
+		name            string
+		subsystemLevels map[Subsystem]int
+		logSubsystem    Subsystem
+		expectEmitted   bool
+	}{
+		{
+			name:          "no override follows global verbosity",
+			logSubsystem:  Collector,
+			expectEmitted: false,
+		},
+		{
+			name:            "subsystem at debug emits debug logs",
+			subsystemLevels: map[Subsystem]int{Collector: DEBUG},
+			logSubsystem:    Collector,
+			expectEmitted:   true,
+		},
+		{
+			name:            "other subsystems stay at global verbosity",
+			subsystemLevels: map[Subsystem]int{Collector: DEBUG},
+			logSubsystem:    Controller,
+			expectEmitted:   false,
+		},
+		{
+			name:            "subsystem below debug does not emit debug logs",
+			subsystemLevels: map[Subsystem]int{Engine: VERBOSE},
+			logSubsystem:    Engine,
+			expectEmitted:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ResetSubsystemLevels()
+			for s, level := range tt.subsystemLevels {
+				SetSubsystemLevel(s, level)
+			}
+
+			root, messages := newRecordingLogger(DEFAULT)
+			ctx := log.IntoContext(context.Background(), root.WithValues("reconcileID", "abc"))
+
+			FromContext(ctx, tt.logSubsystem).V(DEBUG).Info("debug message")
+
+			if emitted := len(*messages) > 0; emitted != tt.expectEmitted {
+				t.Errorf("expected emitted=%v, got messages %v", tt.expectEmitted, *messages)
+			}
+		})
+	}
+}
+
+func TestForSubsystem_CanLowerVerbosity(t *testing.T) {
+	defer ResetSubsystemLevels()
+	SetSubsystemLevel(Controller, DEFAULT)
+
+	root, messages := newRecordingLogger(TRACE)
+
+	ForSubsystem(root, Controller).V(DEBUG).Info("controller debug")
+	ForSubsystem(root, Collector).V(DEBUG).Info("collector debug")
+
+	if len(*messages) != 1 {
+		t.Fatalf("expected only the collector message, got %v", *messages)
+	}
+}
+
+func TestForSubsystem_ErrorsAlwaysEmitted(t *testing.T) {
+	defer ResetSubsystemLevels()
+	SetSubsystemLevel(Analyzer, 0)
+
+	root, messages := newRecordingLogger(DEFAULT)
+	ForSubsystem(root, Analyzer).Error(nil, "analysis failed")
+
+	if len(*messages) != 1 {
+		t.Fatalf("expected error to be emitted, got %v", *messages)
+	}
+}
+
+func TestLoadSubsystemLevels(t *testing.T) {
+	defer ResetSubsystemLevels()
+	ResetSubsystemLevels()
+
+	t.Setenv(LogLevelEnvVar(Collector), "DEBUG")
+	t.Setenv(LogLevelEnvVar(Engine), "5")
+	t.Setenv(LogLevelEnvVar(Controller), "loud")
+
+	err := LoadSubsystemLevels()
+	if err == nil {
+		t.Error("expected an error for the invalid controller level")
+	}
+
+	if level, ok := SubsystemLevel(Collector); !ok || level != DEBUG {
+		t.Errorf("expected collector level %d, got %d (set=%v)", DEBUG, level, ok)
+	}
+	if level, ok := SubsystemLevel(Engine); !ok || level != TRACE {
+		t.Errorf("expected engine level %d, got %d (set=%v)", TRACE, level, ok)
+	}
+	if _, ok := SubsystemLevel(Controller); ok {
+		t.Error("expected invalid controller level to be ignored")
+	}
+	if _, ok := SubsystemLevel(Analyzer); ok {
+		t.Error("expected analyzer without a variable to follow the global level")
+	}
+}
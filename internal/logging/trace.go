@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// TraceIDKey is the log key of the trace ID identifying one optimization cycle.
+const TraceIDKey = "traceID"
+
+type traceIDContextKey struct{}
+
+// NewTraceID returns a random 16 character hex ID for an optimization cycle.
+func NewTraceID() string {
+	b := make([]byte, 8)
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// WithTraceID returns a copy of ctx carrying traceID, whose logger adds it to every log line
+// under TraceIDKey. Loggers obtained with FromContext from the returned context, or from
+// contexts derived from it, all log the same trace ID, so the collection, analysis and apply
+// steps of a cycle can be correlated.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	ctx = context.WithValue(ctx, traceIDContextKey{}, traceID)
+	return ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues(TraceIDKey, traceID))
+}
+
+// TraceIDFromContext returns the trace ID set on ctx by WithTraceID, or an empty string.
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDContextKey{}).(string)
+	return traceID
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestWithTraceID_PropagatesToSubsystemLoggers(t *testing.T) {
+	logger, messages := newRecordingLogger(TRACE)
+	ctx := WithTraceID(log.IntoContext(context.Background(), logger), "cycle-1")
+
+	if got := TraceIDFromContext(ctx); got != "cycle-1" {
+		t.Fatalf("TraceIDFromContext() = %q, want cycle-1", got)
+	}
+	for _, s := range Subsystems {
+		FromContext(ctx, s).Info("step", "subsystem", s)
+	}
+	// A context derived from the cycle's keeps its trace ID
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+	FromContext(child, Engine).V(DEBUG).Info("derived step")
+
+	if len(*messages) != len(Subsystems)+1 {
+		t.Fatalf("expected %d log lines, got %d", len(Subsystems)+1, len(*messages))
+	}
+	for _, message := range *messages {
+		if !strings.Contains(message, `"traceID"="cycle-1"`) {
+			t.Errorf("expected trace ID in %s", message)
+		}
+	}
+}
+
+func TestTraceIDFromContext_Unset(t *testing.T) {
+	if got := TraceIDFromContext(context.Background()); got != "" {
+		t.Errorf("expected no trace ID, got %q", got)
+	}
+}
+
+func TestNewTraceID_Unique(t *testing.T) {
+	first, second := NewTraceID(), NewTraceID()
+	if len(first) != 16 || first == second {
+		t.Errorf("expected distinct 16 character IDs, got %q and %q", first, second)
+	}
+}
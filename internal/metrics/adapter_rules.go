@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+)
+
+// externalMetricsAPIPath is the path of the external metrics API version HPA reads WVA metrics from.
+const externalMetricsAPIPath = "/apis/external.metrics.k8s.io/v1beta1"
+
+// ExternalMetricsRuleOptions configures the generated prometheus-adapter rules.
+type ExternalMetricsRuleOptions struct {
+	// ControllerInstance, when set, restricts the rules to the series of one WVA controller instance.
+	ControllerInstance string
+	// TenantNamespaceLabel resolves namespaces by the tenant_namespace label, for controllers
+	// started with the tenant namespace label enabled.
+	TenantNamespaceLabel bool
+}
+
+// AdapterRule is a prometheus-adapter external metrics rule, reduced to the fields WVA sets.
+type AdapterRule struct {
+	SeriesQuery  string             `json:"seriesQuery"`
+	Resources    AdapterResources   `json:"resources"`
+	Name         AdapterNameMapping `json:"name"`
+	MetricsQuery string             `json:"metricsQuery"`
+}
+
+// AdapterResources maps series labels to the Kubernetes resources they name.
+type AdapterResources struct {
+	Overrides map[string]AdapterResource `json:"overrides"`
+}
+
+// AdapterResource is the Kubernetes resource a series label names.
+type AdapterResource struct {
+	Resource string `json:"resource"`
+}
+
+// AdapterNameMapping renames the series exposed through the external metrics API.
+type AdapterNameMapping struct {
+	Matches string `json:"matches"`
+	As      string `json:"as"`
+}
+
+// ExternalMetricsRules returns the prometheus-adapter rules exposing the desired replicas through
+// the external metrics API. The namespace of a request selects the series of the VariantAutoscalings
+// in that namespace, so each tenant discovers only its own variants.
+func ExternalMetricsRules(opts ExternalMetricsRuleOptions) []AdapterRule {
+	namespace := namespaceLabel(opts.TenantNamespaceLabel)
+	selector := ""
+	if opts.ControllerInstance != "" {
+		selector = fmt.Sprintf(`,%s=%q`, constants.LabelControllerInstance, opts.ControllerInstance)
+	}
+
+	return []AdapterRule{{
+		SeriesQuery: fmt.Sprintf(`%s{%s!="",%s!=""%s}`,
+			constants.WVADesiredReplicas, constants.LabelVariantName, namespace, selector),
+		Resources: AdapterResources{Overrides: map[string]AdapterResource{
+			namespace:                  {Resource: "namespace"},
+			constants.LabelVariantName: {Resource: "deployment"},
+		}},
+		Name: AdapterNameMapping{
+			Matches: "^" + constants.WVADesiredReplicas,
+			As:      constants.WVADesiredReplicas,
+		},
+		MetricsQuery: fmt.Sprintf("%s{<<.LabelMatchers>>%s}", constants.WVADesiredReplicas, selector),
+	}}
+}
+
+// ExternalMetricsRulesYAML renders the rules returned by ExternalMetricsRules as prometheus-adapter
+// Helm values.
+func ExternalMetricsRulesYAML(opts ExternalMetricsRuleOptions) ([]byte, error) {
+	values := map[string]any{
+		"rules": map[string]any{"external": ExternalMetricsRules(opts)},
+	}
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal external metrics rules: %w", err)
+	}
+	return data, nil
+}
+
+// ExternalMetricsAPIPath returns the external metrics API path HPA reads metric from for the
+// VariantAutoscalings in namespace.
+func ExternalMetricsAPIPath(namespace, metric string) string {
+	return externalMetricsAPIPath + "/namespaces/" + namespace + "/" + metric
+}
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+)
+
+// namespaceOverride returns the series label a rule maps to the namespace resource.
+func namespaceOverride(t *testing.T, rule AdapterRule) string {
+	t.Helper()
+	for label, resource := range rule.Resources.Overrides {
+		if resource.Resource == "namespace" {
+			return label
+		}
+	}
+	t.Fatalf("rule %q has no namespace override", rule.SeriesQuery)
+	return ""
+}
+
+func TestExternalMetricsRules_PerTenantDiscovery(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	SetTenantNamespaceLabelEnabled(true)
+	defer SetTenantNamespaceLabelEnabled(false)
+
+	registry := prometheus.NewRegistry()
+	if err := InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	// Both tenants scale a deployment of the same name
+	desired := map[string]int32{"team-a": 3, "team-b": 5}
+	emitter := NewMetricsEmitter()
+	for namespace, replicas := range desired {
+		va := &llmdOptv1alpha1.VariantAutoscaling{
+			ObjectMeta: metav1.ObjectMeta{Name: "llama-va", Namespace: namespace},
+			Spec: llmdOptv1alpha1.VariantAutoscalingSpec{
+				ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"},
+			},
+		}
+		if err := emitter.EmitReplicaMetrics(context.Background(), va, 2, replicas, "H100"); err != nil {
+			t.Fatalf("failed to emit replica metrics: %v", err)
+		}
+	}
+
+	rules := ExternalMetricsRules(ExternalMetricsRuleOptions{TenantNamespaceLabel: true})
+	if len(rules) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(rules))
+	}
+	label := namespaceOverride(t, rules[0])
+	if label != constants.LabelTenantNamespace {
+		t.Fatalf("expected namespaces resolved by %s, got %s", constants.LabelTenantNamespace, label)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for namespace, replicas := range desired {
+		// Select the series the adapter serves for a request in the tenant's namespace
+		var values []float64
+		for _, family := range families {
+			if family.GetName() != constants.WVADesiredReplicas {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				for _, pair := range metric.GetLabel() {
+					if pair.GetName() == label && pair.GetValue() == namespace {
+						values = append(values, metric.GetGauge().GetValue())
+					}
+				}
+			}
+		}
+		if len(values) != 1 || values[0] != float64(replicas) {
+			t.Errorf("%s: expected only its own desired replicas %d, got %v", namespace, replicas, values)
+		}
+	}
+
+	if got, want := ExternalMetricsAPIPath("team-a", constants.WVADesiredReplicas),
+		"/apis/external.metrics.k8s.io/v1beta1/namespaces/team-a/wva_desired_replicas"; got != want {
+		t.Errorf("expected path %q, got %q", want, got)
+	}
+}
+
+func TestExternalMetricsRules_Options(t *testing.T) {
+	rule := ExternalMetricsRules(ExternalMetricsRuleOptions{})[0]
+	if label := namespaceOverride(t, rule); label != constants.LabelExportedNamespace {
+		t.Errorf("expected namespaces resolved by %s by default, got %s", constants.LabelExportedNamespace, label)
+	}
+	if rule.Resources.Overrides[constants.LabelVariantName].Resource != "deployment" {
+		t.Errorf("expected %s mapped to deployments, got %+v", constants.LabelVariantName, rule.Resources.Overrides)
+	}
+
+	rule = ExternalMetricsRules(ExternalMetricsRuleOptions{ControllerInstance: "wva-a"})[0]
+	selector := constants.LabelControllerInstance + `="wva-a"`
+	for _, query := range []string{rule.SeriesQuery, rule.MetricsQuery} {
+		if !strings.Contains(query, selector) {
+			t.Errorf("expected %s selector in %q", selector, query)
+		}
+	}
+}
+
+func TestExternalMetricsRulesYAML(t *testing.T) {
+	data, err := ExternalMetricsRulesYAML(ExternalMetricsRuleOptions{TenantNamespaceLabel: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Rules struct {
+			External []AdapterRule `json:"external"`
+		} `json:"rules"`
+	}
+	if err := yaml.UnmarshalStrict(data, &decoded); err != nil {
+		t.Fatalf("generated values are not valid adapter rules: %v\n%s", err, data)
+	}
+	if len(decoded.Rules.External) != 1 || decoded.Rules.External[0].Name.As != constants.WVADesiredReplicas {
+		t.Errorf("unexpected rules %+v", decoded.Rules.External)
+	}
+}
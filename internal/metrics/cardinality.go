@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// seriesLimiter caps the number of distinct label sets (series) of each metric to protect
+// Prometheus from a cardinality explosion. Series already created are always allowed, so they
+// keep being updated once the cap is reached. It is safe for concurrent use.
+type seriesLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	series map[string]map[string]prometheus.Labels
+	warned map[string]bool
+}
+
+// newSeriesLimiter creates a limiter allowing limit series per metric. Zero disables the cap.
+func newSeriesLimiter(limit int) *seriesLimiter {
+	return &seriesLimiter{
+		limit:  limit,
+		series: make(map[string]map[string]prometheus.Labels),
+		warned: make(map[string]bool),
+	}
+}
+
+// allow reports whether the series with the given labels may be written to metric, recording it
+// when new. The first series refused for a metric is logged.
+func (l *seriesLimiter) allow(ctx context.Context, metric string, labels prometheus.Labels) bool {
+	if l == nil || l.limit <= 0 {
+		return true
+	}
+
+	key := seriesKey(labels)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	known, ok := l.series[metric]
+	if !ok {
+		known = make(map[string]prometheus.Labels)
+		l.series[metric] = known
+	}
+	if _, ok := known[key]; ok {
+		return true
+	}
+	if len(known) >= l.limit {
+		if !l.warned[metric] {
+			l.warned[metric] = true
+			ctrl.LoggerFrom(ctx).Info("Metric series limit reached, new series are dropped",
+				"metric", metric, "limit", l.limit, "labels", labels)
+		}
+		return false
+	}
+	known[key] = maps.Clone(labels)
+	return true
+}
+
+// forget drops the recorded series of metric whose labels contain match, so that deleted
+// series no longer count against the limit
+func (l *seriesLimiter) forget(metric string, match prometheus.Labels) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, labels := range l.series[metric] {
+		if containsLabels(labels, match) {
+			delete(l.series[metric], key)
+		}
+	}
+}
+
+// containsLabels reports whether labels has every name/value pair of match
+func containsLabels(labels, match prometheus.Labels) bool {
+	for name, value := range match {
+		if labels[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// seriesKey returns a canonical representation of a label set
+func seriesKey(labels prometheus.Labels) string {
+	var b strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(labels)) {
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(labels[name])
+		b.WriteByte(',')
+	}
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// desiredDeltaFilter holds back changes of the desired replicas gauge smaller than a minimum
+// delta, so that small fluctuations of the target do not churn the metric HPA and KEDA act on.
+// It remembers the last emitted value of each series. It is safe for concurrent use.
+type desiredDeltaFilter struct {
+	mu      sync.Mutex
+	delta   int32
+	emitted map[string]emittedDesired
+}
+
+// emittedDesired is the last desired replicas emitted on a series, with the series' labels so
+// that it can be forgotten by partial match
+type emittedDesired struct {
+	labels  prometheus.Labels
+	desired int32
+}
+
+// newDesiredDeltaFilter creates a filter requiring changes of at least delta. A delta <= 1 lets
+// every change through.
+func newDesiredDeltaFilter(delta int) *desiredDeltaFilter {
+	return &desiredDeltaFilter{
+		delta:   int32(delta),
+		emitted: make(map[string]emittedDesired),
+	}
+}
+
+// apply returns the desired replicas to emit on the series with the given labels: desired when
+// it differs from the last emitted value by at least the delta, otherwise the last emitted value.
+// Scaling to or from zero is always emitted, so a variant can always reach and leave zero.
+func (f *desiredDeltaFilter) apply(labels prometheus.Labels, desired int32) int32 {
+	if f == nil || f.delta <= 1 {
+		return desired
+	}
+
+	key := seriesKey(labels)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	last, ok := f.emitted[key]
+	if ok && desired != 0 && last.desired != 0 {
+		change := desired - last.desired
+		if change < 0 {
+			change = -change
+		}
+		if change < f.delta {
+			return last.desired
+		}
+	}
+	f.emitted[key] = emittedDesired{labels: labels, desired: desired}
+	return desired
+}
+
+// forget drops the last emitted values of the series whose labels contain match
+func (f *desiredDeltaFilter) forget(match prometheus.Labels) {
+	if f == nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for key, emitted := range f.emitted {
+		if containsLabels(emitted.labels, match) {
+			delete(f.emitted, key)
+		}
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/pkg/core"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -16,12 +18,38 @@ const ControllerInstanceEnvVar = "CONTROLLER_INSTANCE"
 var (
 	replicaScalingTotal *prometheus.CounterVec
 	desiredReplicas     *prometheus.GaugeVec
+	recommendedReplicas *prometheus.GaugeVec
 	currentReplicas     *prometheus.GaugeVec
 	desiredRatio        *prometheus.GaugeVec
+	decisionConfidence  *prometheus.GaugeVec
+
+	lastOptimizationTimestamp *prometheus.GaugeVec
+	maxReplicasCap            *prometheus.GaugeVec
+	collectorDiscrepancyTotal *prometheus.CounterVec
+	optimizePanicsTotal       *prometheus.CounterVec
+	limiterNoInventoryTotal   *prometheus.CounterVec
+	acceleratorUtilization    *prometheus.GaugeVec
 
 	// controllerInstance stores the optional controller instance identifier.
 	// When set, it's added as a label to all emitted metrics.
 	controllerInstance string
+
+	// targetNameLabel adds the scale target name as an extra target_name label
+	// to replica metrics, alongside variant_name (the VariantAutoscaling name).
+	targetNameLabel bool
+
+	// tenantNamespaceLabel adds the VariantAutoscaling namespace as an extra tenant_namespace
+	// label to replica metrics, which keeps its name when scraped.
+	tenantNamespaceLabel bool
+
+	// maxSeriesPerMetric caps the number of series of each metric; zero means unlimited.
+	maxSeriesPerMetric int
+	seriesGuard        *seriesLimiter
+
+	// minDesiredDelta is the smallest change of the desired replicas gauge that is emitted;
+	// 0 or 1 emits every change.
+	minDesiredDelta int
+	desiredFilter   *desiredDeltaFilter
 )
 
 // GetControllerInstance returns the configured controller instance label value
@@ -30,6 +58,32 @@ func GetControllerInstance() string {
 	return controllerInstance
 }
 
+// SetTargetNameLabelEnabled controls whether replica metrics carry the extra target_name label.
+// It must be called before InitMetrics, since the label set is fixed at registration.
+func SetTargetNameLabelEnabled(enabled bool) {
+	targetNameLabel = enabled
+}
+
+// SetTenantNamespaceLabelEnabled controls whether replica metrics carry the extra tenant_namespace
+// label. It must be called before InitMetrics, since the label set is fixed at registration.
+func SetTenantNamespaceLabelEnabled(enabled bool) {
+	tenantNamespaceLabel = enabled
+}
+
+// SetMaxSeriesPerMetric caps the number of distinct label sets of each metric. Once a metric has
+// limit series, new series are dropped while existing ones keep being updated. Zero disables the cap.
+// It must be called before InitMetrics.
+func SetMaxSeriesPerMetric(limit int) {
+	maxSeriesPerMetric = limit
+}
+
+// SetMinDesiredDelta sets the smallest change of a variant's desired replicas that updates the
+// desired replicas gauge; smaller changes keep the last emitted value. Values <= 1 emit every
+// change. It must be called before InitMetrics.
+func SetMinDesiredDelta(delta int) {
+	minDesiredDelta = delta
+}
+
 // InitMetrics registers all custom metrics with the provided registry.
 // This function should be called once during application startup from main().
 // It reads CONTROLLER_INSTANCE from the environment to optionally add
@@ -37,14 +91,34 @@ func GetControllerInstance() string {
 func InitMetrics(registry prometheus.Registerer) error {
 	// Read controller instance from environment
 	controllerInstance = os.Getenv(ControllerInstanceEnvVar)
+	seriesGuard = newSeriesLimiter(maxSeriesPerMetric)
+	desiredFilter = newDesiredDeltaFilter(minDesiredDelta)
 
 	// Build label sets based on whether controller_instance is configured
 	baseLabels := []string{constants.LabelVariantName, constants.LabelNamespace, constants.LabelAcceleratorType}
 	scalingLabels := []string{constants.LabelVariantName, constants.LabelNamespace, constants.LabelDirection, constants.LabelReason}
+	modelLabels := []string{constants.LabelModelName, constants.LabelNamespace}
+	capLabels := []string{constants.LabelVariantName, constants.LabelNamespace, constants.LabelAcceleratorType}
+	discrepancyLabels := []string{constants.LabelModelName, constants.LabelNamespace, constants.LabelMetric}
+	var instanceLabels []string
+	acceleratorLabels := []string{constants.LabelAcceleratorType}
 
 	if controllerInstance != "" {
 		baseLabels = append(baseLabels, constants.LabelControllerInstance)
 		scalingLabels = append(scalingLabels, constants.LabelControllerInstance)
+		modelLabels = append(modelLabels, constants.LabelControllerInstance)
+		capLabels = append(capLabels, constants.LabelControllerInstance)
+		discrepancyLabels = append(discrepancyLabels, constants.LabelControllerInstance)
+		instanceLabels = append(instanceLabels, constants.LabelControllerInstance)
+		acceleratorLabels = append(acceleratorLabels, constants.LabelControllerInstance)
+	}
+	if targetNameLabel {
+		baseLabels = append(baseLabels, constants.LabelTargetName)
+		scalingLabels = append(scalingLabels, constants.LabelTargetName)
+	}
+	if tenantNamespaceLabel {
+		baseLabels = append(baseLabels, constants.LabelTenantNamespace)
+		scalingLabels = append(scalingLabels, constants.LabelTenantNamespace)
 	}
 
 	replicaScalingTotal = prometheus.NewCounterVec(
@@ -61,6 +135,13 @@ func InitMetrics(registry prometheus.Registerer) error {
 		},
 		baseLabels,
 	)
+	recommendedReplicas = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.WVARecommendedReplicas,
+			Help: "Replicas recommended by the saturation analysis for each variant, before policies and limits",
+		},
+		baseLabels,
+	)
 	currentReplicas = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: constants.WVACurrentReplicas,
@@ -75,6 +156,55 @@ func InitMetrics(registry prometheus.Registerer) error {
 		},
 		baseLabels,
 	)
+	decisionConfidence = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.WVADecisionConfidence,
+			Help: "Confidence of the latest decision for each variant, from the coverage and freshness of its metrics",
+		},
+		baseLabels,
+	)
+	lastOptimizationTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.WVALastOptimizationTimestamp,
+			Help: "Unix time of the last successful optimization of each model",
+		},
+		modelLabels,
+	)
+	maxReplicasCap = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.WVAMaxReplicasCap,
+			Help: "Maximum replicas of each variant derived from the cluster accelerator inventory",
+		},
+		capLabels,
+	)
+	collectorDiscrepancyTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.WVACollectorDiscrepancyTotal,
+			Help: "Total number of replica metrics on which the shadow collector disagreed with the primary collector",
+		},
+		discrepancyLabels,
+	)
+	optimizePanicsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.WVAOptimizePanicsTotal,
+			Help: "Total number of optimization cycles that panicked and were recovered",
+		},
+		instanceLabels,
+	)
+	limiterNoInventoryTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.WVALimiterNoInventoryTotal,
+			Help: "Total number of optimization cycles in which the GPU limiter found no accelerator inventory",
+		},
+		instanceLabels,
+	)
+	acceleratorUtilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.WVAAcceleratorUtilization,
+			Help: "Average KV cache usage of all replicas on each accelerator type",
+		},
+		acceleratorLabels,
+	)
 
 	// Register metrics with the registry
 	if err := registry.Register(replicaScalingTotal); err != nil {
@@ -83,12 +213,58 @@ func InitMetrics(registry prometheus.Registerer) error {
 	if err := registry.Register(desiredReplicas); err != nil {
 		return fmt.Errorf("failed to register desiredReplicas metric: %w", err)
 	}
+	if err := registry.Register(recommendedReplicas); err != nil {
+		return fmt.Errorf("failed to register recommendedReplicas metric: %w", err)
+	}
 	if err := registry.Register(currentReplicas); err != nil {
 		return fmt.Errorf("failed to register currentReplicas metric: %w", err)
 	}
 	if err := registry.Register(desiredRatio); err != nil {
 		return fmt.Errorf("failed to register desiredRatio metric: %w", err)
 	}
+	if err := registry.Register(decisionConfidence); err != nil {
+		return fmt.Errorf("failed to register decisionConfidence metric: %w", err)
+	}
+	if err := registry.Register(lastOptimizationTimestamp); err != nil {
+		return fmt.Errorf("failed to register lastOptimizationTimestamp metric: %w", err)
+	}
+	if err := registry.Register(maxReplicasCap); err != nil {
+		return fmt.Errorf("failed to register maxReplicasCap metric: %w", err)
+	}
+	if err := registry.Register(collectorDiscrepancyTotal); err != nil {
+		return fmt.Errorf("failed to register collectorDiscrepancyTotal metric: %w", err)
+	}
+	if err := registry.Register(optimizePanicsTotal); err != nil {
+		return fmt.Errorf("failed to register optimizePanicsTotal metric: %w", err)
+	}
+	if err := registry.Register(limiterNoInventoryTotal); err != nil {
+		return fmt.Errorf("failed to register limiterNoInventoryTotal metric: %w", err)
+	}
+	if err := registry.Register(acceleratorUtilization); err != nil {
+		return fmt.Errorf("failed to register acceleratorUtilization metric: %w", err)
+	}
+
+	// Optimizer cache counters are read from the cache itself at scrape time
+	optimizerCacheHits := prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Name: constants.WVAOptimizerCacheHitsTotal,
+			Help: "Total number of optimizer allocations served from the cache",
+		},
+		func() float64 { return float64(core.TheAllocationCache.Hits()) },
+	)
+	optimizerCacheMisses := prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Name: constants.WVAOptimizerCacheMissesTotal,
+			Help: "Total number of optimizer allocations computed because no cached result was available",
+		},
+		func() float64 { return float64(core.TheAllocationCache.Misses()) },
+	)
+	if err := registry.Register(optimizerCacheHits); err != nil {
+		return fmt.Errorf("failed to register optimizerCacheHits metric: %w", err)
+	}
+	if err := registry.Register(optimizerCacheMisses); err != nil {
+		return fmt.Errorf("failed to register optimizerCacheMisses metric: %w", err)
+	}
 
 	return nil
 }
@@ -118,6 +294,12 @@ func (m *MetricsEmitter) EmitReplicaScalingMetrics(ctx context.Context, va *llmd
 		constants.LabelDirection:   direction,
 		constants.LabelReason:      reason,
 	}
+	if targetNameLabel {
+		labels[constants.LabelTargetName] = va.GetScaleTargetName()
+	}
+	if tenantNamespaceLabel {
+		labels[constants.LabelTenantNamespace] = va.Namespace
+	}
 
 	// Add controller_instance label if configured
 	if controllerInstance != "" {
@@ -128,6 +310,9 @@ func (m *MetricsEmitter) EmitReplicaScalingMetrics(ctx context.Context, va *llmd
 	if replicaScalingTotal == nil {
 		return fmt.Errorf("replicaScalingTotal metric not initialized")
 	}
+	if !seriesGuard.allow(ctx, constants.WVAReplicaScalingTotal, labels) {
+		return nil
+	}
 
 	replicaScalingTotal.With(labels).Inc()
 	return nil
@@ -135,11 +320,27 @@ func (m *MetricsEmitter) EmitReplicaScalingMetrics(ctx context.Context, va *llmd
 
 // EmitReplicaMetrics emits current and desired replica metrics
 func (m *MetricsEmitter) EmitReplicaMetrics(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, current, desired int32, acceleratorType string) error {
+	return m.emitReplicaMetrics(ctx, va, va.Name, va.GetScaleTargetName(), current, desired, acceleratorType)
+}
+
+// EmitLinkedReplicaMetrics emits current and desired replica metrics for target, a linked scale
+// target of va, under the target's name so that an HPA on the linked Deployment selects them
+func (m *MetricsEmitter) EmitLinkedReplicaMetrics(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, target string, current, desired int32, acceleratorType string) error {
+	return m.emitReplicaMetrics(ctx, va, target, target, current, desired, acceleratorType)
+}
+
+func (m *MetricsEmitter) emitReplicaMetrics(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, variantName, targetName string, current, desired int32, acceleratorType string) error {
 	baseLabels := prometheus.Labels{
-		constants.LabelVariantName:     va.Name,
+		constants.LabelVariantName:     variantName,
 		constants.LabelNamespace:       va.Namespace,
 		constants.LabelAcceleratorType: acceleratorType,
 	}
+	if targetNameLabel {
+		baseLabels[constants.LabelTargetName] = targetName
+	}
+	if tenantNamespaceLabel {
+		baseLabels[constants.LabelTenantNamespace] = va.Namespace
+	}
 
 	// Add controller_instance label if configured
 	if controllerInstance != "" {
@@ -150,6 +351,12 @@ func (m *MetricsEmitter) EmitReplicaMetrics(ctx context.Context, va *llmdOptv1al
 	if currentReplicas == nil || desiredReplicas == nil || desiredRatio == nil {
 		return fmt.Errorf("replica metrics not initialized")
 	}
+	// The replica gauges share their label set and are limited together
+	if !seriesGuard.allow(ctx, constants.WVADesiredReplicas, baseLabels) {
+		return nil
+	}
+	// Hold back changes of the desired replicas below the minimum delta
+	desired = desiredFilter.apply(baseLabels, desired)
 
 	currentReplicas.With(baseLabels).Set(float64(current))
 	desiredReplicas.With(baseLabels).Set(float64(desired))
@@ -163,3 +370,235 @@ func (m *MetricsEmitter) EmitReplicaMetrics(ctx context.Context, va *llmdOptv1al
 	desiredRatio.With(baseLabels).Set(float64(desired) / float64(current))
 	return nil
 }
+
+// EmitRecommendedReplicas emits the replicas the saturation analysis recommended for va before
+// policies and limits were applied, next to the desired replicas emitted by EmitReplicaMetrics
+func (m *MetricsEmitter) EmitRecommendedReplicas(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, recommended int32, acceleratorType string) error {
+	baseLabels := prometheus.Labels{
+		constants.LabelVariantName:     va.Name,
+		constants.LabelNamespace:       va.Namespace,
+		constants.LabelAcceleratorType: acceleratorType,
+	}
+	if targetNameLabel {
+		baseLabels[constants.LabelTargetName] = va.GetScaleTargetName()
+	}
+	if tenantNamespaceLabel {
+		baseLabels[constants.LabelTenantNamespace] = va.Namespace
+	}
+
+	// Add controller_instance label if configured
+	if controllerInstance != "" {
+		baseLabels[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	if recommendedReplicas == nil {
+		return fmt.Errorf("recommendedReplicas metric not initialized")
+	}
+	// Limited together with the other replica gauges, which share its label set
+	if !seriesGuard.allow(ctx, constants.WVADesiredReplicas, baseLabels) {
+		return nil
+	}
+
+	recommendedReplicas.With(baseLabels).Set(float64(recommended))
+	return nil
+}
+
+// EmitDecisionConfidence emits the confidence (0-1) of the latest decision for va, next to the
+// desired replicas emitted by EmitReplicaMetrics
+func (m *MetricsEmitter) EmitDecisionConfidence(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, confidence float64, acceleratorType string) error {
+	baseLabels := prometheus.Labels{
+		constants.LabelVariantName:     va.Name,
+		constants.LabelNamespace:       va.Namespace,
+		constants.LabelAcceleratorType: acceleratorType,
+	}
+	if targetNameLabel {
+		baseLabels[constants.LabelTargetName] = va.GetScaleTargetName()
+	}
+	if tenantNamespaceLabel {
+		baseLabels[constants.LabelTenantNamespace] = va.Namespace
+	}
+
+	// Add controller_instance label if configured
+	if controllerInstance != "" {
+		baseLabels[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	if decisionConfidence == nil {
+		return fmt.Errorf("decisionConfidence metric not initialized")
+	}
+	// Limited together with the replica gauges, which share its label set
+	if !seriesGuard.allow(ctx, constants.WVADesiredReplicas, baseLabels) {
+		return nil
+	}
+
+	decisionConfidence.With(baseLabels).Set(confidence)
+	return nil
+}
+
+// DeleteReplicaMetrics removes the replica gauges of va and its linked scale targets for every
+// accelerator type, so that a VA whose scale target was deleted stops exporting its last desired
+// replicas. It returns the number of series removed.
+func (m *MetricsEmitter) DeleteReplicaMetrics(va *llmdOptv1alpha1.VariantAutoscaling) int {
+	deleted := m.deleteReplicaMetrics(va, va.Name, va.GetScaleTargetName())
+	for _, linked := range va.Spec.LinkedScaleTargets {
+		deleted += m.deleteReplicaMetrics(va, linked.Name, linked.Name)
+	}
+	return deleted
+}
+
+func (m *MetricsEmitter) deleteReplicaMetrics(va *llmdOptv1alpha1.VariantAutoscaling, variantName, targetName string) int {
+	match := prometheus.Labels{
+		constants.LabelVariantName: variantName,
+		constants.LabelNamespace:   va.Namespace,
+	}
+	if targetNameLabel {
+		match[constants.LabelTargetName] = targetName
+	}
+	if tenantNamespaceLabel {
+		match[constants.LabelTenantNamespace] = va.Namespace
+	}
+	if controllerInstance != "" {
+		match[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	deleted := 0
+	for _, gauge := range []*prometheus.GaugeVec{currentReplicas, desiredReplicas, desiredRatio, recommendedReplicas, decisionConfidence} {
+		if gauge != nil {
+			deleted += gauge.DeletePartialMatch(match)
+		}
+	}
+	seriesGuard.forget(constants.WVADesiredReplicas, match)
+	desiredFilter.forget(match)
+	return deleted
+}
+
+// EmitLastOptimizationTimestamp records the time of a model's last successful optimization
+func (m *MetricsEmitter) EmitLastOptimizationTimestamp(ctx context.Context, modelID, namespace string, t time.Time) error {
+	labels := prometheus.Labels{
+		constants.LabelModelName: modelID,
+		constants.LabelNamespace: namespace,
+	}
+
+	// Add controller_instance label if configured
+	if controllerInstance != "" {
+		labels[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	if lastOptimizationTimestamp == nil {
+		return fmt.Errorf("lastOptimizationTimestamp metric not initialized")
+	}
+	if !seriesGuard.allow(ctx, constants.WVALastOptimizationTimestamp, labels) {
+		return nil
+	}
+
+	lastOptimizationTimestamp.With(labels).Set(float64(t.UnixNano()) / 1e9)
+	return nil
+}
+
+// EmitMaxReplicasCap records the inventory-derived maximum replicas of a variant
+func (m *MetricsEmitter) EmitMaxReplicasCap(ctx context.Context, variantName, namespace, acceleratorType string, maxReplicas int) error {
+	labels := prometheus.Labels{
+		constants.LabelVariantName:     variantName,
+		constants.LabelNamespace:       namespace,
+		constants.LabelAcceleratorType: acceleratorType,
+	}
+
+	// Add controller_instance label if configured
+	if controllerInstance != "" {
+		labels[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	if maxReplicasCap == nil {
+		return fmt.Errorf("maxReplicasCap metric not initialized")
+	}
+	if !seriesGuard.allow(ctx, constants.WVAMaxReplicasCap, labels) {
+		return nil
+	}
+
+	maxReplicasCap.With(labels).Set(float64(maxReplicas))
+	return nil
+}
+
+// EmitCollectorDiscrepancy counts replica metrics of a model on which the shadow collector
+// disagreed with the primary collector
+func (m *MetricsEmitter) EmitCollectorDiscrepancy(ctx context.Context, modelID, namespace, metric string, count int) error {
+	labels := prometheus.Labels{
+		constants.LabelModelName: modelID,
+		constants.LabelNamespace: namespace,
+		constants.LabelMetric:    metric,
+	}
+
+	// Add controller_instance label if configured
+	if controllerInstance != "" {
+		labels[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	if collectorDiscrepancyTotal == nil {
+		return fmt.Errorf("collectorDiscrepancyTotal metric not initialized")
+	}
+	if !seriesGuard.allow(ctx, constants.WVACollectorDiscrepancyTotal, labels) {
+		return nil
+	}
+
+	collectorDiscrepancyTotal.With(labels).Add(float64(count))
+	return nil
+}
+
+// EmitOptimizePanic counts an optimization cycle that panicked and was recovered
+func (m *MetricsEmitter) EmitOptimizePanic(ctx context.Context) error {
+	labels := prometheus.Labels{}
+
+	// Add controller_instance label if configured
+	if controllerInstance != "" {
+		labels[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	if optimizePanicsTotal == nil {
+		return fmt.Errorf("optimizePanicsTotal metric not initialized")
+	}
+
+	optimizePanicsTotal.With(labels).Inc()
+	return nil
+}
+
+// EmitLimiterNoInventory counts an optimization cycle in which the GPU limiter found no
+// accelerator inventory
+func (m *MetricsEmitter) EmitLimiterNoInventory(ctx context.Context) error {
+	labels := prometheus.Labels{}
+
+	// Add controller_instance label if configured
+	if controllerInstance != "" {
+		labels[constants.LabelControllerInstance] = controllerInstance
+	}
+
+	if limiterNoInventoryTotal == nil {
+		return fmt.Errorf("limiterNoInventoryTotal metric not initialized")
+	}
+
+	limiterNoInventoryTotal.With(labels).Inc()
+	return nil
+}
+
+// EmitAcceleratorUtilization replaces the utilization of every accelerator type with the given
+// values, so accelerator types no longer serving any replica drop out
+func (m *MetricsEmitter) EmitAcceleratorUtilization(ctx context.Context, utilization map[string]float64) error {
+	if acceleratorUtilization == nil {
+		return fmt.Errorf("acceleratorUtilization metric not initialized")
+	}
+
+	acceleratorUtilization.Reset()
+	for accelerator, value := range utilization {
+		labels := prometheus.Labels{
+			constants.LabelAcceleratorType: accelerator,
+		}
+		// Add controller_instance label if configured
+		if controllerInstance != "" {
+			labels[constants.LabelControllerInstance] = controllerInstance
+		}
+		if !seriesGuard.allow(ctx, constants.WVAAcceleratorUtilization, labels) {
+			continue
+		}
+		acceleratorUtilization.With(labels).Set(value)
+	}
+	return nil
+}
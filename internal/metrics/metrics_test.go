@@ -0,0 +1,393 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// gatherLabels returns the labels of every series of the named metric in registry.
+func gatherLabels(t *testing.T, registry *prometheus.Registry, name string) []map[string]string {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	var series []map[string]string
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, pair := range metric.GetLabel() {
+				labels[pair.GetName()] = pair.GetValue()
+			}
+			series = append(series, labels)
+		}
+	}
+	return series
+}
+
+func TestEmitReplicaMetrics_TargetNameLabel(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	defer SetTargetNameLabelEnabled(false)
+
+	va := &llmdOptv1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-va", Namespace: "llm"},
+		Spec: llmdOptv1alpha1.VariantAutoscalingSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		enabled           bool
+		expectTargetLabel bool
+	}{
+		{name: "enabled adds target_name", enabled: true, expectTargetLabel: true},
+		{name: "disabled omits target_name", enabled: false, expectTargetLabel: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetTargetNameLabelEnabled(tt.enabled)
+			registry := prometheus.NewRegistry()
+			if err := InitMetrics(registry); err != nil {
+				t.Fatalf("failed to init metrics: %v", err)
+			}
+
+			emitter := NewMetricsEmitter()
+			if err := emitter.EmitReplicaMetrics(context.Background(), va, 1, 2, "H100"); err != nil {
+				t.Fatalf("failed to emit replica metrics: %v", err)
+			}
+			if err := emitter.EmitReplicaScalingMetrics(context.Background(), va, "up", "KvSpareLow"); err != nil {
+				t.Fatalf("failed to emit scaling metrics: %v", err)
+			}
+
+			for _, name := range []string{constants.WVADesiredReplicas, constants.WVACurrentReplicas, constants.WVADesiredRatio, constants.WVAReplicaScalingTotal} {
+				series := gatherLabels(t, registry, name)
+				if len(series) != 1 {
+					t.Fatalf("%s: expected 1 series, got %d", name, len(series))
+				}
+				labels := series[0]
+				if labels[constants.LabelVariantName] != "llama-va" {
+					t.Errorf("%s: expected variant_name=llama-va, got %q", name, labels[constants.LabelVariantName])
+				}
+				targetName, ok := labels[constants.LabelTargetName]
+				if ok != tt.expectTargetLabel {
+					t.Errorf("%s: expected target_name present=%v, got labels %v", name, tt.expectTargetLabel, labels)
+				}
+				if ok && targetName != "llama-decode" {
+					t.Errorf("%s: expected target_name=llama-decode, got %q", name, targetName)
+				}
+			}
+		})
+	}
+}
+
+func TestEmitLastOptimizationTimestamp_Advances(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+	emitter := NewMetricsEmitter()
+
+	// gatherTimestamp returns the gauge value for the model, or 0 if the series doesn't exist
+	gatherTimestamp := func() float64 {
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatalf("failed to gather metrics: %v", err)
+		}
+		for _, family := range families {
+			if family.GetName() != constants.WVALastOptimizationTimestamp {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				return metric.GetGauge().GetValue()
+			}
+		}
+		return 0
+	}
+
+	first := time.Unix(1700000000, 0)
+	if err := emitter.EmitLastOptimizationTimestamp(context.Background(), "meta/llama-70b", "llm", first); err != nil {
+		t.Fatalf("failed to emit timestamp: %v", err)
+	}
+	if got := gatherTimestamp(); got != 1700000000 {
+		t.Fatalf("expected timestamp 1700000000, got %v", got)
+	}
+
+	// The next successful cycle moves the gauge forward
+	if err := emitter.EmitLastOptimizationTimestamp(context.Background(), "meta/llama-70b", "llm", first.Add(30*time.Second)); err != nil {
+		t.Fatalf("failed to emit timestamp: %v", err)
+	}
+	if got := gatherTimestamp(); got != 1700000030 {
+		t.Errorf("expected timestamp to advance to 1700000030, got %v", got)
+	}
+
+	series := gatherLabels(t, registry, constants.WVALastOptimizationTimestamp)
+	if len(series) != 1 {
+		t.Fatalf("expected 1 series, got %d", len(series))
+	}
+	if series[0][constants.LabelModelName] != "meta/llama-70b" || series[0][constants.LabelNamespace] != "llm" {
+		t.Errorf("unexpected labels %v", series[0])
+	}
+}
+
+func TestMaxSeriesPerMetric_BlocksNewSeries(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	SetMaxSeriesPerMetric(2)
+	defer SetMaxSeriesPerMetric(0)
+
+	registry := prometheus.NewRegistry()
+	if err := InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+	emitter := NewMetricsEmitter()
+
+	now := time.Unix(1700000000, 0)
+	for _, model := range []string{"model-a", "model-b", "model-c"} {
+		if err := emitter.EmitLastOptimizationTimestamp(context.Background(), model, "llm", now); err != nil {
+			t.Fatalf("failed to emit timestamp: %v", err)
+		}
+	}
+	series := gatherLabels(t, registry, constants.WVALastOptimizationTimestamp)
+	if len(series) != 2 {
+		t.Fatalf("expected series creation to stop at the limit of 2, got %d", len(series))
+	}
+	for _, labels := range series {
+		if labels[constants.LabelModelName] == "model-c" {
+			t.Errorf("expected the series beyond the limit to be dropped, got %v", labels)
+		}
+	}
+
+	// Series that already exist keep being updated at the limit
+	if err := emitter.EmitMaxReplicasCap(context.Background(), "variant-a", "llm", "H100", 4); err != nil {
+		t.Fatalf("failed to emit cap: %v", err)
+	}
+	if err := emitter.EmitLastOptimizationTimestamp(context.Background(), "model-a", "llm", now.Add(time.Minute)); err != nil {
+		t.Fatalf("failed to emit timestamp: %v", err)
+	}
+	if got := len(gatherLabels(t, registry, constants.WVAMaxReplicasCap)); got != 1 {
+		t.Errorf("expected the limit to apply per metric, got %d series of %s", got, constants.WVAMaxReplicasCap)
+	}
+	if got := len(gatherLabels(t, registry, constants.WVALastOptimizationTimestamp)); got != 2 {
+		t.Errorf("expected updates of existing series to keep the count at 2, got %d", got)
+	}
+}
+
+func TestEmitReplicaMetrics_ScaleToZeroKeepsSeries(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+	emitter := NewMetricsEmitter()
+	va := &llmdOptv1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-va", Namespace: "llm"},
+		Spec: llmdOptv1alpha1.VariantAutoscalingSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"},
+		},
+	}
+
+	// gatherValues returns the value of every series of the named gauge
+	gatherValues := func(name string) []float64 {
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatalf("failed to gather metrics: %v", err)
+		}
+		var values []float64
+		for _, family := range families {
+			if family.GetName() != name {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				values = append(values, metric.GetGauge().GetValue())
+			}
+		}
+		return values
+	}
+
+	// Scale down to zero, then stay there once the last replica is gone
+	for _, current := range []int32{2, 1, 0} {
+		if err := emitter.EmitReplicaMetrics(context.Background(), va, current, 0, "H100"); err != nil {
+			t.Fatalf("failed to emit replica metrics: %v", err)
+		}
+		for _, name := range []string{constants.WVADesiredReplicas, constants.WVADesiredRatio} {
+			values := gatherValues(name)
+			if len(values) != 1 || values[0] != 0 {
+				t.Errorf("current=%d: expected %s to be present and 0, got %v", current, name, values)
+			}
+		}
+	}
+}
+
+func TestEmitAcceleratorUtilization_ReplacesSeries(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+	emitter := NewMetricsEmitter()
+
+	// gatherUtilization returns the gauge value by accelerator type
+	gatherUtilization := func() map[string]float64 {
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatalf("failed to gather metrics: %v", err)
+		}
+		values := map[string]float64{}
+		for _, family := range families {
+			if family.GetName() != constants.WVAAcceleratorUtilization {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				for _, pair := range metric.GetLabel() {
+					if pair.GetName() == constants.LabelAcceleratorType {
+						values[pair.GetValue()] = metric.GetGauge().GetValue()
+					}
+				}
+			}
+		}
+		return values
+	}
+
+	if err := emitter.EmitAcceleratorUtilization(context.Background(), map[string]float64{"A100": 0.7, "H100": 0.5}); err != nil {
+		t.Fatalf("failed to emit utilization: %v", err)
+	}
+	if got := gatherUtilization(); len(got) != 2 || got["A100"] != 0.7 || got["H100"] != 0.5 {
+		t.Fatalf("unexpected utilization %v", got)
+	}
+
+	// An accelerator type without replicas in the next cycle drops out
+	if err := emitter.EmitAcceleratorUtilization(context.Background(), map[string]float64{"A100": 0.4}); err != nil {
+		t.Fatalf("failed to emit utilization: %v", err)
+	}
+	if got := gatherUtilization(); len(got) != 1 || got["A100"] != 0.4 {
+		t.Errorf("expected only A100=0.4, got %v", got)
+	}
+}
+
+func TestDeleteReplicaMetrics_RemovesVASeries(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	SetMaxSeriesPerMetric(2)
+	defer SetMaxSeriesPerMetric(0)
+
+	registry := prometheus.NewRegistry()
+	if err := InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+	emitter := NewMetricsEmitter()
+	newVA := func(name, target string) *llmdOptv1alpha1.VariantAutoscaling {
+		return &llmdOptv1alpha1.VariantAutoscaling{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "llm"},
+			Spec: llmdOptv1alpha1.VariantAutoscalingSpec{
+				ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: target},
+			},
+		}
+	}
+	deleted, kept := newVA("llama-va", "llama-decode"), newVA("mistral-va", "mistral-decode")
+
+	for _, va := range []*llmdOptv1alpha1.VariantAutoscaling{deleted, kept} {
+		if err := emitter.EmitReplicaMetrics(context.Background(), va, 2, 3, "H100"); err != nil {
+			t.Fatalf("failed to emit replica metrics: %v", err)
+		}
+		if err := emitter.EmitRecommendedReplicas(context.Background(), va, 4, "H100"); err != nil {
+			t.Fatalf("failed to emit recommended replicas: %v", err)
+		}
+		if err := emitter.EmitDecisionConfidence(context.Background(), va, 0.9, "H100"); err != nil {
+			t.Fatalf("failed to emit decision confidence: %v", err)
+		}
+	}
+
+	if got := emitter.DeleteReplicaMetrics(deleted); got != 5 {
+		t.Errorf("expected 5 series to be deleted, got %d", got)
+	}
+	for _, name := range []string{constants.WVADesiredReplicas, constants.WVACurrentReplicas, constants.WVADesiredRatio,
+		constants.WVARecommendedReplicas, constants.WVADecisionConfidence} {
+		series := gatherLabels(t, registry, name)
+		if len(series) != 1 || series[0][constants.LabelVariantName] != "mistral-va" {
+			t.Errorf("%s: expected only the mistral-va series to remain, got %v", name, series)
+		}
+	}
+
+	// The deleted series no longer count against the series limit
+	if err := emitter.EmitReplicaMetrics(context.Background(), newVA("qwen-va", "qwen-decode"), 1, 1, "A100"); err != nil {
+		t.Fatalf("failed to emit replica metrics: %v", err)
+	}
+	if got := len(gatherLabels(t, registry, constants.WVADesiredReplicas)); got != 2 {
+		t.Errorf("expected a new series to fit after the deletion, got %d series", got)
+	}
+}
+
+func TestMinDesiredDelta_HoldsBackSmallChanges(t *testing.T) {
+	t.Setenv(ControllerInstanceEnvVar, "")
+	SetMinDesiredDelta(2)
+	defer SetMinDesiredDelta(0)
+
+	registry := prometheus.NewRegistry()
+	if err := InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+	emitter := NewMetricsEmitter()
+	va := &llmdOptv1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-va", Namespace: "llm"},
+		Spec: llmdOptv1alpha1.VariantAutoscalingSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"},
+		},
+	}
+
+	gatherDesired := func() float64 {
+		t.Helper()
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatalf("failed to gather metrics: %v", err)
+		}
+		for _, family := range families {
+			if family.GetName() == constants.WVADesiredReplicas && len(family.GetMetric()) == 1 {
+				return family.GetMetric()[0].GetGauge().GetValue()
+			}
+		}
+		t.Fatalf("expected one %s series", constants.WVADesiredReplicas)
+		return 0
+	}
+
+	steps := []struct {
+		desired  int32
+		expected float64
+	}{
+		{desired: 4, expected: 4}, // first value is always emitted
+		{desired: 5, expected: 4}, // +1 is below the delta
+		{desired: 3, expected: 4}, // -1 is below the delta
+		{desired: 6, expected: 6}, // +2 reaches the delta
+		{desired: 7, expected: 6}, // measured from the last emitted value, not the last requested
+		{desired: 4, expected: 4}, // -2 reaches the delta
+		{desired: 0, expected: 0}, // scaling to zero is always emitted
+		{desired: 1, expected: 1}, // and so is scaling from zero
+	}
+	for _, step := range steps {
+		if err := emitter.EmitReplicaMetrics(context.Background(), va, 4, step.desired, "H100"); err != nil {
+			t.Fatalf("failed to emit replica metrics: %v", err)
+		}
+		if got := gatherDesired(); got != step.expected {
+			t.Errorf("desired=%d: expected gauge %.0f, got %.0f", step.desired, step.expected, got)
+		}
+	}
+
+	// Deleting the series forgets the last emitted value
+	emitter.DeleteReplicaMetrics(va)
+	if err := emitter.EmitReplicaMetrics(context.Background(), va, 4, 2, "H100"); err != nil {
+		t.Fatalf("failed to emit replica metrics: %v", err)
+	}
+	if got := gatherDesired(); got != 2 {
+		t.Errorf("expected a recreated series to emit its first value, got %.0f", got)
+	}
+}
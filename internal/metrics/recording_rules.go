@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+
+	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+)
+
+const (
+	// RecordingRuleGroupName is the name of the rule group holding the WVA recording rules.
+	RecordingRuleGroupName = "wva.recording.rules"
+
+	// RecordingRuleRateWindow is the range over which scaling operation rates are recorded.
+	RecordingRuleRateWindow = "5m"
+)
+
+// Recorded series names, following the Prometheus level:metric:operations convention.
+const (
+	RecordedVariantDesiredReplicas   = "variant:" + constants.WVADesiredReplicas + ":max"
+	RecordedVariantCurrentReplicas   = "variant:" + constants.WVACurrentReplicas + ":max"
+	RecordedVariantDesiredRatio      = "variant:" + constants.WVADesiredRatio + ":max"
+	RecordedVariantReplicaMismatch   = "variant:wva_replica_mismatch:abs"
+	RecordedVariantScalingRate       = "variant:" + constants.WVAReplicaScalingTotal + ":rate" + RecordingRuleRateWindow
+	RecordedNamespaceDesiredReplicas = "namespace:" + constants.WVADesiredReplicas + ":sum"
+)
+
+// RecordingRuleOptions configures the generated PrometheusRule.
+type RecordingRuleOptions struct {
+	// Name and Namespace of the PrometheusRule object.
+	Name      string
+	Namespace string
+	// Labels are added to the PrometheusRule so the Prometheus ruleSelector picks it up.
+	Labels map[string]string
+	// ControllerInstance, when set, restricts the rules to the series of one WVA controller instance.
+	ControllerInstance string
+	// TenantNamespaceLabel selects the variant namespace by the tenant_namespace label, for
+	// controllers started with the tenant namespace label enabled.
+	TenantNamespaceLabel bool
+}
+
+// namespaceLabel returns the label holding the VariantAutoscaling namespace of scraped WVA
+// series. WVA series are scraped from the controller pod, so their namespace label is stored
+// as exported_namespace, unless the tenant_namespace label is enabled.
+func namespaceLabel(tenantNamespaceLabel bool) string {
+	if tenantNamespaceLabel {
+		return constants.LabelTenantNamespace
+	}
+	return constants.LabelExportedNamespace
+}
+
+// RecordingRules returns a PrometheusRule with recording rules that pre-aggregate the WVA
+// metrics behind the common HPA and KEDA queries. The per-variant series are aggregated with
+// max so duplicates, e.g. left by a restarted controller pod, don't add up.
+func RecordingRules(opts RecordingRuleOptions) *promoperator.PrometheusRule {
+	selector := ""
+	if opts.ControllerInstance != "" {
+		selector = fmt.Sprintf(`{%s=%q}`, constants.LabelControllerInstance, opts.ControllerInstance)
+	}
+	// The per-variant recording rules keep the variant's namespace and name
+	namespace := namespaceLabel(opts.TenantNamespaceLabel)
+	by := strings.Join([]string{namespace, constants.LabelVariantName}, ", ")
+
+	record := func(name, expr string) promoperator.Rule {
+		return promoperator.Rule{Record: name, Expr: intstr.FromString(expr)}
+	}
+	rules := []promoperator.Rule{
+		record(RecordedVariantDesiredReplicas,
+			fmt.Sprintf("max by (%s) (%s%s)", by, constants.WVADesiredReplicas, selector)),
+		record(RecordedVariantCurrentReplicas,
+			fmt.Sprintf("max by (%s) (%s%s)", by, constants.WVACurrentReplicas, selector)),
+		record(RecordedVariantDesiredRatio,
+			fmt.Sprintf("max by (%s) (%s%s)", by, constants.WVADesiredRatio, selector)),
+		record(RecordedVariantReplicaMismatch,
+			fmt.Sprintf("abs(%s - %s)", RecordedVariantDesiredReplicas, RecordedVariantCurrentReplicas)),
+		record(RecordedVariantScalingRate,
+			fmt.Sprintf("sum by (%s, %s) (rate(%s%s[%s]))", by, constants.LabelDirection,
+				constants.WVAReplicaScalingTotal, selector, RecordingRuleRateWindow)),
+		record(RecordedNamespaceDesiredReplicas,
+			fmt.Sprintf("sum by (%s) (%s)", namespace, RecordedVariantDesiredReplicas)),
+	}
+
+	return &promoperator.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: promoperator.SchemeGroupVersion.String(),
+			Kind:       promoperator.PrometheusRuleKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.Name,
+			Namespace: opts.Namespace,
+			Labels:    opts.Labels,
+		},
+		Spec: promoperator.PrometheusRuleSpec{
+			Groups: []promoperator.RuleGroup{{
+				Name:  RecordingRuleGroupName,
+				Rules: rules,
+			}},
+		},
+	}
+}
+
+// RecordingRulesYAML renders the PrometheusRule returned by RecordingRules as a YAML manifest.
+func RecordingRulesYAML(opts RecordingRuleOptions) ([]byte, error) {
+	data, err := yaml.Marshal(RecordingRules(opts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal recording rules: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+)
+
+func TestRecordingRules_ReferenceWVAMetrics(t *testing.T) {
+	rule := RecordingRules(RecordingRuleOptions{Name: "wva-recording-rules", Namespace: "monitoring"})
+
+	if rule.Kind != promoperator.PrometheusRuleKind || rule.APIVersion != "monitoring.coreos.com/v1" {
+		t.Fatalf("unexpected type %s %s", rule.APIVersion, rule.Kind)
+	}
+	if len(rule.Spec.Groups) != 1 || rule.Spec.Groups[0].Name != RecordingRuleGroupName {
+		t.Fatalf("expected a single %s group, got %+v", RecordingRuleGroupName, rule.Spec.Groups)
+	}
+
+	exprs := map[string]string{}
+	for _, r := range rule.Spec.Groups[0].Rules {
+		exprs[r.Record] = r.Expr.String()
+	}
+	byVariant := "by (" + constants.LabelExportedNamespace + ", " + constants.LabelVariantName + ")"
+	tests := map[string][]string{
+		RecordedVariantDesiredReplicas: {constants.WVADesiredReplicas, byVariant},
+		RecordedVariantCurrentReplicas: {constants.WVACurrentReplicas, byVariant},
+		RecordedVariantDesiredRatio:    {constants.WVADesiredRatio, byVariant},
+		RecordedVariantReplicaMismatch: {RecordedVariantDesiredReplicas, RecordedVariantCurrentReplicas},
+		RecordedVariantScalingRate: {
+			"rate(" + constants.WVAReplicaScalingTotal + "[5m])",
+			"by (" + constants.LabelExportedNamespace + ", " + constants.LabelVariantName + ", " + constants.LabelDirection + ")",
+		},
+		RecordedNamespaceDesiredReplicas: {RecordedVariantDesiredReplicas, "by (" + constants.LabelExportedNamespace + ")"},
+	}
+	if len(exprs) != len(tests) {
+		t.Errorf("expected %d recording rules, got %d: %v", len(tests), len(exprs), exprs)
+	}
+	for record, fragments := range tests {
+		expr, ok := exprs[record]
+		if !ok {
+			t.Errorf("missing recording rule %s", record)
+			continue
+		}
+		for _, fragment := range fragments {
+			if !strings.Contains(expr, fragment) {
+				t.Errorf("rule %s: expected %q in expression %q", record, fragment, expr)
+			}
+		}
+		if strings.Contains(expr, constants.LabelControllerInstance) {
+			t.Errorf("rule %s: unexpected controller instance selector in %q", record, expr)
+		}
+	}
+}
+
+func TestRecordingRules_ControllerInstance(t *testing.T) {
+	rule := RecordingRules(RecordingRuleOptions{Name: "wva-recording-rules", ControllerInstance: "wva-a"})
+
+	selector := constants.LabelControllerInstance + `="wva-a"`
+	for _, r := range rule.Spec.Groups[0].Rules {
+		expr := r.Expr.String()
+		// Rules over raw WVA metrics select the instance; rules over recorded series inherit it
+		if strings.Contains(expr, "(wva_") && !strings.Contains(expr, selector) {
+			t.Errorf("rule %s: expected %s selector in %q", r.Record, selector, expr)
+		}
+	}
+}
+
+func TestRecordingRulesYAML(t *testing.T) {
+	data, err := RecordingRulesYAML(RecordingRuleOptions{
+		Name:      "wva-recording-rules",
+		Namespace: "monitoring",
+		Labels:    map[string]string{"release": "prometheus"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded promoperator.PrometheusRule
+	if err := yaml.UnmarshalStrict(data, &decoded); err != nil {
+		t.Fatalf("generated manifest is not a valid PrometheusRule: %v\n%s", err, data)
+	}
+	if decoded.Name != "wva-recording-rules" || decoded.Namespace != "monitoring" ||
+		decoded.Labels["release"] != "prometheus" {
+		t.Errorf("unexpected metadata %+v", decoded.ObjectMeta)
+	}
+	if len(decoded.Spec.Groups) != 1 || decoded.Spec.Groups[0].Rules[0].Record != RecordedVariantDesiredReplicas {
+		t.Errorf("unexpected rule groups %+v", decoded.Spec.Groups)
+	}
+}
+
+func TestRecordingRules_TenantNamespaceLabel(t *testing.T) {
+	rule := RecordingRules(RecordingRuleOptions{Name: "wva-recording-rules", TenantNamespaceLabel: true})
+
+	for _, r := range rule.Spec.Groups[0].Rules {
+		expr := r.Expr.String()
+		if strings.Contains(expr, constants.LabelExportedNamespace) {
+			t.Errorf("rule %s: unexpected %s in %q", r.Record, constants.LabelExportedNamespace, expr)
+		}
+		if strings.Contains(expr, "by (") && !strings.Contains(expr, constants.LabelTenantNamespace) {
+			t.Errorf("rule %s: expected grouping by %s in %q", r.Record, constants.LabelTenantNamespace, expr)
+		}
+	}
+}
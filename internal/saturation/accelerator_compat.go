@@ -0,0 +1,21 @@
+package saturation
+
+import (
+	"strings"
+)
+
+// AcceleratorAllowed reports whether a model may be scaled up on accelerator, given the
+// accelerators it is compatible with, compared case-insensitively; an empty allowed list allows
+// every accelerator. Aliases are not resolved here: the engine normalizes both the accelerator
+// labels and the allow-list to canonical names before analysis.
+func AcceleratorAllowed(accelerator string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, name := range allowed {
+		if strings.EqualFold(name, accelerator) {
+			return true
+		}
+	}
+	return false
+}
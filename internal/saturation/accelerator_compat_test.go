@@ -0,0 +1,115 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
+)
+
+func TestAcceleratorAllowed(t *testing.T) {
+	tests := []struct {
+		name        string
+		accelerator string
+		allowed     []string
+		expected    bool
+	}{
+		{name: "no list allows all", accelerator: "L4", allowed: nil, expected: true},
+		{name: "listed", accelerator: "H100", allowed: []string{"A100", "H100"}, expected: true},
+		{name: "case-insensitive", accelerator: "h100", allowed: []string{"H100"}, expected: true},
+		{name: "not listed", accelerator: "L4", allowed: []string{"A100", "H100"}, expected: false},
+		{name: "unknown accelerator", accelerator: "", allowed: []string{"H100"}, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AcceleratorAllowed(tt.accelerator, tt.allowed); got != tt.expected {
+				t.Errorf("AcceleratorAllowed(%q, %v) = %v, want %v", tt.accelerator, tt.allowed, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAcceleratorAllowed_NormalizedAliases(t *testing.T) {
+	aliases := utils.ParseAcceleratorAliases(map[string]string{
+		"H100": "NVIDIA-H100-80GB-HBM3",
+		"A100": "NVIDIA-A100-SXM4-80GB",
+	})
+
+	tests := []struct {
+		name        string
+		accelerator string
+		allowed     []string
+		expected    bool
+	}{
+		{name: "alias label matches canonical entry", accelerator: "NVIDIA-H100-80GB-HBM3", allowed: []string{"H100"}, expected: true},
+		{name: "alias entry matches canonical label", accelerator: "H100", allowed: []string{"nvidia-h100-80gb-hbm3"}, expected: true},
+		{name: "alias of another accelerator", accelerator: "NVIDIA-A100-SXM4-80GB", allowed: []string{"H100"}, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// As the engine does: the label and the allow-list are both normalized before analysis
+			accelerator := aliases.Normalize(tt.accelerator)
+			allowed := utils.NormalizeAcceleratorNames(tt.allowed, aliases)
+			if got := AcceleratorAllowed(accelerator, allowed); got != tt.expected {
+				t.Errorf("AcceleratorAllowed(%q, %v) = %v, want %v", accelerator, allowed, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateSaturationTargets_NeverScalesUpOnDisallowedAccelerator(t *testing.T) {
+	ctx := context.Background()
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	// The L4 variant is the cheapest, and all replicas are busy enough to need a scale-up
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "l4-1", VariantName: "v-l4", AcceleratorName: "L4", Cost: 5, KvCacheUsage: 0.75},
+		{PodName: "a100-1", VariantName: "v-a100", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.75},
+		{PodName: "h100-1", VariantName: "v-h100", AcceleratorName: "H100", Cost: 20, KvCacheUsage: 0.75},
+	}
+	states := []interfaces.VariantReplicaState{
+		{VariantName: "v-l4", CurrentReplicas: 1},
+		{VariantName: "v-a100", CurrentReplicas: 1},
+		{VariantName: "v-h100", CurrentReplicas: 1},
+	}
+	targetsFor := func(allowed []string) (map[string]int, *interfaces.ModelSaturationAnalysis) {
+		t.Helper()
+		config.AllowedAccelerators = allowed
+		analysis, err := analyzer.AnalyzeModelSaturation(ctx, "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !analysis.ShouldScaleUp {
+			t.Fatal("expected scale-up")
+		}
+		return analyzer.CalculateSaturationTargets(ctx, analysis, states), analysis
+	}
+
+	// Without a list the cheapest variant scales up
+	if targets, _ := targetsFor(nil); targets["v-l4"] != 2 {
+		t.Fatalf("expected the cheapest variant to scale up, got %v", targets)
+	}
+
+	// The model does not run on L4, so the next cheapest allowed variant scales up instead
+	targets, analysis := targetsFor([]string{"A100", "H100"})
+	if targets["v-l4"] != 1 || analysis.TargetReasonCodes["v-l4"] != interfaces.ReasonCodeAcceleratorNotAllowed {
+		t.Errorf("expected no scale-up on L4, got %d (%s)", targets["v-l4"], analysis.TargetReasonCodes["v-l4"])
+	}
+	if targets["v-a100"] != 2 || targets["v-h100"] != 1 {
+		t.Errorf("expected the A100 variant to scale up, got %v", targets)
+	}
+
+	// With no allowed variant at all, nothing scales up
+	targets, _ = targetsFor([]string{"TPU-v5"})
+	for variant, target := range targets {
+		if target != 1 {
+			t.Errorf("expected no scale-up of %s on a disallowed accelerator, got %d", variant, target)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package saturation
+
+import "github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+
+// AcceleratorUtilization returns the average KV cache usage (0.0-1.0) of all replicas on each
+// accelerator type across the given model analyses, for capacity planning. Each variant is
+// weighted by its replica count, so variants of different models sharing an accelerator count
+// per replica. Variants without an accelerator name or replicas are skipped.
+func AcceleratorUtilization(analyses []*interfaces.ModelSaturationAnalysis) map[string]float64 {
+	usage := make(map[string]float64)
+	replicas := make(map[string]int)
+	for _, analysis := range analyses {
+		if analysis == nil {
+			continue
+		}
+		for _, va := range analysis.VariantAnalyses {
+			if va.AcceleratorName == "" || va.ReplicaCount == 0 {
+				continue
+			}
+			usage[va.AcceleratorName] += va.AvgKvCacheUsage * float64(va.ReplicaCount)
+			replicas[va.AcceleratorName] += va.ReplicaCount
+		}
+	}
+	for accelerator, count := range replicas {
+		usage[accelerator] /= float64(count)
+	}
+	return usage
+}
@@ -0,0 +1,61 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestAcceleratorUtilization(t *testing.T) {
+	analyses := []*interfaces.ModelSaturationAnalysis{
+		{
+			ModelID: "llama",
+			VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+				{VariantName: "llama-a100", AcceleratorName: "A100", ReplicaCount: 3, AvgKvCacheUsage: 0.8},
+				{VariantName: "llama-h100", AcceleratorName: "H100", ReplicaCount: 2, AvgKvCacheUsage: 0.5},
+			},
+		},
+		{
+			ModelID: "mistral",
+			VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+				// Shares A100 with llama-a100
+				{VariantName: "mistral-a100", AcceleratorName: "A100", ReplicaCount: 1, AvgKvCacheUsage: 0.4},
+				{VariantName: "mistral-unlabeled", ReplicaCount: 4, AvgKvCacheUsage: 0.9},
+				{VariantName: "mistral-l4", AcceleratorName: "L4", ReplicaCount: 0},
+			},
+		},
+		nil,
+	}
+
+	got := AcceleratorUtilization(analyses)
+	// A100: (0.8*3 + 0.4*1) / 4 replicas
+	want := map[string]float64{"A100": 0.7, "H100": 0.5}
+	if len(got) != len(want) {
+		t.Fatalf("AcceleratorUtilization = %v, want %v", got, want)
+	}
+	for accelerator, expected := range want {
+		if math.Abs(got[accelerator]-expected) > 1e-9 {
+			t.Errorf("utilization of %s = %v, want %v", accelerator, got[accelerator], expected)
+		}
+	}
+
+	if got := AcceleratorUtilization(nil); len(got) != 0 {
+		t.Errorf("AcceleratorUtilization(nil) = %v, want empty", got)
+	}
+}
+
+func TestAnalyzeModelSaturation_AvgKvCacheUsage(t *testing.T) {
+	analysis, err := NewAnalyzer().AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", AcceleratorName: "A100", KvCacheUsage: 0.9}, // saturated
+		{PodName: "pod-2", VariantName: "v1", AcceleratorName: "A100", KvCacheUsage: 0.3},
+	}, interfaces.SaturationScalingConfig{KvCacheThreshold: 0.8, QueueLengthThreshold: 5, KvSpareTrigger: 0.1, QueueSpareTrigger: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Saturated replicas count toward utilization
+	if got := analysis.VariantAnalyses[0].AvgKvCacheUsage; math.Abs(got-0.6) > 1e-9 {
+		t.Errorf("AvgKvCacheUsage = %v, want 0.6", got)
+	}
+}
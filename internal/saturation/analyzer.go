@@ -5,20 +5,58 @@ import (
 	"fmt"
 	"time"
 
-	ctrl "sigs.k8s.io/controller-runtime"
-
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
 )
 
 // Analyzer implements the SaturationAnalyzer interface
-type Analyzer struct{}
+type Analyzer struct {
+	// goodput holds goodput history across analysis cycles; nil disables the goodput trigger
+	goodput *GoodputTracker
+	// scaleDown tracks how long scale-down has been safe; nil disables the scale-down delay
+	// and stabilization cycles
+	scaleDown *ScaleDownStabilizer
+	// coldStart records the last scale-up of each model; nil disables the cold start grace period
+	coldStart *ColdStartGrace
+	// queueHistory holds per-replica queue lengths across cycles; nil disables the queue percentile
+	queueHistory *QueueHistory
+}
 
 // NewAnalyzer creates a new saturation analyzer instance
 func NewAnalyzer() *Analyzer {
 	return &Analyzer{}
 }
 
+// NewAnalyzerWithGoodputTracker creates a saturation analyzer that records goodput in tracker
+// and can scale up on a goodput plateau. The tracker must outlive a single analysis cycle.
+func NewAnalyzerWithGoodputTracker(tracker *GoodputTracker) *Analyzer {
+	return &Analyzer{goodput: tracker}
+}
+
+// WithScaleDownStabilizer makes the analyzer hold scale-down until it has been safe for
+// the configured ScaleDownDelay and ScaleDownStabilizationCycles, as tracked by stabilizer. The stabilizer must outlive a
+// single analysis cycle.
+func (a *Analyzer) WithScaleDownStabilizer(stabilizer *ScaleDownStabilizer) *Analyzer {
+	a.scaleDown = stabilizer
+	return a
+}
+
+// WithColdStartGrace makes the analyzer suppress scale-down of a model for the configured
+// ColdStartGracePeriod after its last scale-up, as recorded in grace. The tracker must outlive
+// a single analysis cycle.
+func (a *Analyzer) WithColdStartGrace(grace *ColdStartGrace) *Analyzer {
+	a.coldStart = grace
+	return a
+}
+
+// WithQueueHistory makes the analyzer classify replicas by the configured QueuePercentile of
+// their queue lengths over recent cycles, as recorded in history. The history must outlive a
+// single analysis cycle.
+func (a *Analyzer) WithQueueHistory(history *QueueHistory) *Analyzer {
+	a.queueHistory = history
+	return a
+}
+
 // AnalyzeModelSaturation analyzes Saturation for all variants of a model.
 // It aggregates metrics across all replicas (from all variants) and determines:
 // 1. Which replicas are non-saturated
@@ -33,6 +71,20 @@ func (a *Analyzer) AnalyzeModelSaturation(
 	config interfaces.SaturationScalingConfig,
 ) (*interfaces.ModelSaturationAnalysis, error) {
 
+	// Keep NaN and Inf values, e.g. from a division by zero in the exporter, out of the averages
+	replicaMetrics, invalidPods := SanitizeReplicaMetrics(replicaMetrics, config.InvalidMetricPolicy)
+	if len(invalidPods) > 0 {
+		policy := config.InvalidMetricPolicy
+		if policy == "" {
+			policy = interfaces.InvalidMetricPolicyDrop
+		}
+		logging.FromContext(ctx, logging.Analyzer).Info("Replica metrics contain NaN or Inf values",
+			"modelID", modelID,
+			"namespace", namespace,
+			"pods", invalidPods,
+			"invalidMetricPolicy", policy)
+	}
+
 	if len(replicaMetrics) == 0 {
 		return &interfaces.ModelSaturationAnalysis{
 			ModelID:       modelID,
@@ -41,15 +93,31 @@ func (a *Analyzer) AnalyzeModelSaturation(
 			TotalReplicas: 0,
 			ShouldScaleUp: false,
 
-			ScaleDownSafe:   false,
-			VariantAnalyses: []interfaces.VariantSaturationAnalysis{},
+			ScaleDownSafe:     false,
+			VariantAnalyses:   []interfaces.VariantSaturationAnalysis{},
+			InvalidMetricPods: invalidPods,
 		}, nil
 	}
 
 	analysis := &interfaces.ModelSaturationAnalysis{
-		ModelID:    modelID,
-		Namespace:  namespace,
-		AnalyzedAt: time.Now(),
+		ModelID:         modelID,
+		Namespace:       namespace,
+		AnalyzedAt:      time.Now(),
+		ScaleDownPolicy: config.ScaleDownPolicy,
+		TargetStrategy:  config.TargetStrategy,
+		VariantWeights:  config.VariantWeights,
+
+		ScaleStepFraction: config.ScaleStepFraction,
+		ScaleUpRounding:   config.ScaleUpRounding,
+		ScaleDownRounding: config.ScaleDownRounding,
+
+		AllowedAccelerators: config.AllowedAccelerators,
+		InvalidMetricPods:   invalidPods,
+	}
+
+	// Smooth transient queue spikes before the replicas are classified
+	if a.queueHistory != nil && config.QueuePercentile > 0 {
+		replicaMetrics = a.queueHistory.Smooth(namespace+"/"+modelID, replicaMetrics, config.QueuePercentile, config.QueuePercentileWindow)
 	}
 
 	// Step 1: Group metrics by variant and calculate per-variant analysis
@@ -68,11 +136,17 @@ func (a *Analyzer) AnalyzeModelSaturation(
 	// Populate with metrics (no reallocation needed)
 	for _, metric := range replicaMetrics {
 		variantMap[metric.VariantName] = append(variantMap[metric.VariantName], metric)
+		analysis.TotalQueueLength += metric.QueueLength
+		analysis.TotalOutputTokenRate += metric.OutputTokenRate
 	}
 
-	// Aggregate statistics across all replicas
+	// Aggregate statistics across all replicas. Each non-saturated replica's spare capacity is
+	// weighted by its accelerator's capacity factor, so that on mixed hardware the average reflects
+	// actual headroom rather than counting a small and a large accelerator the same, and by the
+	// freshness of its metrics.
 	var totalSpareKv float64
 	var totalSpareQueue float64
+	var totalWeight float64
 	var nonSaturatedCount int
 
 	variantAnalyses := make([]interfaces.VariantSaturationAnalysis, 0, len(variantMap))
@@ -83,44 +157,167 @@ func (a *Analyzer) AnalyzeModelSaturation(
 
 		// Aggregate across variants
 		nonSaturatedCount += variantAnalysis.NonSaturatedCount
-		totalSpareKv += variantAnalysis.AvgSpareKvCapacity * float64(variantAnalysis.NonSaturatedCount)
-		totalSpareQueue += variantAnalysis.AvgSpareQueueLength * float64(variantAnalysis.NonSaturatedCount)
+		weight := CapacityFactor(variantAnalysis.AcceleratorName, config) * variantAnalysis.NonSaturatedWeight
+		totalSpareKv += variantAnalysis.AvgSpareKvCapacity * weight
+		totalSpareQueue += variantAnalysis.AvgSpareQueueLength * weight
+		totalWeight += weight
 	}
 
 	analysis.TotalReplicas = len(replicaMetrics)
+	analysis.AvgSpecDecodeAcceptanceRate = AverageSpecDecodeAcceptanceRate(replicaMetrics)
+	analysis.AvgErrorRate = AverageErrorRate(replicaMetrics)
+	analysis.TotalArrivalRate = TotalArrivalRate(replicaMetrics)
 	analysis.NonSaturatedCount = nonSaturatedCount
 	analysis.VariantAnalyses = variantAnalyses
 
 	// Step 2: Calculate average spare Saturation across all non-saturated replicas
-	if nonSaturatedCount > 0 {
-		analysis.AvgSpareKvCapacity = totalSpareKv / float64(nonSaturatedCount)
-		analysis.AvgSpareQueueLength = totalSpareQueue / float64(nonSaturatedCount)
+	if totalWeight > 0 {
+		analysis.AvgSpareKvCapacity = totalSpareKv / totalWeight
+		analysis.AvgSpareQueueLength = totalSpareQueue / totalWeight
 	}
 
 	// Step 3: Determine scale-up recommendation
-	analysis.ShouldScaleUp, analysis.ScaleUpReason = a.shouldScaleUp(
+	analysis.ShouldScaleUp, analysis.ScaleUpReason, analysis.ScaleUpReasonCode = a.shouldScaleUp(
 		analysis.AvgSpareKvCapacity,
 		analysis.AvgSpareQueueLength,
 		config,
 	)
 
-	// Step 4: Determine if scale-down is safe
+	// With a saturated quorum, the spare capacity triggers only fire once enough replicas are
+	// saturated, so a single hot replica does not scale the model while the rest have headroom
+	if analysis.ShouldScaleUp && config.SaturatedQuorum > 0 {
+		saturated := analysis.TotalReplicas - nonSaturatedCount
+		if met, required := SaturatedQuorumMet(saturated, analysis.TotalReplicas, config.SaturatedQuorum); !met {
+			logging.FromContext(ctx, logging.Analyzer).V(logging.DEBUG).Info("Scale-up suppressed below saturated quorum",
+				"modelID", modelID,
+				"namespace", namespace,
+				"saturated", saturated,
+				"required", required,
+				"reason", analysis.ScaleUpReason)
+			analysis.ShouldScaleUp, analysis.ScaleUpReason, analysis.ScaleUpReasonCode = false, "", ""
+		}
+	}
+
+	// Step 3a: Rejected request trigger. Requests turned away with 429s are a direct sign of
+	// saturation, so it takes precedence over the spare capacity triggers and its reason wins
+	analysis.TotalRejectedRequestRate = TotalRejectedRequestRate(replicaMetrics)
+	if rejected, reason := DetectRequestsRejected(analysis.TotalRejectedRequestRate, config.RejectedRequestRateTrigger); rejected {
+		analysis.ShouldScaleUp = true
+		analysis.ScaleUpReason = reason
+		analysis.ScaleUpReasonCode = interfaces.ReasonCodeRequestsRejected
+	}
+
+	// Step 3b: Goodput plateau trigger, a complement to the spare capacity triggers
+	// for throughput-bound workloads
+	if a.goodput != nil && config.GoodputPlateauThreshold > 0 && analysis.TotalOutputTokenRate > 0 {
+		window := a.goodput.Observe(namespace+"/"+modelID, GoodputSample{
+			Replicas:        analysis.TotalReplicas,
+			OutputTokenRate: analysis.TotalOutputTokenRate,
+			QueueLength:     analysis.TotalQueueLength,
+		})
+		if !analysis.ShouldScaleUp {
+			if plateau, reason := DetectGoodputPlateau(window, config.GoodputPlateauThreshold); plateau {
+				analysis.ShouldScaleUp = true
+				analysis.ScaleUpReason = reason
+				analysis.ScaleUpReasonCode = interfaces.ReasonCodeGoodputPlateau
+			}
+		}
+	}
+
+	// Step 3c: Speculative decoding trigger, for engines where a falling acceptance rate
+	// cuts effective throughput before KV cache or queue saturate
+	if !analysis.ShouldScaleUp && config.SpecDecodeAcceptanceThreshold > 0 && analysis.AvgSpecDecodeAcceptanceRate != nil {
+		if degraded, reason := DetectSpecDecodeDegradation(
+			*analysis.AvgSpecDecodeAcceptanceRate, analysis.TotalQueueLength, config.SpecDecodeAcceptanceThreshold); degraded {
+			analysis.ShouldScaleUp = true
+			analysis.ScaleUpReason = reason
+			analysis.ScaleUpReasonCode = interfaces.ReasonCodeSpecDecodeDegraded
+		}
+	}
+
+	// Step 3d: Tokens-in-flight trigger, for models whose few but long requests use up the
+	// batch's context budget while the queue stays short
+	if config.TokensInFlightSpareTrigger > 0 {
+		analysis.AvgTokensInFlightSpare = AverageTokensInFlightSpare(replicaMetrics, TokenCapacity(config))
+		if !analysis.ShouldScaleUp {
+			if low, reason := DetectTokensInFlightSpareLow(
+				analysis.AvgTokensInFlightSpare, config.TokensInFlightSpareTrigger); low {
+				analysis.ShouldScaleUp = true
+				analysis.ScaleUpReason = reason
+				analysis.ScaleUpReasonCode = interfaces.ReasonCodeTokensInFlightSpareLow
+			}
+		}
+	}
+
+	// Step 4: Determine if scale-down is safe, and for how many replicas at once
 	// Pass pre-calculated average spare capacities to avoid redundant iteration
-	analysis.ScaleDownSafe = a.isScaleDownSafe(
+	analysis.ScaleDownSafeReplicas = a.scaleDownSafeReplicas(
 		ctx,
+		replicaMetrics,
 		nonSaturatedCount,
 		analysis.AvgSpareKvCapacity,
 		analysis.AvgSpareQueueLength,
 		config,
 	)
+	analysis.ScaleDownSafe = analysis.ScaleDownSafeReplicas > 0
+
+	// Step 4a: Block scale-down while requests are failing, whatever the capacity math says
+	if elevated, reason := DetectElevatedErrorRate(analysis.AvgErrorRate, config.ErrorRateThreshold); elevated {
+		analysis.ErrorRateElevated = true
+		if analysis.ScaleDownSafe {
+			logging.FromContext(ctx, logging.Analyzer).Info("Scale-down blocked",
+				"modelID", modelID,
+				"namespace", namespace,
+				"reason", reason)
+		}
+		analysis.ScaleDownSafe = false
+	}
+
+	// Step 4b: Hold scale-down while replicas added by a recent scale-up are still picking up
+	// traffic. Holding it here also keeps the scale-down delay from starting meanwhile.
+	if a.coldStart != nil && config.ColdStartGracePeriod > 0 && analysis.ScaleDownSafe {
+		if remaining := a.coldStart.Remaining(namespace, modelID, config.ColdStartGracePeriod); remaining > 0 {
+			logging.FromContext(ctx, logging.Analyzer).V(logging.DEBUG).Info("Scale-down held during cold start grace period",
+				"modelID", modelID,
+				"namespace", namespace,
+				"remaining", remaining,
+				"coldStartGracePeriod", config.ColdStartGracePeriod)
+			analysis.ScaleDownSafe = false
+		}
+	}
+
+	// Step 4c: Require scale-down to stay safe for ScaleDownDelay and for
+	// ScaleDownStabilizationCycles consecutive cycles before acting on it.
+	// A pending scale-up also counts as unsafe and restarts both.
+	if a.scaleDown != nil && (config.ScaleDownDelay > 0 || config.ScaleDownStabilizationCycles > 1) {
+		safe := analysis.ScaleDownSafe && !analysis.ShouldScaleUp
+		safeFor, safeCycles := a.scaleDown.Observe(namespace+"/"+modelID, safe)
+		stable := safe && safeFor >= config.ScaleDownDelay && safeCycles >= config.ScaleDownStabilizationCycles
+		if safe && !stable {
+			logging.FromContext(ctx, logging.Analyzer).V(logging.DEBUG).Info("Scale-down held until load stays low",
+				"modelID", modelID,
+				"namespace", namespace,
+				"safeFor", safeFor,
+				"scaleDownDelay", config.ScaleDownDelay,
+				"safeCycles", safeCycles,
+				"scaleDownStabilizationCycles", config.ScaleDownStabilizationCycles)
+		}
+		analysis.ScaleDownSafe = stable
+	}
 
-	ctrl.LoggerFrom(ctx).V(logging.DEBUG).Info("saturation analysis completed",
+	logging.FromContext(ctx, logging.Analyzer).V(logging.DEBUG).Info("saturation analysis completed",
 		"modelID", modelID,
 		"namespace", namespace,
 		"totalReplicas", analysis.TotalReplicas,
 		"nonSaturated", nonSaturatedCount,
 		"avgSpareKv", analysis.AvgSpareKvCapacity,
 		"avgSpareQueue", analysis.AvgSpareQueueLength,
+		"outputTokenRate", analysis.TotalOutputTokenRate,
+		"specDecodeAcceptanceRate", analysis.AvgSpecDecodeAcceptanceRate,
+		"errorRate", analysis.AvgErrorRate,
+		"rejectedRequestRate", analysis.TotalRejectedRequestRate,
+		"tokensInFlightSpare", analysis.AvgTokensInFlightSpare,
+		"arrivalRate", analysis.TotalArrivalRate,
 		"shouldScaleUp", analysis.ShouldScaleUp,
 		"scaleDownSafe", analysis.ScaleDownSafe)
 
@@ -144,7 +341,7 @@ func (a *Analyzer) analyzeVariant(
 	if len(metrics) > 0 {
 		analysis.AcceleratorName = metrics[0].AcceleratorName
 		analysis.Cost = metrics[0].Cost
-		ctrl.LoggerFrom(ctx).V(logging.DEBUG).Info("Variant analysis initialized",
+		logging.FromContext(ctx, logging.Analyzer).V(logging.DEBUG).Info("Variant analysis initialized",
 			"variant", variantName,
 			"accelerator", analysis.AcceleratorName,
 			"cost", analysis.Cost,
@@ -153,26 +350,37 @@ func (a *Analyzer) analyzeVariant(
 
 	var totalSpareKv float64
 	var totalSpareQueue float64
+	var totalKvUsage float64
+	var totalWeight float64
 	var nonSaturatedCount int
+	var totalAge time.Duration
 
 	for _, metric := range metrics {
+		if metric.Metadata != nil && metric.Metadata.Age > 0 {
+			totalAge += metric.Metadata.Age
+		}
+
 		// Check if replica is saturated
 		isSaturated := metric.KvCacheUsage >= config.KvCacheThreshold ||
-			float64(metric.QueueLength) >= config.QueueLengthThreshold
+			metric.QueueLength >= config.QueueLengthThreshold
 
 		if isSaturated {
 			analysis.SaturatedReplicas = append(analysis.SaturatedReplicas, metric.PodName)
 		} else {
 			// Calculate spare Saturation for non-saturated replica
 			spareKv := config.KvCacheThreshold - metric.KvCacheUsage
-			spareQueue := config.QueueLengthThreshold - float64(metric.QueueLength)
+			spareQueue := config.QueueLengthThreshold - metric.QueueLength
 
-			totalSpareKv += spareKv
-			totalSpareQueue += spareQueue
+			// Weight by metric freshness; without a half-life every weight is 1
+			weight := FreshnessWeight(metric, config.MetricsFreshnessHalfLife)
+			totalSpareKv += spareKv * weight
+			totalSpareQueue += spareQueue * weight
+			totalWeight += weight
 			nonSaturatedCount++
 		}
 
-		// Track max usage
+		// Track total and max usage
+		totalKvUsage += metric.KvCacheUsage
 		if metric.KvCacheUsage > analysis.MaxKvCacheUsage {
 			analysis.MaxKvCacheUsage = metric.KvCacheUsage
 		}
@@ -182,11 +390,16 @@ func (a *Analyzer) analyzeVariant(
 	}
 
 	analysis.NonSaturatedCount = nonSaturatedCount
+	analysis.NonSaturatedWeight = totalWeight
+	if len(metrics) > 0 {
+		analysis.AvgKvCacheUsage = totalKvUsage / float64(len(metrics))
+		analysis.AvgMetricAge = totalAge / time.Duration(len(metrics))
+	}
 
 	// Calculate averages for non-saturated replicas
-	if nonSaturatedCount > 0 {
-		analysis.AvgSpareKvCapacity = totalSpareKv / float64(nonSaturatedCount)
-		analysis.AvgSpareQueueLength = totalSpareQueue / float64(nonSaturatedCount)
+	if totalWeight > 0 {
+		analysis.AvgSpareKvCapacity = totalSpareKv / totalWeight
+		analysis.AvgSpareQueueLength = totalSpareQueue / totalWeight
 	}
 
 	return analysis
@@ -197,39 +410,71 @@ func (a *Analyzer) shouldScaleUp(
 	avgSpareKv float64,
 	avgSpareQueue float64,
 	config interfaces.SaturationScalingConfig,
-) (bool, string) {
+) (bool, string, interfaces.ReasonCode) {
 
 	kvTriggered := avgSpareKv < config.KvSpareTrigger
 	queueTriggered := avgSpareQueue < config.QueueSpareTrigger
 
 	// Early return if no triggers fired
 	if !kvTriggered && !queueTriggered {
-		return false, ""
+		return false, "", ""
 	}
 
-	// Build reason string based on which trigger(s) fired
+	// Build reason string based on which trigger(s) fired.
+	// When both fire, KV is reported as the code since it is the harder limit.
 	switch {
 	case kvTriggered && queueTriggered:
 		return true, fmt.Sprintf("both KV spare (%.3f < %.3f) and queue spare (%.1f < %.1f)",
-			avgSpareKv, config.KvSpareTrigger, avgSpareQueue, config.QueueSpareTrigger)
+			avgSpareKv, config.KvSpareTrigger, avgSpareQueue, config.QueueSpareTrigger), interfaces.ReasonCodeKvSpareLow
 	case kvTriggered:
 		return true, fmt.Sprintf("KV spare Saturation low (%.3f < %.3f)",
-			avgSpareKv, config.KvSpareTrigger)
+			avgSpareKv, config.KvSpareTrigger), interfaces.ReasonCodeKvSpareLow
 	default: // only queueTriggered is true
 		return true, fmt.Sprintf("queue spare Saturation low (%.1f < %.1f)",
-			avgSpareQueue, config.QueueSpareTrigger)
+			avgSpareQueue, config.QueueSpareTrigger), interfaces.ReasonCodeQueueSpareLow
 	}
 }
 
-// isScaleDownSafe simulates realistic load redistribution after removing one replica.
+// scaleDownSafeReplicas returns how many replicas can be removed at once while the simulation of
+// isScaleDownSafe passes, 0 when not even one can. Without a ScaleStepFraction scale-down removes
+// one replica per cycle, so at most one is simulated.
+func (a *Analyzer) scaleDownSafeReplicas(
+	ctx context.Context,
+	replicaMetrics []interfaces.ReplicaMetrics,
+	nonSaturatedCount int,
+	avgSpareKv float64,
+	avgSpareQueue float64,
+	config interfaces.SaturationScalingConfig,
+) int {
+	maxRemoved := 1
+	if config.ScaleStepFraction > 0 {
+		maxRemoved = nonSaturatedCount - 1
+	}
+	// The predicted load only grows with each replica removed, so stop at the first unsafe count
+	safe := 0
+	for removed := 1; removed <= maxRemoved; removed++ {
+		if !a.isScaleDownSafe(ctx, replicaMetrics, nonSaturatedCount, removed, avgSpareKv, avgSpareQueue, config) {
+			break
+		}
+		safe = removed
+	}
+	return safe
+}
+
+// isScaleDownSafe simulates realistic load redistribution after removing replicas.
 // Returns isSafe where:
-// - isSafe: true if removing one replica would leave adequate headroom
+// - isSafe: true if removing that many replicas would leave adequate headroom
 //
 // Algorithm: Calculates total current load across non-saturated replicas, then simulates
-// redistributing that load across (N-1) replicas to determine if spare Saturation remains adequate.
+// redistributing that load across (N-removed) replicas to determine if spare Saturation remains adequate.
+// When replicas report arrival rates, their request demand is redistributed as well (see
+// ArrivalRateRemovalLoad) and the higher of the two predicted loads is used, so the request
+// rate can only make the simulation more cautious.
 func (a *Analyzer) isScaleDownSafe(
 	ctx context.Context,
+	replicaMetrics []interfaces.ReplicaMetrics,
 	nonSaturatedCount int,
+	removed int,
 	avgSpareKv float64,
 	avgSpareQueue float64,
 	config interfaces.SaturationScalingConfig,
@@ -238,23 +483,35 @@ func (a *Analyzer) isScaleDownSafe(
 	// Require minimum non-saturated replicas for scale-down safety
 	// With fewer replicas, we cannot safely redistribute load without risking saturation
 	if nonSaturatedCount < MinNonSaturatedReplicasForScaleDown {
-		ctrl.LoggerFrom(ctx).V(logging.DEBUG).Info("Scale-down unsafe: insufficient non-saturated replicas",
+		logging.FromContext(ctx, logging.Analyzer).V(logging.DEBUG).Info("Scale-down unsafe: insufficient non-saturated replicas",
 			"nonSaturated", nonSaturatedCount, "required", MinNonSaturatedReplicasForScaleDown)
 		return false
 	}
+	if nonSaturatedCount-removed < 1 {
+		return false
+	}
 
 	// Calculate current average load per replica
 	// Load = Threshold - Spare
 	avgKvLoad := config.KvCacheThreshold - avgSpareKv
 	avgQueueLoad := config.QueueLengthThreshold - avgSpareQueue
 
-	// Simulate removing one replica: load increases by factor of N/(N-1)
-	// New avg load = current avg load × N/(N-1)
-	remainingCount := nonSaturatedCount - 1
+	// Simulate removing replicas: load increases by factor of N/(N-removed)
+	// New avg load = current avg load × N/(N-removed)
+	remainingCount := nonSaturatedCount - removed
 	scaleFactor := float64(nonSaturatedCount) / float64(remainingCount)
 	avgKvAfterRemoval := avgKvLoad * scaleFactor
 	avgQueueAfterRemoval := avgQueueLoad * scaleFactor
 
+	// Redistribute the actual request rate too: a replica serving most of the traffic at a
+	// modest load snapshot predicts a higher load once its share moves to the others
+	if config.ArrivalRateSimulation {
+		if kvLoad, queueLoad, ok := ArrivalRateRemovalLoad(replicaMetrics, removed, config); ok {
+			avgKvAfterRemoval = max(avgKvAfterRemoval, kvLoad)
+			avgQueueAfterRemoval = max(avgQueueAfterRemoval, queueLoad)
+		}
+	}
+
 	// Calculate spare capacity after redistribution
 	// Spare = Threshold - Load
 	remainingSpareKv := config.KvCacheThreshold - avgKvAfterRemoval
@@ -264,12 +521,24 @@ func (a *Analyzer) isScaleDownSafe(
 	kvSafe := remainingSpareKv >= config.KvSpareTrigger
 	queueSafe := remainingSpareQueue >= config.QueueSpareTrigger
 
+	// With asymmetric thresholds the load after removal must also stay below the lower
+	// scale-down target, so a model that just dropped below the scale-up point keeps its replicas
+	if config.ScaleDownKvCacheThreshold > 0 && avgKvAfterRemoval >= config.ScaleDownKvCacheThreshold {
+		kvSafe = false
+	}
+	if config.ScaleDownQueueLengthThreshold > 0 && avgQueueAfterRemoval >= config.ScaleDownQueueLengthThreshold {
+		queueSafe = false
+	}
+
 	isSafe := kvSafe && queueSafe
 
 	if !isSafe {
-		ctrl.LoggerFrom(ctx).V(logging.DEBUG).Info("Scale-down unsafe: insufficient headroom after redistribution",
+		logging.FromContext(ctx, logging.Analyzer).V(logging.DEBUG).Info("Scale-down unsafe: insufficient headroom after redistribution",
+			"removedReplicas", removed,
 			"remainingSpareKv", remainingSpareKv, "kvTrigger", config.KvSpareTrigger, "kvSafe", kvSafe,
-			"remainingSpareQueue", remainingSpareQueue, "queueTrigger", config.QueueSpareTrigger, "queueSafe", queueSafe)
+			"remainingSpareQueue", remainingSpareQueue, "queueTrigger", config.QueueSpareTrigger, "queueSafe", queueSafe,
+			"kvAfterRemoval", avgKvAfterRemoval, "scaleDownKvCacheThreshold", config.ScaleDownKvCacheThreshold,
+			"queueAfterRemoval", avgQueueAfterRemoval, "scaleDownQueueLengthThreshold", config.ScaleDownQueueLengthThreshold)
 	}
 
 	// Saturation analyzer never initiates scale-down, only approves/denies
@@ -280,10 +549,22 @@ func (a *Analyzer) isScaleDownSafe(
 // Step 1: Pure saturation-based target calculation
 // Uses replica count from Saturation metrics (ready replicas) to avoid excessive scale-up.
 // Rules:
-// - If ANY variant is transitioning (desired ≠ current OR metrics ≠ current): block all scaling for the model
-// - Else if Saturation needs scale-up: cheapest variant (without pending replicas) gets readyReplicas+1
-// - Else if Saturation allows scale-down: most expensive variant gets readyReplicas-1
-// - Else: target = readyReplicas (replicas with metrics)
+//   - If ANY variant is transitioning (desired ≠ current OR metrics ≠ current): block all scaling for the model
+//   - Else if Saturation needs scale-up: variant chosen by the TargetStrategy (cheapest by default) among those
+//     without pending replicas and on an allowed accelerator gets readyReplicas+1
+//   - Else if Saturation allows scale-down: variant chosen by the TargetStrategy (by ScaleDownPolicy, most
+//     expensive first, by default) gets readyReplicas-1
+//   - Else: target = readyReplicas (replicas with metrics)
+//
+// With a ScaleStepFraction the step is that fraction of readyReplicas instead, rounded by
+// ScaleUpRounding (ceil by default) or ScaleDownRounding (floor by default) and at least one replica.
+//
+// Pending replicas of a variant marked StuckPending do not count as a transition; the variant keeps
+// its current replicas as the base target but is still skipped for scale-up. A variant marked
+// HoldForExcessReady counts as a transition. A Paused variant is skipped for both scale-up and
+// scale-down, so the model scales on its other variants.
+//
+// The reason code for each variant's target is recorded in saturationAnalysis.TargetReasonCodes.
 func (a *Analyzer) CalculateSaturationTargets(
 	ctx context.Context,
 	saturationAnalysis *interfaces.ModelSaturationAnalysis,
@@ -291,17 +572,27 @@ func (a *Analyzer) CalculateSaturationTargets(
 ) map[string]int {
 
 	targets := make(map[string]int)
-	logger := ctrl.LoggerFrom(ctx)
+	logger := logging.FromContext(ctx, logging.Analyzer)
 
 	// Nil safety
 	if saturationAnalysis == nil || len(saturationAnalysis.VariantAnalyses) == 0 {
 		// Default: current replicas
+		reasonCodes := make(map[string]interfaces.ReasonCode, len(variantStates))
 		for _, state := range variantStates {
 			targets[state.VariantName] = state.CurrentReplicas
+			reasonCodes[state.VariantName] = interfaces.ReasonCodeNoAnalysis
+		}
+		if saturationAnalysis != nil {
+			saturationAnalysis.TargetReasonCodes = reasonCodes
 		}
 		return targets
 	}
 
+	// Every variant starts as steady; the steps below override the code
+	// for variants whose target is preserved, raised, lowered or guarded.
+	reasonCodes := make(map[string]interfaces.ReasonCode, len(saturationAnalysis.VariantAnalyses))
+	saturationAnalysis.TargetReasonCodes = reasonCodes
+
 	// Build state map for quick lookup
 	stateMap := make(map[string]interfaces.VariantReplicaState)
 	for _, state := range variantStates {
@@ -325,13 +616,22 @@ func (a *Analyzer) CalculateSaturationTargets(
 				fmt.Sprintf("%s: desired(%d)!=current(%d)", va.VariantName, state.DesiredReplicas, state.CurrentReplicas))
 		}
 
-		// Check 2: Metrics vs Current mismatch (pods not yet ready/reporting)
-		metricsCurrentMismatch := va.ReplicaCount != state.CurrentReplicas
+		// Check 2: Metrics vs Current mismatch (pods not yet ready/reporting).
+		// Replicas pending for longer than MaxPendingAge no longer hold back the model.
+		stuckPending := state.StuckPending && va.ReplicaCount+state.PendingReplicas >= state.CurrentReplicas
+		metricsCurrentMismatch := va.ReplicaCount != state.CurrentReplicas && !stuckPending
 		if metricsCurrentMismatch {
 			modelInTransition = true
 			transitionReasons = append(transitionReasons,
 				fmt.Sprintf("%s: metrics(%d)!=current(%d)", va.VariantName, va.ReplicaCount, state.CurrentReplicas))
 		}
+
+		// Check 3: Ready replicas exceed current under ExcessReadyPolicyHold
+		if state.HoldForExcessReady {
+			modelInTransition = true
+			transitionReasons = append(transitionReasons,
+				fmt.Sprintf("%s: ready(%d)>current(%d)", va.VariantName, state.CurrentReplicas+state.ExcessReadyReplicas, state.CurrentReplicas))
+		}
 	}
 
 	// STEP 2: Initialize targets
@@ -339,8 +639,10 @@ func (a *Analyzer) CalculateSaturationTargets(
 	// If model is stable, use metrics count as the base
 	for _, va := range saturationAnalysis.VariantAnalyses {
 		state := stateMap[va.VariantName]
+		reasonCodes[va.VariantName] = interfaces.ReasonCodeSteady
 
 		if modelInTransition {
+			reasonCodes[va.VariantName] = interfaces.ReasonCodePreserved
 			// Model in transition: preserve desired replicas if set, otherwise current
 			if state.DesiredReplicas != 0 && state.DesiredReplicas != state.CurrentReplicas {
 				targets[va.VariantName] = state.DesiredReplicas
@@ -351,12 +653,20 @@ func (a *Analyzer) CalculateSaturationTargets(
 				logger.V(logging.DEBUG).Info("Target set to current (model transitioning)",
 					"variant", va.VariantName, "current", state.CurrentReplicas)
 			}
+		} else if state.StuckPending && va.ReplicaCount != state.CurrentReplicas {
+			// Stuck pending replicas: keep them rather than treat them as removed
+			targets[va.VariantName] = state.CurrentReplicas
+			logger.V(logging.DEBUG).Info("Target set to current (pending replicas stuck)",
+				"variant", va.VariantName, "current", state.CurrentReplicas, "pendingReplicas", state.PendingReplicas)
 		} else {
 			// Model stable: use metrics count
 			targets[va.VariantName] = va.ReplicaCount
 			logger.V(logging.DEBUG).Info("Target initialized to metrics count (stable)",
 				"variant", va.VariantName, "count", va.ReplicaCount)
 		}
+		if state.Paused && !modelInTransition {
+			reasonCodes[va.VariantName] = interfaces.ReasonCodeDeploymentPaused
+		}
 	}
 
 	// STEP 3: If model is transitioning, log and return early (no scaling decisions)
@@ -368,62 +678,70 @@ func (a *Analyzer) CalculateSaturationTargets(
 	}
 
 	// STEP 4: Model is stable - proceed with scaling decisions
+	strategy, ok := TargetStrategyFor(saturationAnalysis.TargetStrategy)
+	if !ok {
+		logger.Info("Unknown target strategy, using cost-aware",
+			"modelID", saturationAnalysis.ModelID,
+			"targetStrategy", saturationAnalysis.TargetStrategy)
+	}
 	if saturationAnalysis.ShouldScaleUp {
-		// Find cheapest variant for scale-up, skipping variants with pending replicas
-		var cheapestVariant *interfaces.VariantSaturationAnalysis
+		// Let the strategy pick the variant to scale up, skipping variants with pending replicas
+		candidates := make([]*interfaces.VariantSaturationAnalysis, 0, len(saturationAnalysis.VariantAnalyses))
 		for i := range saturationAnalysis.VariantAnalyses {
 			va := &saturationAnalysis.VariantAnalyses[i]
 
 			// Skip variants with pending replicas to prevent cascade scaling
 			state := stateMap[va.VariantName]
 			if state.PendingReplicas > 0 {
+				reasonCodes[va.VariantName] = interfaces.ReasonCodePendingGuard
 				logger.V(logging.DEBUG).Info("Skipping variant with pending replicas for scale-up",
 					"variant", va.VariantName, "pendingReplicas", state.PendingReplicas)
 				continue
 			}
 
-			// Select cheapest, with stable tie-breaking by variant name (alphabetically first)
-			if cheapestVariant == nil ||
-				va.Cost < cheapestVariant.Cost ||
-				(va.Cost == cheapestVariant.Cost && va.VariantName < cheapestVariant.VariantName) {
-				cheapestVariant = va
+			// Skip variants whose deployment rollout is paused
+			if state.Paused {
+				logger.V(logging.DEBUG).Info("Skipping variant with paused deployment for scale-up",
+					"variant", va.VariantName)
+				continue
 			}
-		}
-
-		if cheapestVariant != nil {
-			state := stateMap[cheapestVariant.VariantName]
-			baseTarget := targets[cheapestVariant.VariantName]
-			targets[cheapestVariant.VariantName] = baseTarget + 1
-			logger.V(logging.VERBOSE).Info("Saturation target: scale-up cheapest variant",
-				"variant", cheapestVariant.VariantName, "cost", cheapestVariant.Cost, "currentReplicas", state.CurrentReplicas,
-				"readyReplicas", cheapestVariant.ReplicaCount, "baseTarget", baseTarget, "target", targets[cheapestVariant.VariantName], "reason", saturationAnalysis.ScaleUpReason)
-		}
 
-	} else if saturationAnalysis.ScaleDownSafe {
-		// Find most expensive variant for scale-down
-		var mostExpensiveVariant *interfaces.VariantSaturationAnalysis
-		for i := range saturationAnalysis.VariantAnalyses {
-			va := &saturationAnalysis.VariantAnalyses[i]
-			// Can't scale down if at or below minimum (1 replica)
-			baseTarget := targets[va.VariantName]
-			if baseTarget <= 1 {
+			// Skip variants on accelerators the model is not compatible with
+			if !AcceleratorAllowed(va.AcceleratorName, saturationAnalysis.AllowedAccelerators) {
+				reasonCodes[va.VariantName] = interfaces.ReasonCodeAcceleratorNotAllowed
+				logger.V(logging.DEBUG).Info("Skipping variant on disallowed accelerator for scale-up",
+					"variant", va.VariantName, "accelerator", va.AcceleratorName,
+					"allowedAccelerators", saturationAnalysis.AllowedAccelerators)
 				continue
 			}
-			// Select most expensive, with stable tie-breaking by variant name
-			if mostExpensiveVariant == nil ||
-				va.Cost > mostExpensiveVariant.Cost ||
-				(va.Cost == mostExpensiveVariant.Cost && va.VariantName > mostExpensiveVariant.VariantName) {
-				mostExpensiveVariant = va
-			}
+			candidates = append(candidates, va)
+		}
+
+		var scaleUpVariant *interfaces.VariantSaturationAnalysis
+		if len(candidates) > 0 {
+			scaleUpVariant = strategy.ScaleUpVariant(saturationAnalysis, candidates, targets)
+		}
+		if scaleUpVariant != nil {
+			state := stateMap[scaleUpVariant.VariantName]
+			baseTarget := targets[scaleUpVariant.VariantName]
+			targets[scaleUpVariant.VariantName] = scaleUpTarget(saturationAnalysis, baseTarget)
+			reasonCodes[scaleUpVariant.VariantName] = saturationAnalysis.ScaleUpReasonCode
+			logger.V(logging.VERBOSE).Info("Saturation target: scale-up variant",
+				"variant", scaleUpVariant.VariantName, "strategy", saturationAnalysis.TargetStrategy, "cost", scaleUpVariant.Cost, "currentReplicas", state.CurrentReplicas,
+				"readyReplicas", scaleUpVariant.ReplicaCount, "baseTarget", baseTarget, "target", targets[scaleUpVariant.VariantName], "reason", saturationAnalysis.ScaleUpReason)
 		}
 
-		if mostExpensiveVariant != nil {
-			state := stateMap[mostExpensiveVariant.VariantName]
-			baseTarget := targets[mostExpensiveVariant.VariantName]
-			targets[mostExpensiveVariant.VariantName] = baseTarget - 1
-			logger.V(logging.VERBOSE).Info("Saturation target: scale-down most expensive variant",
-				"variant", mostExpensiveVariant.VariantName, "cost", mostExpensiveVariant.Cost, "currentReplicas", state.CurrentReplicas,
-				"readyReplicas", mostExpensiveVariant.ReplicaCount, "baseTarget", baseTarget, "target", targets[mostExpensiveVariant.VariantName])
+	} else if saturationAnalysis.ScaleDownSafe {
+		scaleDownVariant := selectScaleDownVariant(saturationAnalysis, strategy, targets, stateMap)
+		if scaleDownVariant != nil {
+			state := stateMap[scaleDownVariant.VariantName]
+			baseTarget := targets[scaleDownVariant.VariantName]
+			targets[scaleDownVariant.VariantName] = scaleDownTarget(saturationAnalysis, baseTarget, max(1, state.ScaleDownFloor))
+			reasonCodes[scaleDownVariant.VariantName] = interfaces.ReasonCodeScaleDownSafe
+			logger.V(logging.VERBOSE).Info("Saturation target: scale-down variant",
+				"variant", scaleDownVariant.VariantName, "strategy", saturationAnalysis.TargetStrategy, "policy", saturationAnalysis.ScaleDownPolicy,
+				"cost", scaleDownVariant.Cost, "headroom", variantHeadroom(scaleDownVariant), "currentReplicas", state.CurrentReplicas,
+				"readyReplicas", scaleDownVariant.ReplicaCount, "baseTarget", baseTarget, "target", targets[scaleDownVariant.VariantName])
 		}
 	} else {
 		// No scaling action needed - Saturation is adequate and stable
@@ -434,3 +752,41 @@ func (a *Analyzer) CalculateSaturationTargets(
 
 	return targets
 }
+
+// selectScaleDownVariant picks the variant that gives up a replica according to strategy.
+// Variants at or below one target replica, at or below their ScaleDownFloor, or whose deployment
+// rollout is paused are never chosen.
+// Returns nil if no variant can be scaled down.
+func selectScaleDownVariant(
+	saturationAnalysis *interfaces.ModelSaturationAnalysis,
+	strategy TargetStrategy,
+	targets map[string]int,
+	stateMap map[string]interfaces.VariantReplicaState,
+) *interfaces.VariantSaturationAnalysis {
+	candidates := make([]*interfaces.VariantSaturationAnalysis, 0, len(saturationAnalysis.VariantAnalyses))
+	for i := range saturationAnalysis.VariantAnalyses {
+		va := &saturationAnalysis.VariantAnalyses[i]
+		// Can't scale down if at or below minimum (1 replica) or the variant's scale-down floor,
+		// whatever the safety simulation says
+		if targets[va.VariantName] <= max(1, stateMap[va.VariantName].ScaleDownFloor) {
+			continue
+		}
+		if stateMap[va.VariantName].Paused {
+			continue
+		}
+		candidates = append(candidates, va)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return strategy.ScaleDownVariant(saturationAnalysis, candidates, targets)
+}
+
+// variantHeadroom returns the average spare KV cache capacity across all replicas of a
+// variant, counting saturated replicas as having no spare capacity.
+func variantHeadroom(va *interfaces.VariantSaturationAnalysis) float64 {
+	if va.ReplicaCount == 0 {
+		return 0
+	}
+	return va.AvgSpareKvCapacity * float64(va.NonSaturatedCount) / float64(va.ReplicaCount)
+}
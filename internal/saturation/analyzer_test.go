@@ -2,6 +2,7 @@ package saturation
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ func TestAnalyzeModelSaturation_ScaleUp(t *testing.T) {
 		replicaMetrics      []interfaces.ReplicaMetrics
 		expectScaleUp       bool
 		expectScaleUpReason string
+		expectReasonCode    interfaces.ReasonCode
 	}{
 		{
 			name: "scale up due to low KV spare Saturation",
@@ -35,7 +37,8 @@ func TestAnalyzeModelSaturation_ScaleUp(t *testing.T) {
 				{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.75, QueueLength: 2},
 				{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.76, QueueLength: 2},
 			},
-			expectScaleUp: true, // avg spare KV = 0.045 < 0.1
+			expectScaleUp:    true, // avg spare KV = 0.045 < 0.1
+			expectReasonCode: interfaces.ReasonCodeKvSpareLow,
 		},
 		{
 			name: "scale up due to low queue spare Saturation",
@@ -43,7 +46,8 @@ func TestAnalyzeModelSaturation_ScaleUp(t *testing.T) {
 				{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: 3},
 				{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: 3},
 			},
-			expectScaleUp: true, // avg spare queue = 2 < 3
+			expectScaleUp:    true, // avg spare queue = 2 < 3
+			expectReasonCode: interfaces.ReasonCodeQueueSpareLow,
 		},
 		{
 			name: "no scale up - healthy Saturation",
@@ -73,6 +77,10 @@ func TestAnalyzeModelSaturation_ScaleUp(t *testing.T) {
 				t.Errorf("expected ShouldScaleUp=%v, got %v (reason: %s)",
 					tt.expectScaleUp, analysis.ShouldScaleUp, analysis.ScaleUpReason)
 			}
+			if analysis.ScaleUpReasonCode != tt.expectReasonCode {
+				t.Errorf("expected ScaleUpReasonCode=%q, got %q",
+					tt.expectReasonCode, analysis.ScaleUpReasonCode)
+			}
 		})
 	}
 }
@@ -265,6 +273,77 @@ func TestAnalyzeVariant_SaturatedReplicas(t *testing.T) {
 	}
 }
 
+func TestAnalyzeVariant_FractionalQueueThreshold(t *testing.T) {
+	analyzer := &Analyzer{}
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 2.5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    1.5,
+	}
+
+	// Averaged queue lengths are fractional
+	metrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: 2.4}, // Not saturated
+		{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: 2.5}, // Saturated (at threshold)
+		{PodName: "pod-3", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: 3.1}, // Saturated
+	}
+
+	analysis := analyzer.analyzeVariant(context.Background(), "v1", metrics, config)
+
+	if analysis.NonSaturatedCount != 1 {
+		t.Errorf("expected NonSaturatedCount=1, got %d", analysis.NonSaturatedCount)
+	}
+	if analysis.MaxQueueLength != 3.1 {
+		t.Errorf("expected MaxQueueLength=3.1, got %v", analysis.MaxQueueLength)
+	}
+	if math.Abs(analysis.AvgSpareQueueLength-0.1) > 1e-9 {
+		t.Errorf("expected AvgSpareQueueLength=0.1, got %v", analysis.AvgSpareQueueLength)
+	}
+}
+
+func TestAnalyzeModelSaturation_FractionalQueueSpareTrigger(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 2.5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    1.5,
+	}
+
+	tests := []struct {
+		name          string
+		queueLength   float64
+		expectScaleUp bool
+	}{
+		// Truncating 1.1 to 1 would leave a spare of exactly 1.5 and miss the trigger
+		{name: "spare 1.4 below trigger", queueLength: 1.1, expectScaleUp: true},
+		{name: "spare 1.6 above trigger", queueLength: 0.9, expectScaleUp: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: tt.queueLength},
+				{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: tt.queueLength},
+			}
+			analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", metrics, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if analysis.ShouldScaleUp != tt.expectScaleUp {
+				t.Errorf("expected ShouldScaleUp=%v, got %v (reason: %s)", tt.expectScaleUp, analysis.ShouldScaleUp, analysis.ScaleUpReason)
+			}
+			if tt.expectScaleUp && analysis.ScaleUpReasonCode != interfaces.ReasonCodeQueueSpareLow {
+				t.Errorf("expected reason code %s, got %s", interfaces.ReasonCodeQueueSpareLow, analysis.ScaleUpReasonCode)
+			}
+			if math.Abs(analysis.TotalQueueLength-2*tt.queueLength) > 1e-9 {
+				t.Errorf("expected TotalQueueLength=%v, got %v", 2*tt.queueLength, analysis.TotalQueueLength)
+			}
+		})
+	}
+}
+
 func TestAnalyzeModelSaturation_AllSaturated(t *testing.T) {
 	analyzer := NewAnalyzer()
 	config := interfaces.SaturationScalingConfig{
@@ -438,6 +517,140 @@ func TestCalculatesaturationTargets_ScaleDownMostExpensive(t *testing.T) {
 	}
 }
 
+func TestCalculatesaturationTargets_ScaleDownLeastLoaded(t *testing.T) {
+	analyzer := NewAnalyzer()
+
+	newAnalysis := func(policy interfaces.ScaleDownPolicy) *interfaces.ModelSaturationAnalysis {
+		return &interfaces.ModelSaturationAnalysis{
+			ModelID:         "test-model",
+			Namespace:       "test-ns",
+			ShouldScaleUp:   false,
+			ScaleDownSafe:   true,
+			ScaleDownPolicy: policy,
+			VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+				// Busiest variant is also the most expensive
+				{VariantName: "v1-expensive", Cost: 20, ReplicaCount: 2, NonSaturatedCount: 1, AvgSpareKvCapacity: 0.30},
+				// Idle variant: all replicas non-saturated with lots of spare KV
+				{VariantName: "v2-cheap", Cost: 5, ReplicaCount: 2, NonSaturatedCount: 2, AvgSpareKvCapacity: 0.50},
+				{VariantName: "v3-medium", Cost: 15, ReplicaCount: 2, NonSaturatedCount: 2, AvgSpareKvCapacity: 0.20},
+			},
+		}
+	}
+
+	variantStates := []interfaces.VariantReplicaState{
+		{VariantName: "v1-expensive", CurrentReplicas: 2},
+		{VariantName: "v2-cheap", CurrentReplicas: 2},
+		{VariantName: "v3-medium", CurrentReplicas: 2},
+	}
+
+	tests := []struct {
+		name           string
+		policy         interfaces.ScaleDownPolicy
+		expectScaledIn string
+	}{
+		{name: "default policy scales down most expensive", policy: "", expectScaledIn: "v1-expensive"},
+		{name: "cost policy scales down most expensive", policy: interfaces.ScaleDownPolicyCost, expectScaledIn: "v1-expensive"},
+		{name: "least-loaded policy scales down most headroom", policy: interfaces.ScaleDownPolicyLeastLoaded, expectScaledIn: "v2-cheap"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := analyzer.CalculateSaturationTargets(context.Background(), newAnalysis(tt.policy), variantStates)
+			for _, state := range variantStates {
+				expected := 2
+				if state.VariantName == tt.expectScaledIn {
+					expected = 1
+				}
+				if targets[state.VariantName] != expected {
+					t.Errorf("expected %s target=%d, got %d", state.VariantName, expected, targets[state.VariantName])
+				}
+			}
+		})
+	}
+}
+
+func TestCalculatesaturationTargets_ScaleDownLeastLoadedTieBreak(t *testing.T) {
+	analyzer := NewAnalyzer()
+
+	// Equal headroom falls back to cost ordering; variants at one replica are skipped
+	saturationAnalysis := &interfaces.ModelSaturationAnalysis{
+		ModelID:         "test-model",
+		Namespace:       "test-ns",
+		ScaleDownSafe:   true,
+		ScaleDownPolicy: interfaces.ScaleDownPolicyLeastLoaded,
+		VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+			{VariantName: "v1-idle-single", Cost: 5, ReplicaCount: 1, NonSaturatedCount: 1, AvgSpareKvCapacity: 0.80},
+			{VariantName: "v2-cheap", Cost: 5, ReplicaCount: 3, NonSaturatedCount: 3, AvgSpareKvCapacity: 0.40},
+			{VariantName: "v3-expensive", Cost: 20, ReplicaCount: 3, NonSaturatedCount: 3, AvgSpareKvCapacity: 0.40},
+		},
+	}
+
+	variantStates := []interfaces.VariantReplicaState{
+		{VariantName: "v1-idle-single", CurrentReplicas: 1},
+		{VariantName: "v2-cheap", CurrentReplicas: 3},
+		{VariantName: "v3-expensive", CurrentReplicas: 3},
+	}
+
+	targets := analyzer.CalculateSaturationTargets(context.Background(), saturationAnalysis, variantStates)
+
+	if targets["v1-idle-single"] != 1 {
+		t.Errorf("expected v1-idle-single target=1, got %d", targets["v1-idle-single"])
+	}
+	if targets["v2-cheap"] != 3 {
+		t.Errorf("expected v2-cheap target=3, got %d", targets["v2-cheap"])
+	}
+	if targets["v3-expensive"] != 2 {
+		t.Errorf("expected v3-expensive target=2, got %d", targets["v3-expensive"])
+	}
+}
+
+func TestCalculatesaturationTargets_ScaleDownFloor(t *testing.T) {
+	analyzer := NewAnalyzer()
+
+	// The simulation says removing a replica is safe, but the expensive variant is at its floor
+	newAnalysis := func() *interfaces.ModelSaturationAnalysis {
+		return &interfaces.ModelSaturationAnalysis{
+			ModelID:       "test-model",
+			Namespace:     "test-ns",
+			ScaleDownSafe: true,
+			VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+				{VariantName: "v1-expensive", Cost: 20, ReplicaCount: 3},
+				{VariantName: "v2-cheap", Cost: 5, ReplicaCount: 3},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		expensiveFloor  int
+		cheapFloor      int
+		expectExpensive int
+		expectCheap     int
+	}{
+		{name: "no floor scales down most expensive", expectExpensive: 2, expectCheap: 3},
+		{name: "floor below target allows scale-down", expensiveFloor: 2, expectExpensive: 2, expectCheap: 3},
+		{name: "floor at target moves scale-down to next variant", expensiveFloor: 3, expectExpensive: 3, expectCheap: 2},
+		{name: "floor above target never scales up", expensiveFloor: 5, expectExpensive: 3, expectCheap: 2},
+		{name: "all variants at floor hold", expensiveFloor: 3, cheapFloor: 3, expectExpensive: 3, expectCheap: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variantStates := []interfaces.VariantReplicaState{
+				{VariantName: "v1-expensive", CurrentReplicas: 3, ScaleDownFloor: tt.expensiveFloor},
+				{VariantName: "v2-cheap", CurrentReplicas: 3, ScaleDownFloor: tt.cheapFloor},
+			}
+			targets := analyzer.CalculateSaturationTargets(context.Background(), newAnalysis(), variantStates)
+			if targets["v1-expensive"] != tt.expectExpensive {
+				t.Errorf("expected v1-expensive target=%d, got %d", tt.expectExpensive, targets["v1-expensive"])
+			}
+			if targets["v2-cheap"] != tt.expectCheap {
+				t.Errorf("expected v2-cheap target=%d, got %d", tt.expectCheap, targets["v2-cheap"])
+			}
+		})
+	}
+}
+
 func TestCalculatesaturationTargets_ModelLevelTransitionBlocking(t *testing.T) {
 	analyzer := NewAnalyzer()
 
@@ -507,3 +720,185 @@ func TestCalculatesaturationTargets_MetricsMismatchBlocksScaling(t *testing.T) {
 		t.Errorf("expected v2-cheap target=2 (blocked by model transition), got %d", targets["v2-cheap"])
 	}
 }
+
+func TestCalculatesaturationTargets_ReasonCodes(t *testing.T) {
+	twoVariants := []interfaces.VariantSaturationAnalysis{
+		{VariantName: "v1-expensive", Cost: 20, ReplicaCount: 2},
+		{VariantName: "v2-cheap", Cost: 5, ReplicaCount: 2},
+	}
+	stableStates := []interfaces.VariantReplicaState{
+		{VariantName: "v1-expensive", CurrentReplicas: 2},
+		{VariantName: "v2-cheap", CurrentReplicas: 2},
+	}
+
+	tests := []struct {
+		name          string
+		analysis      *interfaces.ModelSaturationAnalysis
+		states        []interfaces.VariantReplicaState
+		expectTargets map[string]int
+		expectCodes   map[string]interfaces.ReasonCode
+	}{
+		{
+			name: "scale-up on KV trigger",
+			analysis: &interfaces.ModelSaturationAnalysis{
+				ShouldScaleUp:     true,
+				ScaleUpReasonCode: interfaces.ReasonCodeKvSpareLow,
+				VariantAnalyses:   twoVariants,
+			},
+			states:        stableStates,
+			expectTargets: map[string]int{"v1-expensive": 2, "v2-cheap": 3},
+			expectCodes: map[string]interfaces.ReasonCode{
+				"v1-expensive": interfaces.ReasonCodeSteady,
+				"v2-cheap":     interfaces.ReasonCodeKvSpareLow,
+			},
+		},
+		{
+			name: "scale-up on queue trigger",
+			analysis: &interfaces.ModelSaturationAnalysis{
+				ShouldScaleUp:     true,
+				ScaleUpReasonCode: interfaces.ReasonCodeQueueSpareLow,
+				VariantAnalyses:   twoVariants,
+			},
+			states:        stableStates,
+			expectTargets: map[string]int{"v1-expensive": 2, "v2-cheap": 3},
+			expectCodes: map[string]interfaces.ReasonCode{
+				"v1-expensive": interfaces.ReasonCodeSteady,
+				"v2-cheap":     interfaces.ReasonCodeQueueSpareLow,
+			},
+		},
+		{
+			name: "scale-up skips variant with pending replicas",
+			analysis: &interfaces.ModelSaturationAnalysis{
+				ShouldScaleUp:     true,
+				ScaleUpReasonCode: interfaces.ReasonCodeKvSpareLow,
+				VariantAnalyses:   twoVariants,
+			},
+			states: []interfaces.VariantReplicaState{
+				{VariantName: "v1-expensive", CurrentReplicas: 2},
+				{VariantName: "v2-cheap", CurrentReplicas: 2, PendingReplicas: 1},
+			},
+			expectTargets: map[string]int{"v1-expensive": 3, "v2-cheap": 2},
+			expectCodes: map[string]interfaces.ReasonCode{
+				"v1-expensive": interfaces.ReasonCodeKvSpareLow,
+				"v2-cheap":     interfaces.ReasonCodePendingGuard,
+			},
+		},
+		{
+			name: "scale-down most expensive",
+			analysis: &interfaces.ModelSaturationAnalysis{
+				ScaleDownSafe:   true,
+				VariantAnalyses: twoVariants,
+			},
+			states:        stableStates,
+			expectTargets: map[string]int{"v1-expensive": 1, "v2-cheap": 2},
+			expectCodes: map[string]interfaces.ReasonCode{
+				"v1-expensive": interfaces.ReasonCodeScaleDownSafe,
+				"v2-cheap":     interfaces.ReasonCodeSteady,
+			},
+		},
+		{
+			name: "model in transition preserves targets",
+			analysis: &interfaces.ModelSaturationAnalysis{
+				ShouldScaleUp:     true,
+				ScaleUpReasonCode: interfaces.ReasonCodeKvSpareLow,
+				VariantAnalyses:   twoVariants,
+			},
+			states: []interfaces.VariantReplicaState{
+				{VariantName: "v1-expensive", CurrentReplicas: 2, DesiredReplicas: 4},
+				{VariantName: "v2-cheap", CurrentReplicas: 2},
+			},
+			expectTargets: map[string]int{"v1-expensive": 4, "v2-cheap": 2},
+			expectCodes: map[string]interfaces.ReasonCode{
+				"v1-expensive": interfaces.ReasonCodePreserved,
+				"v2-cheap":     interfaces.ReasonCodePreserved,
+			},
+		},
+		{
+			name: "no action needed",
+			analysis: &interfaces.ModelSaturationAnalysis{
+				VariantAnalyses: twoVariants,
+			},
+			states:        stableStates,
+			expectTargets: map[string]int{"v1-expensive": 2, "v2-cheap": 2},
+			expectCodes: map[string]interfaces.ReasonCode{
+				"v1-expensive": interfaces.ReasonCodeSteady,
+				"v2-cheap":     interfaces.ReasonCodeSteady,
+			},
+		},
+		{
+			name:          "no variant analyses",
+			analysis:      &interfaces.ModelSaturationAnalysis{},
+			states:        stableStates,
+			expectTargets: map[string]int{"v1-expensive": 2, "v2-cheap": 2},
+			expectCodes: map[string]interfaces.ReasonCode{
+				"v1-expensive": interfaces.ReasonCodeNoAnalysis,
+				"v2-cheap":     interfaces.ReasonCodeNoAnalysis,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := NewAnalyzer().CalculateSaturationTargets(context.Background(), tt.analysis, tt.states)
+
+			for variant, expected := range tt.expectTargets {
+				if targets[variant] != expected {
+					t.Errorf("expected %s target=%d, got %d", variant, expected, targets[variant])
+				}
+			}
+			for variant, expected := range tt.expectCodes {
+				if got := tt.analysis.TargetReasonCodes[variant]; got != expected {
+					t.Errorf("expected %s reason code=%q, got %q", variant, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_AsymmetricScaleDownThresholds(t *testing.T) {
+	analyzer := NewAnalyzer()
+	symmetric := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10, // scale up once average KV usage exceeds 0.70
+		QueueSpareTrigger:    3,
+	}
+	asymmetric := symmetric
+	asymmetric.ScaleDownKvCacheThreshold = 0.50
+
+	// replicas returns three replicas of one variant at the given KV utilization
+	replicas := func(kvUsage float64) []interfaces.ReplicaMetrics {
+		return []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-2", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-3", VariantName: "v1", KvCacheUsage: kvUsage},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		config     interfaces.SaturationScalingConfig
+		kvUsage    float64
+		expectSafe bool
+	}{
+		// Removing a replica at 0.40 leaves 0.60, inside the 0.70 scale-up point
+		{name: "symmetric scales down right after load drops", config: symmetric, kvUsage: 0.40, expectSafe: true},
+		{name: "asymmetric band holds replicas right after load drops", config: asymmetric, kvUsage: 0.40, expectSafe: false},
+		// Removing a replica at 0.30 leaves 0.45, below the 0.50 scale-down target
+		{name: "asymmetric scales down once below the band", config: asymmetric, kvUsage: 0.30, expectSafe: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(tt.kvUsage), tt.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if analysis.ShouldScaleUp {
+				t.Fatalf("expected no scale-up, reason %s", analysis.ScaleUpReason)
+			}
+			if analysis.ScaleDownSafe != tt.expectSafe {
+				t.Errorf("expected ScaleDownSafe=%v, got %v", tt.expectSafe, analysis.ScaleDownSafe)
+			}
+		})
+	}
+}
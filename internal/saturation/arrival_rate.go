@@ -0,0 +1,72 @@
+package saturation
+
+import (
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// TotalArrivalRate returns the request arrival rate (requests/sec) summed across replicas.
+func TotalArrivalRate(replicaMetrics []interfaces.ReplicaMetrics) float64 {
+	var total float64
+	for _, metric := range replicaMetrics {
+		total += metric.ArrivalRate
+	}
+	return total
+}
+
+// ArrivalRateRemovalLoad predicts the average KV cache and queue load of the non-saturated
+// replicas after removed of them are taken away, by redistributing their actual request demand
+// rather than scaling the current load snapshot.
+//
+// Each replica's demand is its arrival rate, weighted by its average input plus output tokens per
+// request when every replica serving requests reports them. The total demand is shared equally by
+// the N-removed remaining replicas, and each replica's share is converted back to load with the average
+// load per unit of demand observed on the replicas serving requests. A replica that takes a
+// larger share of the traffic than the others is therefore not hidden behind the average load.
+//
+// Returns ok=false when fewer than MinNonSaturatedReplicasForScaleDown replicas are non-saturated,
+// removing them would leave none, or none of them reports an arrival rate, so callers fall back
+// to the snapshot simulation.
+func ArrivalRateRemovalLoad(
+	replicaMetrics []interfaces.ReplicaMetrics,
+	removed int,
+	config interfaces.SaturationScalingConfig,
+) (kvLoad, queueLoad float64, ok bool) {
+	nonSaturated := make([]interfaces.ReplicaMetrics, 0, len(replicaMetrics))
+	useTokens := true
+	for _, metric := range replicaMetrics {
+		if metric.KvCacheUsage >= config.KvCacheThreshold || metric.QueueLength >= config.QueueLengthThreshold {
+			continue
+		}
+		nonSaturated = append(nonSaturated, metric)
+		if metric.ArrivalRate > 0 && metric.AvgInputTokens+metric.AvgOutputTokens <= 0 {
+			useTokens = false
+		}
+	}
+	if len(nonSaturated) < MinNonSaturatedReplicasForScaleDown || len(nonSaturated)-removed < 1 {
+		return 0, 0, false
+	}
+
+	var totalDemand, kvPerDemand, queuePerDemand float64
+	var serving int
+	for _, metric := range nonSaturated {
+		if metric.ArrivalRate <= 0 {
+			continue
+		}
+		demand := metric.ArrivalRate
+		if useTokens {
+			demand *= metric.AvgInputTokens + metric.AvgOutputTokens
+		}
+		totalDemand += demand
+		kvPerDemand += metric.KvCacheUsage / demand
+		queuePerDemand += metric.QueueLength / demand
+		serving++
+	}
+	if serving == 0 {
+		return 0, 0, false
+	}
+
+	demandAfterRemoval := totalDemand / float64(len(nonSaturated)-removed)
+	return kvPerDemand / float64(serving) * demandAfterRemoval,
+		queuePerDemand / float64(serving) * demandAfterRemoval,
+		true
+}
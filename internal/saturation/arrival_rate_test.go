@@ -0,0 +1,177 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func arrivalRateTestConfig() interfaces.SaturationScalingConfig {
+	return interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+}
+
+func TestArrivalRateRemovalLoad(t *testing.T) {
+	config := arrivalRateTestConfig()
+
+	tests := []struct {
+		name           string
+		replicaMetrics []interfaces.ReplicaMetrics
+		removed        int // 0 removes one replica
+		expectedOK     bool
+		expectedKv     float64
+		expectedQueue  float64
+	}{
+		{
+			name: "even demand matches the snapshot simulation",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, QueueLength: 1, ArrivalRate: 5, AvgInputTokens: 500, AvgOutputTokens: 200},
+				{PodName: "pod-2", KvCacheUsage: 0.30, QueueLength: 1, ArrivalRate: 5, AvgInputTokens: 500, AvgOutputTokens: 200},
+				{PodName: "pod-3", KvCacheUsage: 0.30, QueueLength: 1, ArrivalRate: 5, AvgInputTokens: 500, AvgOutputTokens: 200},
+			},
+			expectedOK:    true,
+			expectedKv:    0.45,
+			expectedQueue: 1.5,
+		},
+		{
+			name: "removing several replicas shares demand over the rest",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, QueueLength: 1, ArrivalRate: 5},
+				{PodName: "pod-2", KvCacheUsage: 0.30, QueueLength: 1, ArrivalRate: 5},
+				{PodName: "pod-3", KvCacheUsage: 0.30, QueueLength: 1, ArrivalRate: 5},
+			},
+			removed:       2,
+			expectedOK:    true,
+			expectedKv:    0.90,
+			expectedQueue: 3,
+		},
+		{
+			name: "removing every replica",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, ArrivalRate: 5},
+				{PodName: "pod-2", KvCacheUsage: 0.30, ArrivalRate: 5},
+			},
+			removed: 2,
+		},
+		{
+			name: "request size evens out a higher rate of shorter requests",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, ArrivalRate: 10, AvgInputTokens: 80, AvgOutputTokens: 20},
+				{PodName: "pod-2", KvCacheUsage: 0.30, ArrivalRate: 1, AvgInputTokens: 800, AvgOutputTokens: 200},
+				{PodName: "pod-3", KvCacheUsage: 0.30, ArrivalRate: 1, AvgInputTokens: 800, AvgOutputTokens: 200},
+			},
+			expectedOK: true,
+			expectedKv: 0.45,
+		},
+		{
+			name: "uneven demand predicts more load than the snapshot",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, ArrivalRate: 8, AvgInputTokens: 500, AvgOutputTokens: 200},
+				{PodName: "pod-2", KvCacheUsage: 0.30, ArrivalRate: 2, AvgInputTokens: 500, AvgOutputTokens: 200},
+			},
+			// Load per unit of demand averages (0.3/5600 + 0.3/1400) / 2, and all 7000 of demand
+			// moves to the remaining replica
+			expectedOK: true,
+			expectedKv: 0.9375,
+		},
+		{
+			name: "missing token lengths fall back to the request rate alone",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, ArrivalRate: 8, AvgInputTokens: 500, AvgOutputTokens: 200},
+				{PodName: "pod-2", KvCacheUsage: 0.30, ArrivalRate: 2},
+			},
+			expectedOK: true,
+			expectedKv: 0.9375,
+		},
+		{
+			name: "saturated replicas are left out",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, ArrivalRate: 5},
+				{PodName: "pod-2", KvCacheUsage: 0.30, ArrivalRate: 5},
+				{PodName: "pod-3", KvCacheUsage: 0.90, ArrivalRate: 50},
+			},
+			expectedOK: true,
+			expectedKv: 0.60,
+		},
+		{
+			name: "no arrival rates",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30},
+				{PodName: "pod-2", KvCacheUsage: 0.30},
+			},
+		},
+		{
+			name: "too few non-saturated replicas",
+			replicaMetrics: []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", KvCacheUsage: 0.30, ArrivalRate: 5},
+				{PodName: "pod-2", KvCacheUsage: 0.90, ArrivalRate: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv, queue, ok := ArrivalRateRemovalLoad(tt.replicaMetrics, max(1, tt.removed), config)
+			if ok != tt.expectedOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.expectedOK)
+			}
+			if math.Abs(kv-tt.expectedKv) > 1e-9 {
+				t.Errorf("kv load = %.4f, want %.4f", kv, tt.expectedKv)
+			}
+			if math.Abs(queue-tt.expectedQueue) > 1e-9 {
+				t.Errorf("queue load = %.4f, want %.4f", queue, tt.expectedQueue)
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_ArrivalRateBlocksScaleDown(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := arrivalRateTestConfig()
+
+	// Both replicas show the same KV cache snapshot, so removing one looks safe (0.60 < 0.70),
+	// but pod-1 serves four times the requests of pod-2
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.30},
+		{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.30},
+	}
+	analyze := func() *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	if snapshot := analyze(); !snapshot.ScaleDownSafe {
+		t.Fatal("expected scale-down to be safe from the KV cache snapshot alone")
+	}
+
+	replicaMetrics[0].ArrivalRate, replicaMetrics[0].AvgInputTokens, replicaMetrics[0].AvgOutputTokens = 8, 500, 200
+	replicaMetrics[1].ArrivalRate, replicaMetrics[1].AvgInputTokens, replicaMetrics[1].AvgOutputTokens = 2, 500, 200
+	if disabled := analyze(); !disabled.ScaleDownSafe {
+		t.Fatal("expected the arrival rate to be ignored without arrivalRateSimulation")
+	}
+
+	config.ArrivalRateSimulation = true
+	analysis := analyze()
+	if analysis.ScaleDownSafe {
+		t.Error("expected redistributing the arrival rate to predict KV cache saturation and block scale-down")
+	}
+	if analysis.TotalArrivalRate != 10 {
+		t.Errorf("TotalArrivalRate = %.1f, want 10", analysis.TotalArrivalRate)
+	}
+
+	// With even demand the arrival rate agrees with the snapshot
+	replicaMetrics[0].ArrivalRate = 2
+	if even := analyze(); !even.ScaleDownSafe {
+		t.Error("expected scale-down to stay safe with even demand")
+	}
+}
@@ -0,0 +1,28 @@
+package saturation
+
+import (
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// NormalizeQueueLengths scales each replica's queue length by referenceBatchSize divided by the
+// max batch size of its variant, so queue thresholds written for replicas running
+// referenceBatchSize sequences apply to variants of any batch size. Replicas of variants missing
+// from batchSizes keep their absolute queue length. replicaMetrics is not modified; it is
+// returned as is when referenceBatchSize is not positive.
+func NormalizeQueueLengths(
+	replicaMetrics []interfaces.ReplicaMetrics,
+	batchSizes map[string]int,
+	referenceBatchSize int,
+) []interfaces.ReplicaMetrics {
+	if referenceBatchSize <= 0 {
+		return replicaMetrics
+	}
+	normalized := make([]interfaces.ReplicaMetrics, len(replicaMetrics))
+	for i, metric := range replicaMetrics {
+		if batchSize := batchSizes[metric.VariantName]; batchSize > 0 {
+			metric.QueueLength *= float64(referenceBatchSize) / float64(batchSize)
+		}
+		normalized[i] = metric
+	}
+	return normalized
+}
@@ -0,0 +1,65 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestNormalizeQueueLengths(t *testing.T) {
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "small-1", VariantName: "small", QueueLength: 4},
+		{PodName: "large-1", VariantName: "large", QueueLength: 16},
+		{PodName: "unknown-1", VariantName: "unknown", QueueLength: 6},
+	}
+	batchSizes := map[string]int{"small": 32, "large": 128}
+
+	normalized := NormalizeQueueLengths(replicaMetrics, batchSizes, 32)
+	expected := map[string]float64{"small-1": 4, "large-1": 4, "unknown-1": 6}
+	for _, metric := range normalized {
+		if metric.QueueLength != expected[metric.PodName] {
+			t.Errorf("%s: queue length = %.2f, want %.2f", metric.PodName, metric.QueueLength, expected[metric.PodName])
+		}
+	}
+	if replicaMetrics[1].QueueLength != 16 {
+		t.Errorf("input was modified: queue length = %.2f, want 16", replicaMetrics[1].QueueLength)
+	}
+
+	if got := NormalizeQueueLengths(replicaMetrics, batchSizes, 0); got[1].QueueLength != 16 {
+		t.Errorf("without a reference batch size: queue length = %.2f, want 16", got[1].QueueLength)
+	}
+}
+
+func TestAnalyzeModelSaturation_NormalizedQueueLengths(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+		MaxBatchSize:         32,
+	}
+	// A queue of 8 saturates a 32-sequence replica but is half the threshold for a 128-sequence one
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "large-1", VariantName: "large", KvCacheUsage: 0.30, QueueLength: 8},
+		{PodName: "large-2", VariantName: "large", KvCacheUsage: 0.30, QueueLength: 8},
+	}
+
+	absolute, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if absolute.NonSaturatedCount != 0 {
+		t.Errorf("absolute thresholds: expected every replica saturated, got %d non-saturated", absolute.NonSaturatedCount)
+	}
+
+	normalized := NormalizeQueueLengths(replicaMetrics, map[string]int{"large": 128}, config.MaxBatchSize)
+	analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", normalized, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if analysis.NonSaturatedCount != 2 {
+		t.Errorf("normalized thresholds: expected 2 non-saturated replicas, got %d", analysis.NonSaturatedCount)
+	}
+}
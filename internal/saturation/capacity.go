@@ -0,0 +1,13 @@
+package saturation
+
+import "github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+
+// CapacityFactor returns the relative serving capacity of one replica on accelerator, used to
+// weight its spare capacity when averaging across a model's variants. Accelerators without a
+// configured factor weigh 1, so without factors every replica counts the same.
+func CapacityFactor(accelerator string, config interfaces.SaturationScalingConfig) float64 {
+	if factor, ok := config.AcceleratorCapacityFactors[accelerator]; ok {
+		return factor
+	}
+	return 1
+}
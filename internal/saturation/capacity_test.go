@@ -0,0 +1,87 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestAnalyzeModelSaturation_MixedAcceleratorCapacity(t *testing.T) {
+	analyzer := NewAnalyzer()
+	base := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.25,
+		QueueSpareTrigger:    3,
+	}
+	// Two busy A100 replicas and one idle H100 replica that can serve twice as much
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "a100-1", VariantName: "llama-a100", AcceleratorName: "A100", KvCacheUsage: 0.70, QueueLength: 1},
+		{PodName: "a100-2", VariantName: "llama-a100", AcceleratorName: "A100", KvCacheUsage: 0.70, QueueLength: 1},
+		{PodName: "h100-1", VariantName: "llama-h100", AcceleratorName: "H100", KvCacheUsage: 0.20, QueueLength: 1},
+	}
+
+	t.Run("naive averaging counts every replica the same", func(t *testing.T) {
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, base)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// (0.10 + 0.10 + 0.60) / 3
+		if math.Abs(analysis.AvgSpareKvCapacity-0.8/3) > 1e-9 {
+			t.Errorf("expected naive spare KV %.4f, got %.4f", 0.8/3, analysis.AvgSpareKvCapacity)
+		}
+		if analysis.ShouldScaleUp {
+			t.Errorf("expected no scale-up with the naive average, got %s", analysis.ScaleUpReason)
+		}
+	})
+
+	t.Run("capacity factors weight headroom by accelerator", func(t *testing.T) {
+		config := base
+		config.AcceleratorCapacityFactors = map[string]float64{"A100": 1, "H100": 2}
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// (0.10*1 + 0.10*1 + 0.60*2) / (1 + 1 + 2): the idle H100 holds more than half the capacity
+		if math.Abs(analysis.AvgSpareKvCapacity-0.35) > 1e-9 {
+			t.Errorf("expected weighted spare KV 0.35, got %.4f", analysis.AvgSpareKvCapacity)
+		}
+		if analysis.ShouldScaleUp {
+			t.Errorf("expected no scale-up, got %s", analysis.ScaleUpReason)
+		}
+		if analysis.NonSaturatedCount != 3 || analysis.TotalReplicas != 3 {
+			t.Errorf("expected replica counts to be unaffected by weighting, got nonSaturated=%d total=%d",
+				analysis.NonSaturatedCount, analysis.TotalReplicas)
+		}
+	})
+
+	t.Run("busy larger accelerator triggers scale-up the naive average misses", func(t *testing.T) {
+		// Swap the load: the H100 is busy and the A100s are idle
+		swapped := []interfaces.ReplicaMetrics{
+			{PodName: "a100-1", VariantName: "llama-a100", AcceleratorName: "A100", KvCacheUsage: 0.40, QueueLength: 1},
+			{PodName: "a100-2", VariantName: "llama-a100", AcceleratorName: "A100", KvCacheUsage: 0.40, QueueLength: 1},
+			{PodName: "h100-1", VariantName: "llama-h100", AcceleratorName: "H100", KvCacheUsage: 0.75, QueueLength: 1},
+		}
+		naive, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", swapped, base)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		config := base
+		config.AcceleratorCapacityFactors = map[string]float64{"H100": 4}
+		weighted, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", swapped, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// Naive: (0.40 + 0.40 + 0.05) / 3 = 0.283; weighted: (0.40 + 0.40 + 0.05*4) / 6 = 0.167
+		if naive.ShouldScaleUp {
+			t.Errorf("expected naive average %.3f to stay above the trigger", naive.AvgSpareKvCapacity)
+		}
+		if !weighted.ShouldScaleUp || weighted.ScaleUpReasonCode != interfaces.ReasonCodeKvSpareLow {
+			t.Errorf("expected weighted average %.3f to trigger a KV scale-up, got %v (%s)",
+				weighted.AvgSpareKvCapacity, weighted.ShouldScaleUp, weighted.ScaleUpReasonCode)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package saturation
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// ColdStartGrace records, per model, when a scale-up was last applied. Right after a scale-up
+// the new replicas are idle until traffic redistributes onto them, so the model briefly looks
+// over-provisioned; scale-down is suppressed for a grace period to avoid undoing the scale-up.
+// It is safe for concurrent use.
+type ColdStartGrace struct {
+	mu          sync.Mutex
+	clock       clock.PassiveClock
+	lastScaleUp map[string]time.Time
+}
+
+// NewColdStartGrace creates a tracker using the given clock.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+func NewColdStartGrace(clk clock.PassiveClock) *ColdStartGrace {
+	return &ColdStartGrace{
+		clock:       clk,
+		lastScaleUp: make(map[string]time.Time),
+	}
+}
+
+// RecordScaleUp records that a scale-up of the model was applied now.
+func (g *ColdStartGrace) RecordScaleUp(namespace, modelID string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.lastScaleUp[namespace+"/"+modelID] = g.clock.Now()
+}
+
+// Remaining returns how much of grace is left since the model's last scale-up, or 0 when the
+// model has not scaled up within grace.
+func (g *ColdStartGrace) Remaining(namespace, modelID string, grace time.Duration) time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key := namespace + "/" + modelID
+	last, ok := g.lastScaleUp[key]
+	if !ok {
+		return 0
+	}
+	remaining := grace - g.clock.Since(last)
+	if remaining <= 0 {
+		// Expired entries are only needed again after the next scale-up
+		delete(g.lastScaleUp, key)
+		return 0
+	}
+	return remaining
+}
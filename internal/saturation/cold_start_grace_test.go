@@ -0,0 +1,94 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestColdStartGrace_Remaining(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	grace := NewColdStartGrace(fakeClock)
+
+	if remaining := grace.Remaining("ns", "model", 3*time.Minute); remaining != 0 {
+		t.Fatalf("expected no grace before any scale-up, got %s", remaining)
+	}
+
+	grace.RecordScaleUp("ns", "model")
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	if remaining := grace.Remaining("ns", "model", 3*time.Minute); remaining != 2*time.Minute {
+		t.Fatalf("expected 2m of grace left, got %s", remaining)
+	}
+	if remaining := grace.Remaining("other-ns", "model", 3*time.Minute); remaining != 0 {
+		t.Fatalf("expected grace to be per namespace, got %s", remaining)
+	}
+
+	fakeClock.SetTime(fakeClock.Now().Add(2 * time.Minute))
+	if remaining := grace.Remaining("ns", "model", 3*time.Minute); remaining != 0 {
+		t.Fatalf("expected grace to have expired, got %s", remaining)
+	}
+}
+
+func TestAnalyzeModelSaturation_ColdStartGrace(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	grace := NewColdStartGrace(fakeClock)
+	analyzer := NewAnalyzer().WithColdStartGrace(grace)
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+		ColdStartGracePeriod: 3 * time.Minute,
+	}
+
+	// The replicas just added by a scale-up are idle, so the model looks over-provisioned
+	idle := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.2},
+		{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.2},
+		{PodName: "pod-3", VariantName: "v1", KvCacheUsage: 0},
+	}
+	analyze := func() *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", idle, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	if !analyze().ScaleDownSafe {
+		t.Fatal("expected scale-down to be safe before any scale-up")
+	}
+
+	grace.RecordScaleUp("test-ns", "test-model")
+	fakeClock.SetTime(fakeClock.Now().Add(2 * time.Minute))
+	analysis := analyze()
+	if analysis.ScaleDownSafe {
+		t.Error("expected scale-down to be blocked within the cold start grace period")
+	}
+	targets := analyzer.CalculateSaturationTargets(context.Background(), analysis, []interfaces.VariantReplicaState{
+		{VariantName: "v1", CurrentReplicas: 3},
+	})
+	if targets["v1"] != 3 {
+		t.Errorf("expected the target to stay at 3 within the grace period, got %d", targets["v1"])
+	}
+
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	if !analyze().ScaleDownSafe {
+		t.Error("expected scale-down to be safe once the grace period expired")
+	}
+
+	t.Run("zero grace period does not block scale-down", func(t *testing.T) {
+		noGrace := config
+		noGrace.ColdStartGracePeriod = 0
+		grace.RecordScaleUp("test-ns", "test-model")
+		analysis, _ := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", idle, noGrace)
+		if !analysis.ScaleDownSafe {
+			t.Error("expected scale-down to be safe without a grace period")
+		}
+	})
+}
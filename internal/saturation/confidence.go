@@ -0,0 +1,31 @@
+package saturation
+
+import (
+	"math"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// DefaultConfidenceHalfLife is the metric age at which the decision confidence halves when no
+// metricsFreshnessHalfLife is configured.
+const DefaultConfidenceHalfLife = time.Minute
+
+// DecisionConfidence returns how far the decision for a variant can be trusted, from 0 to 1:
+// the fraction of its current replicas reporting metrics, halved with every halfLife of mean
+// metric age. A variant without metrics has confidence 0; a variant without current replicas
+// counts as fully covered by the replicas that report. A halfLife <= 0 uses
+// DefaultConfidenceHalfLife.
+func DecisionConfidence(analysis *interfaces.VariantSaturationAnalysis, currentReplicas int, halfLife time.Duration) float64 {
+	if analysis == nil || analysis.ReplicaCount <= 0 {
+		return 0
+	}
+	coverage := 1.0
+	if currentReplicas > 0 {
+		coverage = min(float64(analysis.ReplicaCount)/float64(currentReplicas), 1.0)
+	}
+	if halfLife <= 0 {
+		halfLife = DefaultConfidenceHalfLife
+	}
+	return coverage * math.Exp2(-float64(analysis.AvgMetricAge)/float64(halfLife))
+}
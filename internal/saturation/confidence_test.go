@@ -0,0 +1,97 @@
+package saturation
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestDecisionConfidence(t *testing.T) {
+	tests := []struct {
+		name     string
+		analysis *interfaces.VariantSaturationAnalysis
+		current  int
+		halfLife time.Duration
+		expected float64
+	}{
+		{
+			name:     "all replicas reporting fresh metrics",
+			analysis: &interfaces.VariantSaturationAnalysis{ReplicaCount: 4},
+			current:  4,
+			expected: 1,
+		},
+		{
+			name:     "half the replicas reporting",
+			analysis: &interfaces.VariantSaturationAnalysis{ReplicaCount: 2},
+			current:  4,
+			expected: 0.5,
+		},
+		{
+			name:     "metrics one default half-life old",
+			analysis: &interfaces.VariantSaturationAnalysis{ReplicaCount: 4, AvgMetricAge: time.Minute},
+			current:  4,
+			expected: 0.5,
+		},
+		{
+			name:     "partial and stale metrics",
+			analysis: &interfaces.VariantSaturationAnalysis{ReplicaCount: 2, AvgMetricAge: 2 * time.Minute},
+			current:  4,
+			halfLife: 2 * time.Minute,
+			expected: 0.25,
+		},
+		{
+			name:     "more reporting than current replicas",
+			analysis: &interfaces.VariantSaturationAnalysis{ReplicaCount: 5},
+			current:  4,
+			expected: 1,
+		},
+		{
+			name:     "no current replicas",
+			analysis: &interfaces.VariantSaturationAnalysis{ReplicaCount: 1},
+			expected: 1,
+		},
+		{
+			name:     "no metrics",
+			analysis: &interfaces.VariantSaturationAnalysis{},
+			current:  4,
+			expected: 0,
+		},
+		{
+			name:     "no analysis",
+			current:  4,
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecisionConfidence(tt.analysis, tt.current, tt.halfLife)
+			if math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("DecisionConfidence() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDecisionConfidence_DropsWithCoverageAndStaleness(t *testing.T) {
+	fresh := DecisionConfidence(&interfaces.VariantSaturationAnalysis{ReplicaCount: 4}, 4, 0)
+
+	previous := fresh
+	for reporting := 3; reporting >= 1; reporting-- {
+		got := DecisionConfidence(&interfaces.VariantSaturationAnalysis{ReplicaCount: reporting}, 4, 0)
+		if got >= previous {
+			t.Errorf("%d of 4 replicas reporting: expected confidence below %v, got %v", reporting, previous, got)
+		}
+		previous = got
+	}
+
+	previous = fresh
+	for _, age := range []time.Duration{10 * time.Second, 30 * time.Second, 2 * time.Minute, 5 * time.Minute} {
+		got := DecisionConfidence(&interfaces.VariantSaturationAnalysis{ReplicaCount: 4, AvgMetricAge: age}, 4, 0)
+		if got >= previous {
+			t.Errorf("metrics %s old: expected confidence below %v, got %v", age, previous, got)
+		}
+		previous = got
+	}
+}
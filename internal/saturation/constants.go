@@ -11,4 +11,12 @@ const (
 	// in the VariantAutoscaling CR. This should match the cost of the cheapest accelerator
 	// to avoid biasing decisions toward unknown-cost variants.
 	DefaultVariantCost = 10.0
+
+	// GoodputWindowSamples is the number of consecutive analysis cycles compared when
+	// looking for a goodput plateau. The oldest and newest samples bound the window.
+	GoodputWindowSamples = 3
+
+	// DefaultQueuePercentileWindow is the number of analysis cycles of queue history used for
+	// the queue percentile when the config does not set queuePercentileWindow.
+	DefaultQueuePercentileWindow = 5
 )
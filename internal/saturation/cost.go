@@ -0,0 +1,18 @@
+package saturation
+
+import "github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+
+// EffectiveCost returns the cost used to rank variants when choosing which one to scale.
+// It blends the variant's cost with the energy factor of its accelerator according to
+// config.CarbonWeight. With a zero weight, or no factor for the accelerator, the variant
+// cost is returned unchanged.
+func EffectiveCost(cost float64, accelerator string, config interfaces.SaturationScalingConfig) float64 {
+	if config.CarbonWeight <= 0 {
+		return cost
+	}
+	energyFactor, ok := config.AcceleratorEnergyFactors[accelerator]
+	if !ok {
+		return cost
+	}
+	return (1-config.CarbonWeight)*cost + config.CarbonWeight*energyFactor
+}
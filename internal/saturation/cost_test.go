@@ -0,0 +1,109 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestEffectiveCost(t *testing.T) {
+	factors := map[string]float64{"L4": 50, "H100": 10}
+
+	tests := []struct {
+		name         string
+		cost         float64
+		accelerator  string
+		carbonWeight float64
+		expected     float64
+	}{
+		{name: "zero weight keeps pure cost", cost: 10, accelerator: "L4", carbonWeight: 0, expected: 10},
+		{name: "full weight uses energy factor", cost: 10, accelerator: "L4", carbonWeight: 1, expected: 50},
+		{name: "blend", cost: 10, accelerator: "L4", carbonWeight: 0.5, expected: 30},
+		{name: "unknown accelerator keeps cost", cost: 10, accelerator: "A100", carbonWeight: 0.5, expected: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := interfaces.SaturationScalingConfig{CarbonWeight: tt.carbonWeight, AcceleratorEnergyFactors: factors}
+			if got := EffectiveCost(tt.cost, tt.accelerator, config); got != tt.expected {
+				t.Errorf("EffectiveCost() = %.2f, want %.2f", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEffectiveCost_HighCarbonAcceleratorDeprioritized(t *testing.T) {
+	analyzer := NewAnalyzer()
+	base := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+		// L4 is cheap but carbon intensive, H100 costs more but is efficient
+		AcceleratorEnergyFactors: map[string]float64{"L4": 50, "H100": 10},
+	}
+
+	// replicas returns two replicas per variant, loaded enough to trigger scale-up.
+	// Each replica carries the effective cost of its variant, as the engine computes it.
+	replicas := func(config interfaces.SaturationScalingConfig) []interfaces.ReplicaMetrics {
+		var metrics []interfaces.ReplicaMetrics
+		for _, v := range []struct {
+			name, accelerator string
+			cost              float64
+		}{
+			{name: "v-l4", accelerator: "L4", cost: 10},
+			{name: "v-h100", accelerator: "H100", cost: 15},
+		} {
+			for _, pod := range []string{"-0", "-1"} {
+				metrics = append(metrics, interfaces.ReplicaMetrics{
+					PodName:         v.name + pod,
+					VariantName:     v.name,
+					AcceleratorName: v.accelerator,
+					KvCacheUsage:    0.75,
+					Cost:            EffectiveCost(v.cost, v.accelerator, config),
+				})
+			}
+		}
+		return metrics
+	}
+	states := []interfaces.VariantReplicaState{
+		{VariantName: "v-l4", CurrentReplicas: 2},
+		{VariantName: "v-h100", CurrentReplicas: 2},
+	}
+
+	tests := []struct {
+		name          string
+		carbonWeight  float64
+		expectScaleUp string
+	}{
+		{name: "pure cost scales the cheap accelerator", carbonWeight: 0, expectScaleUp: "v-l4"},
+		{name: "high carbon weight scales the efficient accelerator", carbonWeight: 0.8, expectScaleUp: "v-h100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := base
+			config.CarbonWeight = tt.carbonWeight
+
+			analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(config), config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !analysis.ShouldScaleUp {
+				t.Fatal("expected scale-up")
+			}
+
+			targets := analyzer.CalculateSaturationTargets(context.Background(), analysis, states)
+			for _, state := range states {
+				expected := state.CurrentReplicas
+				if state.VariantName == tt.expectScaleUp {
+					expected++
+				}
+				if targets[state.VariantName] != expected {
+					t.Errorf("expected %s target=%d, got %d", state.VariantName, expected, targets[state.VariantName])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package saturation
+
+import (
+	"context"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// MetricsCoverage returns the lowest per-variant fraction of current replicas that
+// reported metrics (ReplicaCount / CurrentReplicas) and the variant it belongs to.
+// Variants with no current replicas are fully covered by definition. A variant with
+// replicas but no analysis entry has coverage 0.
+func MetricsCoverage(
+	saturationAnalysis *interfaces.ModelSaturationAnalysis,
+	variantStates []interfaces.VariantReplicaState,
+) (float64, string) {
+	reporting := make(map[string]int)
+	if saturationAnalysis != nil {
+		for _, va := range saturationAnalysis.VariantAnalyses {
+			reporting[va.VariantName] = va.ReplicaCount
+		}
+	}
+
+	lowest := 1.0
+	lowestVariant := ""
+	for _, state := range variantStates {
+		if state.CurrentReplicas <= 0 {
+			continue
+		}
+		coverage := min(float64(reporting[state.VariantName])/float64(state.CurrentReplicas), 1.0)
+		if coverage < lowest {
+			lowest = coverage
+			lowestVariant = state.VariantName
+		}
+	}
+	return lowest, lowestVariant
+}
+
+// GateOnMetricsCoverage holds all targets when the lowest per-variant metrics coverage is
+// below minCoverage, so scaling is never decided on a partial view of the model. The bound
+// is inclusive: coverage equal to minCoverage proceeds, so a minCoverage of 1.0 requires
+// every replica to report rather than holding forever.
+// Held targets keep each variant at its previously desired replicas when a scale operation
+// is still in flight, otherwise at its current replicas, and are tagged ReasonCodePartialMetrics.
+//
+// Returns the targets to use, the measured coverage, and whether the targets were held.
+// A minCoverage of 0 disables the gate.
+func GateOnMetricsCoverage(
+	ctx context.Context,
+	saturationAnalysis *interfaces.ModelSaturationAnalysis,
+	variantStates []interfaces.VariantReplicaState,
+	targets map[string]int,
+	minCoverage float64,
+) (map[string]int, float64, bool) {
+	coverage, lowestVariant := MetricsCoverage(saturationAnalysis, variantStates)
+	if coverage >= minCoverage {
+		return targets, coverage, false
+	}
+
+	logging.FromContext(ctx, logging.Analyzer).Info("Metrics coverage below minimum, holding scaling decisions",
+		"coverage", coverage,
+		"minMetricsCoverage", minCoverage,
+		"lowestVariant", lowestVariant)
+
+	return holdForPartialMetrics(saturationAnalysis, variantStates), coverage, true
+}
+
+// NoMetricsVariants returns the variants that have current replicas but none reporting metrics.
+func NoMetricsVariants(
+	saturationAnalysis *interfaces.ModelSaturationAnalysis,
+	variantStates []interfaces.VariantReplicaState,
+) []string {
+	reporting := make(map[string]int)
+	if saturationAnalysis != nil {
+		for _, va := range saturationAnalysis.VariantAnalyses {
+			reporting[va.VariantName] = va.ReplicaCount
+		}
+	}
+
+	var variants []string
+	for _, state := range variantStates {
+		if state.CurrentReplicas > 0 && reporting[state.VariantName] == 0 {
+			variants = append(variants, state.VariantName)
+		}
+	}
+	return variants
+}
+
+// ApplyNoMetricsVariantPolicy handles a model some of whose variants report metrics while others
+// with replicas report none. With NoMetricsVariantPolicyHold all targets are held as by
+// GateOnMetricsCoverage. Otherwise the variants without metrics are left out of the targets, so
+// they are never scaled and keep their desired replicas, while the others are scaled as computed.
+//
+// Returns the targets to use and whether they were held. A model with no reporting variant at
+// all is left to the analysis.
+func ApplyNoMetricsVariantPolicy(
+	ctx context.Context,
+	saturationAnalysis *interfaces.ModelSaturationAnalysis,
+	variantStates []interfaces.VariantReplicaState,
+	targets map[string]int,
+	policy interfaces.NoMetricsVariantPolicy,
+) (map[string]int, bool) {
+	noMetrics := NoMetricsVariants(saturationAnalysis, variantStates)
+	if len(noMetrics) == 0 || len(noMetrics) == len(variantStates) {
+		return targets, false
+	}
+	logger := logging.FromContext(ctx, logging.Analyzer)
+
+	if policy == interfaces.NoMetricsVariantPolicyHold {
+		logger.Info("Variants without metrics, holding the model's scaling decisions",
+			"variants", noMetrics)
+		return holdForPartialMetrics(saturationAnalysis, variantStates), true
+	}
+
+	logger.Info("Variants without metrics excluded from scaling decisions",
+		"variants", noMetrics)
+	for _, variant := range noMetrics {
+		delete(targets, variant)
+	}
+	return targets, false
+}
+
+// holdForPartialMetrics holds each variant at its previously desired replicas when a scale
+// operation is still in flight, otherwise at its current replicas, tagged ReasonCodePartialMetrics.
+func holdForPartialMetrics(
+	saturationAnalysis *interfaces.ModelSaturationAnalysis,
+	variantStates []interfaces.VariantReplicaState,
+) map[string]int {
+	held := make(map[string]int, len(variantStates))
+	reasonCodes := make(map[string]interfaces.ReasonCode, len(variantStates))
+	for _, state := range variantStates {
+		if state.DesiredReplicas != 0 {
+			held[state.VariantName] = state.DesiredReplicas
+		} else {
+			held[state.VariantName] = state.CurrentReplicas
+		}
+		reasonCodes[state.VariantName] = interfaces.ReasonCodePartialMetrics
+	}
+	if saturationAnalysis != nil {
+		saturationAnalysis.TargetReasonCodes = reasonCodes
+	}
+	return held
+}
@@ -0,0 +1,250 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestMetricsCoverage(t *testing.T) {
+	tests := []struct {
+		name           string
+		reporting      map[string]int
+		states         []interfaces.VariantReplicaState
+		expectCoverage float64
+		expectVariant  string
+	}{
+		{
+			name:      "all replicas reporting",
+			reporting: map[string]int{"v1": 3, "v2": 2},
+			states: []interfaces.VariantReplicaState{
+				{VariantName: "v1", CurrentReplicas: 3},
+				{VariantName: "v2", CurrentReplicas: 2},
+			},
+			expectCoverage: 1.0,
+		},
+		{
+			name:      "lowest variant wins",
+			reporting: map[string]int{"v1": 3, "v2": 1},
+			states: []interfaces.VariantReplicaState{
+				{VariantName: "v1", CurrentReplicas: 4},
+				{VariantName: "v2", CurrentReplicas: 4},
+			},
+			expectCoverage: 0.25,
+			expectVariant:  "v2",
+		},
+		{
+			name:      "variant missing from analysis has no coverage",
+			reporting: map[string]int{"v1": 2},
+			states: []interfaces.VariantReplicaState{
+				{VariantName: "v1", CurrentReplicas: 2},
+				{VariantName: "v2", CurrentReplicas: 1},
+			},
+			expectCoverage: 0,
+			expectVariant:  "v2",
+		},
+		{
+			name:      "variants at zero replicas are ignored",
+			reporting: map[string]int{"v1": 2},
+			states: []interfaces.VariantReplicaState{
+				{VariantName: "v1", CurrentReplicas: 2},
+				{VariantName: "v2", CurrentReplicas: 0},
+			},
+			expectCoverage: 1.0,
+		},
+		{
+			name:      "more reporting pods than replicas is capped",
+			reporting: map[string]int{"v1": 3},
+			states: []interfaces.VariantReplicaState{
+				{VariantName: "v1", CurrentReplicas: 2},
+			},
+			expectCoverage: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis := &interfaces.ModelSaturationAnalysis{}
+			for name, count := range tt.reporting {
+				analysis.VariantAnalyses = append(analysis.VariantAnalyses,
+					interfaces.VariantSaturationAnalysis{VariantName: name, ReplicaCount: count})
+			}
+
+			coverage, variant := MetricsCoverage(analysis, tt.states)
+			if coverage != tt.expectCoverage {
+				t.Errorf("expected coverage %.2f, got %.2f", tt.expectCoverage, coverage)
+			}
+			if variant != tt.expectVariant {
+				t.Errorf("expected lowest variant %q, got %q", tt.expectVariant, variant)
+			}
+		})
+	}
+}
+
+func TestGateOnMetricsCoverage(t *testing.T) {
+	states := []interfaces.VariantReplicaState{
+		{VariantName: "v1", CurrentReplicas: 4, DesiredReplicas: 5},
+		{VariantName: "v2", CurrentReplicas: 2},
+	}
+	newAnalysis := func(v1Reporting int) *interfaces.ModelSaturationAnalysis {
+		return &interfaces.ModelSaturationAnalysis{
+			VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+				{VariantName: "v1", ReplicaCount: v1Reporting},
+				{VariantName: "v2", ReplicaCount: 2},
+			},
+			TargetReasonCodes: map[string]interfaces.ReasonCode{
+				"v1": interfaces.ReasonCodeScaleDownSafe,
+				"v2": interfaces.ReasonCodeSteady,
+			},
+		}
+	}
+	targets := map[string]int{"v1": 3, "v2": 2}
+
+	t.Run("holds targets below the minimum", func(t *testing.T) {
+		analysis := newAnalysis(1)
+		got, coverage, held := GateOnMetricsCoverage(context.Background(), analysis, states, targets, 0.8)
+		if !held {
+			t.Fatal("expected targets to be held")
+		}
+		if coverage != 0.25 {
+			t.Errorf("expected coverage 0.25, got %.2f", coverage)
+		}
+		// v1 holds at its in-flight desired replicas, v2 at its current replicas
+		if got["v1"] != 5 || got["v2"] != 2 {
+			t.Errorf("expected held targets v1=5 v2=2, got %v", got)
+		}
+		for name, code := range analysis.TargetReasonCodes {
+			if code != interfaces.ReasonCodePartialMetrics {
+				t.Errorf("expected reason code %s for %s, got %s", interfaces.ReasonCodePartialMetrics, name, code)
+			}
+		}
+	})
+
+	t.Run("proceeds at or above the minimum", func(t *testing.T) {
+		analysis := newAnalysis(4)
+		got, coverage, held := GateOnMetricsCoverage(context.Background(), analysis, states, targets, 0.8)
+		if held {
+			t.Fatal("expected targets not to be held")
+		}
+		if coverage != 1.0 {
+			t.Errorf("expected coverage 1.0, got %.2f", coverage)
+		}
+		if got["v1"] != 3 || got["v2"] != 2 {
+			t.Errorf("expected targets unchanged, got %v", got)
+		}
+		if analysis.TargetReasonCodes["v1"] != interfaces.ReasonCodeScaleDownSafe {
+			t.Errorf("expected reason code to be preserved, got %s", analysis.TargetReasonCodes["v1"])
+		}
+	})
+
+	// The minimum is inclusive, so that 1.0 means every replica must report rather than
+	// holding forever
+	t.Run("proceeds at exactly the minimum", func(t *testing.T) {
+		for _, tc := range []struct {
+			v1Reporting int
+			minCoverage float64
+		}{
+			{v1Reporting: 3, minCoverage: 0.75},
+			{v1Reporting: 4, minCoverage: 1.0},
+		} {
+			if _, coverage, held := GateOnMetricsCoverage(context.Background(), newAnalysis(tc.v1Reporting), states, targets, tc.minCoverage); held {
+				t.Errorf("expected coverage %.2f not to be held at minimum %.2f", coverage, tc.minCoverage)
+			}
+		}
+		if _, _, held := GateOnMetricsCoverage(context.Background(), newAnalysis(3), states, targets, 0.76); !held {
+			t.Error("expected coverage 0.75 to be held at minimum 0.76")
+		}
+	})
+
+	t.Run("zero minimum disables the gate", func(t *testing.T) {
+		_, _, held := GateOnMetricsCoverage(context.Background(), newAnalysis(0), states, targets, 0)
+		if held {
+			t.Fatal("expected gate to be disabled")
+		}
+	})
+}
+
+func TestApplyNoMetricsVariantPolicy(t *testing.T) {
+	ctx := context.Background()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	// The cheaper variant's pods stopped reporting; the other one is busy enough to need a scale-up
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "a100-1", VariantName: "v-a100", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.75},
+		{PodName: "a100-2", VariantName: "v-a100", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.75},
+	}
+	states := []interfaces.VariantReplicaState{
+		{VariantName: "v-l4", CurrentReplicas: 3},
+		{VariantName: "v-a100", CurrentReplicas: 2},
+	}
+
+	tests := []struct {
+		name          string
+		policy        interfaces.NoMetricsVariantPolicy
+		expectTargets map[string]int
+		expectHeld    bool
+	}{
+		{
+			name:          "default excludes the variant without metrics",
+			expectTargets: map[string]int{"v-a100": 3},
+		},
+		{
+			name:          "exclude scales only the reporting variant",
+			policy:        interfaces.NoMetricsVariantPolicyExclude,
+			expectTargets: map[string]int{"v-a100": 3},
+		},
+		{
+			name:          "hold keeps every variant",
+			policy:        interfaces.NoMetricsVariantPolicyHold,
+			expectTargets: map[string]int{"v-l4": 3, "v-a100": 2},
+			expectHeld:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer := NewAnalyzer()
+			analysis, err := analyzer.AnalyzeModelSaturation(ctx, "test-model", "test-ns", replicaMetrics, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			targets := analyzer.CalculateSaturationTargets(ctx, analysis, states)
+
+			targets, held := ApplyNoMetricsVariantPolicy(ctx, analysis, states, targets, tt.policy)
+			if held != tt.expectHeld {
+				t.Errorf("expected held=%v, got %v", tt.expectHeld, held)
+			}
+			if len(targets) != len(tt.expectTargets) {
+				t.Errorf("expected targets %v, got %v", tt.expectTargets, targets)
+			}
+			for variant, expected := range tt.expectTargets {
+				if targets[variant] != expected {
+					t.Errorf("expected %s target %d, got %v", variant, expected, targets)
+				}
+				if held && analysis.TargetReasonCodes[variant] != interfaces.ReasonCodePartialMetrics {
+					t.Errorf("expected %s held with reason %s, got %s",
+						variant, interfaces.ReasonCodePartialMetrics, analysis.TargetReasonCodes[variant])
+				}
+			}
+		})
+	}
+}
+
+func TestApplyNoMetricsVariantPolicy_LeavesFullyReportingModels(t *testing.T) {
+	states := []interfaces.VariantReplicaState{
+		{VariantName: "v1", CurrentReplicas: 2},
+		{VariantName: "v2", CurrentReplicas: 0},
+	}
+	analysis := &interfaces.ModelSaturationAnalysis{
+		VariantAnalyses: []interfaces.VariantSaturationAnalysis{{VariantName: "v1", ReplicaCount: 2}},
+	}
+	targets, held := ApplyNoMetricsVariantPolicy(context.Background(), analysis, states,
+		map[string]int{"v1": 3, "v2": 0}, interfaces.NoMetricsVariantPolicyHold)
+	if held || targets["v1"] != 3 || targets["v2"] != 0 {
+		t.Errorf("expected a variant at zero replicas not to count as missing metrics, got %v held=%v", targets, held)
+	}
+}
@@ -0,0 +1,29 @@
+package saturation
+
+import (
+	"fmt"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// AverageErrorRate returns the mean HTTP 5xx error rate across all replicas. Replicas without
+// an error rate count as 0, so a model is only considered failing when its replicas report errors.
+func AverageErrorRate(replicaMetrics []interfaces.ReplicaMetrics) float64 {
+	if len(replicaMetrics) == 0 {
+		return 0
+	}
+	var total float64
+	for _, metric := range replicaMetrics {
+		total += metric.ErrorRate
+	}
+	return total / float64(len(replicaMetrics))
+}
+
+// DetectElevatedErrorRate reports whether the error rate is at or above threshold. Requests are
+// already failing then, so removing a replica is unsafe however much spare capacity is left.
+func DetectElevatedErrorRate(errorRate, threshold float64) (bool, string) {
+	if threshold <= 0 || errorRate < threshold {
+		return false, ""
+	}
+	return true, fmt.Sprintf("error rate elevated (%.3f >= %.3f)", errorRate, threshold)
+}
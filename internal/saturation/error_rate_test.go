@@ -0,0 +1,93 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestAverageErrorRate(t *testing.T) {
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", ErrorRate: 0.3},
+		{PodName: "pod-2", ErrorRate: 0.1},
+		{PodName: "pod-3"}, // no errors or no metric
+	}
+	if got := AverageErrorRate(replicaMetrics); math.Abs(got-0.4/3) > 1e-9 {
+		t.Errorf("AverageErrorRate = %.4f, want %.4f", got, 0.4/3)
+	}
+	if got := AverageErrorRate(nil); got != 0 {
+		t.Errorf("AverageErrorRate(nil) = %.4f, want 0", got)
+	}
+}
+
+func TestDetectElevatedErrorRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		errorRate float64
+		threshold float64
+		expected  bool
+	}{
+		{name: "above threshold", errorRate: 0.10, threshold: 0.05, expected: true},
+		{name: "at threshold", errorRate: 0.05, threshold: 0.05, expected: true},
+		{name: "below threshold", errorRate: 0.01, threshold: 0.05, expected: false},
+		{name: "disabled", errorRate: 0.50, threshold: 0, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elevated, reason := DetectElevatedErrorRate(tt.errorRate, tt.threshold)
+			if elevated != tt.expected {
+				t.Errorf("DetectElevatedErrorRate(%.2f, %.2f) = %v, want %v", tt.errorRate, tt.threshold, elevated, tt.expected)
+			}
+			if elevated && reason == "" {
+				t.Error("expected a reason when the error rate is elevated")
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_ElevatedErrorRateBlocksScaleDown(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+		ErrorRateThreshold:   0.05,
+	}
+	variantStates := []interfaces.VariantReplicaState{{VariantName: "v1", CurrentReplicas: 3}}
+
+	// Three lightly loaded replicas: adequate headroom to remove one
+	analyze := func(errorRate float64) *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		replicaMetrics := []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.20, QueueLength: 1, ErrorRate: errorRate},
+			{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.30, QueueLength: 1, ErrorRate: errorRate},
+			{PodName: "pod-3", VariantName: "v1", Cost: 10, KvCacheUsage: 0.25, QueueLength: 1, ErrorRate: errorRate},
+		}
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	healthy := analyze(0.01)
+	if !healthy.ScaleDownSafe || healthy.ErrorRateElevated {
+		t.Fatalf("expected scale-down to be safe at a low error rate, got safe=%v elevated=%v",
+			healthy.ScaleDownSafe, healthy.ErrorRateElevated)
+	}
+	if targets := analyzer.CalculateSaturationTargets(context.Background(), healthy, variantStates); targets["v1"] != 2 {
+		t.Fatalf("expected scale-down to 2 at a low error rate, got %d", targets["v1"])
+	}
+
+	failing := analyze(0.20)
+	if failing.ScaleDownSafe || !failing.ErrorRateElevated {
+		t.Errorf("expected scale-down to be blocked at a high error rate, got safe=%v elevated=%v",
+			failing.ScaleDownSafe, failing.ErrorRateElevated)
+	}
+	if targets := analyzer.CalculateSaturationTargets(context.Background(), failing, variantStates); targets["v1"] != 3 {
+		t.Errorf("expected replicas to be held at 3 at a high error rate, got %d", targets["v1"])
+	}
+}
@@ -0,0 +1,40 @@
+package saturation
+
+import (
+	"context"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// ApplyExcessReadyPolicy handles the states whose ready replicas exceed their current replicas
+// according to policy. ExcessReadyPolicyUseReady raises CurrentReplicas to the ready replicas and
+// ExcessReadyPolicyHold marks the state so CalculateSaturationTargets holds the model's scaling for
+// the cycle. ExcessReadyPolicyClamp, the default, leaves the states as built. Returns the names of
+// the variants with excess ready replicas.
+func ApplyExcessReadyPolicy(
+	ctx context.Context,
+	states []interfaces.VariantReplicaState,
+	policy interfaces.ExcessReadyPolicy,
+) []string {
+	var excess []string
+	for i := range states {
+		state := &states[i]
+		if state.ExcessReadyReplicas <= 0 {
+			continue
+		}
+		excess = append(excess, state.VariantName)
+		switch policy {
+		case interfaces.ExcessReadyPolicyUseReady:
+			state.CurrentReplicas += state.ExcessReadyReplicas
+			logging.FromContext(ctx, logging.Analyzer).Info("Ready replicas exceed current replicas, using ready replicas as current",
+				"variant", state.VariantName, "currentReplicas", state.CurrentReplicas)
+		case interfaces.ExcessReadyPolicyHold:
+			state.HoldForExcessReady = true
+			logging.FromContext(ctx, logging.Analyzer).Info("Ready replicas exceed current replicas, holding scaling decisions for this cycle",
+				"variant", state.VariantName, "currentReplicas", state.CurrentReplicas,
+				"readyReplicas", state.CurrentReplicas+state.ExcessReadyReplicas)
+		}
+	}
+	return excess
+}
@@ -0,0 +1,111 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestApplyExcessReadyPolicy(t *testing.T) {
+	ctx := context.Background()
+	analyzer := NewAnalyzer()
+
+	// A rollback left the cheap variant with 3 ready replicas while its spec says 2; the model
+	// reports 3 replicas of metrics for it and needs more capacity
+	analysis := func() *interfaces.ModelSaturationAnalysis {
+		return &interfaces.ModelSaturationAnalysis{
+			ModelID:           "test-model",
+			Namespace:         "test-ns",
+			ShouldScaleUp:     true,
+			ScaleUpReasonCode: interfaces.ReasonCodeKvSpareLow,
+			VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+				{VariantName: "cheap", Cost: 5, ReplicaCount: 3},
+				{VariantName: "expensive", Cost: 20, ReplicaCount: 1},
+			},
+		}
+	}
+	states := func() []interfaces.VariantReplicaState {
+		return []interfaces.VariantReplicaState{
+			{VariantName: "cheap", CurrentReplicas: 2, ExcessReadyReplicas: 1},
+			{VariantName: "expensive", CurrentReplicas: 1},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		policy      interfaces.ExcessReadyPolicy
+		wantCurrent int
+		wantHold    bool
+		wantTargets map[string]int
+	}{
+		{
+			name:        "clamp keeps spec replicas and the metrics mismatch blocks scaling",
+			policy:      interfaces.ExcessReadyPolicyClamp,
+			wantCurrent: 2,
+			wantTargets: map[string]int{"cheap": 2, "expensive": 1},
+		},
+		{
+			name:        "use-ready takes ready replicas as current and scales up",
+			policy:      interfaces.ExcessReadyPolicyUseReady,
+			wantCurrent: 3,
+			wantTargets: map[string]int{"cheap": 4, "expensive": 1},
+		},
+		{
+			name:        "hold keeps current targets for the cycle",
+			policy:      interfaces.ExcessReadyPolicyHold,
+			wantCurrent: 2,
+			wantHold:    true,
+			wantTargets: map[string]int{"cheap": 2, "expensive": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variantStates := states()
+			excess := ApplyExcessReadyPolicy(ctx, variantStates, tt.policy)
+			if len(excess) != 1 || excess[0] != "cheap" {
+				t.Fatalf("expected cheap to have excess ready replicas, got %v", excess)
+			}
+			if variantStates[0].CurrentReplicas != tt.wantCurrent || variantStates[0].HoldForExcessReady != tt.wantHold {
+				t.Fatalf("expected current %d and hold %v, got %+v", tt.wantCurrent, tt.wantHold, variantStates[0])
+			}
+			if variantStates[1].CurrentReplicas != 1 || variantStates[1].HoldForExcessReady {
+				t.Fatalf("expected the other variant to be unchanged, got %+v", variantStates[1])
+			}
+
+			targets := analyzer.CalculateSaturationTargets(ctx, analysis(), variantStates)
+			for name, want := range tt.wantTargets {
+				if targets[name] != want {
+					t.Fatalf("expected targets %v, got %v", tt.wantTargets, targets)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateSaturationTargets_HoldForExcessReadyWithMatchingMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	// Metrics match the spec replicas, so only the hold keeps the model from scaling up
+	analysis := &interfaces.ModelSaturationAnalysis{
+		ModelID:           "test-model",
+		ShouldScaleUp:     true,
+		ScaleUpReasonCode: interfaces.ReasonCodeKvSpareLow,
+		VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+			{VariantName: "cheap", Cost: 5, ReplicaCount: 2},
+		},
+	}
+	states := []interfaces.VariantReplicaState{
+		{VariantName: "cheap", CurrentReplicas: 2, ExcessReadyReplicas: 1},
+	}
+	ApplyExcessReadyPolicy(ctx, states, interfaces.ExcessReadyPolicyHold)
+
+	targets := NewAnalyzer().CalculateSaturationTargets(ctx, analysis, states)
+	if targets["cheap"] != 2 {
+		t.Fatalf("expected the hold to keep the target at 2, got %d", targets["cheap"])
+	}
+	if analysis.TargetReasonCodes["cheap"] != interfaces.ReasonCodePreserved {
+		t.Fatalf("expected reason %s, got %s", interfaces.ReasonCodePreserved, analysis.TargetReasonCodes["cheap"])
+	}
+}
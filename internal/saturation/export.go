@@ -0,0 +1,49 @@
+package saturation
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// AnalysisExportLevel controls how much of a model's saturation analysis is written to the
+// saturation analysis annotation of one of its VariantAutoscalings.
+type AnalysisExportLevel string
+
+const (
+	// AnalysisExportOff disables the export
+	AnalysisExportOff AnalysisExportLevel = ""
+	// AnalysisExportSummary exports the model-level aggregates and decisions without the
+	// per-variant breakdown
+	AnalysisExportSummary AnalysisExportLevel = "summary"
+	// AnalysisExportFull exports the complete analysis, including each variant's spare
+	// capacity and saturated replicas
+	AnalysisExportFull AnalysisExportLevel = "full"
+)
+
+// ParseAnalysisExportLevel parses an export level case-insensitively.
+// Unrecognized values disable the export.
+func ParseAnalysisExportLevel(value string) AnalysisExportLevel {
+	switch level := AnalysisExportLevel(strings.ToLower(strings.TrimSpace(value))); level {
+	case AnalysisExportSummary, AnalysisExportFull:
+		return level
+	default:
+		return AnalysisExportOff
+	}
+}
+
+// ExportAnalysis serializes analysis as JSON at the given level.
+// Returns nil if the export is disabled.
+func ExportAnalysis(analysis *interfaces.ModelSaturationAnalysis, level AnalysisExportLevel) ([]byte, error) {
+	switch level {
+	case AnalysisExportFull:
+		return json.Marshal(analysis)
+	case AnalysisExportSummary:
+		summary := *analysis
+		summary.VariantAnalyses = nil
+		return json.Marshal(&summary)
+	default:
+		return nil, nil
+	}
+}
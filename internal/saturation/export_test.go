@@ -0,0 +1,101 @@
+package saturation
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestParseAnalysisExportLevel(t *testing.T) {
+	tests := map[string]AnalysisExportLevel{
+		"":        AnalysisExportOff,
+		"off":     AnalysisExportOff,
+		"summary": AnalysisExportSummary,
+		" Full ":  AnalysisExportFull,
+		"verbose": AnalysisExportOff,
+	}
+	for value, expected := range tests {
+		if got := ParseAnalysisExportLevel(value); got != expected {
+			t.Errorf("ParseAnalysisExportLevel(%q) = %q, want %q", value, got, expected)
+		}
+	}
+}
+
+func TestExportAnalysis_MatchesComputedAnalysis(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "v1-pod-1", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.85, QueueLength: 2},
+		{PodName: "v1-pod-2", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.70, QueueLength: 1},
+		{PodName: "v2-pod-1", VariantName: "v2", AcceleratorName: "H100", Cost: 20, KvCacheUsage: 0.75, QueueLength: 4},
+	}
+
+	analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	analyzer.CalculateSaturationTargets(context.Background(), analysis, []interfaces.VariantReplicaState{
+		{VariantName: "v1", CurrentReplicas: 2},
+		{VariantName: "v2", CurrentReplicas: 1},
+	})
+
+	decode := func(t *testing.T, level AnalysisExportLevel) interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		data, err := ExportAnalysis(analysis, level)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var decoded interfaces.ModelSaturationAnalysis
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("exported analysis is not valid JSON: %v", err)
+		}
+		// JSON drops the monotonic clock reading, so compare the timestamp separately
+		if !decoded.AnalyzedAt.Equal(analysis.AnalyzedAt) {
+			t.Errorf("AnalyzedAt = %v, want %v", decoded.AnalyzedAt, analysis.AnalyzedAt)
+		}
+		decoded.AnalyzedAt = analysis.AnalyzedAt
+		return decoded
+	}
+
+	t.Run("full export round-trips the analysis", func(t *testing.T) {
+		decoded := decode(t, AnalysisExportFull)
+		if !reflect.DeepEqual(decoded, *analysis) {
+			t.Errorf("exported analysis differs from computed one:\n got: %+v\nwant: %+v", decoded, *analysis)
+		}
+		for _, va := range decoded.VariantAnalyses {
+			if va.VariantName == "v1" && !reflect.DeepEqual(va.SaturatedReplicas, []string{"v1-pod-1"}) {
+				t.Errorf("expected v1-pod-1 to be reported saturated, got %v", va.SaturatedReplicas)
+			}
+		}
+	})
+
+	t.Run("summary export omits the variant breakdown", func(t *testing.T) {
+		decoded := decode(t, AnalysisExportSummary)
+		if decoded.VariantAnalyses != nil {
+			t.Errorf("expected no variant analyses, got %+v", decoded.VariantAnalyses)
+		}
+		expected := *analysis
+		expected.VariantAnalyses = nil
+		if !reflect.DeepEqual(decoded, expected) {
+			t.Errorf("exported summary differs from computed one:\n got: %+v\nwant: %+v", decoded, expected)
+		}
+		if len(analysis.VariantAnalyses) != 2 {
+			t.Errorf("summary export must not modify the analysis")
+		}
+	})
+
+	t.Run("disabled export returns nothing", func(t *testing.T) {
+		data, err := ExportAnalysis(analysis, AnalysisExportOff)
+		if err != nil || data != nil {
+			t.Errorf("expected no data and no error, got %q, %v", data, err)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package saturation
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// DefaultFlapThreshold is the number of direction changes within flapWindow at which a variant
+// is reported as flapping when flapThreshold is not set.
+const DefaultFlapThreshold = 4
+
+// flapHistory is the recent scaling history of a variant
+type flapHistory struct {
+	desired   int
+	direction int // +1 after a scale-up, -1 after a scale-down, 0 before the first change
+	flips     []time.Time
+}
+
+// FlapDetector tracks, per variant, how often its desired replicas reversed direction, e.g. a
+// scale-up followed by a scale-down. Repeated reversals within a short window are a symptom of
+// scale-up and scale-down triggers set too close together. It is safe for concurrent use.
+type FlapDetector struct {
+	mu       sync.Mutex
+	clock    clock.PassiveClock
+	variants map[string]*flapHistory
+}
+
+// NewFlapDetector creates a detector using the given clock.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+func NewFlapDetector(clk clock.PassiveClock) *FlapDetector {
+	return &FlapDetector{
+		clock:    clk,
+		variants: make(map[string]*flapHistory),
+	}
+}
+
+// Observe records the desired replicas published for the variant identified by key and returns
+// the number of direction changes within the last window, including one made by this cycle.
+// Cycles that leave the desired replicas unchanged do not reset the direction.
+func (d *FlapDetector) Observe(key string, desired int, window time.Duration) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.clock.Now()
+	history, ok := d.variants[key]
+	if !ok {
+		d.variants[key] = &flapHistory{desired: desired}
+		return 0
+	}
+
+	if desired != history.desired {
+		direction := 1
+		if desired < history.desired {
+			direction = -1
+		}
+		if history.direction != 0 && direction != history.direction {
+			history.flips = append(history.flips, now)
+		}
+		history.direction = direction
+		history.desired = desired
+	}
+
+	// Drop direction changes that have left the window
+	kept := history.flips[:0]
+	for _, flip := range history.flips {
+		if now.Sub(flip) < window {
+			kept = append(kept, flip)
+		}
+	}
+	history.flips = kept
+	return len(history.flips)
+}
+
+// Forget drops the history of the variant identified by key, e.g. once its VariantAutoscaling
+// is deleted.
+func (d *FlapDetector) Forget(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.variants, key)
+}
+
+// Retain drops the history of every variant whose key is not in keys, e.g. variants whose
+// VariantAutoscaling no longer exists.
+func (d *FlapDetector) Retain(keys map[string]bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for key := range d.variants {
+		if !keys[key] {
+			delete(d.variants, key)
+		}
+	}
+}
+
+// Flapping reports whether directionChanges reached threshold, or DefaultFlapThreshold when
+// threshold is 0.
+func Flapping(directionChanges, threshold int) bool {
+	if threshold <= 0 {
+		threshold = DefaultFlapThreshold
+	}
+	return directionChanges >= threshold
+}
@@ -0,0 +1,82 @@
+package saturation
+
+import (
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestFlapDetector_OscillatingSeries(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	detector := NewFlapDetector(fakeClock)
+	window := 10 * time.Minute
+	const threshold = 3
+
+	// Up, down, up, down, ... one cycle per minute
+	series := []int{2, 3, 2, 3, 2, 3}
+	expectedChanges := []int{0, 0, 1, 2, 3, 4}
+	for i, desired := range series {
+		changes := detector.Observe("ns/llama", desired, window)
+		if changes != expectedChanges[i] {
+			t.Fatalf("cycle %d: expected %d direction changes, got %d", i, expectedChanges[i], changes)
+		}
+		// The third flip, on the fifth cycle, reaches the threshold
+		if flapping := Flapping(changes, threshold); flapping != (i >= 4) {
+			t.Fatalf("cycle %d: expected flapping=%v, got %v", i, i >= 4, flapping)
+		}
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	}
+	// Steady replicas let the flips age out of the window
+	fakeClock.SetTime(fakeClock.Now().Add(window))
+	if changes := detector.Observe("ns/llama", 3, window); Flapping(changes, threshold) {
+		t.Errorf("expected flapping to clear once the flips left the window, got %d changes", changes)
+	}
+}
+
+func TestFlapDetector_MonotonicScalingIsNotFlapping(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	detector := NewFlapDetector(fakeClock)
+
+	// A steady ramp up and down, with unchanged cycles in between, reverses direction only once
+	for _, desired := range []int{1, 2, 2, 3, 4, 4, 3, 2, 2, 1} {
+		if changes := detector.Observe("ns/llama", desired, time.Hour); changes > 1 {
+			t.Fatalf("expected at most 1 direction change for a ramp, got %d", changes)
+		}
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	}
+	if changes := detector.Observe("ns/other", 5, time.Hour); changes != 0 {
+		t.Errorf("expected variants tracked independently, got %d changes", changes)
+	}
+}
+
+func TestFlapDetector_RetainAndForget(t *testing.T) {
+	detector := NewFlapDetector(clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	for _, key := range []string{"ns/a", "ns/b", "ns/c"} {
+		for _, desired := range []int{2, 3, 2} {
+			detector.Observe(key, desired, time.Hour)
+		}
+	}
+
+	detector.Retain(map[string]bool{"ns/a": true, "ns/b": true})
+	detector.Forget("ns/b")
+
+	// Forgotten variants start a new history: their first observation reports no change
+	if changes := detector.Observe("ns/a", 3, time.Hour); changes != 2 {
+		t.Errorf("expected ns/a history to be kept, got %d changes", changes)
+	}
+	for _, key := range []string{"ns/b", "ns/c"} {
+		if changes := detector.Observe(key, 3, time.Hour); changes != 0 {
+			t.Errorf("expected %s history to be dropped, got %d changes", key, changes)
+		}
+	}
+}
+
+func TestFlapping_DefaultThreshold(t *testing.T) {
+	if Flapping(DefaultFlapThreshold-1, 0) {
+		t.Errorf("expected %d changes below the default threshold", DefaultFlapThreshold-1)
+	}
+	if !Flapping(DefaultFlapThreshold, 0) {
+		t.Errorf("expected %d changes to reach the default threshold", DefaultFlapThreshold)
+	}
+}
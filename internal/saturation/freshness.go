@@ -0,0 +1,19 @@
+package saturation
+
+import (
+	"math"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// FreshnessWeight returns the weight of a replica's metrics in the model-level spare capacity
+// averages, given their age: 1 for fresh metrics, halving with every halfLife of age, so slightly
+// stale replicas still count but fresher ones dominate. Metrics without an age, and any metrics
+// when halfLife is 0, weigh 1.
+func FreshnessWeight(metric interfaces.ReplicaMetrics, halfLife time.Duration) float64 {
+	if halfLife <= 0 || metric.Metadata == nil || metric.Metadata.Age <= 0 {
+		return 1
+	}
+	return math.Exp2(-float64(metric.Metadata.Age) / float64(halfLife))
+}
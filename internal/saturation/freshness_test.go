@@ -0,0 +1,88 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestFreshnessWeight(t *testing.T) {
+	aged := func(age time.Duration) interfaces.ReplicaMetrics {
+		return interfaces.ReplicaMetrics{Metadata: &interfaces.ReplicaMetricsMetadata{Age: age}}
+	}
+	tests := []struct {
+		name     string
+		metric   interfaces.ReplicaMetrics
+		halfLife time.Duration
+		expected float64
+	}{
+		{name: "fresh", metric: aged(0), halfLife: 30 * time.Second, expected: 1},
+		{name: "one half-life", metric: aged(30 * time.Second), halfLife: 30 * time.Second, expected: 0.5},
+		{name: "two half-lives", metric: aged(time.Minute), halfLife: 30 * time.Second, expected: 0.25},
+		{name: "no metadata", metric: interfaces.ReplicaMetrics{}, halfLife: 30 * time.Second, expected: 1},
+		{name: "disabled", metric: aged(time.Hour), halfLife: 0, expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FreshnessWeight(tt.metric, tt.halfLife); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("FreshnessWeight = %.4f, want %.4f", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_FresherReplicasDominate(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	metadata := func(age time.Duration) *interfaces.ReplicaMetricsMetadata {
+		return &interfaces.ReplicaMetricsMetadata{Age: age, FreshnessStatus: "fresh"}
+	}
+	// Two replicas report busy right now; two report idle, but their samples are a minute old
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.75, Metadata: metadata(0)},
+		{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.75, Metadata: metadata(0)},
+		{PodName: "pod-3", VariantName: "v1", Cost: 10, KvCacheUsage: 0.20, Metadata: metadata(time.Minute)},
+		{PodName: "pod-4", VariantName: "v1", Cost: 10, KvCacheUsage: 0.20, Metadata: metadata(time.Minute)},
+	}
+	analyze := func(config interfaces.SaturationScalingConfig) *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	// Unweighted, the stale idle replicas average out the busy ones: (0.05+0.05+0.6+0.6)/4
+	unweighted := analyze(config)
+	if math.Abs(unweighted.AvgSpareKvCapacity-0.325) > 1e-9 || unweighted.ShouldScaleUp {
+		t.Fatalf("expected unweighted spare KV 0.325 without scale-up, got %.3f (scale-up %v)",
+			unweighted.AvgSpareKvCapacity, unweighted.ShouldScaleUp)
+	}
+
+	// With a 15s half-life the minute-old replicas weigh 1/16 each
+	config.MetricsFreshnessHalfLife = 15 * time.Second
+	weighted := analyze(config)
+	expected := (0.05*2 + 0.6*2/16) / (2 + 2.0/16)
+	if math.Abs(weighted.AvgSpareKvCapacity-expected) > 1e-9 {
+		t.Errorf("expected weighted spare KV %.4f, got %.4f", expected, weighted.AvgSpareKvCapacity)
+	}
+	if !weighted.ShouldScaleUp || weighted.ScaleUpReasonCode != interfaces.ReasonCodeKvSpareLow {
+		t.Errorf("expected the fresh busy replicas to trigger scale-up, got %v (%s)",
+			weighted.ShouldScaleUp, weighted.ScaleUpReasonCode)
+	}
+	if weighted.NonSaturatedCount != 4 {
+		t.Errorf("expected weighting not to change the non-saturated count, got %d", weighted.NonSaturatedCount)
+	}
+	if age := weighted.VariantAnalyses[0].AvgMetricAge; age != 30*time.Second {
+		t.Errorf("expected mean metric age 30s, got %s", age)
+	}
+}
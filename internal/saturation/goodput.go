@@ -0,0 +1,87 @@
+package saturation
+
+import (
+	"fmt"
+	"sync"
+)
+
+// GoodputSample is the aggregate throughput and queue depth of a model at one analysis cycle.
+type GoodputSample struct {
+	Replicas        int     // Replicas that reported metrics
+	OutputTokenRate float64 // Output tokens/sec summed across replicas
+	QueueLength     float64 // Waiting requests summed across replicas
+}
+
+// GoodputTracker keeps the last GoodputWindowSamples samples per model so that goodput
+// trends can be evaluated across analysis cycles. It is safe for concurrent use.
+type GoodputTracker struct {
+	mu      sync.Mutex
+	history map[string][]GoodputSample
+}
+
+// NewGoodputTracker creates an empty goodput tracker.
+func NewGoodputTracker() *GoodputTracker {
+	return &GoodputTracker{
+		history: make(map[string][]GoodputSample),
+	}
+}
+
+// Observe records a sample for the given model key and returns the current window,
+// oldest first. History is reset when the replica count changes, since throughput
+// from a different number of replicas is not comparable.
+func (t *GoodputTracker) Observe(key string, sample GoodputSample) []GoodputSample {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	window := t.history[key]
+	if len(window) > 0 && window[len(window)-1].Replicas != sample.Replicas {
+		window = nil
+	}
+	window = append(window, sample)
+	if len(window) > GoodputWindowSamples {
+		window = window[len(window)-GoodputWindowSamples:]
+	}
+	t.history[key] = window
+
+	return append([]GoodputSample(nil), window...)
+}
+
+// Retain drops the history of every model whose key is not in keys, e.g. models left without
+// a VariantAutoscaling.
+func (t *GoodputTracker) Retain(keys map[string]bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key := range t.history {
+		if !keys[key] {
+			delete(t.history, key)
+		}
+	}
+}
+
+// DetectGoodputPlateau reports whether goodput has stopped growing while the queue keeps growing,
+// which means the replicas are saturated and throughput is the limit rather than demand.
+//
+// A plateau requires a full window of samples in which the queue never shrinks and ends
+// longer than it started, while goodput grew by less than threshold (relative to the first sample).
+func DetectGoodputPlateau(window []GoodputSample, threshold float64) (bool, string) {
+	if threshold <= 0 || len(window) < GoodputWindowSamples {
+		return false, ""
+	}
+
+	first, last := window[0], window[len(window)-1]
+	if first.OutputTokenRate <= 0 || last.QueueLength <= first.QueueLength {
+		return false, ""
+	}
+	for i := 1; i < len(window); i++ {
+		if window[i].QueueLength < window[i-1].QueueLength {
+			return false, ""
+		}
+	}
+
+	growth := (last.OutputTokenRate - first.OutputTokenRate) / first.OutputTokenRate
+	if growth >= threshold {
+		return false, ""
+	}
+	return true, fmt.Sprintf("goodput plateau (%.1f -> %.1f tokens/s, growth %.3f < %.3f) while queue grew (%.1f -> %.1f)",
+		first.OutputTokenRate, last.OutputTokenRate, growth, threshold, first.QueueLength, last.QueueLength)
+}
@@ -0,0 +1,209 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestDetectGoodputPlateau(t *testing.T) {
+	tests := []struct {
+		name          string
+		window        []GoodputSample
+		threshold     float64
+		expectPlateau bool
+	}{
+		{
+			name: "flat goodput with growing queue",
+			window: []GoodputSample{
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 2},
+				{Replicas: 2, OutputTokenRate: 1010, QueueLength: 4},
+				{Replicas: 2, OutputTokenRate: 1020, QueueLength: 7},
+			},
+			threshold:     0.05,
+			expectPlateau: true,
+		},
+		{
+			name: "growing goodput with growing queue",
+			window: []GoodputSample{
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 2},
+				{Replicas: 2, OutputTokenRate: 1200, QueueLength: 4},
+				{Replicas: 2, OutputTokenRate: 1400, QueueLength: 7},
+			},
+			threshold:     0.05,
+			expectPlateau: false,
+		},
+		{
+			name: "flat goodput with flat queue",
+			window: []GoodputSample{
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 3},
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 3},
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 3},
+			},
+			threshold:     0.05,
+			expectPlateau: false,
+		},
+		{
+			name: "queue dipped within the window",
+			window: []GoodputSample{
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 2},
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 1},
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 5},
+			},
+			threshold:     0.05,
+			expectPlateau: false,
+		},
+		{
+			name: "window not yet full",
+			window: []GoodputSample{
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 2},
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 6},
+			},
+			threshold:     0.05,
+			expectPlateau: false,
+		},
+		{
+			name: "disabled with zero threshold",
+			window: []GoodputSample{
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 2},
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 4},
+				{Replicas: 2, OutputTokenRate: 1000, QueueLength: 7},
+			},
+			threshold:     0,
+			expectPlateau: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plateau, reason := DetectGoodputPlateau(tt.window, tt.threshold)
+			if plateau != tt.expectPlateau {
+				t.Errorf("expected plateau=%v, got %v (reason %q)", tt.expectPlateau, plateau, reason)
+			}
+			if plateau && reason == "" {
+				t.Error("expected a reason when a plateau is detected")
+			}
+		})
+	}
+}
+
+func TestGoodputTracker_ResetsOnReplicaChange(t *testing.T) {
+	tracker := NewGoodputTracker()
+
+	tracker.Observe("ns/model", GoodputSample{Replicas: 2, OutputTokenRate: 1000, QueueLength: 2})
+	tracker.Observe("ns/model", GoodputSample{Replicas: 2, OutputTokenRate: 1000, QueueLength: 4})
+	window := tracker.Observe("ns/model", GoodputSample{Replicas: 3, OutputTokenRate: 1500, QueueLength: 1})
+
+	if len(window) != 1 {
+		t.Fatalf("expected history reset after replica change, got %d samples", len(window))
+	}
+
+	// Other models are tracked independently
+	window = tracker.Observe("ns/other", GoodputSample{Replicas: 2, OutputTokenRate: 500, QueueLength: 0})
+	if len(window) != 1 {
+		t.Errorf("expected 1 sample for other model, got %d", len(window))
+	}
+}
+
+func TestGoodputTracker_Retain(t *testing.T) {
+	tracker := NewGoodputTracker()
+	tracker.Observe("ns/model", GoodputSample{Replicas: 2, OutputTokenRate: 1000})
+	tracker.Observe("ns/other", GoodputSample{Replicas: 2, OutputTokenRate: 1000})
+
+	tracker.Retain(map[string]bool{"ns/model": true})
+
+	if window := tracker.Observe("ns/model", GoodputSample{Replicas: 2, OutputTokenRate: 1000}); len(window) != 2 {
+		t.Errorf("expected ns/model history to be kept, got %d samples", len(window))
+	}
+	if window := tracker.Observe("ns/other", GoodputSample{Replicas: 2, OutputTokenRate: 1000}); len(window) != 1 {
+		t.Errorf("expected ns/other history to be dropped, got %d samples", len(window))
+	}
+}
+
+func TestAnalyzeModelSaturation_GoodputPlateauScaleUp(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:        0.80,
+		QueueLengthThreshold:    10,
+		KvSpareTrigger:          0.10,
+		QueueSpareTrigger:       3,
+		GoodputPlateauThreshold: 0.05,
+	}
+
+	// replicas returns two replicas with moderate KV usage, so the spare capacity
+	// triggers do not fire, and the given per-replica goodput and queue length.
+	replicas := func(tokenRate float64, queueLength float64) []interfaces.ReplicaMetrics {
+		return []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: queueLength, OutputTokenRate: tokenRate},
+			{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: queueLength, OutputTokenRate: tokenRate},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		cycles           [][]interfaces.ReplicaMetrics
+		expectScaleUp    bool
+		expectReasonCode interfaces.ReasonCode
+	}{
+		{
+			name: "plateauing goodput with rising queue scales up",
+			cycles: [][]interfaces.ReplicaMetrics{
+				replicas(500, 1),
+				replicas(505, 2),
+				replicas(510, 4),
+			},
+			expectScaleUp:    true,
+			expectReasonCode: interfaces.ReasonCodeGoodputPlateau,
+		},
+		{
+			name: "rising goodput with rising queue does not scale up",
+			cycles: [][]interfaces.ReplicaMetrics{
+				replicas(500, 1),
+				replicas(600, 2),
+				replicas(700, 4),
+			},
+			expectScaleUp: false,
+		},
+		{
+			name: "plateau needs a full window",
+			cycles: [][]interfaces.ReplicaMetrics{
+				replicas(500, 1),
+				replicas(500, 4),
+			},
+			expectScaleUp: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer := NewAnalyzerWithGoodputTracker(NewGoodputTracker())
+
+			var analysis *interfaces.ModelSaturationAnalysis
+			for _, cycle := range tt.cycles {
+				var err error
+				analysis, err = analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", cycle, config)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if analysis.ShouldScaleUp != tt.expectScaleUp {
+				t.Errorf("expected ShouldScaleUp=%v, got %v (reason %q)", tt.expectScaleUp, analysis.ShouldScaleUp, analysis.ScaleUpReason)
+			}
+			if analysis.ScaleUpReasonCode != tt.expectReasonCode {
+				t.Errorf("expected reason code %q, got %q", tt.expectReasonCode, analysis.ScaleUpReasonCode)
+			}
+		})
+	}
+
+	t.Run("no tracker disables the trigger", func(t *testing.T) {
+		analyzer := NewAnalyzer()
+		var analysis *interfaces.ModelSaturationAnalysis
+		for _, cycle := range [][]interfaces.ReplicaMetrics{replicas(500, 1), replicas(505, 2), replicas(510, 4)} {
+			analysis, _ = analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", cycle, config)
+		}
+		if analysis.ShouldScaleUp {
+			t.Errorf("expected no scale-up without a goodput tracker, got reason %q", analysis.ScaleUpReason)
+		}
+	})
+}
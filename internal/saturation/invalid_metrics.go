@@ -0,0 +1,69 @@
+package saturation
+
+import (
+	"math"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// SanitizeReplicaMetrics keeps NaN and Inf values out of the model-level averages. A replica whose
+// KV cache usage or queue length is not finite is dropped, or counted as saturated with
+// InvalidMetricPolicySaturated: its KV cache usage is set to 1, at or above any valid threshold,
+// and a non-finite queue length to 0. Non-finite optional rates (goodput, error rate, arrival rate
+// and the like) are set to 0, which reads as unavailable.
+//
+// Returns the sanitized metrics, sharing no memory with the input when any value was replaced, and
+// the names of the replicas with a non-finite KV cache usage or queue length.
+func SanitizeReplicaMetrics(
+	replicaMetrics []interfaces.ReplicaMetrics,
+	policy interfaces.InvalidMetricPolicy,
+) ([]interfaces.ReplicaMetrics, []string) {
+	var sanitized []interfaces.ReplicaMetrics
+	var invalidPods []string
+	for i, metric := range replicaMetrics {
+		invalid := !finite(metric.KvCacheUsage) || !finite(metric.QueueLength)
+		optional := []*float64{
+			&metric.OutputTokenRate, &metric.SpecDecodeAcceptanceRate, &metric.ErrorRate,
+			&metric.RejectedRequestRate, &metric.TokensInFlight,
+			&metric.ArrivalRate, &metric.AvgInputTokens, &metric.AvgOutputTokens,
+		}
+		changed := invalid
+		if !finite(metric.SpecDecodeAcceptanceRate) {
+			metric.HasSpecDecodeAcceptanceRate = false
+		}
+		for _, value := range optional {
+			if !finite(*value) {
+				*value = 0
+				changed = true
+			}
+		}
+		if changed && sanitized == nil {
+			sanitized = make([]interfaces.ReplicaMetrics, i, len(replicaMetrics))
+			copy(sanitized, replicaMetrics[:i])
+		}
+
+		if invalid {
+			invalidPods = append(invalidPods, metric.PodName)
+			if policy == interfaces.InvalidMetricPolicySaturated {
+				metric.KvCacheUsage = 1
+				if !finite(metric.QueueLength) {
+					metric.QueueLength = 0
+				}
+			} else {
+				continue
+			}
+		}
+		if sanitized != nil {
+			sanitized = append(sanitized, metric)
+		}
+	}
+	if sanitized == nil {
+		return replicaMetrics, invalidPods
+	}
+	return sanitized, invalidPods
+}
+
+// finite reports whether v is neither NaN nor Inf.
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
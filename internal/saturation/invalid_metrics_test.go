@@ -0,0 +1,107 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestSanitizeReplicaMetrics(t *testing.T) {
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", KvCacheUsage: 0.40, QueueLength: 1},
+		{PodName: "pod-2", KvCacheUsage: math.NaN(), QueueLength: 1},
+		{PodName: "pod-3", KvCacheUsage: 0.50, QueueLength: math.Inf(1)},
+		{PodName: "pod-4", KvCacheUsage: 0.30, QueueLength: 2, ErrorRate: math.NaN(), ArrivalRate: math.Inf(1)},
+	}
+
+	dropped, invalidPods := SanitizeReplicaMetrics(replicaMetrics, "")
+	if len(invalidPods) != 2 || invalidPods[0] != "pod-2" || invalidPods[1] != "pod-3" {
+		t.Errorf("invalidPods = %v, want [pod-2 pod-3]", invalidPods)
+	}
+	if len(dropped) != 2 || dropped[0].PodName != "pod-1" || dropped[1].PodName != "pod-4" {
+		t.Fatalf("expected pod-1 and pod-4 to remain, got %+v", dropped)
+	}
+	if dropped[1].ErrorRate != 0 || dropped[1].ArrivalRate != 0 {
+		t.Errorf("expected non-finite optional rates to be zeroed, got error rate %v and arrival rate %v",
+			dropped[1].ErrorRate, dropped[1].ArrivalRate)
+	}
+	if !math.IsNaN(replicaMetrics[1].KvCacheUsage) || !math.IsNaN(replicaMetrics[3].ErrorRate) {
+		t.Error("expected the input metrics to be left unchanged")
+	}
+
+	saturated, _ := SanitizeReplicaMetrics(replicaMetrics, interfaces.InvalidMetricPolicySaturated)
+	if len(saturated) != 4 {
+		t.Fatalf("expected all replicas to remain with the saturated policy, got %d", len(saturated))
+	}
+	if saturated[1].KvCacheUsage != 1 || saturated[1].QueueLength != 1 {
+		t.Errorf("pod-2 = (%v, %v), want KV cache usage 1 and its queue length kept",
+			saturated[1].KvCacheUsage, saturated[1].QueueLength)
+	}
+	if saturated[2].KvCacheUsage != 1 || saturated[2].QueueLength != 0 {
+		t.Errorf("pod-3 = (%v, %v), want KV cache usage 1 and queue length 0",
+			saturated[2].KvCacheUsage, saturated[2].QueueLength)
+	}
+
+	valid := replicaMetrics[:1]
+	if got, invalid := SanitizeReplicaMetrics(valid, ""); &got[0] != &valid[0] || invalid != nil {
+		t.Error("expected finite metrics to be returned as is")
+	}
+}
+
+func TestAnalyzeModelSaturation_NaNReplicaExcluded(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.20, QueueLength: 0},
+		{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.30, QueueLength: 1},
+		{PodName: "pod-3", VariantName: "v1", Cost: 10, KvCacheUsage: math.NaN(), QueueLength: 0},
+	}
+
+	analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.IsNaN(analysis.AvgSpareKvCapacity) || math.Abs(analysis.AvgSpareKvCapacity-0.55) > 1e-9 {
+		t.Errorf("AvgSpareKvCapacity = %v, want 0.55 from the two valid replicas", analysis.AvgSpareKvCapacity)
+	}
+	if analysis.TotalReplicas != 2 || analysis.NonSaturatedCount != 2 {
+		t.Errorf("expected 2 replicas analyzed, got total %d, non-saturated %d",
+			analysis.TotalReplicas, analysis.NonSaturatedCount)
+	}
+	if len(analysis.InvalidMetricPods) != 1 || analysis.InvalidMetricPods[0] != "pod-3" {
+		t.Errorf("InvalidMetricPods = %v, want [pod-3]", analysis.InvalidMetricPods)
+	}
+	if !analysis.ScaleDownSafe {
+		t.Error("expected scale-down to be judged on the valid replicas")
+	}
+
+	config.InvalidMetricPolicy = interfaces.InvalidMetricPolicySaturated
+	analysis, err = analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if analysis.TotalReplicas != 3 || analysis.NonSaturatedCount != 2 {
+		t.Errorf("expected the NaN replica to count as saturated, got total %d, non-saturated %d",
+			analysis.TotalReplicas, analysis.NonSaturatedCount)
+	}
+	if math.Abs(analysis.AvgSpareKvCapacity-0.55) > 1e-9 {
+		t.Errorf("AvgSpareKvCapacity = %v, want 0.55", analysis.AvgSpareKvCapacity)
+	}
+
+	// A model whose only replica reports NaN is analyzed as having no replicas
+	config.InvalidMetricPolicy = ""
+	analysis, err = analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics[2:], config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if analysis.TotalReplicas != 0 || analysis.ShouldScaleUp || analysis.ScaleDownSafe {
+		t.Errorf("expected an empty analysis, got %+v", analysis)
+	}
+}
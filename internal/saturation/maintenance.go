@@ -0,0 +1,28 @@
+package saturation
+
+import (
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// ActiveMaintenanceWindow returns the first of windows that contains now, or nil when scaling
+// is not frozen.
+func ActiveMaintenanceWindow(windows []interfaces.MaintenanceWindow, now time.Time) *interfaces.MaintenanceWindow {
+	for i := range windows {
+		if windows[i].Contains(now) {
+			return &windows[i]
+		}
+	}
+	return nil
+}
+
+// HoldForMaintenance returns the target to publish for a variant while window is active: its
+// desired replicas, so no scale change is made. Outside a window target is returned unchanged.
+// Returns whether the target was held.
+func HoldForMaintenance(window *interfaces.MaintenanceWindow, target, desired int) (int, bool) {
+	if window == nil {
+		return target, false
+	}
+	return desired, true
+}
@@ -0,0 +1,59 @@
+package saturation
+
+import (
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestHoldForMaintenance_FrozenWithinWindowAndResumesAfter(t *testing.T) {
+	windows := []interfaces.MaintenanceWindow{
+		{Name: "network-upgrade", Start: "2026-03-01T22:00:00Z", End: "2026-03-02T02:00:00Z"},
+	}
+	clock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 1, 21, 59, 0, 0, time.UTC))
+	const desired, computed = 3, 5
+
+	steps := []struct {
+		advance    time.Duration
+		wantHeld   bool
+		wantTarget int
+	}{
+		{advance: 0, wantHeld: false, wantTarget: computed},
+		{advance: time.Minute, wantHeld: true, wantTarget: desired},
+		{advance: 3*time.Hour + 59*time.Minute, wantHeld: true, wantTarget: desired},
+		{advance: time.Minute, wantHeld: false, wantTarget: computed},
+	}
+	for _, step := range steps {
+		clock.SetTime(clock.Now().Add(step.advance))
+		window := ActiveMaintenanceWindow(windows, clock.Now())
+		target, held := HoldForMaintenance(window, computed, desired)
+		if held != step.wantHeld || target != step.wantTarget {
+			t.Errorf("at %s: expected target %d (held=%v), got %d (held=%v)",
+				clock.Now().Format(time.RFC3339), step.wantTarget, step.wantHeld, target, held)
+		}
+		if held && window.Name != "network-upgrade" {
+			t.Errorf("at %s: expected the network-upgrade window, got %q", clock.Now().Format(time.RFC3339), window.Name)
+		}
+	}
+}
+
+func TestActiveMaintenanceWindow(t *testing.T) {
+	now := time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC)
+	windows := []interfaces.MaintenanceWindow{
+		{Name: "past", Start: "2026-02-01T22:00:00Z", End: "2026-02-02T02:00:00Z"},
+		{Name: "offset", Start: "2026-03-01T23:30:00+01:00", End: "2026-03-02T01:00:00+01:00"},
+		{Name: "later", Start: "2026-03-01T22:00:00Z", End: "2026-03-02T02:00:00Z"},
+	}
+	if window := ActiveMaintenanceWindow(windows, now); window == nil || window.Name != "offset" {
+		t.Errorf("expected the first window containing now, got %+v", window)
+	}
+	if window := ActiveMaintenanceWindow(windows[:1], now); window != nil {
+		t.Errorf("expected no active window, got %+v", window)
+	}
+	if window := ActiveMaintenanceWindow(nil, now); window != nil {
+		t.Errorf("expected no active window without windows, got %+v", window)
+	}
+}
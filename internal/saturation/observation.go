@@ -0,0 +1,64 @@
+package saturation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// ObservationTracker counts, per model, the optimization cycles whose saturation analysis
+// succeeded since the engine started, so that a model is not scaled on its first sample.
+// It is safe for concurrent use.
+type ObservationTracker struct {
+	mu     sync.Mutex
+	cycles map[string]int
+}
+
+// NewObservationTracker creates an empty tracker.
+func NewObservationTracker() *ObservationTracker {
+	return &ObservationTracker{cycles: make(map[string]int)}
+}
+
+// Observe records a cycle with metrics for the model and returns the number of cycles observed,
+// including this one.
+func (t *ObservationTracker) Observe(namespace, modelID string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := namespace + "/" + modelID
+	t.cycles[key]++
+	return t.cycles[key]
+}
+
+// HoldForObservation holds all targets at the variants' current replicas while fewer than
+// minCycles cycles of the model have been observed, so no scale decision is based on the few
+// samples collected right after startup. Held targets are tagged ReasonCodeObserving.
+//
+// Returns the targets to use and whether they were held. A minCycles of 0 disables the hold.
+func HoldForObservation(
+	ctx context.Context,
+	saturationAnalysis *interfaces.ModelSaturationAnalysis,
+	variantStates []interfaces.VariantReplicaState,
+	targets map[string]int,
+	observed, minCycles int,
+) (map[string]int, bool) {
+	if observed >= minCycles {
+		return targets, false
+	}
+
+	logging.FromContext(ctx, logging.Analyzer).Info("Observing model before its first scaling decision, holding current replicas",
+		"observedCycles", observed,
+		"minObservationCycles", minCycles)
+
+	held := make(map[string]int, len(variantStates))
+	reasonCodes := make(map[string]interfaces.ReasonCode, len(variantStates))
+	for _, state := range variantStates {
+		held[state.VariantName] = state.CurrentReplicas
+		reasonCodes[state.VariantName] = interfaces.ReasonCodeObserving
+	}
+	if saturationAnalysis != nil {
+		saturationAnalysis.TargetReasonCodes = reasonCodes
+	}
+	return held, true
+}
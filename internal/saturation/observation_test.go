@@ -0,0 +1,74 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestHoldForObservation_NoScaleChangeUntilMinCycles(t *testing.T) {
+	tracker := NewObservationTracker()
+	states := []interfaces.VariantReplicaState{
+		{VariantName: "v1", CurrentReplicas: 2, DesiredReplicas: 3},
+		{VariantName: "v2", CurrentReplicas: 1},
+	}
+	// The analysis wants to scale both variants on every cycle
+	computed := map[string]int{"v1": 4, "v2": 0}
+
+	const minCycles = 3
+	for cycle := 1; cycle <= minCycles+1; cycle++ {
+		analysis := &interfaces.ModelSaturationAnalysis{}
+		observed := tracker.Observe("llm", "llama")
+		if observed != cycle {
+			t.Fatalf("cycle %d: expected %d observed cycles, got %d", cycle, cycle, observed)
+		}
+
+		targets, held := HoldForObservation(context.Background(), analysis, states, computed, observed, minCycles)
+		if cycle < minCycles {
+			if !held {
+				t.Fatalf("cycle %d: expected targets held", cycle)
+			}
+			for _, state := range states {
+				if targets[state.VariantName] != state.CurrentReplicas {
+					t.Errorf("cycle %d: expected %s held at current %d, got %d",
+						cycle, state.VariantName, state.CurrentReplicas, targets[state.VariantName])
+				}
+				if analysis.TargetReasonCodes[state.VariantName] != interfaces.ReasonCodeObserving {
+					t.Errorf("cycle %d: expected reason %s for %s, got %s", cycle,
+						interfaces.ReasonCodeObserving, state.VariantName, analysis.TargetReasonCodes[state.VariantName])
+				}
+			}
+			continue
+		}
+		if held {
+			t.Fatalf("cycle %d: expected targets released after %d cycles", cycle, minCycles)
+		}
+		if targets["v1"] != 4 || targets["v2"] != 0 {
+			t.Errorf("cycle %d: expected computed targets, got %v", cycle, targets)
+		}
+		if analysis.TargetReasonCodes != nil {
+			t.Errorf("cycle %d: expected reason codes untouched, got %v", cycle, analysis.TargetReasonCodes)
+		}
+	}
+}
+
+func TestHoldForObservation_Disabled(t *testing.T) {
+	states := []interfaces.VariantReplicaState{{VariantName: "v1", CurrentReplicas: 2}}
+	targets, held := HoldForObservation(context.Background(), nil, states, map[string]int{"v1": 5}, 1, 0)
+	if held || targets["v1"] != 5 {
+		t.Errorf("expected targets unchanged with the warm-up disabled, got %v held=%v", targets, held)
+	}
+}
+
+func TestObservationTracker_CountsModelsIndependently(t *testing.T) {
+	tracker := NewObservationTracker()
+	tracker.Observe("llm", "llama")
+	tracker.Observe("llm", "llama")
+	if got := tracker.Observe("llm", "mistral"); got != 1 {
+		t.Errorf("expected 1 cycle for another model, got %d", got)
+	}
+	if got := tracker.Observe("other", "llama"); got != 1 {
+		t.Errorf("expected 1 cycle for the model in another namespace, got %d", got)
+	}
+}
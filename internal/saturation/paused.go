@@ -0,0 +1,12 @@
+package saturation
+
+// HoldForPausedDeployment returns the target to publish for a variant whose deployment rollout
+// is paused: its desired replicas, so no scale change is recommended while operators hold the
+// rollout. For a deployment that is not paused target is returned unchanged.
+// Returns whether the target was held.
+func HoldForPausedDeployment(paused bool, target, desired int) (int, bool) {
+	if !paused {
+		return target, false
+	}
+	return desired, true
+}
@@ -0,0 +1,72 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestHoldForPausedDeployment(t *testing.T) {
+	if got, held := HoldForPausedDeployment(false, 5, 3); got != 5 || held {
+		t.Errorf("HoldForPausedDeployment(false) = (%d, %v), want (5, false)", got, held)
+	}
+	if got, held := HoldForPausedDeployment(true, 5, 3); got != 3 || !held {
+		t.Errorf("HoldForPausedDeployment(true) = (%d, %v), want (3, true)", got, held)
+	}
+}
+
+func TestCalculateSaturationTargets_SkipsPausedDeployment(t *testing.T) {
+	ctx := context.Background()
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	targetsFor := func(kvUsage float64, pausedVariant string) (map[string]int, *interfaces.ModelSaturationAnalysis) {
+		t.Helper()
+		replicaMetrics := []interfaces.ReplicaMetrics{
+			{PodName: "cheap-1", VariantName: "v-cheap", Cost: 5, KvCacheUsage: kvUsage},
+			{PodName: "cheap-2", VariantName: "v-cheap", Cost: 5, KvCacheUsage: kvUsage},
+			{PodName: "pricey-1", VariantName: "v-pricey", Cost: 20, KvCacheUsage: kvUsage},
+			{PodName: "pricey-2", VariantName: "v-pricey", Cost: 20, KvCacheUsage: kvUsage},
+		}
+		states := []interfaces.VariantReplicaState{
+			{VariantName: "v-cheap", CurrentReplicas: 2, Paused: pausedVariant == "v-cheap"},
+			{VariantName: "v-pricey", CurrentReplicas: 2, Paused: pausedVariant == "v-pricey"},
+		}
+		analysis, err := analyzer.AnalyzeModelSaturation(ctx, "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analyzer.CalculateSaturationTargets(ctx, analysis, states), analysis
+	}
+
+	// Scale-up normally goes to the cheapest variant; while it is paused the other one scales up
+	targets, analysis := targetsFor(0.75, "v-cheap")
+	if !analysis.ShouldScaleUp {
+		t.Fatal("expected scale-up")
+	}
+	if targets["v-cheap"] != 2 || targets["v-pricey"] != 3 {
+		t.Errorf("expected the paused variant to keep its replicas and the other to scale up, got %v", targets)
+	}
+	if code := analysis.TargetReasonCodes["v-cheap"]; code != interfaces.ReasonCodeDeploymentPaused {
+		t.Errorf("paused variant reason code = %s, want %s", code, interfaces.ReasonCodeDeploymentPaused)
+	}
+
+	// Scale-down normally removes from the most expensive variant; while it is paused the other one gives up a replica
+	targets, analysis = targetsFor(0.10, "v-pricey")
+	if !analysis.ScaleDownSafe {
+		t.Fatal("expected scale-down to be safe")
+	}
+	if targets["v-pricey"] != 2 || targets["v-cheap"] != 1 {
+		t.Errorf("expected the paused variant to keep its replicas and the other to scale down, got %v", targets)
+	}
+
+	// Once resumed the cheapest variant scales up again
+	if targets, _ = targetsFor(0.75, ""); targets["v-cheap"] != 3 || targets["v-pricey"] != 2 {
+		t.Errorf("expected the cheapest variant to scale up without a pause, got %v", targets)
+	}
+}
@@ -0,0 +1,73 @@
+package saturation
+
+import (
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// QueueHistory keeps the queue lengths of each replica over recent analysis cycles, so that a
+// replica's effective queue can be a percentile of its history rather than its latest sample.
+// It is safe for concurrent use.
+type QueueHistory struct {
+	mu      sync.Mutex
+	history map[string]map[string][]float64 // model key -> pod name -> queue lengths, oldest first
+}
+
+// NewQueueHistory creates an empty queue history.
+func NewQueueHistory() *QueueHistory {
+	return &QueueHistory{
+		history: make(map[string]map[string][]float64),
+	}
+}
+
+// Smooth records the queue length of each replica of the model with the given key and returns a
+// copy of replicaMetrics whose queue lengths are the given percentile (0-100) of each replica's
+// last window samples. A window of 0 uses DefaultQueuePercentileWindow. Replicas no longer
+// reported are forgotten, so a replica that comes back starts a new history.
+func (h *QueueHistory) Smooth(key string, replicaMetrics []interfaces.ReplicaMetrics, percentile float64, window int) []interfaces.ReplicaMetrics {
+	if window <= 0 {
+		window = DefaultQueuePercentileWindow
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	previous := h.history[key]
+	current := make(map[string][]float64, len(replicaMetrics))
+	smoothed := slices.Clone(replicaMetrics)
+	for i := range smoothed {
+		samples := append(previous[smoothed[i].PodName], smoothed[i].QueueLength)
+		if len(samples) > window {
+			samples = samples[len(samples)-window:]
+		}
+		current[smoothed[i].PodName] = samples
+		smoothed[i].QueueLength = Percentile(samples, percentile)
+	}
+	h.history[key] = current
+	return smoothed
+}
+
+// Retain drops the history of every model whose key is not in keys, e.g. models left without
+// a VariantAutoscaling.
+func (h *QueueHistory) Retain(keys map[string]bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key := range h.history {
+		if !keys[key] {
+			delete(h.history, key)
+		}
+	}
+}
+
+// Percentile returns the nearest-rank percentile (0-100) of values, or 0 without values.
+func Percentile(values []float64, percentile float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Sorted(slices.Values(values))
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	return sorted[min(max(rank, 1), len(sorted))-1]
+}
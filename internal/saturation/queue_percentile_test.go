@@ -0,0 +1,130 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestPercentile(t *testing.T) {
+	values := []float64{9, 1, 3, 7, 5}
+	tests := []struct {
+		percentile float64
+		expected   float64
+	}{
+		{percentile: 0, expected: 1},
+		{percentile: 20, expected: 1},
+		{percentile: 50, expected: 5},
+		{percentile: 80, expected: 7},
+		{percentile: 100, expected: 9},
+	}
+	for _, tt := range tests {
+		if got := Percentile(values, tt.percentile); got != tt.expected {
+			t.Errorf("Percentile(%v, %.0f) = %.1f, want %.1f", values, tt.percentile, got, tt.expected)
+		}
+	}
+	if got := Percentile(nil, 50); got != 0 {
+		t.Errorf("Percentile(nil) = %.1f, want 0", got)
+	}
+}
+
+func TestQueueHistory_Smooth(t *testing.T) {
+	history := NewQueueHistory()
+	observe := func(queues map[string]float64) map[string]float64 {
+		t.Helper()
+		var replicaMetrics []interfaces.ReplicaMetrics
+		for pod, queue := range queues {
+			replicaMetrics = append(replicaMetrics, interfaces.ReplicaMetrics{PodName: pod, QueueLength: queue})
+		}
+		smoothed := history.Smooth("ns/model", replicaMetrics, 100, 3)
+		for _, metric := range replicaMetrics {
+			if metric.QueueLength != queues[metric.PodName] {
+				t.Fatalf("Smooth modified the input metrics of %s", metric.PodName)
+			}
+		}
+		result := make(map[string]float64, len(smoothed))
+		for _, metric := range smoothed {
+			result[metric.PodName] = metric.QueueLength
+		}
+		return result
+	}
+
+	observe(map[string]float64{"pod-1": 8, "pod-2": 1})
+	observe(map[string]float64{"pod-1": 1, "pod-2": 1})
+	if got := observe(map[string]float64{"pod-1": 2, "pod-2": 1}); got["pod-1"] != 8 || got["pod-2"] != 1 {
+		t.Errorf("expected the spike to be the maximum of the window, got %v", got)
+	}
+	// The spike leaves the 3-cycle window
+	if got := observe(map[string]float64{"pod-1": 2, "pod-2": 1}); got["pod-1"] != 2 {
+		t.Errorf("expected the spike to age out of the window, got %v", got)
+	}
+	// A replica that disappears starts a new history when it comes back
+	observe(map[string]float64{"pod-2": 1})
+	if got := observe(map[string]float64{"pod-1": 0, "pod-2": 1}); got["pod-1"] != 0 {
+		t.Errorf("expected a returning replica to start a new history, got %v", got)
+	}
+}
+
+func TestQueueHistory_Retain(t *testing.T) {
+	history := NewQueueHistory()
+	spike := []interfaces.ReplicaMetrics{{PodName: "pod-1", QueueLength: 8}}
+	history.Smooth("ns/model", spike, 100, 3)
+	history.Smooth("ns/other", spike, 100, 3)
+
+	history.Retain(map[string]bool{"ns/model": true})
+
+	idle := []interfaces.ReplicaMetrics{{PodName: "pod-1", QueueLength: 1}}
+	if got := history.Smooth("ns/model", idle, 100, 3); got[0].QueueLength != 8 {
+		t.Errorf("expected ns/model history to be kept, got queue %v", got[0].QueueLength)
+	}
+	if got := history.Smooth("ns/other", idle, 100, 3); got[0].QueueLength != 1 {
+		t.Errorf("expected ns/other history to be dropped, got queue %v", got[0].QueueLength)
+	}
+}
+
+func TestAnalyzeModelSaturation_QueuePercentileStabilizesSpikes(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	// Both replicas queue briefly every third cycle, then drain
+	spiky := []float64{1, 1, 8, 1, 1, 9, 1, 1, 8, 1}
+	scaleUps := func(analyzer *Analyzer, config interfaces.SaturationScalingConfig, queues []float64) []bool {
+		t.Helper()
+		var decisions []bool
+		for _, queue := range queues {
+			replicaMetrics := []interfaces.ReplicaMetrics{
+				{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.3, QueueLength: queue},
+				{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.3, QueueLength: queue},
+			}
+			analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			decisions = append(decisions, analysis.ShouldScaleUp)
+		}
+		return decisions
+	}
+
+	unsmoothed := scaleUps(NewAnalyzer().WithQueueHistory(NewQueueHistory()), config, spiky)
+	if !unsmoothed[2] || unsmoothed[3] {
+		t.Fatalf("expected the spikes alone to flip the scale-up decision without smoothing, got %v", unsmoothed)
+	}
+
+	config.QueuePercentile = 50
+	config.QueuePercentileWindow = 5
+	for cycle, scaleUp := range scaleUps(NewAnalyzer().WithQueueHistory(NewQueueHistory()), config, spiky) {
+		if scaleUp {
+			t.Errorf("cycle %d: expected a stable classification under percentile smoothing, got a scale-up", cycle)
+		}
+	}
+
+	// Sustained queuing still scales up once it fills most of the window
+	sustained := scaleUps(NewAnalyzer().WithQueueHistory(NewQueueHistory()), config, []float64{1, 1, 8, 8, 8, 8})
+	if sustained[2] || !sustained[len(sustained)-1] {
+		t.Errorf("expected sustained queuing to scale up after smoothing, got %v", sustained)
+	}
+}
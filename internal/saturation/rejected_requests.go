@@ -0,0 +1,27 @@
+package saturation
+
+import (
+	"fmt"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// TotalRejectedRequestRate returns the rate of rejected (HTTP 429) requests per second summed
+// across all replicas. Replicas without the metric count as 0.
+func TotalRejectedRequestRate(replicaMetrics []interfaces.ReplicaMetrics) float64 {
+	var total float64
+	for _, metric := range replicaMetrics {
+		total += metric.RejectedRequestRate
+	}
+	return total
+}
+
+// DetectRequestsRejected reports whether requests are rejected at or above trigger per second.
+// Clients are already being turned away then, so the model needs another replica whatever
+// its spare KV cache and queue capacity look like.
+func DetectRequestsRejected(rejectedRate, trigger float64) (bool, string) {
+	if trigger <= 0 || rejectedRate < trigger {
+		return false, ""
+	}
+	return true, fmt.Sprintf("requests rejected (%.3f/s >= %.3f/s)", rejectedRate, trigger)
+}
@@ -0,0 +1,99 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestTotalRejectedRequestRate(t *testing.T) {
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", RejectedRequestRate: 0.5},
+		{PodName: "pod-2", RejectedRequestRate: 1.5},
+		{PodName: "pod-3"}, // no rejections or no metric
+	}
+	if got := TotalRejectedRequestRate(replicaMetrics); got != 2 {
+		t.Errorf("TotalRejectedRequestRate = %.2f, want 2", got)
+	}
+	if got := TotalRejectedRequestRate(nil); got != 0 {
+		t.Errorf("TotalRejectedRequestRate(nil) = %.2f, want 0", got)
+	}
+}
+
+func TestDetectRequestsRejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     float64
+		trigger  float64
+		expected bool
+	}{
+		{name: "above trigger", rate: 2, trigger: 0.5, expected: true},
+		{name: "at trigger", rate: 0.5, trigger: 0.5, expected: true},
+		{name: "below trigger", rate: 0.1, trigger: 0.5, expected: false},
+		{name: "disabled", rate: 10, trigger: 0, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rejected, reason := DetectRequestsRejected(tt.rate, tt.trigger)
+			if rejected != tt.expected {
+				t.Errorf("DetectRequestsRejected(%.2f, %.2f) = %v, want %v", tt.rate, tt.trigger, rejected, tt.expected)
+			}
+			if rejected && reason == "" {
+				t.Error("expected a reason when requests are rejected")
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_RejectionsScaleUp(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	analyze := func(replicaMetrics []interfaces.ReplicaMetrics, config interfaces.SaturationScalingConfig) *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	// KV and queue spare are just above their triggers (0.12 >= 0.10 and 3.5 >= 3), but the
+	// replicas already turn requests away
+	borderline := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.68, QueueLength: 1.5, RejectedRequestRate: 0.4},
+		{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.68, QueueLength: 1.5, RejectedRequestRate: 0.4},
+	}
+	if spareOnly := analyze(borderline, config); spareOnly.ShouldScaleUp {
+		t.Fatalf("expected no scale-up from spare triggers alone, reason %s", spareOnly.ScaleUpReason)
+	}
+
+	config.RejectedRequestRateTrigger = 0.5
+	analysis := analyze(borderline, config)
+	if !analysis.ShouldScaleUp || analysis.ScaleUpReasonCode != interfaces.ReasonCodeRequestsRejected {
+		t.Errorf("expected scale-up on rejected requests, got %v (%s)", analysis.ShouldScaleUp, analysis.ScaleUpReasonCode)
+	}
+	if analysis.TotalRejectedRequestRate != 0.8 {
+		t.Errorf("TotalRejectedRequestRate = %.2f, want 0.8", analysis.TotalRejectedRequestRate)
+	}
+	targets := analyzer.CalculateSaturationTargets(context.Background(), analysis, []interfaces.VariantReplicaState{
+		{VariantName: "v1", CurrentReplicas: 2},
+	})
+	if targets["v1"] != 3 {
+		t.Errorf("expected rejections to add a replica immediately, got target %d", targets["v1"])
+	}
+
+	// Rejections take precedence over a spare capacity trigger that fired too
+	saturated := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.75, QueueLength: 1, RejectedRequestRate: 1},
+		{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.75, QueueLength: 1, RejectedRequestRate: 1},
+	}
+	if analysis := analyze(saturated, config); analysis.ScaleUpReasonCode != interfaces.ReasonCodeRequestsRejected {
+		t.Errorf("expected the rejection reason to win over the KV trigger, got %s", analysis.ScaleUpReasonCode)
+	}
+}
@@ -0,0 +1,20 @@
+package saturation
+
+import "math"
+
+// SaturatedQuorumMet reports whether enough of a model's replicas are saturated for the spare
+// capacity triggers to scale up, and how many are required. A quorum below 1 is a fraction of
+// totalReplicas, rounded up; a quorum of 1 or more is a replica count, capped at totalReplicas
+// so that a model with fewer replicas can still scale up. A quorum of 0 is always met.
+func SaturatedQuorumMet(saturatedReplicas, totalReplicas int, quorum float64) (bool, int) {
+	if quorum <= 0 {
+		return true, 0
+	}
+	var required int
+	if quorum < 1 {
+		required = int(math.Ceil(quorum * float64(totalReplicas)))
+	} else {
+		required = min(int(quorum), totalReplicas)
+	}
+	return saturatedReplicas >= required, required
+}
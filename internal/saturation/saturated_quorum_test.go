@@ -0,0 +1,93 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestSaturatedQuorumMet(t *testing.T) {
+	tests := []struct {
+		name         string
+		saturated    int
+		total        int
+		quorum       float64
+		wantMet      bool
+		wantRequired int
+	}{
+		{name: "disabled", saturated: 0, total: 4, quorum: 0, wantMet: true, wantRequired: 0},
+		{name: "fraction below quorum", saturated: 1, total: 4, quorum: 0.5, wantMet: false, wantRequired: 2},
+		{name: "fraction at quorum", saturated: 2, total: 4, quorum: 0.5, wantMet: true, wantRequired: 2},
+		{name: "fraction rounds up", saturated: 1, total: 3, quorum: 0.5, wantMet: false, wantRequired: 2},
+		{name: "count below quorum", saturated: 1, total: 4, quorum: 2, wantMet: false, wantRequired: 2},
+		{name: "count above quorum", saturated: 3, total: 4, quorum: 2, wantMet: true, wantRequired: 2},
+		{name: "count capped at replicas", saturated: 2, total: 2, quorum: 3, wantMet: true, wantRequired: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			met, required := SaturatedQuorumMet(tt.saturated, tt.total, tt.quorum)
+			if met != tt.wantMet || required != tt.wantRequired {
+				t.Errorf("SaturatedQuorumMet(%d, %d, %.2f) = (%v, %d), want (%v, %d)",
+					tt.saturated, tt.total, tt.quorum, met, required, tt.wantMet, tt.wantRequired)
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_SaturatedQuorum(t *testing.T) {
+	analyzer := NewAnalyzer()
+	baseConfig := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	// Non-saturated replicas at 0.75 KV leave 0.05 spare, below the 0.10 trigger;
+	// replicas at 0.90 KV are saturated
+	replicas := func(kvCacheUsages ...float64) []interfaces.ReplicaMetrics {
+		metrics := make([]interfaces.ReplicaMetrics, 0, len(kvCacheUsages))
+		for i, usage := range kvCacheUsages {
+			metrics = append(metrics, interfaces.ReplicaMetrics{
+				PodName:      "pod-" + string(rune('a'+i)),
+				VariantName:  "v1",
+				Cost:         10,
+				KvCacheUsage: usage,
+				QueueLength:  1,
+			})
+		}
+		return metrics
+	}
+
+	tests := []struct {
+		name          string
+		quorum        float64
+		kvCacheUsages []float64
+		wantScaleUp   bool
+	}{
+		{name: "no quorum, one saturated replica", quorum: 0, kvCacheUsages: []float64{0.90, 0.75, 0.75, 0.75}, wantScaleUp: true},
+		{name: "fraction quorum not met", quorum: 0.5, kvCacheUsages: []float64{0.90, 0.75, 0.75, 0.75}, wantScaleUp: false},
+		{name: "fraction quorum met", quorum: 0.5, kvCacheUsages: []float64{0.90, 0.90, 0.75, 0.75}, wantScaleUp: true},
+		{name: "count quorum not met", quorum: 2, kvCacheUsages: []float64{0.90, 0.75, 0.75, 0.75}, wantScaleUp: false},
+		{name: "count quorum exceeded", quorum: 2, kvCacheUsages: []float64{0.90, 0.90, 0.90, 0.75}, wantScaleUp: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := baseConfig
+			config.SaturatedQuorum = tt.quorum
+			analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(tt.kvCacheUsages...), config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if analysis.ShouldScaleUp != tt.wantScaleUp {
+				t.Errorf("ShouldScaleUp = %v, want %v (reason %q)", analysis.ShouldScaleUp, tt.wantScaleUp, analysis.ScaleUpReason)
+			}
+			if tt.wantScaleUp && analysis.ScaleUpReasonCode != interfaces.ReasonCodeKvSpareLow {
+				t.Errorf("ScaleUpReasonCode = %s, want %s", analysis.ScaleUpReasonCode, interfaces.ReasonCodeKvSpareLow)
+			}
+			if !tt.wantScaleUp && (analysis.ScaleUpReason != "" || analysis.ScaleUpReasonCode != "") {
+				t.Errorf("expected no scale-up reason below quorum, got %q (%s)", analysis.ScaleUpReason, analysis.ScaleUpReasonCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package saturation
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// ScaleDownStabilizer tracks, per model, since when and for how many consecutive cycles
+// scale-down has been safe, so that capacity is only removed after a sustained period of
+// low load rather than on a momentary dip. It is safe for concurrent use.
+type ScaleDownStabilizer struct {
+	mu    sync.Mutex
+	clock clock.PassiveClock
+	safe  map[string]safeStreak
+}
+
+// safeStreak is the current run of safe cycles of a model
+type safeStreak struct {
+	since  time.Time
+	cycles int
+}
+
+// NewScaleDownStabilizer creates a stabilizer using the given clock.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+func NewScaleDownStabilizer(clk clock.PassiveClock) *ScaleDownStabilizer {
+	return &ScaleDownStabilizer{
+		clock: clk,
+		safe:  make(map[string]safeStreak),
+	}
+}
+
+// Observe records whether scale-down is safe for the given model key in this cycle and returns
+// how long and for how many consecutive cycles, including this one, it has been safe.
+// Any unsafe cycle restarts both.
+func (s *ScaleDownStabilizer) Observe(key string, safe bool) (time.Duration, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !safe {
+		delete(s.safe, key)
+		return 0, 0
+	}
+
+	now := s.clock.Now()
+	streak, ok := s.safe[key]
+	if !ok {
+		streak.since = now
+	}
+	streak.cycles++
+	s.safe[key] = streak
+	return now.Sub(streak.since), streak.cycles
+}
@@ -0,0 +1,177 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestScaleDownStabilizer_ObserveTracksSafeDuration(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	stabilizer := NewScaleDownStabilizer(fakeClock)
+
+	if safeFor, _ := stabilizer.Observe("ns/model", true); safeFor != 0 {
+		t.Fatalf("expected the first safe cycle to start the period, got %s", safeFor)
+	}
+
+	fakeClock.SetTime(fakeClock.Now().Add(3 * time.Minute))
+	if safeFor, _ := stabilizer.Observe("ns/model", true); safeFor != 3*time.Minute {
+		t.Fatalf("expected safe for 3m, got %s", safeFor)
+	}
+
+	// A single unsafe cycle restarts the period
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	if safeFor, _ := stabilizer.Observe("ns/model", false); safeFor != 0 {
+		t.Fatalf("expected an unsafe cycle to report 0, got %s", safeFor)
+	}
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	stabilizer.Observe("ns/model", true)
+	fakeClock.SetTime(fakeClock.Now().Add(4 * time.Minute))
+	if safeFor, _ := stabilizer.Observe("ns/model", true); safeFor != 4*time.Minute {
+		t.Fatalf("expected the period to restart after an unsafe cycle, got %s", safeFor)
+	}
+
+	// Other models are tracked independently
+	if safeFor, _ := stabilizer.Observe("ns/other", true); safeFor != 0 {
+		t.Errorf("expected other model to start its own period, got %s", safeFor)
+	}
+}
+
+func TestAnalyzeModelSaturation_ScaleDownDelay(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	analyzer := NewAnalyzer().WithScaleDownStabilizer(NewScaleDownStabilizer(fakeClock))
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+		ScaleDownDelay:       2 * time.Minute,
+	}
+
+	// replicas returns three replicas of one variant at the given KV utilization
+	replicas := func(kvUsage float64) []interfaces.ReplicaMetrics {
+		return []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-2", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-3", VariantName: "v1", KvCacheUsage: kvUsage},
+		}
+	}
+
+	cycles := []struct {
+		name       string
+		advance    time.Duration
+		kvUsage    float64
+		expectSafe bool
+	}{
+		{name: "low load starts the delay", advance: 0, kvUsage: 0.2, expectSafe: false},
+		{name: "still within the delay", advance: time.Minute, kvUsage: 0.2, expectSafe: false},
+		{name: "momentary spike restarts the delay", advance: 30 * time.Second, kvUsage: 0.6, expectSafe: false},
+		{name: "low load again", advance: 30 * time.Second, kvUsage: 0.2, expectSafe: false},
+		{name: "not yet sustained for the full delay", advance: 90 * time.Second, kvUsage: 0.2, expectSafe: false},
+		{name: "sustained for the full delay", advance: 30 * time.Second, kvUsage: 0.2, expectSafe: true},
+		{name: "remains safe while load stays low", advance: 30 * time.Second, kvUsage: 0.2, expectSafe: true},
+	}
+
+	for _, cycle := range cycles {
+		fakeClock.SetTime(fakeClock.Now().Add(cycle.advance))
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(cycle.kvUsage), config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", cycle.name, err)
+		}
+		if analysis.ScaleDownSafe != cycle.expectSafe {
+			t.Errorf("%s: expected ScaleDownSafe=%v, got %v", cycle.name, cycle.expectSafe, analysis.ScaleDownSafe)
+		}
+	}
+
+	t.Run("zero delay scales down immediately", func(t *testing.T) {
+		noDelay := config
+		noDelay.ScaleDownDelay = 0
+		analysis, _ := analyzer.AnalyzeModelSaturation(context.Background(), "other-model", "test-ns", replicas(0.2), noDelay)
+		if !analysis.ScaleDownSafe {
+			t.Error("expected scale-down to be safe without a delay")
+		}
+	})
+}
+
+func TestScaleDownStabilizer_ObserveCountsConsecutiveCycles(t *testing.T) {
+	stabilizer := NewScaleDownStabilizer(clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	for want := 1; want <= 3; want++ {
+		if _, cycles := stabilizer.Observe("ns/model", true); cycles != want {
+			t.Fatalf("expected %d consecutive safe cycles, got %d", want, cycles)
+		}
+	}
+	if _, cycles := stabilizer.Observe("ns/model", false); cycles != 0 {
+		t.Fatalf("expected an unsafe cycle to reset the count, got %d", cycles)
+	}
+	if _, cycles := stabilizer.Observe("ns/model", true); cycles != 1 {
+		t.Errorf("expected the count to restart at 1, got %d", cycles)
+	}
+}
+
+func TestAnalyzeModelSaturation_ScaleDownStabilizationCycles(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	analyzer := NewAnalyzer().WithScaleDownStabilizer(NewScaleDownStabilizer(fakeClock))
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:             0.80,
+		QueueLengthThreshold:         5,
+		KvSpareTrigger:               0.10,
+		QueueSpareTrigger:            3,
+		ScaleDownStabilizationCycles: 3,
+	}
+
+	// replicas returns three replicas of one variant at the given KV utilization
+	replicas := func(kvUsage float64) []interfaces.ReplicaMetrics {
+		return []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-2", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-3", VariantName: "v1", KvCacheUsage: kvUsage},
+		}
+	}
+
+	cycles := []struct {
+		name       string
+		kvUsage    float64
+		expectSafe bool
+	}{
+		{name: "one-cycle dip does not approve scale-down", kvUsage: 0.2, expectSafe: false},
+		{name: "load returns", kvUsage: 0.6, expectSafe: false},
+		{name: "first safe cycle", kvUsage: 0.2, expectSafe: false},
+		{name: "second safe cycle", kvUsage: 0.2, expectSafe: false},
+		{name: "third consecutive safe cycle approves scale-down", kvUsage: 0.2, expectSafe: true},
+		{name: "remains safe while load stays low", kvUsage: 0.2, expectSafe: true},
+	}
+
+	for _, cycle := range cycles {
+		fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(cycle.kvUsage), config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", cycle.name, err)
+		}
+		if analysis.ScaleDownSafe != cycle.expectSafe {
+			t.Errorf("%s: expected ScaleDownSafe=%v, got %v", cycle.name, cycle.expectSafe, analysis.ScaleDownSafe)
+		}
+	}
+
+	t.Run("both delay and cycles must be met", func(t *testing.T) {
+		both := config
+		both.ScaleDownStabilizationCycles = 2
+		both.ScaleDownDelay = 2 * time.Minute
+		for i := 0; i < 2; i++ {
+			fakeClock.SetTime(fakeClock.Now().Add(30 * time.Second))
+			analysis, _ := analyzer.AnalyzeModelSaturation(context.Background(), "other-model", "test-ns", replicas(0.2), both)
+			if analysis.ScaleDownSafe {
+				t.Fatalf("cycle %d: expected scale-down held until the delay elapses", i+1)
+			}
+		}
+		fakeClock.SetTime(fakeClock.Now().Add(90 * time.Second))
+		analysis, _ := analyzer.AnalyzeModelSaturation(context.Background(), "other-model", "test-ns", replicas(0.2), both)
+		if !analysis.ScaleDownSafe {
+			t.Error("expected scale-down once both the delay and the cycle count are met")
+		}
+	})
+}
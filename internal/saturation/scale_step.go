@@ -0,0 +1,40 @@
+package saturation
+
+import (
+	"math"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// roundReplicas rounds a fractional replica target to whole replicas by policy, or by
+// fallback when policy is unset.
+func roundReplicas(target float64, policy, fallback interfaces.RoundingPolicy) int {
+	if policy == "" {
+		policy = fallback
+	}
+	switch policy {
+	case interfaces.RoundingPolicyFloor:
+		return int(math.Floor(target))
+	case interfaces.RoundingPolicyRound:
+		return int(math.Round(target))
+	default:
+		return int(math.Ceil(target))
+	}
+}
+
+// scaleUpTarget returns the target of a variant gaining capacity from base replicas: base
+// grown by the analysis' ScaleStepFraction and rounded by ScaleUpRounding (default ceil), but
+// always at least one replica more than base.
+func scaleUpTarget(analysis *interfaces.ModelSaturationAnalysis, base int) int {
+	target := roundReplicas(float64(base)*(1+analysis.ScaleStepFraction), analysis.ScaleUpRounding, interfaces.RoundingPolicyCeil)
+	return max(target, base+1)
+}
+
+// scaleDownTarget returns the target of a variant giving up capacity from base replicas: base
+// shrunk by the analysis' ScaleStepFraction and rounded by ScaleDownRounding (default floor),
+// always at least one replica less than base, and never below floor. The step never removes more
+// replicas than the scale-down simulation proved safe (ScaleDownSafeReplicas, at least one).
+func scaleDownTarget(analysis *interfaces.ModelSaturationAnalysis, base, floor int) int {
+	target := roundReplicas(float64(base)*(1-analysis.ScaleStepFraction), analysis.ScaleDownRounding, interfaces.RoundingPolicyFloor)
+	return max(min(target, base-1), base-max(1, analysis.ScaleDownSafeReplicas), floor)
+}
@@ -0,0 +1,142 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestRoundReplicas(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   float64
+		policy   interfaces.RoundingPolicy
+		fallback interfaces.RoundingPolicy
+		expected int
+	}{
+		{name: "ceil", target: 6.2, policy: interfaces.RoundingPolicyCeil, expected: 7},
+		{name: "floor", target: 6.8, policy: interfaces.RoundingPolicyFloor, expected: 6},
+		{name: "round down", target: 6.4, policy: interfaces.RoundingPolicyRound, expected: 6},
+		{name: "round half up", target: 6.5, policy: interfaces.RoundingPolicyRound, expected: 7},
+		{name: "whole target unchanged", target: 6, policy: interfaces.RoundingPolicyCeil, expected: 6},
+		{name: "unset uses fallback", target: 6.2, fallback: interfaces.RoundingPolicyFloor, expected: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundReplicas(tt.target, tt.policy, tt.fallback); got != tt.expected {
+				t.Errorf("roundReplicas(%v, %q, %q) = %d, want %d", tt.target, tt.policy, tt.fallback, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateSaturationTargets_ScaleStepRounding(t *testing.T) {
+	analyzer := NewAnalyzer()
+
+	tests := []struct {
+		name     string
+		scaleUp  bool
+		fraction float64
+		up       interfaces.RoundingPolicy
+		down     interfaces.RoundingPolicy
+		floor    int
+		safe     int
+		expected int
+	}{
+		{name: "no fraction adds one replica", scaleUp: true, expected: 6},
+		{name: "no fraction removes one replica", scaleUp: false, expected: 4},
+		// 5 * 1.3 = 6.5
+		{name: "scale-up defaults to ceil", scaleUp: true, fraction: 0.3, expected: 7},
+		{name: "scale-up floor", scaleUp: true, fraction: 0.3, up: interfaces.RoundingPolicyFloor, expected: 6},
+		{name: "scale-up round", scaleUp: true, fraction: 0.3, up: interfaces.RoundingPolicyRound, expected: 7},
+		// 5 * 0.7 = 3.5
+		{name: "scale-down defaults to floor", scaleUp: false, fraction: 0.3, safe: 4, expected: 3},
+		{name: "scale-down ceil", scaleUp: false, fraction: 0.3, down: interfaces.RoundingPolicyCeil, expected: 4},
+		{name: "scale-down round", scaleUp: false, fraction: 0.3, down: interfaces.RoundingPolicyRound, expected: 4},
+		// 5 * 1.1 = 5.5 and 5 * 0.9 = 4.5 would round back to 5
+		{name: "scale-up step is at least one replica", scaleUp: true, fraction: 0.1, up: interfaces.RoundingPolicyFloor, expected: 6},
+		{name: "scale-down step is at least one replica", scaleUp: false, fraction: 0.1, down: interfaces.RoundingPolicyCeil, expected: 4},
+		// 5 * 0.5 = 2.5
+		{name: "scale-down stops at floor", scaleUp: false, fraction: 0.5, floor: 4, expected: 4},
+		{name: "scale-down stops at one replica", scaleUp: false, fraction: 1, safe: 4, expected: 1},
+		{name: "scale-down step is capped at the replicas proven safe", scaleUp: false, fraction: 0.5, safe: 1, expected: 4},
+		{name: "scale-down step without a safe count removes one replica", scaleUp: false, fraction: 0.5, expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis := &interfaces.ModelSaturationAnalysis{
+				ModelID:               "test-model",
+				Namespace:             "test-ns",
+				ShouldScaleUp:         tt.scaleUp,
+				ScaleDownSafe:         !tt.scaleUp,
+				ScaleStepFraction:     tt.fraction,
+				ScaleUpRounding:       tt.up,
+				ScaleDownRounding:     tt.down,
+				ScaleDownSafeReplicas: tt.safe,
+				VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+					{VariantName: "v1", Cost: 10, ReplicaCount: 5},
+				},
+			}
+			states := []interfaces.VariantReplicaState{
+				{VariantName: "v1", CurrentReplicas: 5, ScaleDownFloor: tt.floor},
+			}
+
+			targets := analyzer.CalculateSaturationTargets(context.Background(), analysis, states)
+			if targets["v1"] != tt.expected {
+				t.Errorf("expected target %d, got %d", tt.expected, targets["v1"])
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_ScaleDownSafeReplicas(t *testing.T) {
+	analyzer := NewAnalyzer()
+	// Removing k of 4 replicas at 0.20 KV cache raises the load to 0.80/(4-k): safe up to
+	// k=2 (0.40), unsafe at k=3 (0.80 leaves no spare capacity)
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.20, QueueLength: 0.5},
+		{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.20, QueueLength: 0.5},
+		{PodName: "pod-3", VariantName: "v1", KvCacheUsage: 0.20, QueueLength: 0.5},
+		{PodName: "pod-4", VariantName: "v1", KvCacheUsage: 0.20, QueueLength: 0.5},
+	}
+
+	tests := []struct {
+		name     string
+		fraction float64
+		expected int
+	}{
+		{name: "one replica per step simulates one removal", expected: 1},
+		{name: "proportional step simulates removing several", fraction: 0.75, expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := interfaces.SaturationScalingConfig{
+				KvCacheThreshold:     0.80,
+				QueueLengthThreshold: 5,
+				KvSpareTrigger:       0.10,
+				QueueSpareTrigger:    3,
+				ScaleStepFraction:    tt.fraction,
+			}
+			analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !analysis.ScaleDownSafe {
+				t.Fatalf("expected ScaleDownSafe")
+			}
+			if analysis.ScaleDownSafeReplicas != tt.expected {
+				t.Errorf("expected ScaleDownSafeReplicas=%d, got %d", tt.expected, analysis.ScaleDownSafeReplicas)
+			}
+
+			// 4 * 0.25 = 1 replica left, capped by the simulation
+			targets := analyzer.CalculateSaturationTargets(context.Background(), analysis,
+				[]interfaces.VariantReplicaState{{VariantName: "v1", CurrentReplicas: 4}})
+			if targets["v1"] != 4-tt.expected {
+				t.Errorf("expected target %d, got %d", 4-tt.expected, targets["v1"])
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package saturation
+
+import "github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+
+// ServiceClassFor returns the service class of the model, or nil when no class lists it.
+// A model listed in several classes takes the one with the highest priority (lowest value).
+func ServiceClassFor(classes []interfaces.ServiceClass, modelID string) *interfaces.ServiceClass {
+	var class *interfaces.ServiceClass
+	for i := range classes {
+		for _, e := range classes[i].Data {
+			if e.Model == modelID && (class == nil || classes[i].Priority < class.Priority) {
+				class = &classes[i]
+			}
+		}
+	}
+	return class
+}
+
+// WithServiceClassQueueThreshold returns config with its queue length threshold replaced by the
+// one of the model's service class, if the class sets one. The queue spare trigger and scale-down
+// queue threshold are capped at the new threshold to keep the config valid.
+func WithServiceClassQueueThreshold(config interfaces.SaturationScalingConfig, class *interfaces.ServiceClass) interfaces.SaturationScalingConfig {
+	if class == nil || class.QueueLengthThreshold <= 0 {
+		return config
+	}
+	config.QueueLengthThreshold = class.QueueLengthThreshold
+	config.QueueSpareTrigger = min(config.QueueSpareTrigger, config.QueueLengthThreshold)
+	config.ScaleDownQueueLengthThreshold = min(config.ScaleDownQueueLengthThreshold, config.QueueLengthThreshold)
+	return config
+}
+
+// ServiceClassBoost returns the factor by which the spare triggers of the model are raised for
+// its service class, and the name of that class. A model listed in several classes takes the one
+// with the highest priority (lowest value). The factor is the ratio of the loosest SLO of any
+// class to the model's SLO, taking the larger of the TPOT and TTFT ratios, capped at
+// config.ServiceClassMaxBoost. It is 1 when the boost is disabled or no class lists the model.
+func ServiceClassBoost(
+	classes []interfaces.ServiceClass,
+	modelID string,
+	config interfaces.SaturationScalingConfig,
+) (float64, string) {
+	if config.ServiceClassMaxBoost < 1 {
+		return 1, ""
+	}
+
+	class := ServiceClassFor(classes, modelID)
+	if class == nil {
+		return 1, ""
+	}
+	var entry *interfaces.ServiceClassEntry
+	for i := range class.Data {
+		if class.Data[i].Model == modelID {
+			entry = &class.Data[i]
+			break
+		}
+	}
+	if entry.SLOTPOT <= 0 || entry.SLOTTFT <= 0 {
+		return 1, ""
+	}
+
+	var loosestTPOT, loosestTTFT int
+	for _, c := range classes {
+		for _, e := range c.Data {
+			loosestTPOT = max(loosestTPOT, e.SLOTPOT)
+			loosestTTFT = max(loosestTTFT, e.SLOTTFT)
+		}
+	}
+
+	boost := max(float64(loosestTPOT)/float64(entry.SLOTPOT), float64(loosestTTFT)/float64(entry.SLOTTFT))
+	return min(max(boost, 1), config.ServiceClassMaxBoost), class.Name
+}
+
+// WithServiceClassBoost returns config with its spare triggers raised by boost, so the model
+// scales up while more spare capacity is left. The triggers are capped at their saturation
+// thresholds to keep the config valid.
+func WithServiceClassBoost(config interfaces.SaturationScalingConfig, boost float64) interfaces.SaturationScalingConfig {
+	if boost <= 1 {
+		return config
+	}
+	config.KvSpareTrigger = min(config.KvSpareTrigger*boost, config.KvCacheThreshold)
+	config.QueueSpareTrigger = min(config.QueueSpareTrigger*boost, config.QueueLengthThreshold)
+	return config
+}
@@ -0,0 +1,183 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+var testServiceClasses = []interfaces.ServiceClass{
+	{
+		Name:     "Premium",
+		Priority: 1,
+		Data: []interfaces.ServiceClassEntry{
+			{Model: "premium-model", SLOTPOT: 24, SLOTTFT: 500},
+			{Model: "shared-model", SLOTPOT: 24, SLOTTFT: 500},
+		},
+	},
+	{
+		Name:     "Standard",
+		Priority: 10,
+		Data: []interfaces.ServiceClassEntry{
+			{Model: "standard-model", SLOTPOT: 200, SLOTTFT: 2000},
+			{Model: "shared-model", SLOTPOT: 200, SLOTTFT: 2000},
+		},
+	},
+}
+
+func TestServiceClassBoost(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{ServiceClassMaxBoost: 2}
+
+	tests := []struct {
+		name          string
+		modelID       string
+		config        interfaces.SaturationScalingConfig
+		expectedBoost float64
+		expectedClass string
+	}{
+		{name: "premium tier is boosted up to the cap", modelID: "premium-model", config: config,
+			expectedBoost: 2, expectedClass: "Premium"},
+		{name: "loosest tier is not boosted", modelID: "standard-model", config: config,
+			expectedBoost: 1, expectedClass: "Standard"},
+		{name: "highest priority class wins", modelID: "shared-model", config: config,
+			expectedBoost: 2, expectedClass: "Premium"},
+		{name: "boost below the cap follows the SLO ratio", modelID: "premium-model",
+			config: interfaces.SaturationScalingConfig{ServiceClassMaxBoost: 10}, expectedBoost: 200.0 / 24, expectedClass: "Premium"},
+		{name: "model without a class", modelID: "other-model", config: config, expectedBoost: 1},
+		{name: "boost disabled", modelID: "premium-model", config: interfaces.SaturationScalingConfig{}, expectedBoost: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boost, className := ServiceClassBoost(testServiceClasses, tt.modelID, tt.config)
+			if boost != tt.expectedBoost || className != tt.expectedClass {
+				t.Errorf("ServiceClassBoost(%s) = %.2f, %q, want %.2f, %q",
+					tt.modelID, boost, className, tt.expectedBoost, tt.expectedClass)
+			}
+		})
+	}
+}
+
+func TestServiceClass_PremiumScalesUpEarlier(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+		ServiceClassMaxBoost: 2,
+	}
+
+	// Identical load: 15% KV spare and a queue spare of 4 are above the base triggers
+	analyze := func(modelID string) *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		boost, _ := ServiceClassBoost(testServiceClasses, modelID, config)
+		replicaMetrics := []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.65, QueueLength: 1},
+			{PodName: "pod-2", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.65, QueueLength: 1},
+		}
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), modelID, "test-ns",
+			replicaMetrics, WithServiceClassBoost(config, boost))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	if standard := analyze("standard-model"); standard.ShouldScaleUp {
+		t.Errorf("expected standard-tier model not to scale up, reason %s", standard.ScaleUpReason)
+	}
+	premium := analyze("premium-model")
+	if !premium.ShouldScaleUp || premium.ScaleUpReasonCode != interfaces.ReasonCodeKvSpareLow {
+		t.Errorf("expected premium-tier model to scale up on low KV spare, got %v (%s)",
+			premium.ShouldScaleUp, premium.ScaleUpReasonCode)
+	}
+}
+
+func TestWithServiceClassBoost_CapsAtThresholds(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.50,
+		QueueSpareTrigger:    3,
+	}
+	boosted := WithServiceClassBoost(config, 2)
+	if boosted.KvSpareTrigger != 0.80 || boosted.QueueSpareTrigger != 5 {
+		t.Errorf("expected triggers capped at thresholds, got kv %.2f queue %.1f",
+			boosted.KvSpareTrigger, boosted.QueueSpareTrigger)
+	}
+	if err := boosted.Validate(); err != nil {
+		t.Errorf("boosted config must stay valid: %v", err)
+	}
+}
+
+func TestServiceClassFor(t *testing.T) {
+	if class := ServiceClassFor(testServiceClasses, "shared-model"); class == nil || class.Name != "Premium" {
+		t.Errorf("expected the highest priority class Premium, got %+v", class)
+	}
+	if class := ServiceClassFor(testServiceClasses, "standard-model"); class == nil || class.Name != "Standard" {
+		t.Errorf("expected class Standard, got %+v", class)
+	}
+	if class := ServiceClassFor(testServiceClasses, "other-model"); class != nil {
+		t.Errorf("expected no class for an unlisted model, got %+v", class)
+	}
+}
+
+func TestServiceClass_PremiumUsesStricterQueueThreshold(t *testing.T) {
+	classes := []interfaces.ServiceClass{
+		{Name: "Premium", Priority: 1, QueueLengthThreshold: 2,
+			Data: []interfaces.ServiceClassEntry{{Model: "premium-model", SLOTPOT: 24, SLOTTFT: 500}}},
+		{Name: "Standard", Priority: 10, QueueLengthThreshold: 8,
+			Data: []interfaces.ServiceClassEntry{{Model: "standard-model", SLOTPOT: 200, SLOTTFT: 2000}}},
+	}
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+
+	premiumConfig := WithServiceClassQueueThreshold(config, ServiceClassFor(classes, "premium-model"))
+	standardConfig := WithServiceClassQueueThreshold(config, ServiceClassFor(classes, "standard-model"))
+	if premiumConfig.QueueLengthThreshold != 2 || standardConfig.QueueLengthThreshold != 8 {
+		t.Fatalf("expected tier queue thresholds 2 and 8, got %.1f and %.1f",
+			premiumConfig.QueueLengthThreshold, standardConfig.QueueLengthThreshold)
+	}
+	if err := premiumConfig.Validate(); err != nil {
+		t.Errorf("premium config must stay valid: %v", err)
+	}
+
+	// Identical load: a queue of 3 saturates the premium tier but not the standard one
+	analyzer := NewAnalyzer()
+	analyze := func(modelID string, config interfaces.SaturationScalingConfig) *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		replicaMetrics := []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.30, QueueLength: 3},
+			{PodName: "pod-2", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.30, QueueLength: 3},
+		}
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), modelID, "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	if standard := analyze("standard-model", standardConfig); standard.ShouldScaleUp || standard.NonSaturatedCount != 2 {
+		t.Errorf("expected standard-tier replicas not saturated, got shouldScaleUp=%v nonSaturated=%d",
+			standard.ShouldScaleUp, standard.NonSaturatedCount)
+	}
+	if premium := analyze("premium-model", premiumConfig); !premium.ShouldScaleUp || premium.NonSaturatedCount != 0 {
+		t.Errorf("expected premium-tier replicas saturated and scaling up, got shouldScaleUp=%v nonSaturated=%d",
+			premium.ShouldScaleUp, premium.NonSaturatedCount)
+	}
+}
+
+func TestWithServiceClassQueueThreshold_Unset(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{QueueLengthThreshold: 5, QueueSpareTrigger: 3}
+	for _, class := range []*interfaces.ServiceClass{nil, {Name: "Standard"}} {
+		if got := WithServiceClassQueueThreshold(config, class); got.QueueLengthThreshold != 5 || got.QueueSpareTrigger != 3 {
+			t.Errorf("expected queue settings unchanged for class %+v, got threshold %.1f trigger %.1f",
+				class, got.QueueLengthThreshold, got.QueueSpareTrigger)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package saturation
+
+import (
+	"fmt"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// AverageSpecDecodeAcceptanceRate returns the mean speculative decoding acceptance rate across
+// the replicas that report one, including a rate of 0. Returns nil when no replica uses
+// speculative decoding.
+func AverageSpecDecodeAcceptanceRate(replicaMetrics []interfaces.ReplicaMetrics) *float64 {
+	var total float64
+	var count int
+	for _, metric := range replicaMetrics {
+		if metric.HasSpecDecodeAcceptanceRate {
+			total += metric.SpecDecodeAcceptanceRate
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	avg := total / float64(count)
+	return &avg
+}
+
+// DetectSpecDecodeDegradation reports whether speculative decoding has degraded under load.
+// With speculative decoding, a falling acceptance rate means fewer tokens per forward pass,
+// so effective throughput drops before the KV cache or queue saturate.
+//
+// Degradation requires requests to be queued, since a low acceptance rate on an idle model
+// costs latency but not capacity, and an acceptance rate below threshold.
+func DetectSpecDecodeDegradation(acceptanceRate, queueLength, threshold float64) (bool, string) {
+	if threshold <= 0 || queueLength <= 0 {
+		return false, ""
+	}
+	if acceptanceRate >= threshold {
+		return false, ""
+	}
+	return true, fmt.Sprintf("speculative decoding acceptance low (%.3f < %.3f) with %.1f requests queued",
+		acceptanceRate, threshold, queueLength)
+}
@@ -0,0 +1,134 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestDetectSpecDecodeDegradation(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptanceRate float64
+		queueLength    float64
+		threshold      float64
+		expectDegraded bool
+	}{
+		{name: "low acceptance under load", acceptanceRate: 0.4, queueLength: 6, threshold: 0.6, expectDegraded: true},
+		{name: "healthy acceptance under load", acceptanceRate: 0.75, queueLength: 6, threshold: 0.6, expectDegraded: false},
+		{name: "low acceptance without queued requests", acceptanceRate: 0.4, queueLength: 0, threshold: 0.6, expectDegraded: false},
+		{name: "no accepted drafts under load", acceptanceRate: 0, queueLength: 6, threshold: 0.6, expectDegraded: true},
+		{name: "trigger disabled", acceptanceRate: 0.4, queueLength: 6, threshold: 0, expectDegraded: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			degraded, reason := DetectSpecDecodeDegradation(tt.acceptanceRate, tt.queueLength, tt.threshold)
+			if degraded != tt.expectDegraded {
+				t.Errorf("expected degraded=%v, got %v (reason %q)", tt.expectDegraded, degraded, reason)
+			}
+			if degraded && reason == "" {
+				t.Error("expected a reason when degradation is detected")
+			}
+		})
+	}
+}
+
+func TestAverageSpecDecodeAcceptanceRate_IgnoresReplicasWithoutSpecDecode(t *testing.T) {
+	metrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", SpecDecodeAcceptanceRate: 0.8, HasSpecDecodeAcceptanceRate: true},
+		{PodName: "pod-2", SpecDecodeAcceptanceRate: 0.6, HasSpecDecodeAcceptanceRate: true},
+		{PodName: "pod-3"},
+	}
+	if got := AverageSpecDecodeAcceptanceRate(metrics); got == nil || *got < 0.699 || *got > 0.701 {
+		t.Errorf("expected average 0.7, got %v", got)
+	}
+	if got := AverageSpecDecodeAcceptanceRate(metrics[2:]); got != nil {
+		t.Errorf("expected no rate without speculative decoding, got %.3f", *got)
+	}
+}
+
+func TestAverageSpecDecodeAcceptanceRate_KeepsZeroRate(t *testing.T) {
+	metrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", SpecDecodeAcceptanceRate: 0.0, HasSpecDecodeAcceptanceRate: true},
+		{PodName: "pod-2", SpecDecodeAcceptanceRate: 0.0, HasSpecDecodeAcceptanceRate: true},
+		{PodName: "pod-3"},
+	}
+	if got := AverageSpecDecodeAcceptanceRate(metrics); got == nil || *got != 0 {
+		t.Errorf("expected a reported average of 0, got %v", got)
+	}
+}
+
+func TestAnalyzeModelSaturation_SpecDecodeDegradationScaleUp(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:              0.80,
+		QueueLengthThreshold:          10,
+		KvSpareTrigger:                0.10,
+		QueueSpareTrigger:             3,
+		SpecDecodeAcceptanceThreshold: 0.6,
+	}
+
+	// replicas returns two replicas with moderate KV usage and a short queue, so the spare
+	// capacity triggers do not fire, with the given acceptance rate and queue length.
+	replicas := func(acceptanceRate float64, queueLength float64) []interfaces.ReplicaMetrics {
+		return []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: queueLength,
+				SpecDecodeAcceptanceRate: acceptanceRate, HasSpecDecodeAcceptanceRate: true},
+			{PodName: "pod-2", VariantName: "v1", KvCacheUsage: 0.50, QueueLength: queueLength,
+				SpecDecodeAcceptanceRate: acceptanceRate, HasSpecDecodeAcceptanceRate: true},
+		}
+	}
+
+	// Load rises while the acceptance rate degrades, cycle by cycle
+	cycles := []struct {
+		acceptanceRate float64
+		queueLength    float64
+		expectScaleUp  bool
+	}{
+		{acceptanceRate: 0.80, queueLength: 0, expectScaleUp: false},
+		{acceptanceRate: 0.72, queueLength: 1, expectScaleUp: false},
+		{acceptanceRate: 0.64, queueLength: 2, expectScaleUp: false},
+		{acceptanceRate: 0.55, queueLength: 3, expectScaleUp: true},
+		{acceptanceRate: 0.45, queueLength: 4, expectScaleUp: true},
+	}
+
+	analyzer := NewAnalyzer()
+	for i, cycle := range cycles {
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns",
+			replicas(cycle.acceptanceRate, cycle.queueLength), config)
+		if err != nil {
+			t.Fatalf("cycle %d: unexpected error: %v", i, err)
+		}
+		if analysis.ShouldScaleUp != cycle.expectScaleUp {
+			t.Errorf("cycle %d: expected ShouldScaleUp=%v, got %v (reason %q)", i, cycle.expectScaleUp, analysis.ShouldScaleUp, analysis.ScaleUpReason)
+		}
+		if cycle.expectScaleUp && analysis.ScaleUpReasonCode != interfaces.ReasonCodeSpecDecodeDegraded {
+			t.Errorf("cycle %d: expected reason code %q, got %q", i, interfaces.ReasonCodeSpecDecodeDegraded, analysis.ScaleUpReasonCode)
+		}
+	}
+
+	t.Run("low acceptance on an idle model does not scale up", func(t *testing.T) {
+		analysis, _ := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(0.3, 0), config)
+		if analysis.ShouldScaleUp {
+			t.Errorf("expected no scale-up without queued requests, got reason %q", analysis.ScaleUpReason)
+		}
+	})
+
+	t.Run("zero acceptance under load scales up", func(t *testing.T) {
+		analysis, _ := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(0.0, 4), config)
+		if !analysis.ShouldScaleUp || analysis.ScaleUpReasonCode != interfaces.ReasonCodeSpecDecodeDegraded {
+			t.Errorf("expected a spec decode scale-up for a 0%% acceptance rate, got %v (reason %q)",
+				analysis.ShouldScaleUp, analysis.ScaleUpReason)
+		}
+	})
+
+	t.Run("threshold unset disables the trigger", func(t *testing.T) {
+		disabled := config
+		disabled.SpecDecodeAcceptanceThreshold = 0
+		analysis, _ := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(0.3, 4), disabled)
+		if analysis.ShouldScaleUp {
+			t.Errorf("expected no scale-up with the trigger disabled, got reason %q", analysis.ScaleUpReason)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package saturation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// StaleDesiredTracker tracks, per variant, since when the desired replicas have differed from
+// the current replicas. CalculateSaturationTargets preserves such a desired while the variant
+// catches up; the tracker lets it be discarded when actuation never does. It is safe for
+// concurrent use.
+type StaleDesiredTracker struct {
+	mu      sync.Mutex
+	clock   clock.PassiveClock
+	pending map[string]pendingDesired
+}
+
+// pendingDesired is a desired replica count that the current replicas have not reached yet
+type pendingDesired struct {
+	desired int
+	since   time.Time
+}
+
+// NewStaleDesiredTracker creates a tracker using the given clock.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+func NewStaleDesiredTracker(clk clock.PassiveClock) *StaleDesiredTracker {
+	return &StaleDesiredTracker{
+		clock:   clk,
+		pending: make(map[string]pendingDesired),
+	}
+}
+
+// Observe records the desired and current replicas of the variant with the given key and returns
+// how long this desired has been waiting for the current replicas to reach it. An unset desired,
+// a desired equal to current or a new desired restarts the wait.
+func (t *StaleDesiredTracker) Observe(key string, desired, current int) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if desired == 0 || desired == current {
+		delete(t.pending, key)
+		return 0
+	}
+
+	now := t.clock.Now()
+	pending, ok := t.pending[key]
+	if !ok || pending.desired != desired {
+		pending = pendingDesired{desired: desired, since: now}
+		t.pending[key] = pending
+	}
+	return now.Sub(pending.since)
+}
+
+// Forget drops the pending desired of the variant identified by key, e.g. once its
+// VariantAutoscaling is deleted.
+func (t *StaleDesiredTracker) Forget(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.pending, key)
+}
+
+// Retain drops the pending desired of every variant whose key is not in keys, e.g. variants
+// whose VariantAutoscaling no longer exists.
+func (t *StaleDesiredTracker) Retain(keys map[string]bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key := range t.pending {
+		if !keys[key] {
+			delete(t.pending, key)
+		}
+	}
+}
+
+// DiscardStale clears the desired replicas of the states whose desired has differed from current
+// for at least timeout, so CalculateSaturationTargets computes their targets from live saturation
+// instead of preserving the stale desired. Variants are keyed by keyPrefix and variant name.
+// Returns the names of the variants whose desired was discarded.
+func (t *StaleDesiredTracker) DiscardStale(
+	ctx context.Context,
+	keyPrefix string,
+	states []interfaces.VariantReplicaState,
+	timeout time.Duration,
+) []string {
+	var discarded []string
+	for i := range states {
+		state := &states[i]
+		pendingFor := t.Observe(keyPrefix+"/"+state.VariantName, state.DesiredReplicas, state.CurrentReplicas)
+		if state.DesiredReplicas == state.CurrentReplicas || pendingFor < timeout {
+			continue
+		}
+		logging.FromContext(ctx, logging.Analyzer).Info("Discarding stale desired replicas, actuation has not caught up",
+			"variant", state.VariantName,
+			"desired", state.DesiredReplicas,
+			"current", state.CurrentReplicas,
+			"pendingFor", pendingFor,
+			"staleDesiredTimeout", timeout)
+		state.DesiredReplicas = 0
+		discarded = append(discarded, state.VariantName)
+	}
+	return discarded
+}
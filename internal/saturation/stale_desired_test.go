@@ -0,0 +1,113 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestStaleDesiredTracker_Observe(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	tracker := NewStaleDesiredTracker(fakeClock)
+
+	if pendingFor := tracker.Observe("ns/v1", 3, 2); pendingFor != 0 {
+		t.Fatalf("expected a new desired to start waiting, got %s", pendingFor)
+	}
+	fakeClock.SetTime(fakeClock.Now().Add(4 * time.Minute))
+	if pendingFor := tracker.Observe("ns/v1", 3, 2); pendingFor != 4*time.Minute {
+		t.Fatalf("expected desired to be pending for 4m, got %s", pendingFor)
+	}
+
+	// A new desired restarts the wait
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	if pendingFor := tracker.Observe("ns/v1", 4, 2); pendingFor != 0 {
+		t.Fatalf("expected a changed desired to restart the wait, got %s", pendingFor)
+	}
+
+	// Reaching the desired clears it
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	tracker.Observe("ns/v1", 4, 4)
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	if pendingFor := tracker.Observe("ns/v1", 4, 3); pendingFor != 0 {
+		t.Fatalf("expected the wait to restart after actuation caught up, got %s", pendingFor)
+	}
+
+	// An unset desired is never pending
+	if pendingFor := tracker.Observe("ns/v2", 0, 3); pendingFor != 0 {
+		t.Fatalf("expected unset desired not to be pending, got %s", pendingFor)
+	}
+}
+
+func TestStaleDesiredTracker_RetainAndForget(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	tracker := NewStaleDesiredTracker(fakeClock)
+	for _, key := range []string{"ns/a", "ns/b", "ns/c"} {
+		tracker.Observe(key, 3, 2)
+	}
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+
+	tracker.Retain(map[string]bool{"ns/a": true, "ns/b": true})
+	tracker.Forget("ns/b")
+
+	if pendingFor := tracker.Observe("ns/a", 3, 2); pendingFor != time.Minute {
+		t.Errorf("expected ns/a to stay pending for 1m, got %s", pendingFor)
+	}
+	for _, key := range []string{"ns/b", "ns/c"} {
+		if pendingFor := tracker.Observe(key, 3, 2); pendingFor != 0 {
+			t.Errorf("expected %s to restart the wait, got %s", key, pendingFor)
+		}
+	}
+}
+
+func TestCalculateSaturationTargets_StaleDesiredOverridden(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	tracker := NewStaleDesiredTracker(fakeClock)
+	analyzer := NewAnalyzer()
+	timeout := 10 * time.Minute
+
+	// Two ready replicas with low spare capacity want a scale-up, but a desired of 5 was
+	// recorded earlier and the deployment never scaled beyond 2
+	analysis := &interfaces.ModelSaturationAnalysis{
+		ModelID:           "test-model",
+		Namespace:         "test-ns",
+		ShouldScaleUp:     true,
+		ScaleUpReasonCode: interfaces.ReasonCodeKvSpareLow,
+		VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+			{VariantName: "v1", Cost: 10, ReplicaCount: 2},
+		},
+	}
+	states := func() []interfaces.VariantReplicaState {
+		return []interfaces.VariantReplicaState{{VariantName: "v1", CurrentReplicas: 2, DesiredReplicas: 5}}
+	}
+
+	// Within the timeout the desired is preserved
+	for _, elapsed := range []time.Duration{0, 5 * time.Minute, 9 * time.Minute} {
+		fakeClock.SetTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(elapsed))
+		variantStates := states()
+		if discarded := tracker.DiscardStale(ctx, "test-ns", variantStates, timeout); len(discarded) != 0 {
+			t.Fatalf("after %s: expected desired to be kept, discarded %v", elapsed, discarded)
+		}
+		targets := analyzer.CalculateSaturationTargets(ctx, analysis, variantStates)
+		if targets["v1"] != 5 || analysis.TargetReasonCodes["v1"] != interfaces.ReasonCodePreserved {
+			t.Fatalf("after %s: expected preserved target 5, got %d (%s)",
+				elapsed, targets["v1"], analysis.TargetReasonCodes["v1"])
+		}
+	}
+
+	// Once stale, the desired is discarded and the target comes from the live analysis
+	fakeClock.SetTime(time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC))
+	variantStates := states()
+	discarded := tracker.DiscardStale(ctx, "test-ns", variantStates, timeout)
+	if len(discarded) != 1 || discarded[0] != "v1" {
+		t.Fatalf("expected v1 desired to be discarded, got %v", discarded)
+	}
+	targets := analyzer.CalculateSaturationTargets(ctx, analysis, variantStates)
+	if targets["v1"] != 3 || analysis.TargetReasonCodes["v1"] != interfaces.ReasonCodeKvSpareLow {
+		t.Errorf("expected fresh scale-up target 3, got %d (%s)", targets["v1"], analysis.TargetReasonCodes["v1"])
+	}
+}
@@ -0,0 +1,77 @@
+package saturation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// PendingReplicaTracker tracks, per variant, since when it has had pending replicas. Pending
+// replicas hold back the model's scaling until they become ready; the tracker lets replicas that
+// never do, e.g. unschedulable pods, stop holding it back. It is safe for concurrent use.
+type PendingReplicaTracker struct {
+	mu    sync.Mutex
+	clock clock.PassiveClock
+	since map[string]time.Time
+}
+
+// NewPendingReplicaTracker creates a tracker using the given clock.
+// The clock is injected for testability; production code passes clock.RealClock{}.
+func NewPendingReplicaTracker(clk clock.PassiveClock) *PendingReplicaTracker {
+	return &PendingReplicaTracker{
+		clock: clk,
+		since: make(map[string]time.Time),
+	}
+}
+
+// Observe records the pending replicas of the variant with the given key and returns how long
+// the variant has had pending replicas without interruption. No pending replicas restart the wait.
+func (t *PendingReplicaTracker) Observe(key string, pending int) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if pending <= 0 {
+		delete(t.since, key)
+		return 0
+	}
+
+	now := t.clock.Now()
+	since, ok := t.since[key]
+	if !ok {
+		since = now
+		t.since[key] = since
+	}
+	return now.Sub(since)
+}
+
+// MarkStuck sets StuckPending on the states that have had pending replicas for at least maxAge,
+// so CalculateSaturationTargets no longer holds the model's scaling for them. Variants are keyed
+// by keyPrefix and variant name. Returns the names of the variants marked stuck.
+func (t *PendingReplicaTracker) MarkStuck(
+	ctx context.Context,
+	keyPrefix string,
+	states []interfaces.VariantReplicaState,
+	maxAge time.Duration,
+) []string {
+	var stuck []string
+	for i := range states {
+		state := &states[i]
+		pendingFor := t.Observe(keyPrefix+"/"+state.VariantName, state.PendingReplicas)
+		if state.PendingReplicas == 0 || pendingFor < maxAge {
+			continue
+		}
+		logging.FromContext(ctx, logging.Analyzer).Info("Pending replicas exceeded the maximum pending age, no longer holding back scaling",
+			"variant", state.VariantName,
+			"pendingReplicas", state.PendingReplicas,
+			"pendingFor", pendingFor,
+			"maxPendingAge", maxAge)
+		state.StuckPending = true
+		stuck = append(stuck, state.VariantName)
+	}
+	return stuck
+}
@@ -0,0 +1,90 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestPendingReplicaTracker_Observe(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	tracker := NewPendingReplicaTracker(fakeClock)
+
+	if pendingFor := tracker.Observe("ns/v1", 1); pendingFor != 0 {
+		t.Fatalf("expected new pending replicas to start waiting, got %s", pendingFor)
+	}
+	fakeClock.SetTime(fakeClock.Now().Add(4 * time.Minute))
+	if pendingFor := tracker.Observe("ns/v1", 2); pendingFor != 4*time.Minute {
+		t.Fatalf("expected replicas to be pending for 4m, got %s", pendingFor)
+	}
+
+	// No pending replicas restart the wait
+	tracker.Observe("ns/v1", 0)
+	fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+	if pendingFor := tracker.Observe("ns/v1", 1); pendingFor != 0 {
+		t.Fatalf("expected the wait to restart after pending replicas cleared, got %s", pendingFor)
+	}
+}
+
+func TestCalculateSaturationTargets_StuckPendingReleasesGuard(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakePassiveClock(start)
+	tracker := NewPendingReplicaTracker(fakeClock)
+	analyzer := NewAnalyzer()
+	maxAge := 10 * time.Minute
+
+	// The cheap variant has a replica that never becomes ready; the model needs more capacity
+	analysis := &interfaces.ModelSaturationAnalysis{
+		ModelID:           "test-model",
+		Namespace:         "test-ns",
+		ShouldScaleUp:     true,
+		ScaleUpReasonCode: interfaces.ReasonCodeKvSpareLow,
+		VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+			{VariantName: "cheap", Cost: 5, ReplicaCount: 2},
+			{VariantName: "expensive", Cost: 20, ReplicaCount: 1},
+		},
+	}
+	states := func() []interfaces.VariantReplicaState {
+		return []interfaces.VariantReplicaState{
+			{VariantName: "cheap", CurrentReplicas: 3, PendingReplicas: 1},
+			{VariantName: "expensive", CurrentReplicas: 1},
+		}
+	}
+
+	// Within the max age the pending replica holds back the whole model
+	for _, elapsed := range []time.Duration{0, 5 * time.Minute, 9 * time.Minute} {
+		fakeClock.SetTime(start.Add(elapsed))
+		variantStates := states()
+		if stuck := tracker.MarkStuck(ctx, "test-ns", variantStates, maxAge); len(stuck) != 0 {
+			t.Fatalf("after %s: expected no stuck variants, got %v", elapsed, stuck)
+		}
+		targets := analyzer.CalculateSaturationTargets(ctx, analysis, variantStates)
+		if targets["cheap"] != 3 || targets["expensive"] != 1 {
+			t.Fatalf("after %s: expected model in transition to keep targets, got %v", elapsed, targets)
+		}
+	}
+
+	// Past the max age the guard is released and the scale-up goes to the other variant
+	fakeClock.SetTime(start.Add(maxAge))
+	variantStates := states()
+	stuck := tracker.MarkStuck(ctx, "test-ns", variantStates, maxAge)
+	if len(stuck) != 1 || stuck[0] != "cheap" || !variantStates[0].StuckPending {
+		t.Fatalf("expected cheap to be marked stuck, got %v", stuck)
+	}
+	targets := analyzer.CalculateSaturationTargets(ctx, analysis, variantStates)
+	if targets["cheap"] != 3 {
+		t.Errorf("expected stuck variant to keep its current replicas, got %d", targets["cheap"])
+	}
+	if analysis.TargetReasonCodes["cheap"] != interfaces.ReasonCodePendingGuard {
+		t.Errorf("expected stuck variant to stay pending-guarded, got %s", analysis.TargetReasonCodes["cheap"])
+	}
+	if targets["expensive"] != 2 || analysis.TargetReasonCodes["expensive"] != interfaces.ReasonCodeKvSpareLow {
+		t.Errorf("expected scale-up of the other variant to 2, got %d (%s)",
+			targets["expensive"], analysis.TargetReasonCodes["expensive"])
+	}
+}
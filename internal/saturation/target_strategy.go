@@ -0,0 +1,242 @@
+package saturation
+
+import (
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// TargetStrategy decides which variant of a stable model gains a replica when the model needs
+// to scale up, and which gives one up when scale-down is safe. CalculateSaturationTargets applies
+// the guards common to all strategies (pending replicas, allowed accelerators, minimum replicas
+// and scale-down floors) and passes the remaining candidates, which are never empty.
+type TargetStrategy interface {
+	// ScaleUpVariant returns the candidate that gains a replica, or nil to leave the model unchanged.
+	ScaleUpVariant(
+		analysis *interfaces.ModelSaturationAnalysis,
+		candidates []*interfaces.VariantSaturationAnalysis,
+		targets map[string]int,
+	) *interfaces.VariantSaturationAnalysis
+
+	// ScaleDownVariant returns the candidate that gives up a replica, or nil to leave the model unchanged.
+	ScaleDownVariant(
+		analysis *interfaces.ModelSaturationAnalysis,
+		candidates []*interfaces.VariantSaturationAnalysis,
+		targets map[string]int,
+	) *interfaces.VariantSaturationAnalysis
+}
+
+// targetStrategies holds the strategies selectable by targetStrategy.
+var targetStrategies = map[interfaces.TargetStrategyName]TargetStrategy{
+	interfaces.TargetStrategyCostAware: CostAwareStrategy{},
+	interfaces.TargetStrategySpread:    SpreadStrategy{},
+	interfaces.TargetStrategyBinPack:   BinPackStrategy{},
+
+	interfaces.TargetStrategyPriorityWeighted: PriorityWeightedStrategy{},
+}
+
+// RegisterTargetStrategy registers strategy under name, replacing any strategy registered under it,
+// and makes name selectable as targetStrategy in the saturation scaling config. It must be called
+// before the config is loaded and targets are calculated, e.g. from an init function.
+func RegisterTargetStrategy(name interfaces.TargetStrategyName, strategy TargetStrategy) {
+	targetStrategies[name] = strategy
+	interfaces.RegisterTargetStrategyName(name)
+}
+
+// TargetStrategyFor returns the strategy registered under name. An empty or unregistered name
+// returns CostAwareStrategy; the second return value is false for an unregistered name.
+func TargetStrategyFor(name interfaces.TargetStrategyName) (TargetStrategy, bool) {
+	if name == "" {
+		return CostAwareStrategy{}, true
+	}
+	strategy, ok := targetStrategies[name]
+	if !ok {
+		return CostAwareStrategy{}, false
+	}
+	return strategy, true
+}
+
+// CostAwareStrategy adds a replica to the cheapest variant and removes one as chosen by the
+// analysis' ScaleDownPolicy: from the most expensive variant, or with "least-loaded" from the
+// variant with the most spare capacity. Ties are broken by variant name.
+type CostAwareStrategy struct{}
+
+// ScaleUpVariant implements TargetStrategy.
+func (CostAwareStrategy) ScaleUpVariant(
+	_ *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	_ map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	var cheapest *interfaces.VariantSaturationAnalysis
+	for _, va := range candidates {
+		// Select cheapest, with stable tie-breaking by variant name (alphabetically first)
+		if cheapest == nil ||
+			va.Cost < cheapest.Cost ||
+			(va.Cost == cheapest.Cost && va.VariantName < cheapest.VariantName) {
+			cheapest = va
+		}
+	}
+	return cheapest
+}
+
+// ScaleDownVariant implements TargetStrategy.
+func (CostAwareStrategy) ScaleDownVariant(
+	analysis *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	_ map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	var selected *interfaces.VariantSaturationAnalysis
+	for _, va := range candidates {
+		if selected == nil {
+			selected = va
+			continue
+		}
+
+		if analysis.ScaleDownPolicy == interfaces.ScaleDownPolicyLeastLoaded {
+			// Select most headroom, then fall back to cost ordering
+			vaHeadroom, selectedHeadroom := variantHeadroom(va), variantHeadroom(selected)
+			if vaHeadroom != selectedHeadroom {
+				if vaHeadroom > selectedHeadroom {
+					selected = va
+				}
+				continue
+			}
+		}
+
+		if moreExpensive(va, selected) {
+			selected = va
+		}
+	}
+	return selected
+}
+
+// SpreadStrategy keeps the variants of a model even: it adds a replica to the variant with the
+// fewest target replicas and removes one from the variant with the most. Ties go to the cheapest
+// variant on scale-up and the most expensive on scale-down.
+type SpreadStrategy struct{}
+
+// ScaleUpVariant implements TargetStrategy.
+func (SpreadStrategy) ScaleUpVariant(
+	_ *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	return selectByTarget(candidates, targets, func(a, b int) bool { return a < b }, cheaper)
+}
+
+// ScaleDownVariant implements TargetStrategy.
+func (SpreadStrategy) ScaleDownVariant(
+	_ *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	return selectByTarget(candidates, targets, func(a, b int) bool { return a > b }, moreExpensive)
+}
+
+// BinPackStrategy concentrates a model on as few variants as possible: it adds a replica to the
+// variant with the most target replicas and removes one from the variant with the fewest, so
+// small variants drain first. Ties go to the cheapest variant on scale-up and the most expensive
+// on scale-down.
+type BinPackStrategy struct{}
+
+// ScaleUpVariant implements TargetStrategy.
+func (BinPackStrategy) ScaleUpVariant(
+	_ *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	return selectByTarget(candidates, targets, func(a, b int) bool { return a > b }, cheaper)
+}
+
+// ScaleDownVariant implements TargetStrategy.
+func (BinPackStrategy) ScaleDownVariant(
+	_ *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	return selectByTarget(candidates, targets, func(a, b int) bool { return a < b }, moreExpensive)
+}
+
+// PriorityWeightedStrategy keeps each variant's share of the model's target replicas in proportion
+// to its weight in the analysis' VariantWeights (1 when unset): it adds a replica to the variant
+// with the fewest target replicas per unit of weight and removes one from the variant with the
+// most. With equal weights it behaves like SpreadStrategy.
+type PriorityWeightedStrategy struct{}
+
+// ScaleUpVariant implements TargetStrategy.
+func (PriorityWeightedStrategy) ScaleUpVariant(
+	analysis *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	return selectByWeightedTarget(analysis, candidates, targets, func(a, b float64) bool { return a < b }, cheaper)
+}
+
+// ScaleDownVariant implements TargetStrategy.
+func (PriorityWeightedStrategy) ScaleDownVariant(
+	analysis *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+) *interfaces.VariantSaturationAnalysis {
+	return selectByWeightedTarget(analysis, candidates, targets, func(a, b float64) bool { return a > b }, moreExpensive)
+}
+
+// selectByWeightedTarget returns the candidate whose target replicas per unit of weight are
+// preferred by better, breaking ties with tieBreak.
+func selectByWeightedTarget(
+	analysis *interfaces.ModelSaturationAnalysis,
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+	better func(a, b float64) bool,
+	tieBreak func(a, b *interfaces.VariantSaturationAnalysis) bool,
+) *interfaces.VariantSaturationAnalysis {
+	share := func(va *interfaces.VariantSaturationAnalysis) float64 {
+		weight, ok := analysis.VariantWeights[va.VariantName]
+		if !ok || weight <= 0 {
+			weight = 1
+		}
+		return float64(targets[va.VariantName]) / weight
+	}
+	var selected *interfaces.VariantSaturationAnalysis
+	for _, va := range candidates {
+		if selected == nil {
+			selected = va
+			continue
+		}
+		vaShare, selectedShare := share(va), share(selected)
+		if better(vaShare, selectedShare) || (vaShare == selectedShare && tieBreak(va, selected)) {
+			selected = va
+		}
+	}
+	return selected
+}
+
+// selectByTarget returns the candidate whose target replicas are preferred by better, breaking
+// ties between equal targets with tieBreak.
+func selectByTarget(
+	candidates []*interfaces.VariantSaturationAnalysis,
+	targets map[string]int,
+	better func(a, b int) bool,
+	tieBreak func(a, b *interfaces.VariantSaturationAnalysis) bool,
+) *interfaces.VariantSaturationAnalysis {
+	var selected *interfaces.VariantSaturationAnalysis
+	for _, va := range candidates {
+		if selected == nil {
+			selected = va
+			continue
+		}
+		target, selectedTarget := targets[va.VariantName], targets[selected.VariantName]
+		if better(target, selectedTarget) || (target == selectedTarget && tieBreak(va, selected)) {
+			selected = va
+		}
+	}
+	return selected
+}
+
+// cheaper orders variants by cost, then alphabetically by name.
+func cheaper(a, b *interfaces.VariantSaturationAnalysis) bool {
+	return a.Cost < b.Cost || (a.Cost == b.Cost && a.VariantName < b.VariantName)
+}
+
+// moreExpensive orders variants by descending cost, then reverse alphabetically by name.
+func moreExpensive(a, b *interfaces.VariantSaturationAnalysis) bool {
+	return a.Cost > b.Cost || (a.Cost == b.Cost && a.VariantName > b.VariantName)
+}
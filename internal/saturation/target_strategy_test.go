@@ -0,0 +1,138 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestCalculateSaturationTargets_TargetStrategy(t *testing.T) {
+	analyzer := NewAnalyzer()
+
+	// v1 is the cheapest, v3 the most expensive; v2 runs the most replicas
+	variantStates := []interfaces.VariantReplicaState{
+		{VariantName: "v1-cheap", CurrentReplicas: 2},
+		{VariantName: "v2-medium", CurrentReplicas: 4},
+		{VariantName: "v3-expensive", CurrentReplicas: 3},
+	}
+	newAnalysis := func(strategy interfaces.TargetStrategyName, scaleUp bool) *interfaces.ModelSaturationAnalysis {
+		return &interfaces.ModelSaturationAnalysis{
+			ModelID:        "test-model",
+			Namespace:      "test-ns",
+			ShouldScaleUp:  scaleUp,
+			ScaleDownSafe:  !scaleUp,
+			TargetStrategy: strategy,
+			// Target replicas per unit of weight: v1 2, v2 4, v3 0.5
+			VariantWeights: map[string]float64{"v3-expensive": 6},
+			VariantAnalyses: []interfaces.VariantSaturationAnalysis{
+				{VariantName: "v1-cheap", Cost: 5, ReplicaCount: 2},
+				{VariantName: "v2-medium", Cost: 10, ReplicaCount: 4},
+				{VariantName: "v3-expensive", Cost: 20, ReplicaCount: 3},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		strategy interfaces.TargetStrategyName
+		scaleUp  bool
+		expected string
+	}{
+		{name: "default scales up cheapest", strategy: "", scaleUp: true, expected: "v1-cheap"},
+		{name: "default scales down most expensive", strategy: "", scaleUp: false, expected: "v3-expensive"},
+		{name: "cost-aware scales up cheapest", strategy: interfaces.TargetStrategyCostAware, scaleUp: true, expected: "v1-cheap"},
+		{name: "cost-aware scales down most expensive", strategy: interfaces.TargetStrategyCostAware, scaleUp: false, expected: "v3-expensive"},
+		{name: "unknown strategy falls back to cost-aware", strategy: "priority", scaleUp: true, expected: "v1-cheap"},
+		{name: "spread scales up fewest replicas", strategy: interfaces.TargetStrategySpread, scaleUp: true, expected: "v1-cheap"},
+		{name: "spread scales down most replicas", strategy: interfaces.TargetStrategySpread, scaleUp: false, expected: "v2-medium"},
+		{name: "bin-pack scales up most replicas", strategy: interfaces.TargetStrategyBinPack, scaleUp: true, expected: "v2-medium"},
+		{name: "bin-pack scales down fewest replicas", strategy: interfaces.TargetStrategyBinPack, scaleUp: false, expected: "v1-cheap"},
+		{name: "priority-weighted scales up fewest replicas per weight", strategy: interfaces.TargetStrategyPriorityWeighted, scaleUp: true, expected: "v3-expensive"},
+		{name: "priority-weighted scales down most replicas per weight", strategy: interfaces.TargetStrategyPriorityWeighted, scaleUp: false, expected: "v2-medium"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := analyzer.CalculateSaturationTargets(context.Background(), newAnalysis(tt.strategy, tt.scaleUp), variantStates)
+			for _, state := range variantStates {
+				expected := state.CurrentReplicas
+				if state.VariantName == tt.expected {
+					if tt.scaleUp {
+						expected++
+					} else {
+						expected--
+					}
+				}
+				if targets[state.VariantName] != expected {
+					t.Errorf("expected %s target=%d, got %d", state.VariantName, expected, targets[state.VariantName])
+				}
+			}
+		})
+	}
+}
+
+func TestSpreadStrategy_TieBreaksByCost(t *testing.T) {
+	candidates := []*interfaces.VariantSaturationAnalysis{
+		{VariantName: "b", Cost: 10},
+		{VariantName: "a", Cost: 10},
+		{VariantName: "c", Cost: 5},
+	}
+	targets := map[string]int{"a": 2, "b": 2, "c": 2}
+
+	if got := (SpreadStrategy{}).ScaleUpVariant(nil, candidates, targets); got.VariantName != "c" {
+		t.Errorf("expected cheapest variant c to scale up, got %s", got.VariantName)
+	}
+	if got := (SpreadStrategy{}).ScaleDownVariant(nil, candidates, targets); got.VariantName != "b" {
+		t.Errorf("expected most expensive variant b (by name) to scale down, got %s", got.VariantName)
+	}
+}
+
+type fixedStrategy struct{ variant string }
+
+func (s fixedStrategy) pick(candidates []*interfaces.VariantSaturationAnalysis) *interfaces.VariantSaturationAnalysis {
+	for _, va := range candidates {
+		if va.VariantName == s.variant {
+			return va
+		}
+	}
+	return nil
+}
+
+func (s fixedStrategy) ScaleUpVariant(_ *interfaces.ModelSaturationAnalysis, candidates []*interfaces.VariantSaturationAnalysis, _ map[string]int) *interfaces.VariantSaturationAnalysis {
+	return s.pick(candidates)
+}
+
+func (s fixedStrategy) ScaleDownVariant(_ *interfaces.ModelSaturationAnalysis, candidates []*interfaces.VariantSaturationAnalysis, _ map[string]int) *interfaces.VariantSaturationAnalysis {
+	return s.pick(candidates)
+}
+
+func TestRegisterTargetStrategy(t *testing.T) {
+	const name interfaces.TargetStrategyName = "test-fixed"
+	RegisterTargetStrategy(name, fixedStrategy{variant: "v2"})
+	defer delete(targetStrategies, name)
+
+	strategy, ok := TargetStrategyFor(name)
+	if !ok {
+		t.Fatalf("expected %s registered", name)
+	}
+	if _, isFixed := strategy.(fixedStrategy); !isFixed {
+		t.Errorf("expected the registered strategy, got %T", strategy)
+	}
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.8,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.1,
+		QueueSpareTrigger:    3,
+		TargetStrategy:       name,
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("expected a registered strategy to be selectable from the config, got %v", err)
+	}
+
+	if strategy, ok := TargetStrategyFor(""); !ok {
+		t.Error("expected the default strategy for an empty name")
+	} else if _, isCostAware := strategy.(CostAwareStrategy); !isCostAware {
+		t.Errorf("expected CostAwareStrategy by default, got %T", strategy)
+	}
+}
@@ -0,0 +1,24 @@
+package saturation
+
+import (
+	"fmt"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// WithTargetKvUtilization returns config with KvSpareTrigger derived from a target KV cache
+// utilization: KvSpareTrigger = KvCacheThreshold - targetUtilization. Average spare KV capacity
+// then falls below the trigger exactly when non-saturated replicas run above the target.
+//
+// Returns an error, and config unchanged, if targetUtilization is outside (0, KvCacheThreshold].
+func WithTargetKvUtilization(
+	config interfaces.SaturationScalingConfig,
+	targetUtilization float64,
+) (interfaces.SaturationScalingConfig, error) {
+	if targetUtilization <= 0 || targetUtilization > config.KvCacheThreshold {
+		return config, fmt.Errorf("targetKvUtilization must be in (0, kvCacheThreshold=%.2f], got %.2f",
+			config.KvCacheThreshold, targetUtilization)
+	}
+	config.KvSpareTrigger = config.KvCacheThreshold - targetUtilization
+	return config, nil
+}
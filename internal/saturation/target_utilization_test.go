@@ -0,0 +1,104 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestWithTargetKvUtilization(t *testing.T) {
+	base := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+
+	tests := []struct {
+		name          string
+		target        float64
+		expectTrigger float64
+		expectErr     bool
+	}{
+		{name: "70% target", target: 0.70, expectTrigger: 0.10},
+		{name: "50% target", target: 0.50, expectTrigger: 0.30},
+		{name: "target at threshold", target: 0.80, expectTrigger: 0},
+		{name: "target above threshold", target: 0.90, expectTrigger: 0.10, expectErr: true},
+		{name: "zero target", target: 0, expectTrigger: 0.10, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := WithTargetKvUtilization(base, tt.target)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error=%v, got %v", tt.expectErr, err)
+			}
+			if math.Abs(config.KvSpareTrigger-tt.expectTrigger) > 1e-9 {
+				t.Errorf("expected KvSpareTrigger=%.2f, got %.2f", tt.expectTrigger, config.KvSpareTrigger)
+			}
+			if config.KvCacheThreshold != base.KvCacheThreshold || config.QueueSpareTrigger != base.QueueSpareTrigger {
+				t.Errorf("expected other settings unchanged, got %+v", config)
+			}
+		})
+	}
+}
+
+func TestWithTargetKvUtilization_ScalingAroundTarget(t *testing.T) {
+	analyzer := NewAnalyzer()
+	base := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.01, // raw trigger would never fire in these cases
+		QueueSpareTrigger:    1,
+	}
+	config, err := WithTargetKvUtilization(base, 0.70)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// replicas returns three replicas of one variant at the given KV utilization
+	replicas := func(kvUsage float64) []interfaces.ReplicaMetrics {
+		return []interfaces.ReplicaMetrics{
+			{PodName: "pod-1", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-2", VariantName: "v1", KvCacheUsage: kvUsage},
+			{PodName: "pod-3", VariantName: "v1", KvCacheUsage: kvUsage},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		kvUsage         float64
+		expectScaleUp   bool
+		expectScaleDown bool
+	}{
+		{name: "above target scales up", kvUsage: 0.75, expectScaleUp: true},
+		{name: "just below target holds", kvUsage: 0.65},
+		{name: "well below target allows scale-down", kvUsage: 0.30, expectScaleDown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(tt.kvUsage), config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if analysis.ShouldScaleUp != tt.expectScaleUp {
+				t.Errorf("expected ShouldScaleUp=%v, got %v", tt.expectScaleUp, analysis.ShouldScaleUp)
+			}
+			if tt.expectScaleUp && analysis.ScaleUpReasonCode != interfaces.ReasonCodeKvSpareLow {
+				t.Errorf("expected reason code %s, got %s", interfaces.ReasonCodeKvSpareLow, analysis.ScaleUpReasonCode)
+			}
+			if analysis.ScaleDownSafe != tt.expectScaleDown {
+				t.Errorf("expected ScaleDownSafe=%v, got %v", tt.expectScaleDown, analysis.ScaleDownSafe)
+			}
+
+			// The raw trigger alone would not have scaled up at the same utilization
+			raw, _ := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicas(tt.kvUsage), base)
+			if raw.ShouldScaleUp {
+				t.Errorf("expected raw trigger not to scale up at %.2f", tt.kvUsage)
+			}
+		})
+	}
+}
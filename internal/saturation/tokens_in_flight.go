@@ -0,0 +1,37 @@
+package saturation
+
+import (
+	"fmt"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// TokenCapacity returns the number of tokens one replica can hold in flight,
+// config.MaxBatchSize * config.ContextLength. Returns 0 when either is unset.
+func TokenCapacity(config interfaces.SaturationScalingConfig) float64 {
+	return float64(config.MaxBatchSize) * float64(config.ContextLength)
+}
+
+// AverageTokensInFlightSpare returns the mean fraction of capacity not held by tokens in flight
+// across replicas, each clamped to 0.0-1.0. Replicas without the metric count as idle.
+// Returns 0 when capacity is not positive or there are no replicas.
+func AverageTokensInFlightSpare(replicaMetrics []interfaces.ReplicaMetrics, capacity float64) float64 {
+	if capacity <= 0 || len(replicaMetrics) == 0 {
+		return 0
+	}
+	var total float64
+	for _, metric := range replicaMetrics {
+		total += min(max(1-metric.TokensInFlight/capacity, 0), 1)
+	}
+	return total / float64(len(replicaMetrics))
+}
+
+// DetectTokensInFlightSpareLow reports whether the average spare token capacity fell below trigger.
+// Unlike the queue trigger, it counts request size, so a few very long requests that fill the
+// batch's context budget still add replicas while the queue stays short.
+func DetectTokensInFlightSpareLow(spare, trigger float64) (bool, string) {
+	if trigger <= 0 || spare >= trigger {
+		return false, ""
+	}
+	return true, fmt.Sprintf("tokens-in-flight spare capacity low (%.3f < %.3f)", spare, trigger)
+}
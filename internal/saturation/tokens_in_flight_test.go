@@ -0,0 +1,92 @@
+package saturation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestAverageTokensInFlightSpare(t *testing.T) {
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", TokensInFlight: 750},
+		{PodName: "pod-2", TokensInFlight: 2000}, // over capacity, clamped to no spare
+		{PodName: "pod-3"},                       // idle or no metric
+	}
+	if got := AverageTokensInFlightSpare(replicaMetrics, 1000); math.Abs(got-1.25/3) > 1e-9 {
+		t.Errorf("AverageTokensInFlightSpare = %.4f, want %.4f", got, 1.25/3)
+	}
+	if got := AverageTokensInFlightSpare(replicaMetrics, 0); got != 0 {
+		t.Errorf("AverageTokensInFlightSpare without capacity = %.4f, want 0", got)
+	}
+	if got := AverageTokensInFlightSpare(nil, 1000); got != 0 {
+		t.Errorf("AverageTokensInFlightSpare(nil) = %.4f, want 0", got)
+	}
+}
+
+func TestDetectTokensInFlightSpareLow(t *testing.T) {
+	tests := []struct {
+		name     string
+		spare    float64
+		trigger  float64
+		expected bool
+	}{
+		{name: "below trigger", spare: 0.05, trigger: 0.20, expected: true},
+		{name: "at trigger", spare: 0.20, trigger: 0.20, expected: false},
+		{name: "above trigger", spare: 0.50, trigger: 0.20, expected: false},
+		{name: "disabled", spare: 0, trigger: 0, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			low, reason := DetectTokensInFlightSpareLow(tt.spare, tt.trigger)
+			if low != tt.expected {
+				t.Errorf("DetectTokensInFlightSpareLow(%.2f, %.2f) = %v, want %v", tt.spare, tt.trigger, low, tt.expected)
+			}
+			if low && reason == "" {
+				t.Error("expected a reason when spare token capacity is low")
+			}
+		})
+	}
+}
+
+func TestAnalyzeModelSaturation_FewHugeRequestsScaleUp(t *testing.T) {
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+
+	// Two replicas each running a handful of near-full-context requests with nothing queued:
+	// queue and KV spare stay above their triggers, but 30k of 32k tokens are in flight.
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.60, QueueLength: 0, TokensInFlight: 30000},
+		{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.60, QueueLength: 0, TokensInFlight: 30000},
+	}
+	analyze := func(config interfaces.SaturationScalingConfig) *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	if queueOnly := analyze(config); queueOnly.ShouldScaleUp {
+		t.Fatalf("expected no scale-up from queue and KV triggers alone, reason %s", queueOnly.ScaleUpReason)
+	}
+
+	config.TokensInFlightSpareTrigger = 0.20
+	config.MaxBatchSize = 4
+	config.ContextLength = 8192
+	analysis := analyze(config)
+	if !analysis.ShouldScaleUp || analysis.ScaleUpReasonCode != interfaces.ReasonCodeTokensInFlightSpareLow {
+		t.Errorf("expected scale-up on low tokens-in-flight spare, got %v (%s)",
+			analysis.ShouldScaleUp, analysis.ScaleUpReasonCode)
+	}
+	if want := 1 - 30000.0/32768; math.Abs(analysis.AvgTokensInFlightSpare-want) > 1e-9 {
+		t.Errorf("AvgTokensInFlightSpare = %.4f, want %.4f", analysis.AvgTokensInFlightSpare, want)
+	}
+}
@@ -0,0 +1,58 @@
+package saturation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+func TestTraceID_ConsistentAcrossCycleLogs(t *testing.T) {
+	var lines []string
+	logger := funcr.New(func(_, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{Verbosity: logging.TRACE})
+
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+	}
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.90, QueueLength: 6},
+		{PodName: "pod-2", VariantName: "v1", AcceleratorName: "A100", Cost: 10, KvCacheUsage: 0.85, QueueLength: 6},
+	}
+	variantStates := []interfaces.VariantReplicaState{{VariantName: "v1", CurrentReplicas: 2}}
+
+	// Run analysis, target calculation and the observation hold of two cycles
+	runCycle := func(traceID string) []string {
+		lines = nil
+		ctx := logging.WithTraceID(log.IntoContext(context.Background(), logger), traceID)
+		analyzer := NewAnalyzer()
+		analysis, err := analyzer.AnalyzeModelSaturation(ctx, "llama", "test-ns", replicaMetrics, config)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		targets := analyzer.CalculateSaturationTargets(ctx, analysis, variantStates)
+		HoldForObservation(ctx, analysis, variantStates, targets, 1, 2)
+		return lines
+	}
+
+	for _, traceID := range []string{"cycle-a", "cycle-b"} {
+		cycleLines := runCycle(traceID)
+		if len(cycleLines) < 3 {
+			t.Fatalf("%s: expected log lines from every step, got %d", traceID, len(cycleLines))
+		}
+		for _, line := range cycleLines {
+			if !strings.Contains(line, `"traceID"="`+traceID+`"`) {
+				t.Errorf("%s: expected the cycle's trace ID in %s", traceID, line)
+			}
+		}
+	}
+}
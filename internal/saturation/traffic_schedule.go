@@ -0,0 +1,46 @@
+package saturation
+
+import (
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// TrafficScheduleBoost returns the factor by which the spare triggers are raised at now for the
+// configured traffic schedule, and the active window it came from. When windows overlap the
+// largest boost wins. It is 1 outside every window.
+func TrafficScheduleBoost(config interfaces.SaturationScalingConfig, now time.Time) (float64, *interfaces.TrafficWindow) {
+	if len(config.TrafficSchedule) == 0 {
+		return 1, nil
+	}
+	location, err := time.LoadLocation(config.TrafficScheduleTimeZone)
+	if err != nil {
+		location = time.UTC // rejected by Validate
+	}
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	boost := 1.0
+	var active *interfaces.TrafficWindow
+	for i := range config.TrafficSchedule {
+		window := &config.TrafficSchedule[i]
+		start, err := time.Parse(interfaces.TrafficWindowTimeLayout, window.Start)
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(interfaces.TrafficWindowTimeLayout, window.End)
+		if err != nil {
+			continue
+		}
+		from, to := start.Hour()*60+start.Minute(), end.Hour()*60+end.Minute()
+		inWindow := from <= minute && minute < to
+		if to < from {
+			// Wraps past midnight
+			inWindow = minute >= from || minute < to
+		}
+		if inWindow && window.Boost > boost {
+			boost, active = window.Boost, window
+		}
+	}
+	return boost, active
+}
@@ -0,0 +1,106 @@
+package saturation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestTrafficScheduleBoost(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{
+		TrafficSchedule: []interfaces.TrafficWindow{
+			{Start: "08:00", End: "10:00", Boost: 2},
+			{Start: "09:00", End: "09:30", Boost: 3},
+			{Start: "22:00", End: "02:00", Boost: 1.5},
+		},
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected float64
+	}{
+		{name: "before the peak", now: at(7, 59), expected: 1},
+		{name: "window start is inclusive", now: at(8, 0), expected: 2},
+		{name: "overlapping windows take the largest boost", now: at(9, 15), expected: 3},
+		{name: "window end is exclusive", now: at(10, 0), expected: 1},
+		{name: "window wrapping midnight, before", now: at(23, 0), expected: 1.5},
+		{name: "window wrapping midnight, after", now: at(1, 59), expected: 1.5},
+		{name: "outside every window", now: at(14, 0), expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boost, window := TrafficScheduleBoost(config, tt.now)
+			if boost != tt.expected {
+				t.Errorf("TrafficScheduleBoost at %s = %.2f, want %.2f", tt.now.Format("15:04"), boost, tt.expected)
+			}
+			if (window != nil) != (tt.expected > 1) {
+				t.Errorf("expected an active window only for a boost, got %v", window)
+			}
+		})
+	}
+}
+
+func TestTrafficScheduleBoost_TimeZone(t *testing.T) {
+	config := interfaces.SaturationScalingConfig{
+		TrafficSchedule:         []interfaces.TrafficWindow{{Start: "08:00", End: "10:00", Boost: 2}},
+		TrafficScheduleTimeZone: "Asia/Tokyo", // UTC+9, no daylight saving
+	}
+	if boost, _ := TrafficScheduleBoost(config, time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC)); boost != 2 {
+		t.Errorf("expected 00:30 UTC to be 09:30 in Tokyo and within the window, got boost %.2f", boost)
+	}
+	if boost, _ := TrafficScheduleBoost(config, time.Date(2025, 1, 1, 8, 30, 0, 0, time.UTC)); boost != 1 {
+		t.Errorf("expected 08:30 UTC to be outside the Tokyo window, got boost %.2f", boost)
+	}
+}
+
+func TestAnalyzeModelSaturation_ScheduledPeakAddsHeadroom(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC))
+	analyzer := NewAnalyzer()
+	config := interfaces.SaturationScalingConfig{
+		KvCacheThreshold:     0.80,
+		QueueLengthThreshold: 5,
+		KvSpareTrigger:       0.10,
+		QueueSpareTrigger:    3,
+		TrafficSchedule:      []interfaces.TrafficWindow{{Start: "08:00", End: "10:00", Boost: 2}},
+	}
+	// Spare KV of 0.15 is enough headroom normally, but not ahead of the morning peak
+	replicaMetrics := []interfaces.ReplicaMetrics{
+		{PodName: "pod-1", VariantName: "v1", Cost: 10, KvCacheUsage: 0.65},
+		{PodName: "pod-2", VariantName: "v1", Cost: 10, KvCacheUsage: 0.65},
+	}
+	analyze := func() *interfaces.ModelSaturationAnalysis {
+		t.Helper()
+		modelConfig := config
+		if boost, _ := TrafficScheduleBoost(config, fakeClock.Now()); boost > 1 {
+			modelConfig = WithServiceClassBoost(config, boost)
+		}
+		analysis, err := analyzer.AnalyzeModelSaturation(context.Background(), "test-model", "test-ns", replicaMetrics, modelConfig)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return analysis
+	}
+
+	if analysis := analyze(); analysis.ShouldScaleUp {
+		t.Fatalf("expected no scale-up outside the peak window, reason %s", analysis.ScaleUpReason)
+	}
+
+	fakeClock.SetTime(time.Date(2025, 1, 1, 8, 30, 0, 0, time.UTC))
+	if analysis := analyze(); !analysis.ShouldScaleUp || analysis.ScaleUpReasonCode != interfaces.ReasonCodeKvSpareLow {
+		t.Errorf("expected scale-up for more headroom within the peak window, got %v (%s)",
+			analysis.ShouldScaleUp, analysis.ScaleUpReasonCode)
+	}
+
+	fakeClock.SetTime(time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC))
+	if analysis := analyze(); analysis.ShouldScaleUp {
+		t.Errorf("expected no scale-up after the peak window, reason %s", analysis.ScaleUpReason)
+	}
+}
@@ -0,0 +1,318 @@
+// Package selftest runs one saturation optimization cycle against a fake cluster with
+// synthetic replica metrics, and checks that the expected scaling decisions are produced
+// and the replica metrics are emitted. It lets a build be smoke-tested without a cluster
+// or Prometheus (via the selftest command).
+package selftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/collector/source"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/common"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/engines/saturation"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/utils"
+)
+
+const (
+	namespace   = "wva-selftest"
+	accelerator = "A100"
+)
+
+// scenario is one synthetic model served by a single variant, with the decision the
+// optimize cycle is expected to make for it.
+type scenario struct {
+	name         string // VA and deployment name
+	modelID      string
+	replicas     int32
+	kvCacheUsage float64
+	queueLength  float64
+
+	expectedAction interfaces.SaturationAction
+	expectedTarget int
+}
+
+// scenarios cover both directions: a model running out of KV cache scales up by one
+// replica, and a model with ample headroom on three replicas scales down by one.
+var scenarios = []scenario{
+	{name: "busy", modelID: "selftest/busy", replicas: 2, kvCacheUsage: 0.75, queueLength: 1,
+		expectedAction: interfaces.ActionScaleUp, expectedTarget: 3},
+	{name: "idle", modelID: "selftest/idle", replicas: 3, kvCacheUsage: 0.05, queueLength: 0,
+		expectedAction: interfaces.ActionScaleDown, expectedTarget: 2},
+}
+
+// saturationConfig holds the documented default thresholds.
+var saturationConfig = interfaces.SaturationScalingConfig{
+	KvCacheThreshold:     0.80,
+	QueueLengthThreshold: 5,
+	KvSpareTrigger:       0.10,
+	QueueSpareTrigger:    3,
+}
+
+// Run executes the self-test, writes a report to out and returns the process exit code:
+// 0 if every check passed, 1 otherwise. It replaces the process-wide saturation config and
+// metrics registry, so it must not run alongside the controller.
+func Run(ctx context.Context, out io.Writer) int {
+	r := &report{out: out}
+	r.run(ctx)
+
+	if r.errors > 0 {
+		fmt.Fprintf(out, "\nSelf-test failed: %d error(s)\n", r.errors)
+		return 1
+	}
+	fmt.Fprintln(out, "\nSelf-test passed")
+	return 0
+}
+
+type report struct {
+	out    io.Writer
+	errors int
+}
+
+func (r *report) ok(subject string) {
+	fmt.Fprintf(r.out, "OK     %s\n", subject)
+}
+
+func (r *report) fail(subject string, err error) {
+	r.errors++
+	fmt.Fprintf(r.out, "ERROR  %s: %v\n", subject, err)
+}
+
+func (r *report) run(ctx context.Context) {
+	k8sClient, scheme, err := newFakeClient()
+	if err != nil {
+		r.fail("fake cluster", err)
+		return
+	}
+	r.ok(fmt.Sprintf("fake cluster with %d variants", len(scenarios)))
+
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		r.fail("metrics registry", err)
+		return
+	}
+	common.Config.UpdateSaturationConfig(map[string]interfaces.SaturationScalingConfig{
+		config.GlobalDefaultsKey: saturationConfig,
+	})
+
+	sourceRegistry := source.NewSourceRegistry()
+	if err := sourceRegistry.Register(source.PrimarySourceName, source.NewNoOpSource()); err != nil {
+		r.fail("metrics source", err)
+		return
+	}
+	engine := saturation.NewEngine(k8sClient, scheme, nil, sourceRegistry)
+	engine.ReplicaMetricsCollector = syntheticCollector{}
+	decisions := &recordingSink{}
+	engine.RegisterDecisionSink(decisions)
+
+	err = engine.OptimizeOnce(ctx)
+	drainDecisionTrigger()
+	if err != nil {
+		r.fail("optimize cycle", err)
+		return
+	}
+	r.ok("optimize cycle")
+
+	r.checkDecisions(decisions.recorded())
+	r.checkMetrics(registry)
+}
+
+// checkDecisions verifies that every scenario got its expected decision.
+func (r *report) checkDecisions(recorded map[string]interfaces.VariantDecision) {
+	for _, s := range scenarios {
+		subject := fmt.Sprintf("decision for %s/%s", namespace, s.name)
+		d, ok := recorded[s.name]
+		switch {
+		case !ok:
+			r.fail(subject, errors.New("no decision produced"))
+		case d.Action != s.expectedAction || d.TargetReplicas != s.expectedTarget:
+			r.fail(subject, fmt.Errorf("expected %s to %d replicas, got %s to %d replicas",
+				s.expectedAction, s.expectedTarget, d.Action, d.TargetReplicas))
+		default:
+			r.ok(fmt.Sprintf("%s: %s %d -> %d replicas (%s)", subject, d.Action, s.replicas, d.TargetReplicas, d.ReasonCode))
+		}
+	}
+}
+
+// checkMetrics verifies that the desired replicas of every scenario were emitted.
+func (r *report) checkMetrics(registry *prometheus.Registry) {
+	families, err := registry.Gather()
+	if err != nil {
+		r.fail("metrics", err)
+		return
+	}
+	desired := make(map[string]float64)
+	for _, family := range families {
+		if family.GetName() != constants.WVADesiredReplicas {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == constants.LabelVariantName {
+					desired[label.GetValue()] = m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+
+	for _, s := range scenarios {
+		subject := fmt.Sprintf("metric %s{%s=%q}", constants.WVADesiredReplicas, constants.LabelVariantName, s.name)
+		value, ok := desired[s.name]
+		switch {
+		case !ok:
+			r.fail(subject, errors.New("not emitted"))
+		case int(value) != s.expectedTarget:
+			r.fail(subject, fmt.Errorf("expected %d, got %v", s.expectedTarget, value))
+		default:
+			r.ok(fmt.Sprintf("%s = %v", subject, value))
+		}
+	}
+}
+
+// newFakeClient returns a client holding a ready deployment and its VariantAutoscaling
+// for every scenario.
+func newFakeClient() (client.Client, *runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, nil, err
+	}
+	if err := llmdVariantAutoscalingV1alpha1.AddToScheme(scheme); err != nil {
+		return nil, nil, err
+	}
+
+	objects := make([]client.Object, 0, 2*len(scenarios))
+	for _, s := range scenarios {
+		objects = append(objects, newDeployment(s), newVariantAutoscaling(s))
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&appsv1.Deployment{}, &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{}).
+		Build()
+	return c, scheme, nil
+}
+
+func newDeployment(s scenario) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: utils.Ptr(s.replicas)},
+		Status: appsv1.DeploymentStatus{
+			Replicas:      s.replicas,
+			ReadyReplicas: s.replicas,
+		},
+	}
+}
+
+func newVariantAutoscaling(s scenario) *llmdVariantAutoscalingV1alpha1.VariantAutoscaling {
+	labels := map[string]string{utils.AcceleratorLabelKey(): accelerator}
+	// Only VAs labeled for this controller instance are optimized when one is configured
+	if instance := metrics.GetControllerInstance(); instance != "" {
+		labels[constants.ControllerInstanceLabelKey] = instance
+	}
+	return &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: namespace, Labels: labels},
+		Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+				Kind: "Deployment",
+				Name: s.name,
+			},
+			ModelID: s.modelID,
+		},
+	}
+}
+
+// syntheticCollector reports the scenario's load on every replica of its deployment.
+type syntheticCollector struct{}
+
+var _ interfaces.MetricsCollector = syntheticCollector{}
+
+func (syntheticCollector) CollectReplicaMetrics(
+	_ context.Context,
+	modelID string,
+	modelNamespace string,
+	deployments map[string]*appsv1.Deployment,
+	_ map[string]*llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+	variantCosts map[string]float64,
+	_ interfaces.OptionalReplicaMetrics,
+) ([]interfaces.ReplicaMetrics, error) {
+	var replicaMetrics []interfaces.ReplicaMetrics
+	for _, s := range scenarios {
+		if s.modelID != modelID || deployments[s.name] == nil {
+			continue
+		}
+		for i := range int(s.replicas) {
+			replicaMetrics = append(replicaMetrics, interfaces.ReplicaMetrics{
+				PodName:         fmt.Sprintf("%s-%d", s.name, i),
+				KvCacheUsage:    s.kvCacheUsage,
+				QueueLength:     s.queueLength,
+				VariantName:     s.name,
+				Namespace:       modelNamespace,
+				ModelID:         modelID,
+				AcceleratorName: accelerator,
+				Cost:            variantCosts[s.name],
+			})
+		}
+	}
+	return replicaMetrics, nil
+}
+
+// recordingSink keeps the last decision emitted for each VA, by VA name.
+type recordingSink struct {
+	mu        sync.Mutex
+	decisions map[string]interfaces.VariantDecision
+}
+
+var _ interfaces.DecisionSink = &recordingSink{}
+
+func (s *recordingSink) Name() string {
+	return "selftest"
+}
+
+func (s *recordingSink) Emit(_ context.Context, va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.decisions == nil {
+		s.decisions = make(map[string]interfaces.VariantDecision)
+	}
+	s.decisions[va.Name] = decision
+	return nil
+}
+
+func (s *recordingSink) recorded() map[string]interfaces.VariantDecision {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	recorded := make(map[string]interfaces.VariantDecision, len(s.decisions))
+	for name, d := range s.decisions {
+		recorded[name] = d
+	}
+	return recorded
+}
+
+// drainDecisionTrigger discards the reconcile events queued by the cycle, since no
+// controller runs to consume them.
+func drainDecisionTrigger() {
+	for {
+		select {
+		case <-common.DecisionTrigger:
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Passes(t *testing.T) {
+	var out bytes.Buffer
+	code := Run(context.Background(), &out)
+
+	report := out.String()
+	require.Equal(t, 0, code, report)
+	assert.NotContains(t, report, "ERROR")
+	assert.Contains(t, report, "OK     decision for wva-selftest/busy: scale-up 2 -> 3 replicas (KvSpareLow)")
+	assert.Contains(t, report, "OK     decision for wva-selftest/idle: scale-down 3 -> 2 replicas (ScaleDownSafe)")
+	assert.Contains(t, report, `OK     metric wva_desired_replicas{variant_name="busy"} = 3`)
+	assert.Contains(t, report, `OK     metric wva_desired_replicas{variant_name="idle"} = 2`)
+	assert.Contains(t, report, "Self-test passed")
+}
@@ -0,0 +1,93 @@
+package sinks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// DefaultAsyncQueueSize is how many decisions an AsyncSink buffers before dropping new ones.
+const DefaultAsyncQueueSize = 256
+
+// queuedDecision is a decision waiting in an AsyncSink queue.
+type queuedDecision struct {
+	va       *llmdOptv1alpha1.VariantAutoscaling
+	decision interfaces.VariantDecision
+}
+
+// AsyncSink delivers decisions to a sink from a background goroutine, so that a slow or
+// unreachable external system cannot stall the engine loop. Decisions wait in a bounded queue;
+// when it is full, new decisions are dropped and Emit returns an error. Delivery errors are
+// logged. The goroutine stops when the context given to NewAsyncSink is done.
+type AsyncSink struct {
+	sink  interfaces.DecisionSink
+	queue chan queuedDecision
+}
+
+var _ interfaces.DecisionSink = &AsyncSink{}
+
+// NewAsyncSink starts delivering decisions to sink through a queue of size decisions.
+// A size < 1 uses DefaultAsyncQueueSize.
+func NewAsyncSink(ctx context.Context, sink interfaces.DecisionSink, size int) *AsyncSink {
+	if size < 1 {
+		size = DefaultAsyncQueueSize
+	}
+	s := &AsyncSink{
+		sink:  sink,
+		queue: make(chan queuedDecision, size),
+	}
+	go s.run(ctx)
+	return s
+}
+
+// Name implements interfaces.DecisionSink.
+func (s *AsyncSink) Name() string {
+	return s.sink.Name()
+}
+
+// Emit implements interfaces.DecisionSink. It only queues the decision and never blocks.
+func (s *AsyncSink) Emit(_ context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	select {
+	case s.queue <- queuedDecision{va: va.DeepCopy(), decision: decision}:
+		return nil
+	default:
+		return fmt.Errorf("queue full, dropped decision for %s/%s", va.Namespace, va.Name)
+	}
+}
+
+// Forget forwards to the wrapped sink when it keeps per-VA state.
+func (s *AsyncSink) Forget(va types.NamespacedName) {
+	if stateful, ok := s.sink.(vaStateSink); ok {
+		stateful.Forget(va)
+	}
+}
+
+// Retain forwards to the wrapped sink when it keeps per-VA state.
+func (s *AsyncSink) Retain(vas map[types.NamespacedName]bool) {
+	if stateful, ok := s.sink.(vaStateSink); ok {
+		stateful.Retain(vas)
+	}
+}
+
+// run delivers queued decisions until ctx is done.
+func (s *AsyncSink) run(ctx context.Context) {
+	logger := logging.FromContext(ctx, logging.Engine)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case queued := <-s.queue:
+			if err := s.sink.Emit(ctx, queued.va, queued.decision); err != nil {
+				logger.Error(err, "Failed to publish decision",
+					"sink", s.sink.Name(),
+					"variant", queued.va.Name,
+					"namespace", queued.va.Namespace)
+			}
+		}
+	}
+}
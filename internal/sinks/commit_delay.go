@@ -0,0 +1,121 @@
+package sinks
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// CommitDelayEnvVar configures the commit delay of the desired replicas metric, as a duration.
+const CommitDelayEnvVar = "WVA_DESIRED_COMMIT_DELAY"
+
+// ParseCommitDelay parses a commit delay setting. Empty, invalid and negative values disable the delay.
+func ParseCommitDelay(value string) time.Duration {
+	delay, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || delay < 0 {
+		return 0
+	}
+	return delay
+}
+
+// commitState tracks the committed desired replicas of a variant and the change pending on it,
+// along with the reason code of the decision that first computed the pending target.
+type commitState struct {
+	committed     int
+	pending       int
+	pendingSince  time.Time
+	pendingReason string
+}
+
+// CommitResult is the outcome of recording a target with CommitDelay.Commit.
+type CommitResult struct {
+	// Replicas is the committed desired replicas to publish.
+	Replicas int
+	// Previous is the desired replicas committed before the call, equal to Replicas on the
+	// first target of a variant.
+	Previous int
+	// First is set when the call committed the first target of the variant.
+	First bool
+	// Changed is set when the call committed a change from Previous.
+	Changed bool
+	// Reason is the reason code of the decision that first computed the committed change.
+	Reason string
+}
+
+// CommitDelay holds back changes of each variant's desired replicas until the new target has
+// been computed unchanged for the delay, so that rapid changes reach HPA as one step rather
+// than a sequence it would stabilize over on its own. Unlike input smoothing it does not alter
+// the target, only when it is published. It is safe for concurrent use.
+type CommitDelay struct {
+	clock clock.PassiveClock
+	delay time.Duration
+
+	mu       sync.Mutex
+	variants map[string]*commitState
+}
+
+// NewCommitDelay creates a commit delay of the given duration. A delay <= 0 commits every target
+// immediately.
+func NewCommitDelay(clk clock.PassiveClock, delay time.Duration) *CommitDelay {
+	return &CommitDelay{
+		clock:    clk,
+		delay:    delay,
+		variants: make(map[string]*commitState),
+	}
+}
+
+// Commit records desired, computed for reason, as the target of the variant identified by key
+// and returns the desired replicas to publish. The first target of a variant is committed
+// immediately; a later change is committed once the same target has been computed for the
+// delay, and any other target in between restarts the wait.
+func (c *CommitDelay) Commit(key string, desired int, reason string) CommitResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	state, ok := c.variants[key]
+	if !ok {
+		c.variants[key] = &commitState{committed: desired, pending: desired, pendingSince: now, pendingReason: reason}
+		return CommitResult{Replicas: desired, Previous: desired, First: true, Reason: reason}
+	}
+	if desired != state.pending {
+		state.pending = desired
+		state.pendingSince = now
+		state.pendingReason = reason
+	}
+	result := CommitResult{Replicas: state.committed, Previous: state.committed}
+	if state.pending != state.committed && (c.delay <= 0 || now.Sub(state.pendingSince) >= c.delay) {
+		state.committed = state.pending
+		result.Replicas = state.committed
+		result.Changed = true
+		result.Reason = state.pendingReason
+	}
+	return result
+}
+
+// Forget drops the state of the variant identified by key, e.g. once its VariantAutoscaling is
+// deleted, so that a variant created again under the same name starts afresh.
+func (c *CommitDelay) Forget(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.variants, key)
+}
+
+// Retain drops the state of every variant whose key is not in keys, e.g. variants whose
+// VariantAutoscaling no longer exists.
+func (c *CommitDelay) Retain(keys map[string]bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.variants {
+		if !keys[key] {
+			delete(c.variants, key)
+		}
+	}
+}
+
+// CommitKey returns the key a variant's desired replicas are committed under.
+func CommitKey(namespace, name string) string {
+	return namespace + "/" + name
+}
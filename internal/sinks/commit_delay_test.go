@@ -0,0 +1,87 @@
+package sinks
+
+import (
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestCommitDelay_TracksVariantsIndependently(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	delay := NewCommitDelay(clk, time.Minute)
+
+	delay.Commit("default/a", 1, "")
+	delay.Commit("default/b", 1, "")
+	if got := delay.Commit("default/a", 3, "").Replicas; got != 1 {
+		t.Errorf("expected a to hold 1, got %d", got)
+	}
+	clk.SetTime(clk.Now().Add(time.Minute))
+	if got := delay.Commit("default/a", 3, "").Replicas; got != 3 {
+		t.Errorf("expected a to commit 3, got %d", got)
+	}
+	if got := delay.Commit("default/b", 2, "").Replicas; got != 1 {
+		t.Errorf("expected b to hold 1, got %d", got)
+	}
+}
+
+func TestCommitDelay_ReportsCommittedChanges(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	delay := NewCommitDelay(clk, time.Minute)
+
+	if got := delay.Commit("default/a", 2, "Steady"); !got.First || got.Changed {
+		t.Errorf("expected the first target to be committed as first, got %+v", got)
+	}
+	if got := delay.Commit("default/a", 4, "KvSpareLow"); got.Changed {
+		t.Errorf("expected a held change not to be reported, got %+v", got)
+	}
+	clk.SetTime(clk.Now().Add(time.Minute))
+	want := CommitResult{Replicas: 4, Previous: 2, Changed: true, Reason: "KvSpareLow"}
+	if got := delay.Commit("default/a", 4, "Steady"); got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+	if got := delay.Commit("default/a", 4, "Steady"); got.Changed {
+		t.Errorf("expected a committed change to be reported once, got %+v", got)
+	}
+}
+
+func TestCommitDelay_RetainAndForget(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	delay := NewCommitDelay(clk, time.Minute)
+
+	delay.Commit("default/a", 1, "")
+	delay.Commit("default/b", 1, "")
+	delay.Retain(map[string]bool{"default/a": true})
+	if got := delay.Commit("default/b", 3, ""); !got.First || got.Replicas != 3 {
+		t.Errorf("expected b to start afresh after Retain, got %+v", got)
+	}
+	delay.Forget("default/a")
+	if got := delay.Commit("default/a", 3, ""); !got.First || got.Replicas != 3 {
+		t.Errorf("expected a to start afresh after Forget, got %+v", got)
+	}
+}
+
+func TestCommitDelay_ZeroDelayCommitsImmediately(t *testing.T) {
+	delay := NewCommitDelay(clocktesting.NewFakePassiveClock(time.Now()), 0)
+	for _, desired := range []int{2, 4, 1} {
+		if got := delay.Commit("default/a", desired, "").Replicas; got != desired {
+			t.Errorf("expected %d, got %d", desired, got)
+		}
+	}
+}
+
+func TestParseCommitDelay(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":     0,
+		"90s":  90 * time.Second,
+		" 2m ": 2 * time.Minute,
+		"-1m":  0,
+		"soon": 0,
+		"0s":   0,
+	}
+	for value, want := range tests {
+		if got := ParseCommitDelay(value); got != want {
+			t.Errorf("ParseCommitDelay(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// ConfigMapSink writes the latest decision of each VA, as a JSON WebhookPayload, to a ConfigMap
+// in the VA's namespace under the VA's name, for consumers that read decisions from the cluster.
+// The ConfigMap is created on the first decision of a namespace. A decision identical to the
+// last one written for the VA, apart from its timestamp, is not written again, so steady
+// variants cost no API calls; the stored timestamp is that of the cycle that first made it.
+// It is safe for concurrent use.
+type ConfigMapSink struct {
+	client client.Client
+	name   string
+
+	mu      sync.Mutex
+	written map[types.NamespacedName]WebhookPayload
+}
+
+var (
+	_ interfaces.DecisionSink = &ConfigMapSink{}
+	_ vaStateSink             = &ConfigMapSink{}
+)
+
+// NewConfigMapSink creates a sink writing to the ConfigMap called name.
+func NewConfigMapSink(c client.Client, name string) *ConfigMapSink {
+	return &ConfigMapSink{
+		client:  c,
+		name:    name,
+		written: make(map[types.NamespacedName]WebhookPayload),
+	}
+}
+
+// Name implements interfaces.DecisionSink.
+func (s *ConfigMapSink) Name() string {
+	return "configmap"
+}
+
+// Emit implements interfaces.DecisionSink.
+func (s *ConfigMapSink) Emit(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	key := types.NamespacedName{Namespace: va.Namespace, Name: va.Name}
+	payload := newWebhookPayload(va, decision)
+	unstamped := payload
+	unstamped.Timestamp = time.Time{}
+	s.mu.Lock()
+	last, ok := s.written[key]
+	s.mu.Unlock()
+	if ok && last == unstamped {
+		return nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode decision: %w", err)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var cm corev1.ConfigMap
+		err := s.client.Get(ctx, client.ObjectKey{Namespace: va.Namespace, Name: s.name}, &cm)
+		if apierrors.IsNotFound(err) {
+			cm = corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: va.Namespace, Name: s.name},
+				Data:       map[string]string{va.Name: string(body)},
+			}
+			return s.client.Create(ctx, &cm)
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[va.Name] = string(body)
+		return s.client.Update(ctx, &cm)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write decision to ConfigMap %s/%s: %w", va.Namespace, s.name, err)
+	}
+
+	s.mu.Lock()
+	s.written[key] = unstamped
+	s.mu.Unlock()
+	return nil
+}
+
+// Forget drops the last decision written for a VA, e.g. once it is deleted.
+func (s *ConfigMapSink) Forget(va types.NamespacedName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.written, va)
+}
+
+// Retain drops the last decision written for every VA not in vas, e.g. VAs that no longer exist.
+func (s *ConfigMapSink) Retain(vas map[types.NamespacedName]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.written {
+		if !vas[key] {
+			delete(s.written, key)
+		}
+	}
+}
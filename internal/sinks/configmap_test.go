@@ -0,0 +1,98 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestConfigMapSink_Emit(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	sink := NewConfigMapSink(c, "wva-decisions")
+
+	// The first decision creates the ConfigMap, later ones update it
+	emits := []struct {
+		va     string
+		target int
+	}{
+		{va: "va-1", target: 2},
+		{va: "va-2", target: 1},
+		{va: "va-1", target: 3},
+	}
+	for _, e := range emits {
+		decision := interfaces.VariantDecision{Action: interfaces.ActionScaleUp, TargetReplicas: e.target}
+		if err := sink.Emit(context.Background(), newVA(e.va), decision); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var cm corev1.ConfigMap
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "wva-decisions"}, &cm); err != nil {
+		t.Fatalf("expected ConfigMap to exist: %v", err)
+	}
+	for va, expected := range map[string]int{"va-1": 3, "va-2": 1} {
+		var payload WebhookPayload
+		if err := json.Unmarshal([]byte(cm.Data[va]), &payload); err != nil {
+			t.Fatalf("invalid payload for %s: %v", va, err)
+		}
+		if payload.Name != va || payload.TargetReplicas != expected {
+			t.Errorf("expected latest decision of %s with target %d, got %+v", va, expected, payload)
+		}
+	}
+}
+
+func TestConfigMapSink_SkipsUnchangedDecisions(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	sink := NewConfigMapSink(c, "wva-decisions")
+	key := client.ObjectKey{Namespace: "default", Name: "wva-decisions"}
+	resourceVersion := func() string {
+		t.Helper()
+		var cm corev1.ConfigMap
+		if err := c.Get(context.Background(), key, &cm); err != nil {
+			t.Fatalf("expected ConfigMap to exist: %v", err)
+		}
+		return cm.ResourceVersion
+	}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	decision := interfaces.VariantDecision{Action: interfaces.ActionNoChange, TargetReplicas: 2, LastRunTime: metav1.NewTime(start)}
+	if err := sink.Emit(context.Background(), newVA("va-1"), decision); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	written := resourceVersion()
+
+	// The same decision in a later cycle is not written again
+	decision.LastRunTime = metav1.NewTime(start.Add(time.Minute))
+	if err := sink.Emit(context.Background(), newVA("va-1"), decision); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := resourceVersion(); got != written {
+		t.Errorf("expected an unchanged decision not to update the ConfigMap, resourceVersion %s -> %s", written, got)
+	}
+
+	// A changed decision, or the same one once forgotten, is written
+	decision.TargetReplicas = 3
+	if err := sink.Emit(context.Background(), newVA("va-1"), decision); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changed := resourceVersion()
+	if changed == written {
+		t.Errorf("expected a changed decision to update the ConfigMap")
+	}
+	sink.Forget(types.NamespacedName{Namespace: "default", Name: "va-1"})
+	if err := sink.Emit(context.Background(), newVA("va-1"), decision); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := resourceVersion(); got == changed {
+		t.Errorf("expected a forgotten VA's decision to be written again")
+	}
+}
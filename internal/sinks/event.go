@@ -0,0 +1,155 @@
+package sinks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// DefaultEventAggregationWindow is how long identical decisions for a VA are folded into
+// the event recorded for the first of them.
+const DefaultEventAggregationWindow = 5 * time.Minute
+
+// Event reasons recorded on a VariantAutoscaling for decisions that change its desired replicas.
+const (
+	EventReasonScaledUp   = "ScaledUp"
+	EventReasonScaledDown = "ScaledDown"
+)
+
+// eventKey identifies identical decisions for a VA.
+type eventKey struct {
+	va         types.NamespacedName
+	previous   int
+	target     int
+	reasonCode interfaces.ReasonCode
+}
+
+// eventWindow tracks an aggregation window opened by a recorded event.
+type eventWindow struct {
+	start      time.Time
+	suppressed int
+}
+
+// EventSink records a Kubernetes event on the VariantAutoscaling for each decision that
+// changes its desired replicas. Identical decisions (same replica change and reason code)
+// for a VA within the aggregation window produce a single event, so a flapping model does
+// not emit an event per cycle; the next event after the window reports how many were folded.
+// It is safe for concurrent use.
+type EventSink struct {
+	recorder record.EventRecorder
+	clock    clock.Clock
+	window   time.Duration
+
+	mu      sync.Mutex
+	windows map[eventKey]*eventWindow
+}
+
+var _ interfaces.DecisionSink = &EventSink{}
+
+// NewEventSink creates a sink recording events through recorder, aggregating identical
+// decisions within window. A window <= 0 records an event for every decision.
+func NewEventSink(recorder record.EventRecorder, clk clock.Clock, window time.Duration) *EventSink {
+	return &EventSink{
+		recorder: recorder,
+		clock:    clk,
+		window:   window,
+		windows:  make(map[eventKey]*eventWindow),
+	}
+}
+
+// Name implements interfaces.DecisionSink.
+func (s *EventSink) Name() string {
+	return "event"
+}
+
+// Emit implements interfaces.DecisionSink.
+func (s *EventSink) Emit(_ context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	if decision.Action == interfaces.ActionNoChange || decision.TargetReplicas == decision.DesiredReplicas {
+		return nil
+	}
+
+	suppressed, ok := s.admit(eventKey{
+		va:         types.NamespacedName{Namespace: va.Namespace, Name: va.Name},
+		previous:   decision.DesiredReplicas,
+		target:     decision.TargetReplicas,
+		reasonCode: decision.ReasonCode,
+	})
+	if !ok {
+		return nil
+	}
+
+	reason := EventReasonScaledUp
+	if decision.TargetReplicas < decision.DesiredReplicas {
+		reason = EventReasonScaledDown
+	}
+	message := fmt.Sprintf("Desired replicas %d -> %d (%s): %s",
+		decision.DesiredReplicas, decision.TargetReplicas, decision.ReasonCode, decision.Reason)
+	if suppressed > 0 {
+		message = fmt.Sprintf("%s (repeated %d more times in the last %s)", message, suppressed, s.window)
+	}
+	s.recorder.Event(va, corev1.EventTypeNormal, reason, message)
+	return nil
+}
+
+// Forget drops the aggregation windows of a VA, e.g. once it is deleted.
+func (s *EventSink) Forget(va types.NamespacedName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.windows {
+		if key.va == va {
+			delete(s.windows, key)
+		}
+	}
+}
+
+// Retain drops the aggregation windows of every VA not in vas, e.g. VAs that no longer exist.
+func (s *EventSink) Retain(vas map[types.NamespacedName]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.windows {
+		if !vas[key.va] {
+			delete(s.windows, key)
+		}
+	}
+}
+
+// admit reports whether a decision with the given key should be recorded and how many
+// identical decisions were suppressed in the window it closes.
+func (s *EventSink) admit(key eventKey) (int, bool) {
+	if s.window <= 0 {
+		return 0, true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	// Drop windows that ended without a repeat so the map does not grow with every VA and
+	// target. Windows with suppressed repeats are kept to report them on the next event.
+	for k, w := range s.windows {
+		if w.suppressed == 0 && now.Sub(w.start) >= s.window {
+			delete(s.windows, k)
+		}
+	}
+
+	w, ok := s.windows[key]
+	if ok && now.Sub(w.start) < s.window {
+		w.suppressed++
+		return 0, false
+	}
+	var suppressed int
+	if ok {
+		suppressed = w.suppressed
+	}
+	s.windows[key] = &eventWindow{start: now}
+	return suppressed, true
+}
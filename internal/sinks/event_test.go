@@ -0,0 +1,113 @@
+package sinks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// drainEvents returns the events recorded so far.
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestEventSink_AggregatesIdenticalDecisions(t *testing.T) {
+	recorder := record.NewFakeRecorder(100)
+	clk := clocktesting.NewFakeClock(time.Now())
+	sink := NewEventSink(recorder, clk, 5*time.Minute)
+	va := newVA("va-1")
+
+	scaleUp := interfaces.VariantDecision{
+		Action: interfaces.ActionScaleUp, DesiredReplicas: 2, TargetReplicas: 3,
+		ReasonCode: interfaces.ReasonCodeKvSpareLow, Reason: "KV spare capacity low",
+	}
+	scaleDown := interfaces.VariantDecision{
+		Action: interfaces.ActionScaleDown, DesiredReplicas: 3, TargetReplicas: 2,
+		ReasonCode: interfaces.ReasonCodeScaleDownSafe, Reason: "scale-down safe",
+	}
+
+	// A flapping model repeats the same two decisions every 30s cycle
+	for i := 0; i < 8; i++ {
+		for _, decision := range []interfaces.VariantDecision{scaleUp, scaleDown} {
+			if err := sink.Emit(context.Background(), va, decision); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		clk.Step(30 * time.Second)
+	}
+	events := drainEvents(recorder)
+	if len(events) != 2 {
+		t.Fatalf("expected one event per distinct decision in the window, got %d: %v", len(events), events)
+	}
+	if !strings.HasPrefix(events[0], "Normal ScaledUp Desired replicas 2 -> 3 (KvSpareLow)") ||
+		!strings.HasPrefix(events[1], "Normal ScaledDown Desired replicas 3 -> 2 (ScaleDownSafe)") {
+		t.Errorf("unexpected events: %v", events)
+	}
+
+	// After the window the next identical decision records an event that reports the repeats
+	clk.Step(5 * time.Minute)
+	if err := sink.Emit(context.Background(), va, scaleUp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	events = drainEvents(recorder)
+	if len(events) != 1 || !strings.Contains(events[0], "(repeated 7 more times in the last 5m0s)") {
+		t.Errorf("expected an aggregated event, got %v", events)
+	}
+
+	// Decisions for another VA or with another target are not folded into the window
+	other := scaleUp
+	other.TargetReplicas = 4
+	for _, emit := range []func() error{
+		func() error { return sink.Emit(context.Background(), newVA("va-2"), scaleUp) },
+		func() error { return sink.Emit(context.Background(), va, other) },
+	} {
+		if err := emit(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if events := drainEvents(recorder); len(events) != 2 {
+		t.Errorf("expected an event per distinct decision, got %d: %v", len(events), events)
+	}
+}
+
+func TestEventSink_SkipsUnchangedDecisions(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	sink := NewEventSink(recorder, clocktesting.NewFakeClock(time.Now()), 0)
+
+	for _, decision := range []interfaces.VariantDecision{
+		{Action: interfaces.ActionNoChange, DesiredReplicas: 2, TargetReplicas: 2},
+		{Action: interfaces.ActionScaleUp, DesiredReplicas: 3, TargetReplicas: 3},
+	} {
+		if err := sink.Emit(context.Background(), newVA("va-1"), decision); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if events := drainEvents(recorder); len(events) != 0 {
+		t.Errorf("expected no events for decisions that keep the desired replicas, got %v", events)
+	}
+
+	// Without a window every change is recorded
+	change := interfaces.VariantDecision{Action: interfaces.ActionScaleUp, DesiredReplicas: 2, TargetReplicas: 3}
+	for i := 0; i < 3; i++ {
+		if err := sink.Emit(context.Background(), newVA("va-1"), change); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if events := drainEvents(recorder); len(events) != 3 {
+		t.Errorf("expected an event per decision without aggregation, got %d", len(events))
+	}
+}
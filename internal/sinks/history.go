@@ -0,0 +1,144 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// DefaultDecisionHistorySize is how many decisions per VA the history sink keeps by default.
+const DefaultDecisionHistorySize = 50
+
+// DecisionHistoryPath is the path under which the controller serves the decision history.
+const DecisionHistoryPath = "/debug/decisions"
+
+// decisionRing is a fixed-size ring buffer of the latest decisions for one VA.
+type decisionRing struct {
+	entries []WebhookPayload
+	next    int
+	full    bool
+}
+
+func (r *decisionRing) add(entry WebhookPayload) {
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// list returns the retained decisions, oldest first.
+func (r *decisionRing) list() []WebhookPayload {
+	if !r.full {
+		return append([]WebhookPayload(nil), r.entries[:r.next]...)
+	}
+	return append(append([]WebhookPayload(nil), r.entries[r.next:]...), r.entries[:r.next]...)
+}
+
+// HistorySink keeps the last decisions of each VA in memory for post-incident analysis,
+// complementing the decision cache, which only holds the current one. It serves them as
+// JSON over HTTP. Decisions of every cycle are kept, including those that do not change
+// the desired replicas. It is safe for concurrent use.
+type HistorySink struct {
+	size int
+
+	mu    sync.RWMutex
+	rings map[types.NamespacedName]*decisionRing
+}
+
+var (
+	_ interfaces.DecisionSink = &HistorySink{}
+	_ http.Handler            = &HistorySink{}
+)
+
+// NewHistorySink creates a sink keeping the last size decisions per VA.
+// A size < 1 keeps a single decision.
+func NewHistorySink(size int) *HistorySink {
+	return &HistorySink{
+		size:  max(size, 1),
+		rings: make(map[types.NamespacedName]*decisionRing),
+	}
+}
+
+// Name implements interfaces.DecisionSink.
+func (s *HistorySink) Name() string {
+	return "history"
+}
+
+// Emit implements interfaces.DecisionSink.
+func (s *HistorySink) Emit(_ context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	key := types.NamespacedName{Namespace: va.Namespace, Name: va.Name}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ring, ok := s.rings[key]
+	if !ok {
+		ring = &decisionRing{entries: make([]WebhookPayload, s.size)}
+		s.rings[key] = ring
+	}
+	ring.add(newWebhookPayload(va, decision))
+	return nil
+}
+
+// History returns the retained decisions of a VA, oldest first.
+func (s *HistorySink) History(namespace, name string) []WebhookPayload {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ring, ok := s.rings[types.NamespacedName{Namespace: namespace, Name: name}]
+	if !ok {
+		return nil
+	}
+	return ring.list()
+}
+
+// Forget drops the retained decisions of a VA, e.g. once it is deleted.
+func (s *HistorySink) Forget(va types.NamespacedName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.rings, va)
+}
+
+// Retain drops the retained decisions of every VA not in vas, e.g. VAs that no longer exist.
+func (s *HistorySink) Retain(vas map[types.NamespacedName]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.rings {
+		if !vas[key] {
+			delete(s.rings, key)
+		}
+	}
+}
+
+// ServeHTTP writes the retained decisions as a JSON object keyed by "namespace/name", each
+// holding that VA's decisions oldest first. The namespace and name query parameters restrict
+// the output to matching VAs.
+func (s *HistorySink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	namespace := r.URL.Query().Get("namespace")
+	name := r.URL.Query().Get("name")
+
+	out := make(map[string][]WebhookPayload)
+	s.mu.RLock()
+	for key, ring := range s.rings {
+		if (namespace != "" && key.Namespace != namespace) || (name != "" && key.Name != name) {
+			continue
+		}
+		out[key.String()] = ring.list()
+	}
+	s.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
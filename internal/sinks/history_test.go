@@ -0,0 +1,81 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+func TestHistorySink_RetainsLastDecisions(t *testing.T) {
+	sink := NewHistorySink(3)
+	va := newVA("va-1")
+
+	for target := 1; target <= 5; target++ {
+		decision := interfaces.VariantDecision{Action: interfaces.ActionScaleUp, DesiredReplicas: target - 1, TargetReplicas: target}
+		if err := sink.Emit(context.Background(), va, decision); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		history := sink.History("default", "va-1")
+		if want := min(target, 3); len(history) != want {
+			t.Fatalf("after %d decisions: expected %d retained, got %d", target, want, len(history))
+		}
+	}
+
+	// The two oldest decisions were dropped; the rest are oldest first
+	history := sink.History("default", "va-1")
+	for i, want := range []int{3, 4, 5} {
+		if history[i].TargetReplicas != want {
+			t.Errorf("history[%d].TargetReplicas = %d, want %d", i, history[i].TargetReplicas, want)
+		}
+	}
+
+	if history := sink.History("default", "va-2"); history != nil {
+		t.Errorf("expected no history for an unknown VA, got %v", history)
+	}
+}
+
+func TestHistorySink_ServeHTTP(t *testing.T) {
+	sink := NewHistorySink(2)
+	for _, name := range []string{"va-1", "va-2"} {
+		if err := sink.Emit(context.Background(), newVA(name), interfaces.VariantDecision{
+			Action: interfaces.ActionScaleDown, DesiredReplicas: 3, TargetReplicas: 2, ReasonCode: interfaces.ReasonCodeScaleDownSafe,
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	get := func(url string) map[string][]WebhookPayload {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		sink.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET %s: status %d", url, rec.Code)
+		}
+		var out map[string][]WebhookPayload
+		if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+			t.Fatalf("GET %s: invalid JSON: %v", url, err)
+		}
+		return out
+	}
+
+	if out := get(DecisionHistoryPath); len(out) != 2 {
+		t.Errorf("expected the history of both VAs, got %v", out)
+	}
+	out := get(DecisionHistoryPath + "?namespace=default&name=va-2")
+	if len(out) != 1 || len(out["default/va-2"]) != 1 {
+		t.Fatalf("expected only va-2's history, got %v", out)
+	}
+	if got := out["default/va-2"][0]; got.TargetReplicas != 2 || got.ReasonCode != string(interfaces.ReasonCodeScaleDownSafe) {
+		t.Errorf("unexpected decision: %+v", got)
+	}
+
+	rec := httptest.NewRecorder()
+	sink.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, DecisionHistoryPath, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected POST to be rejected, got status %d", rec.Code)
+	}
+}
@@ -0,0 +1,39 @@
+package sinks
+
+import (
+	"context"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// LogSink logs each decision through the engine logger.
+// Decisions that change the desired replicas are logged at the default level, others at DEBUG.
+type LogSink struct{}
+
+var _ interfaces.DecisionSink = LogSink{}
+
+// Name implements interfaces.DecisionSink.
+func (LogSink) Name() string {
+	return "log"
+}
+
+// Emit implements interfaces.DecisionSink.
+func (LogSink) Emit(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	logger := logging.FromContext(ctx, logging.Engine)
+	if decision.Action == interfaces.ActionNoChange || decision.TargetReplicas == decision.DesiredReplicas {
+		logger = logger.V(logging.DEBUG)
+	}
+	logger.Info("Scaling decision",
+		"variant", va.Name,
+		"namespace", va.Namespace,
+		"modelID", decision.ModelID,
+		"accelerator", decision.AcceleratorName,
+		"action", decision.Action,
+		"previousDesired", decision.DesiredReplicas,
+		"target", decision.TargetReplicas,
+		"reasonCode", decision.ReasonCode,
+		"reason", decision.Reason)
+	return nil
+}
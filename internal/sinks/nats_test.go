@@ -0,0 +1,240 @@
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// natsConnect is the subset of a client's CONNECT message fakeNATSServer records.
+type natsConnect struct {
+	User      string `json:"user"`
+	Pass      string `json:"pass"`
+	AuthToken string `json:"auth_token"`
+}
+
+// natsMessage is a message received by fakeNATSServer.
+type natsMessage struct {
+	subject string
+	data    []byte
+}
+
+// fakeNATSServer speaks enough of the NATS client protocol to accept connections and
+// record published messages.
+type fakeNATSServer struct {
+	listener   net.Listener
+	connectErr string
+
+	mu       sync.Mutex
+	connects []natsConnect
+	messages []natsMessage
+}
+
+// newFakeNATSServer starts a server on a local port. A non-empty connectErr is sent as -ERR
+// in reply to every CONNECT.
+func newFakeNATSServer(t *testing.T, connectErr string) *fakeNATSServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := &fakeNATSServer{listener: listener, connectErr: connectErr}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeNATSServer) url() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+func (s *fakeNATSServer) serve(conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+	reader := bufio.NewReader(conn)
+	if _, err := io.WriteString(conn, `INFO {"server_id":"fake","max_payload":1048576}`+"\r\n"); err != nil {
+		return
+	}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		op, args, _ := strings.Cut(strings.TrimRight(line, "\r\n"), " ")
+		switch op {
+		case "CONNECT":
+			var connect natsConnect
+			_ = json.Unmarshal([]byte(args), &connect)
+			s.mu.Lock()
+			s.connects = append(s.connects, connect)
+			s.mu.Unlock()
+			if s.connectErr != "" {
+				_, _ = io.WriteString(conn, "-ERR '"+s.connectErr+"'\r\n")
+				return
+			}
+		case "PING":
+			_, _ = io.WriteString(conn, "PONG\r\n")
+		case "PUB":
+			fields := strings.Fields(args)
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2) // payload and its CRLF
+			if _, err := io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, natsMessage{subject: fields[0], data: payload[:size]})
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *fakeNATSServer) received() []natsMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]natsMessage(nil), s.messages...)
+}
+
+func TestNATSSink_PublishesEveryDecision(t *testing.T) {
+	server := newFakeNATSServer(t, "")
+	sink, err := NewNATSSink(server.url(), "wva.decisions")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decisions := []interfaces.VariantDecision{
+		{VariantName: "va-1", ModelID: "llama", Action: interfaces.ActionScaleUp, DesiredReplicas: 1, TargetReplicas: 2,
+			ReasonCode: interfaces.ReasonCodeKvSpareLow},
+		{VariantName: "va-2", ModelID: "llama", Action: interfaces.ActionScaleDown, DesiredReplicas: 3, TargetReplicas: 2,
+			ReasonCode: interfaces.ReasonCodeScaleDownSafe},
+	}
+	for _, d := range decisions {
+		// Publish waits for the server to acknowledge it, so the message is recorded when Emit returns
+		if err := sink.Emit(context.Background(), newVA(d.VariantName), d); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	messages := server.received()
+	if len(messages) != len(decisions) {
+		t.Fatalf("expected %d messages, got %d", len(decisions), len(messages))
+	}
+	for i, d := range decisions {
+		if messages[i].subject != "wva.decisions" {
+			t.Errorf("message %d: expected subject wva.decisions, got %s", i, messages[i].subject)
+		}
+		var payload WebhookPayload
+		if err := json.Unmarshal(messages[i].data, &payload); err != nil {
+			t.Fatalf("message %d: invalid payload: %v", i, err)
+		}
+		if payload.Name != d.VariantName || payload.TargetReplicas != d.TargetReplicas ||
+			payload.PreviousDesired != d.DesiredReplicas || payload.ReasonCode != string(d.ReasonCode) {
+			t.Errorf("message %d: unexpected payload %+v", i, payload)
+		}
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("unexpected error closing the sink: %v", err)
+	}
+	if err := sink.Emit(context.Background(), newVA("va-3"), decisions[0]); err == nil {
+		t.Error("expected an emit after Close to fail")
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if len(server.connects) != 1 {
+		t.Errorf("expected decisions to share one connection and Close not to reconnect, got %d connects", len(server.connects))
+	}
+}
+
+func TestNATSPublisher_SendsCredentials(t *testing.T) {
+	server := newFakeNATSServer(t, "")
+	address := strings.TrimPrefix(server.url(), "nats://")
+
+	tests := []struct {
+		url      string
+		expected natsConnect
+	}{
+		{url: "nats://wva:secret@" + address, expected: natsConnect{User: "wva", Pass: "secret"}},
+		{url: "nats://s3cr3t@" + address, expected: natsConnect{AuthToken: "s3cr3t"}},
+	}
+	for _, tt := range tests {
+		publisher, err := NewNATSPublisher(tt.url)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := publisher.Publish(context.Background(), "wva.decisions", []byte("{}")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_ = publisher.Close()
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if len(server.connects) != len(tests) {
+		t.Fatalf("expected %d connects, got %d", len(tests), len(server.connects))
+	}
+	for i, tt := range tests {
+		c := server.connects[i]
+		if c.User != tt.expected.User || c.Pass != tt.expected.Pass || c.AuthToken != tt.expected.AuthToken {
+			t.Errorf("%s: expected credentials %+v, got %+v", tt.url, tt.expected, c)
+		}
+	}
+}
+
+func TestNATSPublisher_Errors(t *testing.T) {
+	server := newFakeNATSServer(t, "Authorization Violation")
+	publisher, err := NewNATSPublisher(server.url())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer publisher.Close() //nolint:errcheck
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if err := publisher.Publish(ctx, "wva.decisions", []byte("{}")); err == nil ||
+		!strings.Contains(strings.ToLower(err.Error()), "authorization violation") {
+		t.Errorf("expected the server's error, got %v", err)
+	}
+
+	for _, invalid := range []string{"http://nats:4222", "nats://", "://"} {
+		if _, err := NewNATSPublisher(invalid); err == nil {
+			t.Errorf("expected URL %q to be rejected", invalid)
+		}
+	}
+	for _, subject := range []string{"", "wva decisions", "wva.*"} {
+		if _, err := NewNATSSink("nats://nats:4222", subject); err == nil {
+			t.Errorf("expected subject %q to be rejected", subject)
+		}
+	}
+}
+
+func TestTopicSink_ReportsPublishErrors(t *testing.T) {
+	publishErr := errors.New("broker unavailable")
+	sink := NewTopicSink("test", publisherFunc(func(context.Context, string, []byte) error { return publishErr }), "wva.decisions")
+	if err := sink.Emit(context.Background(), newVA("va-1"), interfaces.VariantDecision{}); !errors.Is(err, publishErr) {
+		t.Errorf("expected the publish error, got %v", err)
+	}
+}
+
+// publisherFunc adapts a function to TopicPublisher.
+type publisherFunc func(ctx context.Context, subject string, data []byte) error
+
+func (f publisherFunc) Publish(ctx context.Context, subject string, data []byte) error {
+	return f(ctx, subject, data)
+}
@@ -0,0 +1,92 @@
+package sinks
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/actuator"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// PrometheusSink publishes decisions as the replica metrics consumed by HPA and KEDA, along with
+// the replicas recommended before policies and limits and the decision's confidence, and counts
+// scaling operations by reason code.
+type PrometheusSink struct {
+	actuator    *actuator.Actuator
+	commitDelay *CommitDelay
+}
+
+var _ interfaces.DecisionSink = &PrometheusSink{}
+
+// NewPrometheusSink creates a sink that emits metrics through an actuator using k8sClient.
+func NewPrometheusSink(k8sClient client.Client) *PrometheusSink {
+	return &PrometheusSink{actuator: actuator.NewActuator(k8sClient)}
+}
+
+// WithCommitDelay makes the sink publish a variant's desired replicas through commitDelay, which
+// may be shared with other publishers of the metric such as the engine's safety net. A nil
+// commitDelay publishes every target. It returns s.
+func (s *PrometheusSink) WithCommitDelay(commitDelay *CommitDelay) *PrometheusSink {
+	s.commitDelay = commitDelay
+	return s
+}
+
+// Name implements interfaces.DecisionSink.
+func (s *PrometheusSink) Name() string {
+	return "prometheus"
+}
+
+// Emit publishes current and desired replicas for va, which are emitted on every cycle to keep
+// HPA alive, and increments the scaling counter when the desired replicas actually moved. With a
+// commit delay the previously committed desired replicas are emitted until the new target holds,
+// and the counter only counts the change once it is committed.
+func (s *PrometheusSink) Emit(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	emitted := va
+	var commit CommitResult
+	if s.commitDelay != nil {
+		desired := va.Status.DesiredOptimizedAlloc.NumReplicas
+		commit = s.commitDelay.Commit(CommitKey(va.Namespace, va.Name), desired, string(decision.ReasonCode))
+		if commit.Replicas != desired {
+			logging.FromContext(ctx, logging.Engine).V(logging.DEBUG).Info("Holding desired replicas until the target is stable",
+				"variantName", va.Name, "committedReplicas", commit.Replicas, "targetReplicas", desired)
+			emitted = va.DeepCopy()
+			emitted.Status.DesiredOptimizedAlloc.NumReplicas = commit.Replicas
+		}
+	}
+	if err := s.actuator.EmitMetrics(ctx, emitted); err != nil {
+		return err
+	}
+	// Like the desired replicas, a failure to emit the recommendation does not fail the decision
+	if err := s.actuator.MetricsEmitter.EmitRecommendedReplicas(
+		ctx, va, int32(decision.RecommendedReplicas), va.Status.DesiredOptimizedAlloc.Accelerator); err != nil {
+		logging.FromContext(ctx, logging.Engine).Error(err, "Failed to emit recommended replicas", "variantName", va.Name)
+	}
+	if err := s.actuator.MetricsEmitter.EmitDecisionConfidence(
+		ctx, va, decision.Confidence, va.Status.DesiredOptimizedAlloc.Accelerator); err != nil {
+		logging.FromContext(ctx, logging.Engine).Error(err, "Failed to emit decision confidence", "variantName", va.Name)
+	}
+
+	// A change held back by the commit delay is counted on the cycle it is committed, in the
+	// direction and for the reason it was first computed
+	if s.commitDelay != nil && !commit.First {
+		if !commit.Changed {
+			return nil
+		}
+		direction := "up"
+		if commit.Replicas < commit.Previous {
+			direction = "down"
+		}
+		return s.actuator.MetricsEmitter.EmitReplicaScalingMetrics(ctx, va, direction, commit.Reason)
+	}
+	if decision.Action == interfaces.ActionNoChange || decision.TargetReplicas == decision.DesiredReplicas {
+		return nil
+	}
+	direction := "up"
+	if decision.TargetReplicas < decision.DesiredReplicas {
+		direction = "down"
+	}
+	return s.actuator.MetricsEmitter.EmitReplicaScalingMetrics(ctx, va, direction, string(decision.ReasonCode))
+}
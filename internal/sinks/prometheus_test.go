@@ -0,0 +1,279 @@
+package sinks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
+)
+
+// gaugeValue returns the value of the named gauge's single series in registry.
+func gaugeValue(t *testing.T, registry *prometheus.Registry, name string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() == name && len(family.GetMetric()) == 1 {
+			return family.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	t.Fatalf("expected one series of %s", name)
+	return 0
+}
+
+// counterTotal returns the sum of the named counter's series in registry.
+func counterTotal(t *testing.T, registry *prometheus.Registry, name string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	total := 0.0
+	for _, family := range families {
+		if family.GetName() == name {
+			for _, metric := range family.GetMetric() {
+				total += metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return total
+}
+
+// variantGaugeValue returns the value of the named gauge's series for variantName in registry.
+func variantGaugeValue(t *testing.T, registry *prometheus.Registry, name, variantName string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, pair := range metric.GetLabel() {
+				if pair.GetName() == constants.LabelVariantName && pair.GetValue() == variantName {
+					return metric.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	t.Fatalf("expected a series of %s for %s", name, variantName)
+	return 0
+}
+
+func TestPrometheusSink_RecommendedAndDesiredReplicas(t *testing.T) {
+	t.Setenv(metrics.ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-decode", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+	sink := NewPrometheusSink(fake.NewClientBuilder().WithObjects(deploy).Build())
+
+	tests := []struct {
+		name        string
+		recommended int
+		desired     int
+	}{
+		{name: "clamp binding", recommended: 5, desired: 3},
+		{name: "no clamp", recommended: 3, desired: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			va := newVA("llama-va")
+			va.Spec.ScaleTargetRef = autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"}
+			va.Status.DesiredOptimizedAlloc = llmdOptv1alpha1.OptimizedAlloc{NumReplicas: tt.desired, Accelerator: "H100"}
+
+			decision := interfaces.VariantDecision{
+				VariantName:         "llama-decode",
+				Action:              interfaces.ActionScaleUp,
+				DesiredReplicas:     2,
+				TargetReplicas:      tt.desired,
+				RecommendedReplicas: tt.recommended,
+				ReasonCode:          interfaces.ReasonCodeKvSpareLow,
+			}
+			if err := sink.Emit(context.Background(), va, decision); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := gaugeValue(t, registry, constants.WVARecommendedReplicas); got != float64(tt.recommended) {
+				t.Errorf("expected %s %d, got %v", constants.WVARecommendedReplicas, tt.recommended, got)
+			}
+			if got := gaugeValue(t, registry, constants.WVADesiredReplicas); got != float64(tt.desired) {
+				t.Errorf("expected %s %d, got %v", constants.WVADesiredReplicas, tt.desired, got)
+			}
+		})
+	}
+}
+
+func TestPrometheusSink_DecisionConfidence(t *testing.T) {
+	t.Setenv(metrics.ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-decode", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+	sink := NewPrometheusSink(fake.NewClientBuilder().WithObjects(deploy).Build())
+	va := newVA("llama-va")
+	va.Spec.ScaleTargetRef = autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"}
+	va.Status.DesiredOptimizedAlloc = llmdOptv1alpha1.OptimizedAlloc{NumReplicas: 2, Accelerator: "H100"}
+
+	for _, confidence := range []float64{1, 0.25} {
+		decision := interfaces.VariantDecision{
+			VariantName:     "llama-decode",
+			Action:          interfaces.ActionNoChange,
+			DesiredReplicas: 2,
+			TargetReplicas:  2,
+			Confidence:      confidence,
+		}
+		if err := sink.Emit(context.Background(), va, decision); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := gaugeValue(t, registry, constants.WVADecisionConfidence); got != confidence {
+			t.Errorf("expected %s %v, got %v", constants.WVADecisionConfidence, confidence, got)
+		}
+	}
+}
+
+func TestPrometheusSink_CommitDelay(t *testing.T) {
+	t.Setenv(metrics.ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-decode", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	sink := NewPrometheusSink(fake.NewClientBuilder().WithObjects(deploy).Build()).WithCommitDelay(NewCommitDelay(clk, time.Minute))
+
+	va := newVA("llama-va")
+	va.Spec.ScaleTargetRef = autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"}
+
+	// Each step computes a target, emits it after advancing the clock, and expects the emitted desired
+	// replicas and the scaling operations counted so far
+	steps := []struct {
+		name     string
+		advance  time.Duration
+		target   int
+		emitted  int
+		scalings float64
+	}{
+		{name: "first target is committed immediately", target: 2, emitted: 2},
+		{name: "new target is held", advance: 30 * time.Second, target: 4, emitted: 2},
+		{name: "changed target restarts the wait", advance: 30 * time.Second, target: 5, emitted: 2},
+		{name: "target not yet stable for the delay", advance: 59 * time.Second, target: 5, emitted: 2},
+		{name: "target stable for the delay is committed", advance: time.Second, target: 5, emitted: 5, scalings: 1},
+		{name: "committed target is counted once", advance: 30 * time.Second, target: 5, emitted: 5, scalings: 1},
+		{name: "scale-down target is held", advance: 30 * time.Second, target: 3, emitted: 5, scalings: 1},
+		{name: "return to the committed target cancels the pending change", advance: 30 * time.Second, target: 5, emitted: 5, scalings: 1},
+		{name: "cancelled change does not commit", advance: time.Minute, target: 5, emitted: 5, scalings: 1},
+	}
+	for _, step := range steps {
+		clk.SetTime(clk.Now().Add(step.advance))
+		va.Status.DesiredOptimizedAlloc = llmdOptv1alpha1.OptimizedAlloc{NumReplicas: step.target, Accelerator: "H100"}
+		decision := interfaces.VariantDecision{
+			VariantName:     "llama-decode",
+			Action:          interfaces.ActionScaleUp,
+			DesiredReplicas: 2,
+			TargetReplicas:  step.target,
+			ReasonCode:      interfaces.ReasonCodeKvSpareLow,
+		}
+		if err := sink.Emit(context.Background(), va, decision); err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+		if got := gaugeValue(t, registry, constants.WVADesiredReplicas); got != float64(step.emitted) {
+			t.Errorf("%s: expected %s %d, got %v", step.name, constants.WVADesiredReplicas, step.emitted, got)
+		}
+		if got := counterTotal(t, registry, constants.WVAReplicaScalingTotal); got != step.scalings {
+			t.Errorf("%s: expected %v scaling operations, got %v", step.name, step.scalings, got)
+		}
+	}
+	if va.Status.DesiredOptimizedAlloc.NumReplicas != 5 {
+		t.Errorf("expected the VA status to be left unchanged, got %d", va.Status.DesiredOptimizedAlloc.NumReplicas)
+	}
+}
+
+func TestPrometheusSink_LinkedScaleTargets(t *testing.T) {
+	t.Setenv(metrics.ControllerInstanceEnvVar, "")
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-decode", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+	router := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-router", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+		Status:     appsv1.DeploymentStatus{Replicas: 1},
+	}
+	sink := NewPrometheusSink(fake.NewClientBuilder().WithObjects(deploy, router).Build())
+
+	va := newVA("llama-va")
+	va.Spec.ScaleTargetRef = autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama-decode"}
+	va.Spec.LinkedScaleTargets = []llmdOptv1alpha1.LinkedScaleTarget{{Name: "llama-router", Ratio: "0.5"}}
+
+	tests := []struct {
+		desired int
+		linked  int
+	}{
+		{desired: 4, linked: 2},
+		{desired: 5, linked: 3}, // rounded up
+		{desired: 1, linked: 1},
+		{desired: 0, linked: 0},
+	}
+	for _, tt := range tests {
+		va.Status.DesiredOptimizedAlloc = llmdOptv1alpha1.OptimizedAlloc{NumReplicas: tt.desired, Accelerator: "H100"}
+		decision := interfaces.VariantDecision{
+			VariantName:     "llama-decode",
+			Action:          interfaces.ActionNoChange,
+			DesiredReplicas: tt.desired,
+			TargetReplicas:  tt.desired,
+		}
+		if err := sink.Emit(context.Background(), va, decision); err != nil {
+			t.Fatalf("desired %d: unexpected error: %v", tt.desired, err)
+		}
+
+		if got := variantGaugeValue(t, registry, constants.WVADesiredReplicas, "llama-va"); got != float64(tt.desired) {
+			t.Errorf("expected variant %s %d, got %v", constants.WVADesiredReplicas, tt.desired, got)
+		}
+		if got := variantGaugeValue(t, registry, constants.WVADesiredReplicas, "llama-router"); got != float64(tt.linked) {
+			t.Errorf("desired %d: expected linked %s %d, got %v", tt.desired, constants.WVADesiredReplicas, tt.linked, got)
+		}
+		if got := variantGaugeValue(t, registry, constants.WVACurrentReplicas, "llama-router"); got != 1 {
+			t.Errorf("expected linked %s 1, got %v", constants.WVACurrentReplicas, got)
+		}
+	}
+}
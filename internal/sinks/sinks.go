@@ -0,0 +1,92 @@
+// Package sinks provides DecisionSink implementations and fans scaling decisions out to them.
+package sinks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// FanOut delivers each decision to all registered sinks, in registration order.
+// A failing sink does not prevent the remaining sinks from receiving the decision.
+// It is safe for concurrent use.
+type FanOut struct {
+	mu    sync.RWMutex
+	sinks []interfaces.DecisionSink
+}
+
+var _ interfaces.DecisionSink = &FanOut{}
+
+// vaStateSink is implemented by sinks that keep per-VA state, so that the state of deleted VAs
+// can be dropped.
+type vaStateSink interface {
+	Forget(va types.NamespacedName)
+	Retain(vas map[types.NamespacedName]bool)
+}
+
+var (
+	_ vaStateSink = &HistorySink{}
+	_ vaStateSink = &EventSink{}
+	_ vaStateSink = &AsyncSink{}
+)
+
+// NewFanOut creates a fan-out over the given sinks.
+func NewFanOut(sinks ...interfaces.DecisionSink) *FanOut {
+	return &FanOut{sinks: sinks}
+}
+
+// Register adds a sink that receives all subsequent decisions.
+func (f *FanOut) Register(sink interfaces.DecisionSink) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sinks = append(f.sinks, sink)
+}
+
+// Sinks returns the registered sinks.
+func (f *FanOut) Sinks() []interfaces.DecisionSink {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return append([]interfaces.DecisionSink(nil), f.sinks...)
+}
+
+// Name implements interfaces.DecisionSink.
+func (f *FanOut) Name() string {
+	return "fan-out"
+}
+
+// Emit sends the decision to every registered sink and returns the joined errors
+// of the sinks that failed, each prefixed with the sink name.
+func (f *FanOut) Emit(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	var errs []error
+	for _, sink := range f.Sinks() {
+		if err := sink.Emit(ctx, va, decision); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Forget drops the state a registered sink keeps for a VA, e.g. once it is deleted.
+func (f *FanOut) Forget(va types.NamespacedName) {
+	for _, sink := range f.Sinks() {
+		if stateful, ok := sink.(vaStateSink); ok {
+			stateful.Forget(va)
+		}
+	}
+}
+
+// Retain drops the state registered sinks keep for every VA not in vas, e.g. VAs that no
+// longer exist.
+func (f *FanOut) Retain(vas map[types.NamespacedName]bool) {
+	for _, sink := range f.Sinks() {
+		if stateful, ok := sink.(vaStateSink); ok {
+			stateful.Retain(vas)
+		}
+	}
+}
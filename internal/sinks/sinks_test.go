@@ -0,0 +1,210 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// recordingSink records the decisions it receives and optionally fails every emit.
+type recordingSink struct {
+	name      string
+	err       error
+	decisions []interfaces.VariantDecision
+}
+
+func (s *recordingSink) Name() string { return s.name }
+
+func (s *recordingSink) Emit(_ context.Context, _ *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	s.decisions = append(s.decisions, decision)
+	return s.err
+}
+
+func newVA(name string) *llmdOptv1alpha1.VariantAutoscaling {
+	return &llmdOptv1alpha1.VariantAutoscaling{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+}
+
+func TestFanOut_EverySinkReceivesEveryDecision(t *testing.T) {
+	first := &recordingSink{name: "first"}
+	second := &recordingSink{name: "second"}
+	fanOut := NewFanOut(first)
+	fanOut.Register(second)
+
+	decisions := []interfaces.VariantDecision{
+		{VariantName: "va-1", Action: interfaces.ActionScaleUp, DesiredReplicas: 1, TargetReplicas: 2},
+		{VariantName: "va-2", Action: interfaces.ActionNoChange, DesiredReplicas: 3, TargetReplicas: 3},
+	}
+	for _, d := range decisions {
+		if err := fanOut.Emit(context.Background(), newVA(d.VariantName), d); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	for _, sink := range []*recordingSink{first, second} {
+		if len(sink.decisions) != len(decisions) {
+			t.Fatalf("%s: expected %d decisions, got %d", sink.name, len(decisions), len(sink.decisions))
+		}
+		for i, d := range decisions {
+			if sink.decisions[i].VariantName != d.VariantName {
+				t.Errorf("%s: expected decision %d for %s, got %s", sink.name, i, d.VariantName, sink.decisions[i].VariantName)
+			}
+		}
+	}
+}
+
+func TestFanOut_SinkErrorDoesNotBlockOthers(t *testing.T) {
+	failing := &recordingSink{name: "failing", err: errors.New("unavailable")}
+	healthy := &recordingSink{name: "healthy"}
+	fanOut := NewFanOut(failing, healthy)
+
+	err := fanOut.Emit(context.Background(), newVA("va-1"), interfaces.VariantDecision{VariantName: "va-1"})
+	if err == nil {
+		t.Fatal("expected an error from the failing sink")
+	}
+	if !strings.Contains(err.Error(), "failing sink") {
+		t.Errorf("expected error to name the failing sink, got %q", err)
+	}
+	if len(healthy.decisions) != 1 {
+		t.Errorf("expected healthy sink to receive the decision, got %d", len(healthy.decisions))
+	}
+}
+
+func TestFanOut_RetainAndForgetDropVAState(t *testing.T) {
+	history := NewHistorySink(5)
+	events := NewEventSink(record.NewFakeRecorder(10), clocktesting.NewFakeClock(time.Now()), time.Minute)
+	fanOut := NewFanOut(LogSink{}, history, events)
+
+	decision := interfaces.VariantDecision{Action: interfaces.ActionScaleUp, DesiredReplicas: 1, TargetReplicas: 2}
+	for _, name := range []string{"va-1", "va-2", "va-3"} {
+		if err := fanOut.Emit(context.Background(), newVA(name), decision); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	fanOut.Retain(map[types.NamespacedName]bool{
+		{Namespace: "default", Name: "va-1"}: true,
+		{Namespace: "default", Name: "va-2"}: true,
+	})
+	fanOut.Forget(types.NamespacedName{Namespace: "default", Name: "va-2"})
+
+	for name, kept := range map[string]bool{"va-1": true, "va-2": false, "va-3": false} {
+		if got := history.History("default", name) != nil; got != kept {
+			t.Errorf("%s: expected history kept=%v, got %v", name, kept, got)
+		}
+	}
+	if len(events.windows) != 1 {
+		t.Errorf("expected only the va-1 event window to be kept, got %d", len(events.windows))
+	}
+}
+
+func TestLogSink_Emit(t *testing.T) {
+	err := LogSink{}.Emit(context.Background(), newVA("va-1"), interfaces.VariantDecision{Action: interfaces.ActionScaleDown, DesiredReplicas: 3, TargetReplicas: 2})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWebhookSink_Emit(t *testing.T) {
+	var received WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	decision := interfaces.VariantDecision{
+		ModelID:         "llama",
+		AcceleratorName: "A100",
+		Action:          interfaces.ActionScaleUp,
+		DesiredReplicas: 1,
+		TargetReplicas:  2,
+		ReasonCode:      interfaces.ReasonCodeKvSpareLow,
+	}
+	if err := NewWebhookSink(server.URL).Emit(context.Background(), newVA("va-1"), decision); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.Name != "va-1" || received.TargetReplicas != 2 || received.PreviousDesired != 1 || received.ReasonCode != string(interfaces.ReasonCodeKvSpareLow) {
+		t.Errorf("unexpected payload: %+v", received)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	if err := NewWebhookSink(failing.URL).Emit(context.Background(), newVA("va-1"), decision); err == nil {
+		t.Error("expected an error for a non-2xx response")
+	}
+}
+
+// blockingSink blocks every emit until released and reports each decision it receives.
+type blockingSink struct {
+	release  chan struct{}
+	received chan interfaces.VariantDecision
+}
+
+func (s *blockingSink) Name() string { return "blocking" }
+
+func (s *blockingSink) Emit(_ context.Context, _ *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	<-s.release
+	s.received <- decision
+	return nil
+}
+
+func TestAsyncSink_DoesNotBlockAndDropsWhenQueueFull(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slow := &blockingSink{release: make(chan struct{}), received: make(chan interfaces.VariantDecision, 3)}
+	sink := NewAsyncSink(ctx, slow, 1)
+
+	// The first decision is picked up by the worker, the second fills the queue
+	if err := sink.Emit(ctx, newVA("va-1"), interfaces.VariantDecision{TargetReplicas: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sink.queue) > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if err := sink.Emit(ctx, newVA("va-1"), interfaces.VariantDecision{TargetReplicas: 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := sink.Emit(ctx, newVA("va-1"), interfaces.VariantDecision{TargetReplicas: 3}); err == nil {
+		t.Error("expected an error when the queue is full")
+	}
+
+	close(slow.release)
+	for _, expected := range []int{1, 2} {
+		select {
+		case d := <-slow.received:
+			if d.TargetReplicas != expected {
+				t.Errorf("expected decision with target %d, got %d", expected, d.TargetReplicas)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for decision with target %d", expected)
+		}
+	}
+	select {
+	case d := <-slow.received:
+		t.Errorf("expected the dropped decision not to be delivered, got target %d", d.TargetReplicas)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
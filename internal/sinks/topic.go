@@ -0,0 +1,72 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// TopicPublisher publishes a message to a subject (topic) of a message broker.
+type TopicPublisher interface {
+	Publish(ctx context.Context, subject string, data []byte) error
+}
+
+// TopicSink publishes each decision as a JSON WebhookPayload to a message topic, for
+// event-driven autoscalers that consume decisions from a broker instead of Prometheus.
+type TopicSink struct {
+	name      string
+	subject   string
+	publisher TopicPublisher
+}
+
+var _ interfaces.DecisionSink = &TopicSink{}
+
+// NewTopicSink creates a sink named name publishing to subject through publisher.
+func NewTopicSink(name string, publisher TopicPublisher, subject string) *TopicSink {
+	return &TopicSink{
+		name:      name,
+		subject:   subject,
+		publisher: publisher,
+	}
+}
+
+// NewNATSSink creates a sink publishing to subject on the NATS server at url.
+func NewNATSSink(url, subject string) (*TopicSink, error) {
+	if err := validateNATSSubject(subject); err != nil {
+		return nil, err
+	}
+	publisher, err := NewNATSPublisher(url)
+	if err != nil {
+		return nil, err
+	}
+	return NewTopicSink("nats", publisher, subject), nil
+}
+
+// Name implements interfaces.DecisionSink.
+func (s *TopicSink) Name() string {
+	return s.name
+}
+
+// Close closes the sink's publisher when it holds a connection, e.g. to a NATS server.
+func (s *TopicSink) Close() error {
+	if closer, ok := s.publisher.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Emit implements interfaces.DecisionSink.
+func (s *TopicSink) Emit(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	body, err := json.Marshal(newWebhookPayload(va, decision))
+	if err != nil {
+		return fmt.Errorf("failed to encode decision: %w", err)
+	}
+	if err := s.publisher.Publish(ctx, s.subject, body); err != nil {
+		return fmt.Errorf("failed to publish decision to %s: %w", s.subject, err)
+	}
+	return nil
+}
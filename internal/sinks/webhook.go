@@ -0,0 +1,94 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	llmdOptv1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+)
+
+// DefaultWebhookTimeout bounds each webhook POST so a slow receiver cannot stall the engine loop.
+const DefaultWebhookTimeout = 5 * time.Second
+
+// WebhookPayload is the JSON body POSTed by WebhookSink for each decision.
+type WebhookPayload struct {
+	Name            string    `json:"name"`
+	Namespace       string    `json:"namespace"`
+	ModelID         string    `json:"modelID"`
+	Accelerator     string    `json:"accelerator"`
+	Action          string    `json:"action"`
+	CurrentReplicas int       `json:"currentReplicas"`
+	PreviousDesired int       `json:"previousDesiredReplicas"`
+	TargetReplicas  int       `json:"targetReplicas"`
+	ReasonCode      string    `json:"reasonCode"`
+	Reason          string    `json:"reason"`
+	Timestamp       time.Time `json:"timestamp"`
+}
+
+// newWebhookPayload builds the JSON payload of a decision, shared by the webhook and topic sinks.
+func newWebhookPayload(va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) WebhookPayload {
+	return WebhookPayload{
+		Name:            va.Name,
+		Namespace:       va.Namespace,
+		ModelID:         decision.ModelID,
+		Accelerator:     decision.AcceleratorName,
+		Action:          string(decision.Action),
+		CurrentReplicas: decision.CurrentReplicas,
+		PreviousDesired: decision.DesiredReplicas,
+		TargetReplicas:  decision.TargetReplicas,
+		ReasonCode:      string(decision.ReasonCode),
+		Reason:          decision.Reason,
+		Timestamp:       decision.LastRunTime.Time,
+	}
+}
+
+// WebhookSink POSTs each decision as JSON to a URL. Any non-2xx response is an error.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+var _ interfaces.DecisionSink = &WebhookSink{}
+
+// NewWebhookSink creates a sink posting to url with DefaultWebhookTimeout.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: DefaultWebhookTimeout},
+	}
+}
+
+// Name implements interfaces.DecisionSink.
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Emit implements interfaces.DecisionSink.
+func (s *WebhookSink) Emit(ctx context.Context, va *llmdOptv1alpha1.VariantAutoscaling, decision interfaces.VariantDecision) error {
+	body, err := json.Marshal(newWebhookPayload(va, decision))
+	if err != nil {
+		return fmt.Errorf("failed to encode decision: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post decision: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	wvav1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+)
+
+// AcceleratorAliases maps accelerator names, as users label them, to a canonical name.
+// Keys are lower-cased so that lookups are case-insensitive.
+type AcceleratorAliases map[string]string
+
+// ParseAcceleratorAliases builds the alias map from ConfigMap data.
+// Each key is a canonical accelerator name and its value a comma-separated list of aliases:
+//
+//	A100: "a100, NVIDIA-A100-SXM4-80GB, NVIDIA-A100-PCIE-80GB"
+//
+// The canonical name is always an alias of itself. An alias listed under more than one
+// canonical name resolves to the first canonical name in sorted key order.
+func ParseAcceleratorAliases(data map[string]string) AcceleratorAliases {
+	aliases := make(AcceleratorAliases)
+	for _, canonical := range slices.Sorted(maps.Keys(data)) {
+		name := strings.TrimSpace(canonical)
+		if name == "" {
+			continue
+		}
+		for _, alias := range append([]string{name}, strings.Split(data[canonical], ",")...) {
+			key := strings.ToLower(strings.TrimSpace(alias))
+			if key == "" {
+				continue
+			}
+			if _, exists := aliases[key]; !exists {
+				aliases[key] = name
+			}
+		}
+	}
+	return aliases
+}
+
+// Normalize returns the canonical name for an accelerator.
+// Names without an alias are returned unchanged.
+func (a AcceleratorAliases) Normalize(name string) string {
+	if canonical, ok := a[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return canonical
+	}
+	return name
+}
+
+// NormalizeAcceleratorLabels rewrites the accelerator name label of each VA to its canonical name,
+// so that grouping, GPU limiting and cost lookup see one name per accelerator type.
+// The VAs are modified in place and must not be shared with the informer cache.
+func NormalizeAcceleratorLabels(vas []wvav1alpha1.VariantAutoscaling, aliases AcceleratorAliases) {
+	if len(aliases) == 0 {
+		return
+	}
+	key := AcceleratorLabelKey()
+	for i := range vas {
+		acc, exists := vas[i].Labels[key]
+		if !exists {
+			continue
+		}
+		vas[i].Labels[key] = aliases.Normalize(acc)
+	}
+}
+
+// NormalizeAcceleratorNames returns a copy of names with each name rewritten to its canonical
+// name, e.g. for an accelerator allow-list compared with normalized labels. It returns names
+// itself when there are no aliases.
+func NormalizeAcceleratorNames(names []string, aliases AcceleratorAliases) []string {
+	if len(aliases) == 0 || len(names) == 0 {
+		return names
+	}
+	normalized := make([]string, len(names))
+	for i, name := range names {
+		normalized[i] = aliases.Normalize(name)
+	}
+	return normalized
+}
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wvav1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+)
+
+func TestAcceleratorAliases_Normalize(t *testing.T) {
+	aliases := ParseAcceleratorAliases(map[string]string{
+		"A100": "a100, NVIDIA-A100-SXM4-80GB, NVIDIA-A100-PCIE-80GB",
+		"H100": "nvidia-h100-80gb-hbm3",
+	})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "canonical name", input: "A100", expected: "A100"},
+		{name: "lower-case alias", input: "a100", expected: "A100"},
+		{name: "full product name", input: "NVIDIA-A100-SXM4-80GB", expected: "A100"},
+		{name: "alias matched case-insensitively", input: "NVIDIA-H100-80GB-HBM3", expected: "H100"},
+		{name: "canonical name matched case-insensitively", input: "h100", expected: "H100"},
+		{name: "unknown name unchanged", input: "MI300X", expected: "MI300X"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aliases.Normalize(tt.input); got != tt.expected {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	var empty AcceleratorAliases
+	if got := empty.Normalize("a100"); got != "a100" {
+		t.Errorf("expected name unchanged without aliases, got %q", got)
+	}
+}
+
+func TestNormalizeAcceleratorLabels_GroupsAliasedVariants(t *testing.T) {
+	aliases := ParseAcceleratorAliases(map[string]string{
+		"A100": "a100, NVIDIA-A100-SXM4-80GB",
+	})
+
+	newVA := func(name, accelerator string) wvav1alpha1.VariantAutoscaling {
+		return wvav1alpha1.VariantAutoscaling{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{AcceleratorNameLabel: accelerator},
+			},
+			Spec: wvav1alpha1.VariantAutoscalingSpec{ModelID: "llama"},
+		}
+	}
+	vas := []wvav1alpha1.VariantAutoscaling{
+		newVA("va-1", "A100"),
+		newVA("va-2", "a100"),
+		newVA("va-3", "NVIDIA-A100-SXM4-80GB"),
+		{ObjectMeta: metav1.ObjectMeta{Name: "va-4", Namespace: "default"}, Spec: wvav1alpha1.VariantAutoscalingSpec{ModelID: "llama"}},
+	}
+
+	NormalizeAcceleratorLabels(vas, aliases)
+
+	groups := GroupVariantAutoscalingByModel(vas)
+	group, ok := groups["llama|default"]
+	if !ok || len(group) != 4 {
+		t.Fatalf("expected all variants in one model group, got %v", groups)
+	}
+
+	byAccelerator := make(map[string]int)
+	for i := range group {
+		byAccelerator[GetAcceleratorType(&group[i])]++
+	}
+	if byAccelerator["A100"] != 3 {
+		t.Errorf("expected 3 variants on A100, got %v", byAccelerator)
+	}
+	if byAccelerator[""] != 1 {
+		t.Errorf("expected the unlabeled variant to stay unlabeled, got %v", byAccelerator)
+	}
+}
+
+func TestNormalizeAcceleratorNames(t *testing.T) {
+	aliases := ParseAcceleratorAliases(map[string]string{
+		"H100": "NVIDIA-H100-80GB-HBM3",
+	})
+	names := []string{"nvidia-h100-80gb-hbm3", "L4"}
+
+	got := NormalizeAcceleratorNames(names, aliases)
+	if len(got) != 2 || got[0] != "H100" || got[1] != "L4" {
+		t.Errorf("expected [H100 L4], got %v", got)
+	}
+	if names[0] != "nvidia-h100-80gb-hbm3" {
+		t.Errorf("expected the input to be left unchanged, got %v", names)
+	}
+	if got := NormalizeAcceleratorNames(names, nil); len(got) != 2 || got[0] != names[0] {
+		t.Errorf("expected names unchanged without aliases, got %v", got)
+	}
+}
+
+func TestCreateSystemData_ResolvesAliasedAcceleratorCost(t *testing.T) {
+	aliases := ParseAcceleratorAliases(map[string]string{
+		"A100": "a100, NVIDIA-A100-SXM4-80GB",
+	})
+	acceleratorCm := map[string]map[string]string{
+		"NVIDIA-A100-SXM4-80GB": {"device": "NVIDIA-A100-SXM4-80GB", "cost": "40.00"},
+		"MI300X":                {"device": "AMD-MI300X-192G", "cost": "65.00"},
+	}
+
+	systemData := CreateSystemData(acceleratorCm, map[string]string{}, aliases)
+
+	costs := make(map[string]float32)
+	for _, acc := range systemData.Spec.Accelerators.Spec {
+		costs[acc.Name] = acc.Cost
+	}
+
+	// A variant labeled with any alias resolves to the cost configured under another alias
+	if cost, ok := costs[aliases.Normalize("a100")]; !ok || cost != 40 {
+		t.Errorf("expected cost 40 for a100, got %v (accelerators %v)", cost, costs)
+	}
+	if cost, ok := costs["MI300X"]; !ok || cost != 65 {
+		t.Errorf("expected unaliased accelerator to keep its name and cost, got %v", costs)
+	}
+}
+
+func TestCreateSystemData_DeduplicatesAliasedAcceleratorCosts(t *testing.T) {
+	aliases := ParseAcceleratorAliases(map[string]string{
+		"A100": "a100, NVIDIA-A100-PCIE-80GB, NVIDIA-A100-SXM4-80GB",
+	})
+
+	tests := []struct {
+		name          string
+		acceleratorCm map[string]map[string]string
+		expectedCost  float32
+	}{
+		{
+			name: "canonical key wins over aliases",
+			acceleratorCm: map[string]map[string]string{
+				"NVIDIA-A100-PCIE-80GB": {"device": "NVIDIA-A100-PCIE-80GB", "cost": "30.00"},
+				"A100":                  {"device": "NVIDIA-A100-SXM4-80GB", "cost": "40.00"},
+				"NVIDIA-A100-SXM4-80GB": {"device": "NVIDIA-A100-SXM4-80GB", "cost": "50.00"},
+			},
+			expectedCost: 40,
+		},
+		{
+			name: "first alias in sorted order wins without a canonical key",
+			acceleratorCm: map[string]map[string]string{
+				"NVIDIA-A100-SXM4-80GB": {"device": "NVIDIA-A100-SXM4-80GB", "cost": "50.00"},
+				"NVIDIA-A100-PCIE-80GB": {"device": "NVIDIA-A100-PCIE-80GB", "cost": "30.00"},
+			},
+			expectedCost: 30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Repeat to catch any dependence on map iteration order
+			for range 20 {
+				accelerators := CreateSystemData(tt.acceleratorCm, map[string]string{}, aliases).Spec.Accelerators.Spec
+				if len(accelerators) != 1 {
+					t.Fatalf("expected one accelerator entry, got %v", accelerators)
+				}
+				if accelerators[0].Name != "A100" || accelerators[0].Cost != tt.expectedCost {
+					t.Fatalf("expected A100 with cost %v, got %s with cost %v",
+						tt.expectedCost, accelerators[0].Name, accelerators[0].Cost)
+				}
+			}
+		})
+	}
+}
@@ -28,7 +28,7 @@ func BuildAllocationFromMetrics(
 
 	// Accelerator type - strict validation required
 	acc := ""
-	if val, ok := va.Labels["inference.optimization/acceleratorName"]; ok && val != "" {
+	if val := GetAcceleratorType(va); val != "" {
 		acc = val
 	} else {
 		return interfaces.Allocation{},
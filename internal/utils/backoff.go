@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// BackoffPolicy is an exponential retry policy for API reads. After a failed attempt the caller
+// waits Base, doubling the wait after each retry up to Cap, for at most Retries retries.
+// Waits carry up to 10% jitter, like StandardBackoff.
+type BackoffPolicy struct {
+	// Retries is the number of retries after the first attempt
+	Retries int
+	// Base is the wait before the first retry
+	Base time.Duration
+	// Cap is the longest wait between retries; 0 means uncapped
+	Cap time.Duration
+}
+
+// DefaultDeploymentGetBackoff matches StandardBackoff: 5 attempts, 100ms doubling.
+var DefaultDeploymentGetBackoff = BackoffPolicy{
+	Retries: 4,
+	Base:    100 * time.Millisecond,
+}
+
+// deploymentGetBackoff is the policy of GetDeploymentWithBackoff, set by SetDeploymentGetBackoff.
+var deploymentGetBackoff = DefaultDeploymentGetBackoff
+
+// Validate checks that the policy's parameters are usable.
+func (p BackoffPolicy) Validate() error {
+	if p.Retries < 0 {
+		return fmt.Errorf("retries must be >= 0, got %d", p.Retries)
+	}
+	if p.Base < 0 {
+		return fmt.Errorf("base must be >= 0, got %s", p.Base)
+	}
+	if p.Cap < 0 {
+		return fmt.Errorf("cap must be >= 0, got %s", p.Cap)
+	}
+	if p.Cap > 0 && p.Cap < p.Base {
+		return fmt.Errorf("cap (%s) must be >= base (%s)", p.Cap, p.Base)
+	}
+	return nil
+}
+
+// Delay returns the wait before the given retry, counted from 0, without jitter.
+func (p BackoffPolicy) Delay(retry int) time.Duration {
+	delay := p.Base
+	for i := 0; i < retry && (p.Cap <= 0 || delay < p.Cap); i++ {
+		delay *= 2
+	}
+	if p.Cap > 0 && delay > p.Cap {
+		delay = p.Cap
+	}
+	return delay
+}
+
+// SetDeploymentGetBackoff sets the retry policy of GetDeploymentWithBackoff.
+// Call it once during application startup, before any deployment is read.
+func SetDeploymentGetBackoff(policy BackoffPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("invalid deployment get backoff: %w", err)
+	}
+	deploymentGetBackoff = policy
+	return nil
+}
+
+// GetResourceWithPolicy performs a Get operation, retrying transient errors according to policy.
+// NotFound errors are returned immediately. Once the retries are exhausted, the last error is returned.
+func GetResourceWithPolicy[T client.Object](ctx context.Context, c client.Client, objKey client.ObjectKey, obj T, policy BackoffPolicy, resourceType string) error {
+	for retry := 0; ; retry++ {
+		err := c.Get(ctx, objKey, obj)
+		if err == nil || apierrors.IsNotFound(err) || retry >= policy.Retries {
+			return err
+		}
+
+		ctrl.LoggerFrom(ctx).Error(err, "Transient error getting resource, retrying",
+			"resourceType", resourceType,
+			"name", objKey.Name,
+			"namespace", objKey.Namespace,
+			"retry", retry+1,
+			"retries", policy.Retries)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait.Jitter(policy.Delay(retry), 0.1)):
+		}
+	}
+}
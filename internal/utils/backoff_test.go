@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// flakyDeploymentClient returns a client holding one deployment. Its first `failures` Gets
+// return getErr. The returned slice records the time of every Get attempt.
+func flakyDeploymentClient(t *testing.T, failures int, getErr error) (client.Client, *[]time.Time) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "vllm", Namespace: "default"}}
+	var attempts []time.Time
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deploy).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			attempts = append(attempts, time.Now())
+			if len(attempts) <= failures {
+				return getErr
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+	}).Build()
+	return c, &attempts
+}
+
+func setDeploymentGetBackoff(t *testing.T, policy BackoffPolicy) {
+	t.Helper()
+	previous := deploymentGetBackoff
+	if err := SetDeploymentGetBackoff(policy); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { deploymentGetBackoff = previous })
+}
+
+func TestBackoffPolicy_Delay(t *testing.T) {
+	policy := BackoffPolicy{Retries: 5, Base: 10 * time.Millisecond, Cap: 50 * time.Millisecond}
+	expected := []time.Duration{10, 20, 40, 50, 50}
+	for retry, want := range expected {
+		if got := policy.Delay(retry); got != want*time.Millisecond {
+			t.Errorf("Delay(%d) = %s, want %s", retry, got, want*time.Millisecond)
+		}
+	}
+	if got := (BackoffPolicy{Base: 10 * time.Millisecond}).Delay(4); got != 160*time.Millisecond {
+		t.Errorf("uncapped Delay(4) = %s, want 160ms", got)
+	}
+}
+
+func TestSetDeploymentGetBackoff_RejectsInvalidPolicy(t *testing.T) {
+	invalid := []BackoffPolicy{
+		{Retries: -1, Base: time.Millisecond},
+		{Retries: 3, Base: -time.Millisecond},
+		{Retries: 3, Base: 10 * time.Millisecond, Cap: time.Millisecond},
+	}
+	for _, policy := range invalid {
+		if err := SetDeploymentGetBackoff(policy); err == nil {
+			t.Errorf("expected %+v to be rejected", policy)
+		}
+	}
+	if deploymentGetBackoff != DefaultDeploymentGetBackoff {
+		t.Errorf("rejected policy must not replace the current one, got %+v", deploymentGetBackoff)
+	}
+}
+
+func TestGetDeploymentWithBackoff_HonorsConfiguredPolicy(t *testing.T) {
+	policy := BackoffPolicy{Retries: 3, Base: 20 * time.Millisecond, Cap: 30 * time.Millisecond}
+	setDeploymentGetBackoff(t, policy)
+
+	t.Run("succeeds after transient errors", func(t *testing.T) {
+		c, attempts := flakyDeploymentClient(t, 2, errors.New("connection refused"))
+		var deploy appsv1.Deployment
+		if err := GetDeploymentWithBackoff(context.Background(), c, "vllm", "default", &deploy); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(*attempts) != 3 {
+			t.Errorf("expected 3 attempts, got %d", len(*attempts))
+		}
+	})
+
+	t.Run("gives up after the configured retries", func(t *testing.T) {
+		getErr := errors.New("connection refused")
+		c, attempts := flakyDeploymentClient(t, 10, getErr)
+		var deploy appsv1.Deployment
+		if err := GetDeploymentWithBackoff(context.Background(), c, "vllm", "default", &deploy); !errors.Is(err, getErr) {
+			t.Fatalf("expected the last Get error, got %v", err)
+		}
+		if len(*attempts) != policy.Retries+1 {
+			t.Fatalf("expected %d attempts, got %d", policy.Retries+1, len(*attempts))
+		}
+		// Waits of 20ms, 30ms (capped from 40ms) and 30ms, each with at most 10% jitter
+		for retry := 0; retry < policy.Retries; retry++ {
+			waited := (*attempts)[retry+1].Sub((*attempts)[retry])
+			if want := policy.Delay(retry); waited < want {
+				t.Errorf("retry %d waited %s, want at least %s", retry, waited, want)
+			}
+		}
+		if total := (*attempts)[policy.Retries].Sub((*attempts)[0]); total > time.Second {
+			t.Errorf("expected retries to follow the fast policy, took %s", total)
+		}
+	})
+
+	t.Run("does not retry NotFound", func(t *testing.T) {
+		notFound := apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "vllm")
+		c, attempts := flakyDeploymentClient(t, 10, notFound)
+		var deploy appsv1.Deployment
+		if err := GetDeploymentWithBackoff(context.Background(), c, "vllm", "default", &deploy); !apierrors.IsNotFound(err) {
+			t.Fatalf("expected NotFound, got %v", err)
+		}
+		if len(*attempts) != 1 {
+			t.Errorf("expected 1 attempt, got %d", len(*attempts))
+		}
+	})
+}
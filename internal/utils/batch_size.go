@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The llm-d Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+const (
+	// MaxNumSeqsArg is the vLLM argument setting how many sequences a replica runs concurrently.
+	MaxNumSeqsArg = "--max-num-seqs"
+
+	// DefaultMaxBatchSize is the batch size assumed for a variant whose deployment does not set
+	// MaxNumSeqsArg.
+	DefaultMaxBatchSize = 32
+)
+
+// DeploymentMaxBatchSize returns the maximum batch size of a deployment's replicas from the
+// MaxNumSeqsArg argument of its containers, given as "--max-num-seqs N" or "--max-num-seqs=N"
+// in the command or args. ok is false when no container sets a positive value.
+func DeploymentMaxBatchSize(deploy *appsv1.Deployment) (int, bool) {
+	if deploy == nil {
+		return 0, false
+	}
+	for _, container := range deploy.Spec.Template.Spec.Containers {
+		args := append(append([]string{}, container.Command...), container.Args...)
+		for i, arg := range args {
+			var value string
+			switch {
+			case arg == MaxNumSeqsArg && i+1 < len(args):
+				value = args[i+1]
+			case strings.HasPrefix(arg, MaxNumSeqsArg+"="):
+				value = strings.TrimPrefix(arg, MaxNumSeqsArg+"=")
+			default:
+				continue
+			}
+			if n, err := strconv.Atoi(value); err == nil && n > 0 {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The llm-d Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDeploymentMaxBatchSize(t *testing.T) {
+	deployment := func(containers ...corev1.Container) *appsv1.Deployment {
+		return &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{Containers: containers},
+		}}}
+	}
+
+	tests := []struct {
+		name     string
+		deploy   *appsv1.Deployment
+		expected int
+		ok       bool
+	}{
+		{
+			name:     "separate value",
+			deploy:   deployment(corev1.Container{Args: []string{"--model", "llama", "--max-num-seqs", "128"}}),
+			expected: 128,
+			ok:       true,
+		},
+		{
+			name:     "inline value",
+			deploy:   deployment(corev1.Container{Args: []string{"--max-num-seqs=64"}}),
+			expected: 64,
+			ok:       true,
+		},
+		{
+			name:     "set in the command",
+			deploy:   deployment(corev1.Container{Command: []string{"vllm", "serve", "--max-num-seqs", "16"}}),
+			expected: 16,
+			ok:       true,
+		},
+		{
+			name: "set on a later container",
+			deploy: deployment(
+				corev1.Container{Name: "proxy", Args: []string{"--port", "8000"}},
+				corev1.Container{Name: "vllm", Args: []string{"--max-num-seqs=256"}},
+			),
+			expected: 256,
+			ok:       true,
+		},
+		{
+			name:   "not set",
+			deploy: deployment(corev1.Container{Args: []string{"--model", "llama"}}),
+		},
+		{
+			name:   "missing value",
+			deploy: deployment(corev1.Container{Args: []string{"--max-num-seqs"}}),
+		},
+		{
+			name:   "invalid value",
+			deploy: deployment(corev1.Container{Args: []string{"--max-num-seqs=zero", "--max-num-seqs", "0"}}),
+		},
+		{
+			name: "nil deployment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DeploymentMaxBatchSize(tt.deploy)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("DeploymentMaxBatchSize() = (%d, %v), want (%d, %v)", got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
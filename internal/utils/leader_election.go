@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/tools/leaderelection"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// LeaderElectionTimings are the lease timings of the controller manager's leader election.
+type LeaderElectionTimings struct {
+	// LeaseDuration is how long non-leader candidates wait before forcing acquisition of leadership
+	LeaseDuration time.Duration
+	// RenewDeadline is how long the leader retries refreshing leadership before giving it up
+	RenewDeadline time.Duration
+	// RetryPeriod is how long clients wait between tries of actions
+	RetryPeriod time.Duration
+}
+
+// DefaultLeaderElectionTimings are raised from the controller-runtime defaults (15s, 10s, 2s)
+// to tolerate network latency and API server delays without leader churn.
+var DefaultLeaderElectionTimings = LeaderElectionTimings{
+	LeaseDuration: 60 * time.Second,
+	RenewDeadline: 50 * time.Second,
+	RetryPeriod:   10 * time.Second,
+}
+
+// Validate checks the timings against the constraints of client-go's leader elector, which
+// would otherwise only reject them once the manager starts.
+func (t LeaderElectionTimings) Validate() error {
+	if t.LeaseDuration <= 0 || t.RenewDeadline <= 0 || t.RetryPeriod <= 0 {
+		return fmt.Errorf("lease duration (%s), renew deadline (%s) and retry period (%s) must be > 0",
+			t.LeaseDuration, t.RenewDeadline, t.RetryPeriod)
+	}
+	if t.LeaseDuration <= t.RenewDeadline {
+		return fmt.Errorf("lease duration (%s) must be greater than renew deadline (%s)", t.LeaseDuration, t.RenewDeadline)
+	}
+	if minDeadline := time.Duration(leaderelection.JitterFactor * float64(t.RetryPeriod)); t.RenewDeadline <= minDeadline {
+		return fmt.Errorf("renew deadline (%s) must be greater than %.1f x retry period (%s)",
+			t.RenewDeadline, leaderelection.JitterFactor, t.RetryPeriod)
+	}
+	return nil
+}
+
+// Apply sets the timings on the manager options.
+func (t LeaderElectionTimings) Apply(opts *ctrl.Options) {
+	opts.LeaseDuration = &t.LeaseDuration
+	opts.RenewDeadline = &t.RenewDeadline
+	opts.RetryPeriod = &t.RetryPeriod
+}
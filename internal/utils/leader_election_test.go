@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestLeaderElectionTimings_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		timings LeaderElectionTimings
+		wantErr bool
+	}{
+		{name: "defaults", timings: DefaultLeaderElectionTimings},
+		{name: "high-latency control plane", timings: LeaderElectionTimings{
+			LeaseDuration: 120 * time.Second, RenewDeadline: 90 * time.Second, RetryPeriod: 15 * time.Second}},
+		{name: "zero retry period", timings: LeaderElectionTimings{
+			LeaseDuration: 60 * time.Second, RenewDeadline: 50 * time.Second}, wantErr: true},
+		{name: "renew deadline not below lease duration", timings: LeaderElectionTimings{
+			LeaseDuration: 30 * time.Second, RenewDeadline: 30 * time.Second, RetryPeriod: 5 * time.Second}, wantErr: true},
+		{name: "retry period too close to renew deadline", timings: LeaderElectionTimings{
+			LeaseDuration: 60 * time.Second, RenewDeadline: 12 * time.Second, RetryPeriod: 10 * time.Second}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.timings.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLeaderElectionTimings_Apply(t *testing.T) {
+	timings := LeaderElectionTimings{
+		LeaseDuration: 120 * time.Second,
+		RenewDeadline: 90 * time.Second,
+		RetryPeriod:   15 * time.Second,
+	}
+	var opts ctrl.Options
+	timings.Apply(&opts)
+
+	if opts.LeaseDuration == nil || *opts.LeaseDuration != 120*time.Second {
+		t.Errorf("expected lease duration 2m, got %v", opts.LeaseDuration)
+	}
+	if opts.RenewDeadline == nil || *opts.RenewDeadline != 90*time.Second {
+		t.Errorf("expected renew deadline 1m30s, got %v", opts.RenewDeadline)
+	}
+	if opts.RetryPeriod == nil || *opts.RetryPeriod != 15*time.Second {
+		t.Errorf("expected retry period 15s, got %v", opts.RetryPeriod)
+	}
+
+	// The options keep their own copies of the values
+	timings.LeaseDuration = time.Second
+	if *opts.LeaseDuration != 120*time.Second {
+		t.Errorf("expected options not to alias the timings, got lease duration %s", *opts.LeaseDuration)
+	}
+}
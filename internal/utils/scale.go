@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/logging"
+)
+
+// GetCurrentReplicas returns the current replicas of a scale target read from its /scale
+// subresource, the same view HPA uses. status.replicas is preferred; spec.replicas is used
+// while the status has not caught up yet (status.replicas == 0).
+// Works for any kind implementing the scale subresource, not just Deployments.
+func GetCurrentReplicas(ctx context.Context, c client.Client, obj client.Object) (int, error) {
+	scale := &autoscalingv1.Scale{}
+	if err := c.SubResource("scale").Get(ctx, obj, scale); err != nil {
+		return 0, err
+	}
+	if scale.Status.Replicas == 0 {
+		return int(scale.Spec.Replicas), nil
+	}
+	return int(scale.Status.Replicas), nil
+}
+
+// DeploymentScaleTarget is the ScaleTargetProvider for a Deployment.
+type DeploymentScaleTarget struct {
+	client client.Client
+	deploy *appsv1.Deployment
+}
+
+var _ interfaces.ScaleTargetProvider = &DeploymentScaleTarget{}
+
+// NewDeploymentScaleTarget creates a ScaleTargetProvider for deploy. The deployment's status is
+// used as fetched; only the scale subresource is read and written through c.
+func NewDeploymentScaleTarget(c client.Client, deploy *appsv1.Deployment) *DeploymentScaleTarget {
+	return &DeploymentScaleTarget{client: c, deploy: deploy}
+}
+
+// GetReplicas returns the current replicas from the scale subresource. If it cannot be read,
+// the deployment's own status.replicas (or spec.replicas while the status is 0) is used instead.
+func (t *DeploymentScaleTarget) GetReplicas(ctx context.Context) (int, error) {
+	replicas, err := GetCurrentReplicas(ctx, t.client, t.deploy)
+	if err == nil {
+		return replicas, nil
+	}
+	logging.FromContext(ctx, logging.Engine).V(logging.DEBUG).Info("Could not read scale subresource, using deployment replicas",
+		"deployment", t.deploy.Name,
+		"namespace", t.deploy.Namespace,
+		"error", err)
+	replicas = int(t.deploy.Status.Replicas)
+	if replicas == 0 && t.deploy.Spec.Replicas != nil {
+		replicas = int(*t.deploy.Spec.Replicas)
+	}
+	return replicas, nil
+}
+
+// GetReadyReplicas returns the deployment's status.readyReplicas.
+func (t *DeploymentScaleTarget) GetReadyReplicas(_ context.Context) (int, error) {
+	return int(t.deploy.Status.ReadyReplicas), nil
+}
+
+// SetReplicas updates spec.replicas through the scale subresource.
+func (t *DeploymentScaleTarget) SetReplicas(ctx context.Context, replicas int32) error {
+	scale := &autoscalingv1.Scale{}
+	if err := t.client.SubResource("scale").Get(ctx, t.deploy, scale); err != nil {
+		return err
+	}
+	if scale.Spec.Replicas == replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	return t.client.SubResource("scale").Update(ctx, t.deploy, client.WithSubResourceBody(scale))
+}
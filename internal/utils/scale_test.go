@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetCurrentReplicas(t *testing.T) {
+	tests := []struct {
+		name           string
+		specReplicas   int32
+		statusReplicas int32
+		expected       int
+	}{
+		{name: "status replicas", specReplicas: 3, statusReplicas: 2, expected: 2},
+		{name: "status not caught up falls back to spec", specReplicas: 3, statusReplicas: 0, expected: 3},
+		{name: "scaled to zero", specReplicas: 0, statusReplicas: 0, expected: 0},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "vllm", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: Ptr(tt.specReplicas)},
+				Status:     appsv1.DeploymentStatus{Replicas: tt.statusReplicas},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deploy).WithStatusSubresource(deploy).Build()
+
+			got, err := GetCurrentReplicas(context.Background(), c, deploy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %d replicas, got %d", tt.expected, got)
+			}
+
+			// The scale subresource reports the same value as the deployment itself
+			fromDeployment := int(deploy.Status.Replicas)
+			if fromDeployment == 0 {
+				fromDeployment = int(*deploy.Spec.Replicas)
+			}
+			if got != fromDeployment {
+				t.Errorf("expected scale subresource to match deployment (%d), got %d", fromDeployment, got)
+			}
+		})
+	}
+}
+
+func TestGetCurrentReplicas_NotFound(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "default"}}
+	if _, err := GetCurrentReplicas(context.Background(), c, deploy); err == nil {
+		t.Error("expected error for missing deployment")
+	}
+}
+
+func TestDeploymentScaleTarget(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	ctx := context.Background()
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "vllm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: Ptr(int32(3))},
+		Status:     appsv1.DeploymentStatus{Replicas: 3, ReadyReplicas: 2},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deploy).WithStatusSubresource(deploy).Build()
+	target := NewDeploymentScaleTarget(c, deploy)
+
+	if replicas, err := target.GetReplicas(ctx); err != nil || replicas != 3 {
+		t.Errorf("GetReplicas() = %d, %v; want 3", replicas, err)
+	}
+	if ready, err := target.GetReadyReplicas(ctx); err != nil || ready != 2 {
+		t.Errorf("GetReadyReplicas() = %d, %v; want 2", ready, err)
+	}
+
+	if err := target.SetReplicas(ctx, 5); err != nil {
+		t.Fatalf("SetReplicas() failed: %v", err)
+	}
+	var updated appsv1.Deployment
+	if err := c.Get(ctx, client.ObjectKeyFromObject(deploy), &updated); err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+	if updated.Spec.Replicas == nil || *updated.Spec.Replicas != 5 {
+		t.Errorf("expected spec.replicas 5 after SetReplicas, got %v", updated.Spec.Replicas)
+	}
+}
+
+func TestDeploymentScaleTarget_FallsBackToDeployment(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+	// The scale subresource can't be read for a deployment the client doesn't know
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "vllm", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: Ptr(int32(4))},
+	}
+	target := NewDeploymentScaleTarget(c, deploy)
+
+	if replicas, err := target.GetReplicas(context.Background()); err != nil || replicas != 4 {
+		t.Errorf("GetReplicas() = %d, %v; want spec.replicas 4", replicas, err)
+	}
+	if err := target.SetReplicas(context.Background(), 2); err == nil {
+		t.Error("expected SetReplicas to fail for a missing deployment")
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -86,8 +88,11 @@ func GetResourceWithBackoff[T client.Object](ctx context.Context, c client.Clien
 }
 
 // Helper functions for common resource types with standard backoff
+
+// GetDeploymentWithBackoff gets a Deployment, retrying transient errors with the policy
+// set by SetDeploymentGetBackoff (DefaultDeploymentGetBackoff unless configured).
 func GetDeploymentWithBackoff(ctx context.Context, c client.Client, name, namespace string, deploy *appsv1.Deployment) error {
-	return GetResourceWithBackoff(ctx, c, client.ObjectKey{Name: name, Namespace: namespace}, deploy, StandardBackoff, "Deployment")
+	return GetResourceWithPolicy(ctx, c, client.ObjectKey{Name: name, Namespace: namespace}, deploy, deploymentGetBackoff, "Deployment")
 }
 
 func GetConfigMapWithBackoff(ctx context.Context, c client.Client, name, namespace string, cm *corev1.ConfigMap) error {
@@ -122,9 +127,12 @@ func UpdateStatusWithBackoff[T client.Object](ctx context.Context, c client.Clie
 
 // Adapter to create wva system data types from config maps.
 // Note: WVA operates in unlimited mode, so capacity data is not used.
+// Accelerator names are normalized through aliases, so that costs keyed by any alias
+// are found under the canonical name used by the VariantAutoscalings.
 func CreateSystemData(
 	acceleratorCm map[string]map[string]string,
-	serviceClassCm map[string]string) *infernoConfig.SystemData {
+	serviceClassCm map[string]string,
+	aliases AcceleratorAliases) *infernoConfig.SystemData {
 
 	systemData := &infernoConfig.SystemData{
 		Spec: infernoConfig.SystemSpec{
@@ -137,16 +145,36 @@ func CreateSystemData(
 		},
 	}
 
-	// get accelerator data
+	// get accelerator data; keys that alias the same accelerator yield a single entry, taken from
+	// the key spelled as the canonical name if present and otherwise from the first key in sorted order
+	keys := slices.SortedFunc(maps.Keys(acceleratorCm), func(a, b string) int {
+		aCanonical, bCanonical := aliases.Normalize(a) == a, aliases.Normalize(b) == b
+		if aCanonical != bCanonical {
+			if aCanonical {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
 	acceleratorData := []infernoConfig.AcceleratorSpec{}
-	for key, val := range acceleratorCm {
+	seen := make(map[string]string, len(keys))
+	for _, key := range keys {
+		val := acceleratorCm[key]
+		name := aliases.Normalize(key)
+		if first, ok := seen[name]; ok {
+			ctrl.Log.Info("duplicate accelerator cost entry in configmap, skipping accelerator",
+				"name", key, "canonicalName", name, "using", first)
+			continue
+		}
 		cost, err := strconv.ParseFloat(val["cost"], 32)
 		if err != nil {
 			ctrl.Log.Info("failed to parse accelerator cost in configmap, skipping accelerator", "name", key)
 			continue
 		}
+		seen[name] = key
 		acceleratorData = append(acceleratorData, infernoConfig.AcceleratorSpec{
-			Name:         key,
+			Name:         name,
 			Type:         val["device"],
 			Multiplicity: 1,                         // TODO: multiplicity should be in the configured accelerator spec
 			Power:        infernoConfig.PowerSpec{}, // Not currently used
@@ -200,12 +228,14 @@ func CreateSystemData(
 
 // add model accelerator pair profile data to inferno system data
 
-// Add server specs to inferno system data
+// Add server specs to inferno system data. maxBatchSize is the variant's maximum batch size,
+// e.g. from DeploymentMaxBatchSize; a non-positive value uses DefaultMaxBatchSize.
 func AddServerInfoToSystemData(
 	sd *infernoConfig.SystemData,
 	va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
 	currentAlloc *interfaces.Allocation,
-	className string) (err error) {
+	className string,
+	maxBatchSize int) (err error) {
 
 	// server load statistics
 	var arrivalRate, avgOutputTokens, avgInputTokens, cost, itlAverage, ttftAverage float64
@@ -231,12 +261,10 @@ func AddServerInfoToSystemData(
 	}
 
 	// server allocation
-	// Calculate cost from Spec.VariantCost (unit cost) * Replicas
+	// Calculate cost from the variant's unit cost * Replicas
 	var unitCost float64
-	if va.Spec.VariantCost != "" {
-		if val, err := strconv.ParseFloat(va.Spec.VariantCost, 64); err == nil {
-			unitCost = val
-		}
+	if val, ok, err := va.GetVariantCost(); ok && err == nil {
+		unitCost = val
 	}
 	// TODO: Use a constant for default cost if not set, or rely on CRD defaulting
 	if unitCost == 0 {
@@ -279,16 +307,11 @@ func AddServerInfoToSystemData(
 		DesiredAlloc:    infernoConfig.AllocationData{},
 	}
 
-	// set max batch size if configured
-	maxBatchSize := 32 // Default value now that ModelProfile is removed
-
-	// set max batch size if configured - now handled by capacity/hardcoded or label-based lookups
-	// For now, removing the dependency on ModelProfile.
-	// TODO: Retrieve this from a ConfigMap or other source if needed.
-
-	if maxBatchSize > 0 {
-		serverSpec.MaxBatchSize = maxBatchSize
+	// Fall back to the default batch size when the variant's is unknown
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultMaxBatchSize
 	}
+	serverSpec.MaxBatchSize = maxBatchSize
 
 	sd.Spec.Servers.Spec = append(sd.Spec.Servers.Spec, *serverSpec)
 	return nil
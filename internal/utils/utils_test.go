@@ -7,10 +7,38 @@ import (
 
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	wvav1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	infernoConfig "github.com/llm-d-incubation/workload-variant-autoscaler/pkg/config"
 	testutils "github.com/llm-d-incubation/workload-variant-autoscaler/test/utils"
 )
 
+func TestAddServerInfoToSystemData_MaxBatchSize(t *testing.T) {
+	va := &wvav1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-h100", Namespace: "default"},
+		Spec:       wvav1alpha1.VariantAutoscalingSpec{ModelID: "meta/llama"},
+	}
+
+	cases := []struct {
+		name         string
+		maxBatchSize int
+		expected     int
+	}{
+		{name: "variant batch size", maxBatchSize: 128, expected: 128},
+		{name: "unknown batch size falls back to the default", maxBatchSize: 0, expected: DefaultMaxBatchSize},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sd := &infernoConfig.SystemData{}
+			assert.NoError(t, AddServerInfoToSystemData(sd, va, nil, "default", tc.maxBatchSize))
+			if assert.Len(t, sd.Spec.Servers.Spec, 1) {
+				assert.Equal(t, tc.expected, sd.Spec.Servers.Spec[0].MaxBatchSize)
+			}
+		})
+	}
+}
+
 func TestQueryPrometheusWithBackoff(t *testing.T) {
 	t.Parallel()
 
@@ -18,6 +18,8 @@ package utils
 
 import (
 	"context"
+	"os"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -54,9 +56,43 @@ func InactiveVariantAutoscalingByModel(ctx context.Context, client client.Client
 	return GroupVariantAutoscalingByModel(vas), nil
 }
 
-// AcceleratorNameLabel is the label key used to specify the accelerator name for a VA.
+// AcceleratorNameLabel is the default label key used to specify the accelerator name for a VA.
 const AcceleratorNameLabel = "inference.optimization/acceleratorName"
 
+// AcceleratorLabelKeyEnvVar is the environment variable that overrides AcceleratorNameLabel.
+const AcceleratorLabelKeyEnvVar = "WVA_ACCELERATOR_LABEL_KEY"
+
+// acceleratorLabelKey is the label key holding the accelerator name of a VA, set once at startup.
+var acceleratorLabelKey = AcceleratorNameLabel
+
+// SetAcceleratorLabelKey sets the label key returned by AcceleratorLabelKey, typically from
+// WVA_ACCELERATOR_LABEL_KEY. An empty key restores AcceleratorNameLabel.
+// Call it once during application startup, before any VA is read.
+func SetAcceleratorLabelKey(key string) {
+	if key = strings.TrimSpace(key); key != "" {
+		acceleratorLabelKey = key
+		return
+	}
+	acceleratorLabelKey = AcceleratorNameLabel
+}
+
+// AcceleratorLabelKey returns the label key holding the accelerator name of a VA:
+// the key set with SetAcceleratorLabelKey, otherwise AcceleratorNameLabel.
+func AcceleratorLabelKey() string {
+	return acceleratorLabelKey
+}
+
+// DefaultAcceleratorEnvVar is the environment variable holding the cluster-default accelerator,
+// used for VAs without an accelerator name label. It is the same key the defaulting webhook reads
+// from the autoscaler ConfigMap, from which the deployment sources it.
+const DefaultAcceleratorEnvVar = "WVA_DEFAULT_ACCELERATOR"
+
+// DefaultAccelerator returns the cluster-default accelerator from WVA_DEFAULT_ACCELERATOR,
+// or an empty string when none is configured.
+func DefaultAccelerator() string {
+	return strings.TrimSpace(os.Getenv(DefaultAcceleratorEnvVar))
+}
+
 // GroupVariantAutoscalingByModel groups VariantAutoscalings by model ID and namespace.
 // Variants of the same model on different accelerators are grouped together to enable
 // cost-based optimization (scale up cheaper variants, scale down expensive variants).
@@ -75,16 +111,17 @@ func GroupVariantAutoscalingByModel(
 
 // GetAcceleratorType extracts the accelerator type from a VariantAutoscaling.
 // It checks in order:
-// 1. The inference.optimization/acceleratorName label
-// 2. Returns empty string if neither is available
+// 1. The accelerator name label (see AcceleratorLabelKey)
+// 2. The cluster-default accelerator (see DefaultAccelerator), for single-accelerator clusters
+// 3. Returns empty string if neither is available
 func GetAcceleratorType(va *wvav1alpha1.VariantAutoscaling) string {
 	if va.Labels != nil {
-		if acc, exists := va.Labels[AcceleratorNameLabel]; exists {
+		if acc, exists := va.Labels[AcceleratorLabelKey()]; exists {
 			return acc
 		}
 	}
 
-	return ""
+	return DefaultAccelerator()
 }
 
 // ActiveVariantAutoscalings retrieves all VariantAutoscaling resources that are ready for optimization
@@ -17,11 +17,19 @@ limitations under the License.
 package utils
 
 import (
+	"context"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	wvav1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
 )
 
 func TestGetAcceleratorType(t *testing.T) {
@@ -196,3 +204,94 @@ func TestGroupVariantAutoscalingByModel(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAcceleratorType_CustomLabelKey(t *testing.T) {
+	SetAcceleratorLabelKey(" example.com/gpu-model ")
+	t.Cleanup(func() { SetAcceleratorLabelKey("") })
+
+	vas := []wvav1alpha1.VariantAutoscaling{{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"example.com/gpu-model": "NVIDIA-H100-80GB-HBM3",
+				AcceleratorNameLabel:    "A100",
+			},
+		},
+	}}
+	if key := AcceleratorLabelKey(); key != "example.com/gpu-model" {
+		t.Fatalf("AcceleratorLabelKey() = %q, want the custom key", key)
+	}
+	if got := GetAcceleratorType(&vas[0]); got != "NVIDIA-H100-80GB-HBM3" {
+		t.Errorf("GetAcceleratorType() = %q, want the accelerator from the custom label", got)
+	}
+
+	NormalizeAcceleratorLabels(vas, ParseAcceleratorAliases(map[string]string{"H100": "NVIDIA-H100-80GB-HBM3"}))
+	if got := GetAcceleratorType(&vas[0]); got != "H100" {
+		t.Errorf("expected the custom label to be normalized to H100, got %q", got)
+	}
+
+	delete(vas[0].Labels, "example.com/gpu-model")
+	if got := GetAcceleratorType(&vas[0]); got != "" {
+		t.Errorf("expected the default label to be ignored when a custom key is set, got %q", got)
+	}
+}
+
+func TestGetAcceleratorType_DefaultAccelerator(t *testing.T) {
+	t.Setenv(DefaultAcceleratorEnvVar, " H100 ")
+
+	unlabeled := &wvav1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-va", Namespace: "default"},
+		Spec: wvav1alpha1.VariantAutoscalingSpec{
+			ModelID:        "meta/llama-3.1-8b",
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{Kind: "Deployment", Name: "llama"},
+		},
+	}
+	if got := GetAcceleratorType(unlabeled); got != "H100" {
+		t.Fatalf("GetAcceleratorType() = %q, want the default accelerator", got)
+	}
+
+	labeled := unlabeled.DeepCopy()
+	labeled.Labels = map[string]string{AcceleratorNameLabel: "A100"}
+	if got := GetAcceleratorType(labeled); got != "A100" {
+		t.Errorf("GetAcceleratorType() = %q, want the label to take precedence over the default", got)
+	}
+
+	// The VA is processed like a labeled one...
+	deployment := appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))}}
+	alloc, err := BuildAllocationFromMetrics(interfaces.OptimizerMetrics{}, unlabeled, deployment, 10)
+	if err != nil {
+		t.Fatalf("expected a VA without accelerator label to be processed with the default, got %v", err)
+	}
+	if alloc.Accelerator != "H100" {
+		t.Errorf("expected allocation on the default accelerator, got %q", alloc.Accelerator)
+	}
+
+	// ...and its metrics carry the default accelerator
+	registry := prometheus.NewRegistry()
+	if err := metrics.InitMetrics(registry); err != nil {
+		t.Fatalf("failed to init metrics: %v", err)
+	}
+	if err := metrics.NewMetricsEmitter().EmitReplicaMetrics(context.Background(), unlabeled, 2, 3,
+		GetAcceleratorType(unlabeled)); err != nil {
+		t.Fatalf("failed to emit replica metrics: %v", err)
+	}
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	var accelerators []string
+	for _, family := range families {
+		if family.GetName() != constants.WVADesiredReplicas {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, pair := range metric.GetLabel() {
+				if pair.GetName() == constants.LabelAcceleratorType {
+					accelerators = append(accelerators, pair.GetValue())
+				}
+			}
+		}
+	}
+	if len(accelerators) != 1 || accelerators[0] != "H100" {
+		t.Errorf("expected desired replicas emitted with accelerator H100, got %v", accelerators)
+	}
+}
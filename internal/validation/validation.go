@@ -0,0 +1,262 @@
+// Package validation checks the autoscaler's ConfigMaps and VariantAutoscaling resources
+// without starting the manager, so misconfigurations can be caught before deployment
+// (e.g. as a CI gate via the --validate-only flag).
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/interfaces"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/saturation"
+)
+
+// Options names the ConfigMaps to validate and where to find the VariantAutoscalings.
+type Options struct {
+	// Namespace holds the autoscaler's ConfigMaps
+	Namespace string
+	// WatchNamespace restricts the VariantAutoscalings validated; empty means all namespaces
+	WatchNamespace string
+
+	SaturationConfigMapName       string
+	AcceleratorCostsConfigMapName string
+	ServiceClassesConfigMapName   string
+}
+
+// DefaultOptions returns the ConfigMap names and namespace used by the controller,
+// honoring the same environment variable overrides.
+func DefaultOptions() Options {
+	return Options{
+		Namespace:                     config.GetNamespace(),
+		SaturationConfigMapName:       config.GetSaturationConfigMapName(),
+		AcceleratorCostsConfigMapName: config.GetAcceleratorCostsConfigMapName(),
+		ServiceClassesConfigMapName:   config.GetServiceClassesConfigMapName(),
+	}
+}
+
+// Run validates the ConfigMaps and VariantAutoscalings described by opts, writes a report
+// to out and returns the process exit code: 0 if everything is valid, 1 otherwise.
+// A missing ConfigMap is reported but not treated as an error, since the controller
+// falls back to defaults for it.
+func Run(ctx context.Context, c client.Reader, opts Options, out io.Writer) int {
+	r := &report{out: out}
+
+	saturationConfigs := r.validateSaturationConfigMap(ctx, c, opts)
+	r.validateAcceleratorCostsConfigMap(ctx, c, opts)
+	r.validateServiceClassesConfigMap(ctx, c, opts)
+	r.validateVariantAutoscalings(ctx, c, opts, saturationConfigs)
+
+	if r.errors > 0 {
+		fmt.Fprintf(out, "\nValidation failed: %d error(s)\n", r.errors)
+		return 1
+	}
+	fmt.Fprintln(out, "\nValidation passed")
+	return 0
+}
+
+type report struct {
+	out    io.Writer
+	errors int
+}
+
+func (r *report) ok(subject string) {
+	fmt.Fprintf(r.out, "OK     %s\n", subject)
+}
+
+func (r *report) skip(subject, reason string) {
+	fmt.Fprintf(r.out, "SKIP   %s: %s\n", subject, reason)
+}
+
+func (r *report) fail(subject string, err error) {
+	r.errors++
+	fmt.Fprintf(r.out, "ERROR  %s: %v\n", subject, err)
+}
+
+// getConfigMap fetches a ConfigMap, reporting it as skipped when absent.
+// Returns nil if the ConfigMap could not be read.
+func (r *report) getConfigMap(ctx context.Context, c client.Reader, namespace, name string) *corev1.ConfigMap {
+	subject := fmt.Sprintf("configmap %s/%s", namespace, name)
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.skip(subject, "not found")
+		} else {
+			r.fail(subject, err)
+		}
+		return nil
+	}
+	return cm
+}
+
+func (r *report) validateSaturationConfigMap(
+	ctx context.Context, c client.Reader, opts Options,
+) map[string]interfaces.SaturationScalingConfig {
+	cm := r.getConfigMap(ctx, c, opts.Namespace, opts.SaturationConfigMapName)
+	if cm == nil {
+		return nil
+	}
+	errs := config.ValidateSaturationScalingConfigMap(cm.Data)
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		subject := fmt.Sprintf("configmap %s/%s key %q", cm.Namespace, cm.Name, key)
+		if err, invalid := errs[key]; invalid {
+			r.fail(subject, err)
+		} else {
+			r.ok(subject)
+		}
+	}
+	return config.ParseSaturationScalingConfigMap(cm.Data)
+}
+
+func (r *report) validateAcceleratorCostsConfigMap(ctx context.Context, c client.Reader, opts Options) {
+	cm := r.getConfigMap(ctx, c, opts.Namespace, opts.AcceleratorCostsConfigMapName)
+	if cm == nil {
+		return
+	}
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		subject := fmt.Sprintf("configmap %s/%s key %q", cm.Namespace, cm.Name, key)
+		if err := validateAcceleratorCost(cm.Data[key]); err != nil {
+			r.fail(subject, err)
+		} else {
+			r.ok(subject)
+		}
+	}
+}
+
+// validateAcceleratorCost checks an accelerator entry of the form {"device": ..., "cost": "40.00"}.
+func validateAcceleratorCost(value string) error {
+	var entry map[string]string
+	if err := json.Unmarshal([]byte(value), &entry); err != nil {
+		return fmt.Errorf("failed to parse: %w", err)
+	}
+	if entry["device"] == "" {
+		return fmt.Errorf("device must not be empty")
+	}
+	cost, err := strconv.ParseFloat(entry["cost"], 64)
+	if err != nil {
+		return fmt.Errorf("cost must be a number, got %q", entry["cost"])
+	}
+	if cost < 0 {
+		return fmt.Errorf("cost must be >= 0, got %.2f", cost)
+	}
+	return nil
+}
+
+func (r *report) validateServiceClassesConfigMap(ctx context.Context, c client.Reader, opts Options) {
+	cm := r.getConfigMap(ctx, c, opts.Namespace, opts.ServiceClassesConfigMapName)
+	if cm == nil {
+		return
+	}
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		subject := fmt.Sprintf("configmap %s/%s key %q", cm.Namespace, cm.Name, key)
+		var sc interfaces.ServiceClass
+		if err := yaml.Unmarshal([]byte(cm.Data[key]), &sc); err != nil {
+			r.fail(subject, fmt.Errorf("failed to parse: %w", err))
+			continue
+		}
+		if err := sc.Validate(); err != nil {
+			r.fail(subject, err)
+			continue
+		}
+		r.ok(subject)
+	}
+}
+
+func (r *report) validateVariantAutoscalings(
+	ctx context.Context, c client.Reader, opts Options,
+	saturationConfigs map[string]interfaces.SaturationScalingConfig,
+) {
+	var vaList llmdVariantAutoscalingV1alpha1.VariantAutoscalingList
+	var listOpts []client.ListOption
+	if opts.WatchNamespace != "" {
+		listOpts = append(listOpts, client.InNamespace(opts.WatchNamespace))
+	}
+	if err := c.List(ctx, &vaList, listOpts...); err != nil {
+		r.fail("variantautoscalings", fmt.Errorf("failed to list: %w", err))
+		return
+	}
+	for i := range vaList.Items {
+		va := &vaList.Items[i]
+		subject := fmt.Sprintf("variantautoscaling %s/%s", va.Namespace, va.Name)
+		if err := validateVariantAutoscaling(va, saturationConfigs); err != nil {
+			r.fail(subject, err)
+		} else {
+			r.ok(subject)
+		}
+	}
+}
+
+// validateVariantAutoscaling checks the spec fields the engine interprets beyond what the
+// CRD schema enforces, such as targetKvUtilization against the model's kvCacheThreshold.
+func validateVariantAutoscaling(
+	va *llmdVariantAutoscalingV1alpha1.VariantAutoscaling,
+	saturationConfigs map[string]interfaces.SaturationScalingConfig,
+) error {
+	if va.Spec.ModelID == "" {
+		return fmt.Errorf("modelID must not be empty")
+	}
+	if va.Spec.ScaleTargetRef.Name == "" {
+		return fmt.Errorf("scaleTargetRef.name must not be empty")
+	}
+	if va.Spec.VariantCost != "" {
+		cost, err := strconv.ParseFloat(va.Spec.VariantCost, 64)
+		if err != nil || cost < 0 {
+			return fmt.Errorf("variantCost must be a non-negative number, got %q", va.Spec.VariantCost)
+		}
+	}
+	if va.Spec.Cost != nil && va.Spec.Cost.PerReplica.Sign() < 0 {
+		return fmt.Errorf("cost.perReplica must be non-negative, got %q", va.Spec.Cost.PerReplica.String())
+	}
+	if va.Spec.MaxScaleUpRate != nil && *va.Spec.MaxScaleUpRate < 1 {
+		return fmt.Errorf("maxScaleUpRate must be >= 1, got %d", *va.Spec.MaxScaleUpRate)
+	}
+	if va.Spec.MaxScaleDownRate != nil && *va.Spec.MaxScaleDownRate < 1 {
+		return fmt.Errorf("maxScaleDownRate must be >= 1, got %d", *va.Spec.MaxScaleDownRate)
+	}
+	if va.Spec.ScaleDownFloor != nil && *va.Spec.ScaleDownFloor < 1 {
+		return fmt.Errorf("scaleDownFloor must be >= 1, got %d", *va.Spec.ScaleDownFloor)
+	}
+	if va.Spec.TargetKvUtilization != "" {
+		target, err := strconv.ParseFloat(va.Spec.TargetKvUtilization, 64)
+		if err != nil {
+			return fmt.Errorf("targetKvUtilization must be a number, got %q", va.Spec.TargetKvUtilization)
+		}
+		// Without an applicable saturation config the engine skips the model, so there is
+		// no kvCacheThreshold to check the target against
+		if base, _, ok := config.ResolveSaturationScalingConfig(saturationConfigs, va.Spec.ModelID, va.Namespace); ok {
+			if _, err := saturation.WithTargetKvUtilization(base, target); err != nil {
+				return err
+			}
+		}
+	}
+	if va.Spec.ScaleToZero != nil && va.Spec.ScaleToZero.RetentionPeriod != "" {
+		if _, err := config.ValidateRetentionPeriod(va.Spec.ScaleToZero.RetentionPeriod); err != nil {
+			return fmt.Errorf("scaleToZero.retentionPeriod: %w", err)
+		}
+	}
+	linked := make(map[string]bool, len(va.Spec.LinkedScaleTargets))
+	for _, target := range va.Spec.LinkedScaleTargets {
+		if target.Name == "" {
+			return fmt.Errorf("linkedScaleTargets: name must not be empty")
+		}
+		if target.Name == va.Spec.ScaleTargetRef.Name || linked[target.Name] {
+			return fmt.Errorf("linkedScaleTargets: %s is linked more than once or is the scale target", target.Name)
+		}
+		linked[target.Name] = true
+		if _, err := target.LinkedReplicas(1); err != nil {
+			return fmt.Errorf("linkedScaleTargets: %w", err)
+		}
+	}
+	return nil
+}
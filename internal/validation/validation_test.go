@@ -0,0 +1,209 @@
+package validation
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	llmdVariantAutoscalingV1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/config"
+)
+
+const testNamespace = "wva-system"
+
+func testOptions() Options {
+	return Options{
+		Namespace:                     testNamespace,
+		SaturationConfigMapName:       config.DefaultSaturationConfigMapName,
+		AcceleratorCostsConfigMapName: config.DefaultAcceleratorCostsConfigMapName,
+		ServiceClassesConfigMapName:   config.DefaultServiceClassesConfigMapName,
+	}
+}
+
+func configMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Data:       data,
+	}
+}
+
+func variantAutoscaling(name, modelID, targetKvUtilization string) *llmdVariantAutoscalingV1alpha1.VariantAutoscaling {
+	return &llmdVariantAutoscalingV1alpha1.VariantAutoscaling{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "llm"},
+		Spec: llmdVariantAutoscalingV1alpha1.VariantAutoscalingSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       name,
+			},
+			ModelID:             modelID,
+			VariantCost:         "10.0",
+			TargetKvUtilization: targetKvUtilization,
+		},
+	}
+}
+
+// validFixtures returns one valid ConfigMap of each kind and a valid VariantAutoscaling.
+func validFixtures() []client.Object {
+	return []client.Object{
+		configMap(config.DefaultSaturationConfigMapName, map[string]string{
+			"default": `
+kvCacheThreshold: 0.80
+queueLengthThreshold: 5
+kvSpareTrigger: 0.1
+queueSpareTrigger: 3
+`,
+			"llm-override": `
+namespace: llm
+kvCacheThreshold: 0.90
+`,
+		}),
+		configMap(config.DefaultAcceleratorCostsConfigMapName, map[string]string{
+			"A100": `{"device": "NVIDIA-A100-PCIE-80GB", "cost": "40.00"}`,
+		}),
+		configMap(config.DefaultServiceClassesConfigMapName, map[string]string{
+			"premium.yaml": `
+name: Premium
+priority: 1
+data:
+  - model: meta/llama0-70b
+    slo-tpot: 80
+    slo-ttft: 500
+`,
+		}),
+		variantAutoscaling("llama-a100", "meta/llama0-70b", "0.75"),
+	}
+}
+
+func runValidation(t *testing.T, objs ...client.Object) (int, string) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, llmdVariantAutoscalingV1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	var out bytes.Buffer
+	code := Run(context.Background(), c, testOptions(), &out)
+	return code, out.String()
+}
+
+func TestRun_ValidFixtures(t *testing.T) {
+	code, report := runValidation(t, validFixtures()...)
+	assert.Equal(t, 0, code, report)
+	assert.Contains(t, report, "Validation passed")
+	assert.NotContains(t, report, "ERROR")
+}
+
+func TestRun_MissingConfigMapsAreSkipped(t *testing.T) {
+	code, report := runValidation(t)
+	assert.Equal(t, 0, code, report)
+	assert.Contains(t, report, "SKIP   configmap wva-system/saturation-scaling-config: not found")
+}
+
+func TestRun_InvalidFixtures(t *testing.T) {
+	tests := []struct {
+		name        string
+		replace     client.Object
+		expectError string
+	}{
+		{
+			name: "invalid saturation override after inheritance",
+			replace: configMap(config.DefaultSaturationConfigMapName, map[string]string{
+				"default": "kvCacheThreshold: 0.80\nkvSpareTrigger: 0.1\n",
+				"broken":  "namespace: llm\nkvSpareTrigger: 0.9\n",
+			}),
+			expectError: `key "broken": invalid`,
+		},
+		{
+			name: "unparseable saturation entry",
+			replace: configMap(config.DefaultSaturationConfigMapName, map[string]string{
+				"default": "kvCacheThreshold: [",
+			}),
+			expectError: `key "default": failed to parse`,
+		},
+		{
+			name: "non-numeric accelerator cost",
+			replace: configMap(config.DefaultAcceleratorCostsConfigMapName, map[string]string{
+				"A100": `{"device": "NVIDIA-A100-PCIE-80GB", "cost": "cheap"}`,
+			}),
+			expectError: "cost must be a number",
+		},
+		{
+			name: "service class without SLOs",
+			replace: configMap(config.DefaultServiceClassesConfigMapName, map[string]string{
+				"premium.yaml": "name: Premium\npriority: 1\ndata:\n  - model: meta/llama0-70b\n",
+			}),
+			expectError: "slo-tpot must be > 0",
+		},
+		{
+			name:        "targetKvUtilization above the resolved kvCacheThreshold",
+			replace:     variantAutoscaling("llama-a100", "meta/llama0-70b", "0.95"),
+			expectError: "targetKvUtilization must be in (0, kvCacheThreshold=0.90]",
+		},
+		{
+			name: "non-positive scaleToZero retention period",
+			replace: func() client.Object {
+				va := variantAutoscaling("llama-a100", "meta/llama0-70b", "0.75")
+				va.Spec.ScaleToZero = &llmdVariantAutoscalingV1alpha1.ScaleToZeroSpec{RetentionPeriod: "0s"}
+				return va
+			}(),
+			expectError: "scaleToZero.retentionPeriod: retention period must be positive",
+		},
+		{
+			name: "linked scale target is the scale target",
+			replace: func() client.Object {
+				va := variantAutoscaling("llama-a100", "meta/llama0-70b", "0.75")
+				va.Spec.LinkedScaleTargets = []llmdVariantAutoscalingV1alpha1.LinkedScaleTarget{{Name: "llama-a100", Ratio: "0.5"}}
+				return va
+			}(),
+			expectError: "linkedScaleTargets: llama-a100 is linked more than once or is the scale target",
+		},
+		{
+			name: "non-positive linked scale target ratio",
+			replace: func() client.Object {
+				va := variantAutoscaling("llama-a100", "meta/llama0-70b", "0.75")
+				va.Spec.LinkedScaleTargets = []llmdVariantAutoscalingV1alpha1.LinkedScaleTarget{{Name: "router", Ratio: "0"}}
+				return va
+			}(),
+			expectError: "linkedScaleTargets:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objs []client.Object
+			for _, obj := range validFixtures() {
+				if obj.GetName() == tt.replace.GetName() {
+					obj = tt.replace
+				}
+				objs = append(objs, obj)
+			}
+
+			code, report := runValidation(t, objs...)
+			assert.Equal(t, 1, code, report)
+			assert.Contains(t, report, tt.expectError)
+			assert.Contains(t, report, "Validation failed: 1 error(s)")
+		})
+	}
+}
+
+func TestDefaultOptions_FollowsHelmConfigMapNames(t *testing.T) {
+	t.Setenv("POD_NAMESPACE", testNamespace)
+	t.Setenv(config.AcceleratorCostsConfigMapNameEnvVar, "wva-workload-variant-autoscaler-accelerator-unit-costs")
+	t.Setenv("SATURATION_CONFIG_MAP_NAME", "")
+
+	opts := DefaultOptions()
+	assert.Equal(t, testNamespace, opts.Namespace)
+	assert.Equal(t, "wva-workload-variant-autoscaler-accelerator-unit-costs", opts.AcceleratorCostsConfigMapName)
+	assert.Equal(t, config.DefaultSaturationConfigMapName, opts.SaturationConfigMapName)
+}
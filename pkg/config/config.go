@@ -39,3 +39,43 @@ func SaturatedAllocationPolicyEnum(s string) SaturatedAllocationPolicy {
 		return DefaultSaturatedAllocationPolicy
 	}
 }
+
+// options for allocation under zero load (no arrival rate)
+type ZeroLoadPolicy int
+
+const (
+	MinReplicas ZeroLoadPolicy = iota // 0 : keep the server's minimum number of replicas
+	ScaleToZero                       // 1 : release all replicas, regardless of the minimum
+	OneReplica                        // 2 : keep at least one replica to serve the first requests
+	KeepWarm                          // 3 : keep the current number of replicas, at least the minimum
+)
+
+func (p ZeroLoadPolicy) String() string {
+	switch p {
+	case MinReplicas:
+		return "MinReplicas"
+	case ScaleToZero:
+		return "ScaleToZero"
+	case OneReplica:
+		return "OneReplica"
+	case KeepWarm:
+		return "KeepWarm"
+	default:
+		return "Unknown"
+	}
+}
+
+func ZeroLoadPolicyEnum(s string) ZeroLoadPolicy {
+	switch s {
+	case "MinReplicas":
+		return MinReplicas
+	case "ScaleToZero":
+		return ScaleToZero
+	case "OneReplica":
+		return OneReplica
+	case "KeepWarm":
+		return KeepWarm
+	default:
+		return DefaultZeroLoadPolicy
+	}
+}
@@ -116,3 +116,33 @@ func TestSaturatedAllocationPolicy_RoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestZeroLoadPolicy_RoundTrip(t *testing.T) {
+	policies := []ZeroLoadPolicy{
+		MinReplicas,
+		ScaleToZero,
+		OneReplica,
+		KeepWarm,
+	}
+
+	for _, policy := range policies {
+		t.Run(policy.String(), func(t *testing.T) {
+			str := policy.String()
+			roundTrip := ZeroLoadPolicyEnum(str)
+			if roundTrip != policy {
+				t.Errorf("Round trip failed: %v -> %v -> %v", policy, str, roundTrip)
+			}
+		})
+	}
+}
+
+func TestZeroLoadPolicyEnum_Default(t *testing.T) {
+	for _, input := range []string{"", "keepwarm", "InvalidPolicy"} {
+		if got := ZeroLoadPolicyEnum(input); got != DefaultZeroLoadPolicy {
+			t.Errorf("ZeroLoadPolicyEnum(%q) = %v, want %v", input, got, DefaultZeroLoadPolicy)
+		}
+	}
+	if got := ZeroLoadPolicy(999).String(); got != "Unknown" {
+		t.Errorf("ZeroLoadPolicy(999).String() = %v, want Unknown", got)
+	}
+}
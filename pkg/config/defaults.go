@@ -2,6 +2,7 @@ package config
 
 import (
 	"math"
+	"time"
 )
 
 /**
@@ -34,3 +35,12 @@ const DefaultServiceClassPriority int = DefaultLowPriority
 
 // default option for allocation under saturated condition
 var DefaultSaturatedAllocationPolicy SaturatedAllocationPolicy = None
+
+// default option for allocation under zero load
+var DefaultZeroLoadPolicy ZeroLoadPolicy = MinReplicas
+
+// time to live of a cached allocation
+var AllocationCacheTTL = 5 * time.Minute
+
+// arrival rate resolution (req/min) used when matching cached allocations
+var AllocationCacheRateResolution = float32(0.1)
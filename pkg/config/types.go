@@ -116,6 +116,7 @@ type ServerSpec struct {
 	KeepAccelerator bool           `json:"keepAccelerator"` // option to not change accelerator
 	MinNumReplicas  int            `json:"minNumReplicas"`  // minimum number of replicas
 	MaxBatchSize    int            `json:"maxBatchSize"`    // overriding value for the maximum batch size
+	ZeroLoadPolicy  string         `json:"zeroLoadPolicy"`  // allocation policy under zero load
 	CurrentAlloc    AllocationData `json:"currentAlloc"`    // current allocation
 	DesiredAlloc    AllocationData `json:"desiredAlloc"`    // desired allocation
 }
@@ -23,8 +23,24 @@ type Allocation struct {
 	maxArrvRatePerReplica float32 // maximum arrival rate per replica (req/msec)
 }
 
-// Create an allocation of an accelerator to a server; nil if not feasible
+// Create an allocation of an accelerator to a server; nil if not feasible.
+// Results are cached by workload signature, so repeated calls with an unchanged
+// workload skip the queueing analysis.
 func CreateAllocation(serverName string, gName string) *Allocation {
+	key, ok := allocationCacheKey(serverName, gName)
+	if !ok {
+		return createAllocation(serverName, gName)
+	}
+	if alloc, hit := TheAllocationCache.Get(key); hit {
+		return alloc
+	}
+	alloc := createAllocation(serverName, gName)
+	TheAllocationCache.Set(key, alloc)
+	return alloc
+}
+
+// Calculate an allocation of an accelerator to a server; nil if not feasible
+func createAllocation(serverName string, gName string) *Allocation {
 	var (
 		acc *Accelerator
 
@@ -258,7 +274,7 @@ func (a *Allocation) Saturated(totalRate float32) bool {
 // Allocation in case of zero load
 func zeroLoadAllocation(server *Server, model *Model, acc *Accelerator, perf *config.ModelAcceleratorPerfData) *Allocation {
 
-	numReplicas := server.minNumReplicas
+	numReplicas := server.zeroLoadReplicas()
 	gName := acc.Name()
 	if numReplicas == 0 {
 		alloc := &Allocation{accelerator: "", numReplicas: 0, batchSize: 0,
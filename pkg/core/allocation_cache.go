@@ -0,0 +1,186 @@
+package core
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/pkg/config"
+)
+
+var (
+	// a static reference to the singleton allocation cache; its time to live follows
+	// config.AllocationCacheTTL, so it can be changed (or caching disabled) after package init
+	TheAllocationCache = newAllocationCache(func() time.Duration { return config.AllocationCacheTTL })
+)
+
+// Signature of the inputs to CreateAllocation.
+// The workload (model, accelerator, arrival rate, token lengths) is what changes between
+// optimization runs; the remaining fields make sure a change in performance data, SLO
+// targets, cost, or server limits is never answered from the cache.
+type AllocationCacheKey struct {
+	Model        string
+	Accelerator  string
+	ArrivalRate  float32 // rounded to config.AllocationCacheRateResolution
+	AvgInTokens  int
+	AvgOutTokens int
+
+	zeroLoad       bool
+	serviceClass   string
+	target         Target
+	perf           config.ModelAcceleratorPerfData
+	cost           float32
+	numInstances   int
+	minNumReplicas int
+	maxBatchSize   int
+
+	zeroLoadReplicas int
+}
+
+// Cached result of CreateAllocation; a nil allocation records an infeasible combination
+type allocationCacheEntry struct {
+	alloc   *Allocation
+	expires time.Time
+}
+
+// Cache of allocations keyed by workload signature
+type AllocationCache struct {
+	mu      sync.Mutex
+	ttl     func() time.Duration
+	now     func() time.Time
+	entries map[AllocationCacheKey]allocationCacheEntry
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// Create a new allocation cache; a non-positive ttl disables caching
+func NewAllocationCache(ttl time.Duration) *AllocationCache {
+	return newAllocationCache(func() time.Duration { return ttl })
+}
+
+// Create a new allocation cache whose time to live is read on every Get and Set
+func newAllocationCache(ttl func() time.Duration) *AllocationCache {
+	return &AllocationCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[AllocationCacheKey]allocationCacheEntry),
+	}
+}
+
+// Get a cached allocation; the returned allocation is a copy and may be modified by the caller
+func (c *AllocationCache) Get(key AllocationCacheKey) (*Allocation, bool) {
+	if c.ttl() <= 0 {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, exists := c.entries[key]
+	if !exists || !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.hits.Add(1)
+	if entry.alloc == nil {
+		return nil, true
+	}
+	return entry.alloc.Clone(), true
+}
+
+// Store an allocation (nil if not feasible)
+func (c *AllocationCache) Set(key AllocationCacheKey, alloc *Allocation) {
+	ttl := c.ttl()
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for k, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	entry := allocationCacheEntry{expires: now.Add(ttl)}
+	if alloc != nil {
+		entry.alloc = alloc.Clone()
+	}
+	c.entries[key] = entry
+}
+
+// Remove all cached allocations
+func (c *AllocationCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[AllocationCacheKey]allocationCacheEntry)
+}
+
+// Number of cached allocations, including expired ones not yet evicted
+func (c *AllocationCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Total number of cache hits
+func (c *AllocationCache) Hits() uint64 {
+	return c.hits.Load()
+}
+
+// Total number of cache misses
+func (c *AllocationCache) Misses() uint64 {
+	return c.misses.Load()
+}
+
+// Build the cache key for an allocation of an accelerator to a server; false if inputs are missing
+func allocationCacheKey(serverName string, gName string) (AllocationCacheKey, bool) {
+	acc := GetAccelerator(gName)
+	server := GetServer(serverName)
+	if acc == nil || server == nil || server.Load() == nil {
+		return AllocationCacheKey{}, false
+	}
+	model := GetModel(server.ModelName())
+	if model == nil {
+		return AllocationCacheKey{}, false
+	}
+	perf := model.PerfData(gName)
+	svc := GetServiceClass(server.ServiceClassName())
+	if perf == nil || svc == nil {
+		return AllocationCacheKey{}, false
+	}
+	target := svc.ModelTarget(model.Name())
+	if target == nil {
+		return AllocationCacheKey{}, false
+	}
+
+	load := server.Load()
+	return AllocationCacheKey{
+		Model:        model.Name(),
+		Accelerator:  gName,
+		ArrivalRate:  roundArrivalRate(load.ArrivalRate),
+		AvgInTokens:  load.AvgInTokens,
+		AvgOutTokens: load.AvgOutTokens,
+
+		zeroLoad:       load.ArrivalRate == 0 || load.AvgOutTokens == 0,
+		serviceClass:   svc.Name(),
+		target:         *target,
+		perf:           *perf,
+		cost:           acc.Cost(),
+		numInstances:   model.NumInstances(gName),
+		minNumReplicas: server.minNumReplicas,
+		maxBatchSize:   server.maxBatchSize,
+
+		zeroLoadReplicas: server.zeroLoadReplicas(),
+	}, true
+}
+
+// Round an arrival rate to the cache resolution
+func roundArrivalRate(rate float32) float32 {
+	res := config.AllocationCacheRateResolution
+	if res <= 0 {
+		return rate
+	}
+	return float32(math.Round(float64(rate/res))) * res
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/llm-d-incubation/workload-variant-autoscaler/pkg/config"
+)
+
+// Helper function to reset the global allocation cache with a controllable clock
+func setupTestAllocationCache(ttl time.Duration) (*time.Time, func()) {
+	previous := TheAllocationCache
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	TheAllocationCache = NewAllocationCache(ttl)
+	TheAllocationCache.now = func() time.Time { return now }
+	return &now, func() { TheAllocationCache = previous }
+}
+
+// Helper function to set the load of the test server
+func setTestServerLoad(arrivalRate float32, avgInTokens, avgOutTokens int) {
+	load := GetServer("test-server").Load()
+	load.ArrivalRate = arrivalRate
+	load.AvgInTokens = avgInTokens
+	load.AvgOutTokens = avgOutTokens
+}
+
+func TestAllocationCache_IdenticalInputsHit(t *testing.T) {
+	setupCompleteTestSystem()
+	_, restore := setupTestAllocationCache(time.Minute)
+	defer restore()
+
+	first := CreateAllocation("test-server", "test-gpu")
+	if first == nil {
+		t.Fatal("CreateAllocation returned nil, setup may be incorrect")
+	}
+	second := CreateAllocation("test-server", "test-gpu")
+
+	if got := TheAllocationCache.Misses(); got != 1 {
+		t.Errorf("Misses() = %d, want 1", got)
+	}
+	if got := TheAllocationCache.Hits(); got != 1 {
+		t.Errorf("Hits() = %d, want 1", got)
+	}
+	if second.String() != first.String() {
+		t.Errorf("cached allocation = %s, want %s", second, first)
+	}
+
+	// Callers modify allocations; the cached copy must not be affected
+	second.SetNumReplicas(42)
+	third := CreateAllocation("test-server", "test-gpu")
+	if third.NumReplicas() == 42 {
+		t.Error("cached allocation was modified through a returned copy")
+	}
+}
+
+func TestAllocationCache_ChangedInputsMiss(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func()
+	}{
+		{
+			name:   "arrival rate",
+			change: func() { setTestServerLoad(120, 100, 200) },
+		},
+		{
+			name:   "input tokens",
+			change: func() { setTestServerLoad(60, 300, 200) },
+		},
+		{
+			name:   "output tokens",
+			change: func() { setTestServerLoad(60, 100, 400) },
+		},
+		{
+			name:   "performance data",
+			change: func() { GetModel("test-model").PerfData("test-gpu").DecodeParms.Alpha = 6.0 },
+		},
+		{
+			name:   "service class target",
+			change: func() { GetServiceClass("default").ModelTarget("test-model").ITL = 40.0 },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupCompleteTestSystem()
+			_, restore := setupTestAllocationCache(time.Minute)
+			defer restore()
+
+			setTestServerLoad(60, 100, 200)
+			CreateAllocation("test-server", "test-gpu")
+			tt.change()
+			CreateAllocation("test-server", "test-gpu")
+
+			if got := TheAllocationCache.Hits(); got != 0 {
+				t.Errorf("Hits() = %d, want 0", got)
+			}
+			if got := TheAllocationCache.Misses(); got != 2 {
+				t.Errorf("Misses() = %d, want 2", got)
+			}
+		})
+	}
+}
+
+func TestAllocationCache_RoundedArrivalRateHits(t *testing.T) {
+	setupCompleteTestSystem()
+	_, restore := setupTestAllocationCache(time.Minute)
+	defer restore()
+
+	setTestServerLoad(60.01, 100, 200)
+	CreateAllocation("test-server", "test-gpu")
+	setTestServerLoad(60.02, 100, 200)
+	CreateAllocation("test-server", "test-gpu")
+
+	if got := TheAllocationCache.Hits(); got != 1 {
+		t.Errorf("Hits() = %d, want 1", got)
+	}
+}
+
+func TestAllocationCache_TTL(t *testing.T) {
+	setupCompleteTestSystem()
+	now, restore := setupTestAllocationCache(time.Minute)
+	defer restore()
+
+	CreateAllocation("test-server", "test-gpu")
+	*now = now.Add(30 * time.Second)
+	CreateAllocation("test-server", "test-gpu")
+	if got := TheAllocationCache.Hits(); got != 1 {
+		t.Errorf("Hits() before expiry = %d, want 1", got)
+	}
+
+	*now = now.Add(31 * time.Second)
+	CreateAllocation("test-server", "test-gpu")
+	if got := TheAllocationCache.Misses(); got != 2 {
+		t.Errorf("Misses() after expiry = %d, want 2", got)
+	}
+}
+
+func TestAllocationCache_Disabled(t *testing.T) {
+	setupCompleteTestSystem()
+	_, restore := setupTestAllocationCache(0)
+	defer restore()
+
+	CreateAllocation("test-server", "test-gpu")
+	CreateAllocation("test-server", "test-gpu")
+
+	if TheAllocationCache.Hits() != 0 || TheAllocationCache.Len() != 0 {
+		t.Errorf("disabled cache recorded hits=%d entries=%d", TheAllocationCache.Hits(), TheAllocationCache.Len())
+	}
+}
+
+func TestAllocationCache_DisabledByConfig(t *testing.T) {
+	setupCompleteTestSystem()
+	previousTTL := config.AllocationCacheTTL
+	defer func() { config.AllocationCacheTTL = previousTTL }()
+	TheAllocationCache.Clear()
+
+	// The singleton is created at package init; setting the TTL afterwards must still disable it
+	config.AllocationCacheTTL = 0
+	hits := TheAllocationCache.Hits()
+	CreateAllocation("test-server", "test-gpu")
+	CreateAllocation("test-server", "test-gpu")
+
+	if got := TheAllocationCache.Hits(); got != hits || TheAllocationCache.Len() != 0 {
+		t.Errorf("cache disabled by config recorded hits=%d entries=%d", got-hits, TheAllocationCache.Len())
+	}
+}
+
+func TestAllocationCache_Clear(t *testing.T) {
+	setupCompleteTestSystem()
+	_, restore := setupTestAllocationCache(time.Minute)
+	defer restore()
+
+	CreateAllocation("test-server", "test-gpu")
+	TheAllocationCache.Clear()
+	CreateAllocation("test-server", "test-gpu")
+
+	if got := TheAllocationCache.Hits(); got != 0 {
+		t.Errorf("Hits() after Clear = %d, want 0", got)
+	}
+}
@@ -1182,3 +1182,68 @@ func TestZeroLoadAllocation_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestZeroLoadAllocation_Policies(t *testing.T) {
+	model := &Model{
+		name: "test-model",
+		numInstances: map[string]int{
+			"test-gpu": 1,
+		},
+	}
+	acc := &Accelerator{
+		name: "test-gpu",
+		spec: &config.AcceleratorSpec{
+			Cost: 100.0,
+		},
+	}
+	perf := &config.ModelAcceleratorPerfData{
+		MaxBatchSize: 16,
+		DecodeParms:  config.DecodeParms{Alpha: 5.0, Beta: 2.0},
+		PrefillParms: config.PrefillParms{Gamma: 10.0, Delta: 1.5},
+	}
+	warm := &Allocation{accelerator: "test-gpu", numReplicas: 3}
+
+	tests := []struct {
+		name           string
+		policy         string
+		minNumReplicas int
+		curAllocation  *Allocation
+		wantAccel      string
+		wantReplicas   int
+	}{
+		{name: "default keeps minimum", policy: "", minNumReplicas: 0, curAllocation: warm, wantAccel: "", wantReplicas: 0},
+		{name: "MinReplicas keeps minimum", policy: "MinReplicas", minNumReplicas: 2, curAllocation: warm, wantAccel: "test-gpu", wantReplicas: 2},
+		{name: "ScaleToZero ignores minimum", policy: "ScaleToZero", minNumReplicas: 2, curAllocation: warm, wantAccel: "", wantReplicas: 0},
+		{name: "OneReplica without minimum", policy: "OneReplica", minNumReplicas: 0, curAllocation: warm, wantAccel: "test-gpu", wantReplicas: 1},
+		{name: "OneReplica respects higher minimum", policy: "OneReplica", minNumReplicas: 2, curAllocation: nil, wantAccel: "test-gpu", wantReplicas: 2},
+		{name: "KeepWarm keeps current replicas", policy: "KeepWarm", minNumReplicas: 1, curAllocation: warm, wantAccel: "test-gpu", wantReplicas: 3},
+		{name: "KeepWarm respects higher minimum", policy: "KeepWarm", minNumReplicas: 4, curAllocation: warm, wantAccel: "test-gpu", wantReplicas: 4},
+		{name: "KeepWarm without current allocation", policy: "KeepWarm", minNumReplicas: 0, curAllocation: nil, wantAccel: "", wantReplicas: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServerFromSpec(&config.ServerSpec{
+				Name:           "test-server",
+				Model:          "test-model",
+				MinNumReplicas: tt.minNumReplicas,
+				ZeroLoadPolicy: tt.policy,
+			})
+			server.SetCurAllocation(tt.curAllocation)
+
+			alloc := zeroLoadAllocation(server, model, acc, perf)
+			if alloc == nil {
+				t.Fatal("zeroLoadAllocation() returned nil")
+			}
+			if alloc.accelerator != tt.wantAccel {
+				t.Errorf("accelerator = %v, want %v", alloc.accelerator, tt.wantAccel)
+			}
+			if alloc.numReplicas != tt.wantReplicas {
+				t.Errorf("numReplicas = %v, want %v", alloc.numReplicas, tt.wantReplicas)
+			}
+			if wantCost := acc.Cost() * float32(tt.wantReplicas); alloc.cost != wantCost {
+				t.Errorf("cost = %v, want %v", alloc.cost, wantCost)
+			}
+		})
+	}
+}
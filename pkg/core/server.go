@@ -14,6 +14,7 @@ type Server struct {
 	keepAccelerator  bool
 	minNumReplicas   int
 	maxBatchSize     int
+	zeroLoadPolicy   config.ZeroLoadPolicy
 
 	// server load statistics
 	load *config.ServerLoadSpec
@@ -44,6 +45,7 @@ func NewServerFromSpec(spec *config.ServerSpec) *Server {
 		keepAccelerator:  spec.KeepAccelerator,
 		minNumReplicas:   spec.MinNumReplicas,
 		maxBatchSize:     spec.MaxBatchSize,
+		zeroLoadPolicy:   config.ZeroLoadPolicyEnum(spec.ZeroLoadPolicy),
 
 		allAllocations: map[string]*Allocation{},
 		curAllocation:  AllocationFromData(&spec.CurrentAlloc),
@@ -104,6 +106,27 @@ func (s *Server) KeepAccelerator() bool {
 	return s.keepAccelerator
 }
 
+func (s *Server) ZeroLoadPolicy() config.ZeroLoadPolicy {
+	return s.zeroLoadPolicy
+}
+
+// Number of replicas to allocate when the server has no load, according to its zero load policy
+func (s *Server) zeroLoadReplicas() int {
+	switch s.zeroLoadPolicy {
+	case config.ScaleToZero:
+		return 0
+	case config.OneReplica:
+		return max(s.minNumReplicas, 1)
+	case config.KeepWarm:
+		if s.curAllocation != nil {
+			return max(s.minNumReplicas, s.curAllocation.numReplicas)
+		}
+		return s.minNumReplicas
+	default:
+		return s.minNumReplicas
+	}
+}
+
 func (s *Server) Load() *config.ServerLoadSpec {
 	return s.load
 }
@@ -36,6 +36,7 @@ import (
 
 	v1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/test/utils"
 )
 
@@ -313,7 +314,7 @@ var _ = Describe("ShareGPT Scale-Up Test", Ordered, func() {
 				Eventually(func(g Gomega) {
 					result, err := k8sClient.RESTClient().
 						Get().
-						AbsPath("/apis/external.metrics.k8s.io/v1beta1/namespaces/" + model.namespace + "/" + constants.WVADesiredReplicas).
+						AbsPath(metrics.ExternalMetricsAPIPath(model.namespace, constants.WVADesiredReplicas)).
 						DoRaw(ctx)
 					g.Expect(err).NotTo(HaveOccurred(), "Should be able to query external metrics API")
 					g.Expect(string(result)).To(ContainSubstring(constants.WVADesiredReplicas), "Metric should be available")
@@ -24,6 +24,7 @@ import (
 
 	v1alpha1 "github.com/llm-d-incubation/workload-variant-autoscaler/api/v1alpha1"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/constants"
+	"github.com/llm-d-incubation/workload-variant-autoscaler/internal/metrics"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/test/utils"
 	"github.com/llm-d-incubation/workload-variant-autoscaler/test/utils/resources"
 	. "github.com/onsi/ginkgo/v2"
@@ -236,7 +237,7 @@ retention_period: %s`, modelName, retentionPeriodShort),
 			Eventually(func(g Gomega) {
 				result, err := k8sClient.RESTClient().
 					Get().
-					AbsPath("/apis/external.metrics.k8s.io/v1beta1/namespaces/" + namespace + "/" + constants.WVADesiredReplicas).
+					AbsPath(metrics.ExternalMetricsAPIPath(namespace, constants.WVADesiredReplicas)).
 					DoRaw(ctx)
 				g.Expect(err).NotTo(HaveOccurred(), "Should be able to query external metrics API")
 				g.Expect(string(result)).To(ContainSubstring(constants.WVADesiredReplicas), "Metric should be available")